@@ -253,6 +253,16 @@ controllers:
   shootStatus:
     concurrentSyncs: {{ required ".Values.config.controllers.shootStatus.concurrentSyncs is required" .Values.config.controllers.shootStatus.concurrentSyncs }}
   {{- end }}
+  {{- if .Values.config.controllers.shootDiagnostics }}
+  shootDiagnostics:
+    concurrentSyncs: {{ required ".Values.config.controllers.shootDiagnostics.concurrentSyncs is required" .Values.config.controllers.shootDiagnostics.concurrentSyncs }}
+    bundleTTL: {{ required ".Values.config.controllers.shootDiagnostics.bundleTTL is required" .Values.config.controllers.shootDiagnostics.bundleTTL }}
+  {{- end }}
+  {{- if .Values.config.controllers.shootAlertSilence }}
+  shootAlertSilence:
+    concurrentSyncs: {{ required ".Values.config.controllers.shootAlertSilence.concurrentSyncs is required" .Values.config.controllers.shootAlertSilence.concurrentSyncs }}
+    syncPeriod: {{ required ".Values.config.controllers.shootAlertSilence.syncPeriod is required" .Values.config.controllers.shootAlertSilence.syncPeriod }}
+  {{- end }}
   {{- if .Values.config.controllers.managedSeed }}
   managedSeed:
     concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -365,6 +375,10 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.config.tracing }}
+tracing:
+{{ toYaml .Values.config.tracing | indent 2 }}
+{{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
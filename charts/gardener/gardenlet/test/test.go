@@ -772,6 +772,14 @@ func ComputeExpectedGardenletConfiguration(
 			ShootStatus: &gardenletconfigv1alpha1.ShootStatusControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
+			ShootDiagnostics: &gardenletconfigv1alpha1.ShootDiagnosticsControllerConfiguration{
+				ConcurrentSyncs: &five,
+				BundleTTL:       &metav1.Duration{Duration: 15 * time.Minute},
+			},
+			ShootAlertSilence: &gardenletconfigv1alpha1.ShootAlertSilenceControllerConfiguration{
+				ConcurrentSyncs: &five,
+				SyncPeriod:      &metav1.Duration{Duration: 10 * time.Minute},
+			},
 			TokenRequestorServiceAccount: &gardenletconfigv1alpha1.TokenRequestorServiceAccountControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
@@ -148,6 +148,10 @@ config.yaml: |
   nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 4 }}
   {{- end }}
+  {{- if .Values.config.tracing }}
+  tracing:
+{{ toYaml .Values.config.tracing | indent 4 }}
+  {{- end }}
 {{- end -}}
 
 {{- define "operator.config.name" -}}
@@ -277,6 +277,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			localworker.DefaultAddOptions.SelfHostedShootCluster = generalOpts.Completed().SelfHostedShootCluster
 			localBackupBucketOptions.Completed().Apply(&localbackupbucket.DefaultAddOptions)
 			localBackupBucketOptions.Completed().Apply(&localbackupentry.DefaultAddOptions)
+			localbastion.DefaultAddOptions.BackupBucketPath = localBackupBucketOptions.Completed().BackupBucketPath
 			heartbeatCtrlOptions.Completed().Apply(&heartbeat.DefaultAddOptions)
 			prometheusWebhookOptions.Completed().Apply(&prometheuswebhook.DefaultAddOptions)
 
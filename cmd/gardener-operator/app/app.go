@@ -38,6 +38,7 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -71,6 +72,28 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *operatorconfigv1alpha1.OperatorConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+			ServiceName:        "gardener-operator",
+			Endpoint:           cfg.Tracing.Endpoint,
+			Insecure:           cfg.Tracing.Insecure,
+			SamplingPercentage: ptr.Deref(cfg.Tracing.SamplingPercentage, 100),
+		})
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Error(err, "Failed shutting down tracing")
+			}
+		}()
+	}
+
 	log.Info("Getting rest config")
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.RuntimeClientConnection.Kubeconfig = kubeconfig
@@ -72,6 +72,7 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Name is a const for the name of this component.
@@ -105,6 +106,28 @@ func NewCommand() *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *gardenletconfigv1alpha1.GardenletConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if cfg.Tracing != nil {
+		log.Info("Setting up tracing", "endpoint", cfg.Tracing.Endpoint)
+		shutdownTracing, err := tracing.Setup(ctx, tracing.Options{
+			ServiceName:        "gardenlet",
+			Endpoint:           cfg.Tracing.Endpoint,
+			Insecure:           cfg.Tracing.Insecure,
+			SamplingPercentage: ptr.Deref(cfg.Tracing.SamplingPercentage, 100),
+		})
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Error(err, "Failed shutting down tracing")
+			}
+		}()
+	}
+
 	if kubeconfig := os.Getenv("GARDEN_KUBECONFIG"); kubeconfig != "" {
 		cfg.GardenClientConnection.Kubeconfig = kubeconfig
 	}
@@ -20,8 +20,59 @@ type HealthCheckConfig struct {
 	// ShootRESTOptions allow overwriting certain default settings of the shoot rest.Config.
 	// +optional
 	ShootRESTOptions *RESTOptions `json:"shootRESTOptions,omitempty"`
+	// DeclarativeHealthChecks is a list of health checks defined by object selectors and CEL expressions. They are
+	// evaluated by the health check controller in addition to the health checks implemented in Go. This way,
+	// extensions can report the health of the objects they manage without implementing health checks.
+	// +optional
+	DeclarativeHealthChecks []DeclarativeHealthCheck `json:"declarativeHealthChecks,omitempty"`
+}
+
+// DeclarativeHealthCheck defines a health check for the objects of a certain kind.
+type DeclarativeHealthCheck struct {
+	// ExtensionKind is the kind of the extension resource whose health condition the check contributes to, e.g.
+	// `Extension`, `ControlPlane` or `Worker`.
+	ExtensionKind string `json:"extensionKind"`
+	// ConditionType is the type of the health condition the check contributes to, e.g. `ControlPlaneHealthy`.
+	ConditionType string `json:"conditionType"`
+	// Cluster is the cluster containing the checked objects. Defaults to `seed`.
+	// +optional
+	Cluster *HealthCheckCluster `json:"cluster,omitempty"`
+	// APIVersion is the API version of the checked objects, e.g. `apps/v1`.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the checked objects, e.g. `Deployment`.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the checked objects. Defaults to the namespace of the extension resource in the
+	// seed cluster and to `kube-system` in the shoot cluster.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// Name is the name of the checked object. Exactly one of Name and LabelSelector must be set.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// LabelSelector selects the checked objects. Exactly one of Name and LabelSelector must be set.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Rule is a CEL expression which is evaluated for every checked object. The object is available as variable
+	// `object`. It is healthy if the expression evaluates to true.
+	Rule string `json:"rule"`
+	// Message is a CEL expression evaluating to the detail which is reported if the rule evaluates to false. The object
+	// is available as variable `object`. Defaults to a message containing the object and the rule.
+	// +optional
+	Message *string `json:"message,omitempty"`
+	// MinObjects is the minimum number of checked objects which must exist. Defaults to 1.
+	// +optional
+	MinObjects *int32 `json:"minObjects,omitempty"`
 }
 
+// HealthCheckCluster is the cluster containing the objects checked by a health check.
+type HealthCheckCluster string
+
+const (
+	// HealthCheckClusterSeed is the seed cluster (or the runtime cluster for garden extensions).
+	HealthCheckClusterSeed HealthCheckCluster = "seed"
+	// HealthCheckClusterShoot is the shoot cluster.
+	HealthCheckClusterShoot HealthCheckCluster = "shoot"
+)
+
 // RESTOptions define a subset of optional parameters for a rest.Config.
 // Default values when unset are those from https://github.com/kubernetes/client-go/blob/master/rest/config.go.
 type RESTOptions struct {
@@ -11,8 +11,56 @@ package v1alpha1
 
 import (
 	time "time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeclarativeHealthCheck) DeepCopyInto(out *DeclarativeHealthCheck) {
+	*out = *in
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(HealthCheckCluster)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinObjects != nil {
+		in, out := &in.MinObjects, &out.MinObjects
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeclarativeHealthCheck.
+func (in *DeclarativeHealthCheck) DeepCopy() *DeclarativeHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(DeclarativeHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 	*out = *in
@@ -22,6 +70,13 @@ func (in *HealthCheckConfig) DeepCopyInto(out *HealthCheckConfig) {
 		*out = new(RESTOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeclarativeHealthChecks != nil {
+		in, out := &in.DeclarativeHealthChecks, &out.DeclarativeHealthChecks
+		*out = make([]DeclarativeHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	sessionEventStart = "start"
+	sessionEventEnd   = "end"
+	// sessionLogEmptyField is the placeholder for empty fields in the session log.
+	sessionLogEmptyField = "-"
+)
+
+// ObjectKeyPrefix returns the prefix of the keys of the objects in the backup bucket in which the session log and
+// transcripts of the given Bastion are stored. It returns an empty string if session recording is not enabled.
+func ObjectKeyPrefix(bastion *extensionsv1alpha1.Bastion) string {
+	if bastion.Spec.SessionRecording == nil {
+		return ""
+	}
+	return path.Join(bastion.Spec.SessionRecording.BackupEntryName, "bastions", bastion.Name, string(bastion.UID))
+}
+
+// SessionLogObjectKey returns the key of the object in the backup bucket in which the session log of the given Bastion
+// is stored.
+func SessionLogObjectKey(bastion *extensionsv1alpha1.Bastion) string {
+	return path.Join(ObjectKeyPrefix(bastion), path.Base(extensionsv1alpha1.BastionSessionLogPath))
+}
+
+// TranscriptObjectKey returns the key of the object in the backup bucket in which the given transcript file (as
+// referenced in the session log) of the given Bastion is stored.
+func TranscriptObjectKey(bastion *extensionsv1alpha1.Bastion, transcript string) string {
+	return path.Join(ObjectKeyPrefix(bastion), "transcripts", path.Base(transcript))
+}
+
+// ParseSessionLog parses the session log written by a bastion host with session recording enabled (see
+// extensionsv1alpha1.BastionSessionLogPath) and returns the recorded sessions ordered by their start. Each line of the
+// log has the format `<RFC3339 timestamp> <start|end> <session ID> <source address> <node> <transcript>`, where empty
+// fields are written as `-`.
+func ParseSessionLog(bastion *extensionsv1alpha1.Bastion, data []byte) ([]extensionsv1alpha1.BastionSession, error) {
+	var (
+		sessions []extensionsv1alpha1.BastionSession
+		indices  = map[string]int{}
+		scanner  = bufio.NewScanner(bytes.NewReader(data))
+	)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("invalid session log line %d: expected 6 fields but got %d", lineNumber, len(fields))
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp in session log line %d: %w", lineNumber, err)
+		}
+		timestamp = timestamp.UTC()
+
+		var (
+			event = fields[1]
+			id    = fields[2]
+		)
+
+		switch event {
+		case sessionEventStart:
+			if _, ok := indices[id]; ok {
+				return nil, fmt.Errorf("duplicate start of session %s in session log line %d", id, lineNumber)
+			}
+
+			session := extensionsv1alpha1.BastionSession{
+				ID:        id,
+				Source:    fields[3],
+				StartTime: metav1.NewTime(timestamp),
+			}
+			if node := fields[4]; node != sessionLogEmptyField {
+				session.Node = node
+			}
+			if transcript := fields[5]; transcript != sessionLogEmptyField {
+				session.Transcript = ptr.To(TranscriptObjectKey(bastion, transcript))
+			}
+
+			indices[id] = len(sessions)
+			sessions = append(sessions, session)
+
+		case sessionEventEnd:
+			idx, ok := indices[id]
+			if !ok {
+				return nil, fmt.Errorf("end of unknown session %s in session log line %d", id, lineNumber)
+			}
+			sessions[idx].EndTime = ptr.To(metav1.NewTime(timestamp))
+
+		default:
+			return nil, fmt.Errorf("invalid event %q in session log line %d", event, lineNumber)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed reading session log: %w", err)
+	}
+
+	return sessions, nil
+}
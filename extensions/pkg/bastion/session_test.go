@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/extensions/pkg/bastion"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("Session", func() {
+	var bastion *extensionsv1alpha1.Bastion
+
+	BeforeEach(func() {
+		bastion = &extensionsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "shoot--foo--bar",
+				UID:       "1234",
+			},
+			Spec: extensionsv1alpha1.BastionSpec{
+				SessionRecording: &extensionsv1alpha1.BastionSessionRecording{
+					BackupEntryName: "shoot--foo--bar--5678",
+					Transcripts:     true,
+				},
+			},
+		}
+	})
+
+	Describe("#ObjectKeyPrefix", func() {
+		It("should return an empty prefix if session recording is disabled", func() {
+			bastion.Spec.SessionRecording = nil
+
+			Expect(ObjectKeyPrefix(bastion)).To(BeEmpty())
+		})
+
+		It("should return the prefix in the directory of the backup entry", func() {
+			Expect(ObjectKeyPrefix(bastion)).To(Equal("shoot--foo--bar--5678/bastions/foo/1234"))
+		})
+	})
+
+	Describe("#SessionLogObjectKey", func() {
+		It("should return the key of the session log", func() {
+			Expect(SessionLogObjectKey(bastion)).To(Equal("shoot--foo--bar--5678/bastions/foo/1234/sessions.log"))
+		})
+	})
+
+	Describe("#TranscriptObjectKey", func() {
+		It("should return the key of the transcript", func() {
+			Expect(TranscriptObjectKey(bastion, "abcd.log")).To(Equal("shoot--foo--bar--5678/bastions/foo/1234/transcripts/abcd.log"))
+		})
+
+		It("should not allow escaping the transcripts directory", func() {
+			Expect(TranscriptObjectKey(bastion, "../../../other.log")).To(Equal("shoot--foo--bar--5678/bastions/foo/1234/transcripts/other.log"))
+		})
+	})
+
+	Describe("#ParseSessionLog", func() {
+		var start time.Time
+
+		BeforeEach(func() {
+			start = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+		})
+
+		It("should return no sessions for an empty log", func() {
+			Expect(ParseSessionLog(bastion, nil)).To(BeEmpty())
+		})
+
+		It("should parse forwarded and interactive sessions", func() {
+			data := []byte(`2026-10-19T08:00:00+00:00 start 1 1.2.3.4 10.250.0.2 -
+2026-10-19T08:01:00+00:00 start 2 1.2.3.4 - 2.log
+
+2026-10-19T08:02:00+00:00 end 1 1.2.3.4 10.250.0.2 -
+`)
+
+			Expect(ParseSessionLog(bastion, data)).To(Equal([]extensionsv1alpha1.BastionSession{
+				{
+					ID:        "1",
+					Source:    "1.2.3.4",
+					Node:      "10.250.0.2",
+					StartTime: metav1.NewTime(start),
+					EndTime:   ptr.To(metav1.NewTime(start.Add(2 * time.Minute))),
+				},
+				{
+					ID:         "2",
+					Source:     "1.2.3.4",
+					StartTime:  metav1.NewTime(start.Add(time.Minute)),
+					Transcript: ptr.To("shoot--foo--bar--5678/bastions/foo/1234/transcripts/2.log"),
+				},
+			}))
+		})
+
+		DescribeTable("should fail for invalid logs",
+			func(data, expectedError string) {
+				sessions, err := ParseSessionLog(bastion, []byte(data))
+				Expect(err).To(MatchError(ContainSubstring(expectedError)))
+				Expect(sessions).To(BeNil())
+			},
+
+			Entry("wrong number of fields", "2026-10-19T08:00:00+00:00 start 1 1.2.3.4", "expected 6 fields but got 4"),
+			Entry("invalid timestamp", "yesterday start 1 1.2.3.4 - -", "invalid timestamp in session log line 1"),
+			Entry("invalid event", "2026-10-19T08:00:00+00:00 pause 1 1.2.3.4 - -", `invalid event "pause"`),
+			Entry("duplicate start", "2026-10-19T08:00:00+00:00 start 1 1.2.3.4 - -\n2026-10-19T08:00:00+00:00 start 1 1.2.3.4 - -", "duplicate start of session 1 in session log line 2"),
+			Entry("end of unknown session", "2026-10-19T08:00:00+00:00 end 1 1.2.3.4 - -", "end of unknown session 1"),
+		)
+	})
+})
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generic
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Actuator acts upon extension resources of type T. It is the only interface an extension kind has to implement in
+// order to be reconciled by the generic reconciler. Additional operations are supported by implementing the optional
+// capability interfaces [Migrator], [Restorer], [ForceDeleter], [Planner] and [HealthChecker].
+//
+// The cluster passed to the actuator is nil if the extension resource does not reside in a shoot namespace.
+// Changes the actuator applies to the status of the passed object are persisted together with the last operation.
+type Actuator[T extensionsv1alpha1.Object] interface {
+	// Reconcile reconciles the extension resource.
+	Reconcile(context.Context, logr.Logger, T, *extensionscontroller.Cluster) error
+	// Delete is invoked when the extension resource is deleted.
+	Delete(context.Context, logr.Logger, T, *extensionscontroller.Cluster) error
+}
+
+// Migrator can optionally be implemented by an [Actuator] in order to prepare the extension resource for the migration
+// to another seed. If it is not implemented, the migrate operation only removes the finalizer.
+type Migrator[T extensionsv1alpha1.Object] interface {
+	// Migrate prepares the extension resource for migration.
+	Migrate(context.Context, logr.Logger, T, *extensionscontroller.Cluster) error
+}
+
+// Restorer can optionally be implemented by an [Actuator] in order to restore the extension resource from a previously
+// saved state. If it is not implemented, the restore operation invokes [Actuator.Reconcile].
+type Restorer[T extensionsv1alpha1.Object] interface {
+	// Restore restores the extension resource from a previously saved state.
+	Restore(context.Context, logr.Logger, T, *extensionscontroller.Cluster) error
+}
+
+// ForceDeleter can optionally be implemented by an [Actuator] in order to support the forceful deletion of shoots.
+// If it is not implemented, [Actuator.Delete] is invoked when the shoot is forcefully deleted.
+type ForceDeleter[T extensionsv1alpha1.Object] interface {
+	// ForceDelete is invoked when the shoot associated with the extension resource is deleted forcefully.
+	ForceDelete(context.Context, logr.Logger, T, *extensionscontroller.Cluster) error
+}
+
+// Planner can optionally be implemented by an [Actuator] in order to support the `plan` operation, i.e., the
+// computation of the changes a reconciliation would perform. The operation is only supported for extension kinds
+// whose status has a .plan field (currently Infrastructure and Worker).
+type Planner[T extensionsv1alpha1.Object] interface {
+	// Plan returns the changes which would be performed when reconciling the extension resource.
+	Plan(context.Context, logr.Logger, T, *extensionscontroller.Cluster) ([]extensionsv1alpha1.PlannedChange, error)
+}
+
+// HealthChecker can optionally be implemented by an [Actuator] in order to register a health check controller for the
+// extension kind which executes the returned health checks.
+type HealthChecker interface {
+	// HealthChecks returns the health checks mapped to the condition types they contribute to.
+	HealthChecks() []healthcheck.ConditionTypeToHealthCheck
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+)
+
+// AddArgs are arguments for adding a generic controller for extension resources of type T to a manager.
+type AddArgs[T extensionsv1alpha1.Object] struct {
+	// Actuator is the actuator for the extension resources.
+	Actuator Actuator[T]
+	// ControllerName is the name of the controller. Defaults to the lower-cased kind of the extension resources.
+	ControllerName string
+	// FinalizerName is the name of the finalizer. Defaults to `extensions.gardener.cloud/<lower-cased kind>`.
+	FinalizerName string
+	// ControllerOptions are the controller options used for creating a controller.
+	// The options.Reconciler is always overridden with a reconciler created from the
+	// given actuator.
+	ControllerOptions controller.Options
+	// Predicates are the predicates to use.
+	// If unset, the DefaultPredicates are used.
+	Predicates []predicate.Predicate
+	// Type is the type of the resource considered for reconciliation.
+	Type string
+	// WatchBuilder defines additional watches on controllers that should be set up.
+	WatchBuilder extensionscontroller.WatchBuilder
+	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
+	// If the annotation is not ignored, the extension controller will only reconcile
+	// with a present operation annotation typically set during a reconcile (e.g. in the maintenance time) by the Gardenlet
+	IgnoreOperationAnnotation bool
+	// ExtensionClasses defines the extension classes this controller is responsible for.
+	ExtensionClasses []extensionsv1alpha1.ExtensionClass
+	// KnownCodes is a map of known error codes and their respective error check functions.
+	KnownCodes map[gardencorev1beta1.ErrorCode]func(string) bool
+	// HealthCheck contains the arguments for the health check controller. If set, a health check controller is added
+	// which executes the health checks of the actuator (if it implements HealthChecker) and the declarative health
+	// checks configured for the extension kind.
+	HealthCheck *healthcheck.DefaultAddArgs
+}
+
+// DefaultPredicates returns the default predicates for a generic reconciler. The predicates react on the `plan`
+// operation annotation if the actuator implements Planner.
+func DefaultPredicates[T extensionsv1alpha1.Object](ctx context.Context, mgr manager.Manager, actuator Actuator[T], ignoreOperationAnnotation bool) []predicate.Predicate {
+	if _, ok := actuator.(Planner[T]); ok {
+		return extensionspredicate.DefaultPlanningControllerPredicates(ignoreOperationAnnotation, extensionspredicate.ShootNotFailedPredicate(ctx, mgr))
+	}
+	return extensionspredicate.DefaultControllerPredicates(ignoreOperationAnnotation, extensionspredicate.ShootNotFailedPredicate(ctx, mgr))
+}
+
+// Add creates a new generic controller for extension resources of type T and adds it to the Manager.
+func Add[T extensionsv1alpha1.Object](ctx context.Context, mgr manager.Manager, args AddArgs[T]) error {
+	kind := kindOf[T]()
+
+	if args.ControllerName == "" {
+		args.ControllerName = strings.ToLower(kind)
+	}
+	if args.Predicates == nil {
+		args.Predicates = DefaultPredicates(ctx, mgr, args.Actuator, args.IgnoreOperationAnnotation)
+	}
+	predicates := predicateutils.AddTypeAndClassPredicates(args.Predicates, args.ExtensionClasses, args.Type)
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(args.ControllerName).
+		WithOptions(args.ControllerOptions).
+		Watches(
+			newObject[T](),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(predicates...),
+		).
+		Build(NewReconciler(mgr, args.Actuator, args.FinalizerName, args.KnownCodes))
+	if err != nil {
+		return err
+	}
+
+	gvk, err := apiutil.GVKForObject(newObject[T](), mgr.GetScheme())
+	if err != nil {
+		return fmt.Errorf("failed determining GroupVersionKind of %s: %w", kind, err)
+	}
+
+	newObjList := func() client.ObjectList {
+		obj, err := mgr.GetScheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err != nil {
+			return nil
+		}
+		objList, _ := obj.(client.ObjectList)
+		return objList
+	}
+	if newObjList() == nil {
+		return fmt.Errorf("list type of %s is not registered in the scheme", kind)
+	}
+
+	// do not watch cluster if respect operation annotation to prevent unwanted reconciliations in case the operation
+	// annotation is already present & the extension CRD is already deleting
+	if args.IgnoreOperationAnnotation {
+		if err := c.Watch(source.Kind[client.Object](
+			mgr.GetCache(),
+			&extensionsv1alpha1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(mapper.ClusterToObjectMapper(mgr.GetClient(), newObjList, predicates)),
+		)); err != nil {
+			return err
+		}
+	}
+
+	if args.HealthCheck != nil {
+		var healthChecks []healthcheck.ConditionTypeToHealthCheck
+		if healthChecker, ok := args.Actuator.(HealthChecker); ok {
+			healthChecks = healthChecker.HealthChecks()
+		}
+
+		if err := healthcheck.DefaultRegistration(
+			args.Type,
+			gvk,
+			newObjList,
+			func() extensionsv1alpha1.Object { return newObject[T]() },
+			mgr,
+			*args.HealthCheck,
+			nil,
+			healthChecks,
+			nil,
+		); err != nil {
+			return fmt.Errorf("failed adding health check controller for %s: %w", kind, err)
+		}
+	}
+
+	// Add additional watches to the controller besides the standard one.
+	return args.WatchBuilder.AddToController(c)
+}
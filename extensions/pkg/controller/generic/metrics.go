@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generic
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// MetricsNamespace is the metric namespace for the generic extension reconciler.
+const MetricsNamespace = "extension"
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// OperationDurationSeconds defines the histogram extension_operation_duration_seconds.
+	OperationDurationSeconds = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of operations on extension resources in seconds.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+		},
+		[]string{
+			"kind",
+			"type",
+			"operation",
+			"result",
+		},
+	)
+
+	// OperationErrorsTotal defines the counter extension_operation_errors_total.
+	OperationErrorsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "operation_errors_total",
+			Help:      "Total number of failed operations on extension resources by error code.",
+		},
+		[]string{
+			"kind",
+			"type",
+			"operation",
+			"code",
+		},
+	)
+)
+
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+
+	// codeUnknown is used as code label for errors which could not be classified.
+	codeUnknown = "unknown"
+)
@@ -0,0 +1,517 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+// TracerName is the name of the tracer used for the spans of the generic reconciler.
+const TracerName = "github.com/gardener/gardener/extensions/pkg/controller/generic"
+
+// operationPlan is the operation label value used for the plan operation which has no last operation type.
+const operationPlan = "Plan"
+
+type reconciler[T extensionsv1alpha1.Object] struct {
+	actuator      Actuator[T]
+	kind          string
+	finalizerName string
+	knownCodes    map[gardencorev1beta1.ErrorCode]func(string) bool
+
+	client        client.Client
+	statusUpdater extensionscontroller.StatusUpdater
+}
+
+// NewReconciler creates a new reconcile.Reconciler that reconciles extension resources of type T with the given
+// actuator. If the finalizer name is empty, the default finalizer name of the extension kind is used. Errors returned
+// by the actuator are classified with the given known codes before they are reported in the status.
+func NewReconciler[T extensionsv1alpha1.Object](
+	mgr manager.Manager,
+	actuator Actuator[T],
+	finalizerName string,
+	knownCodes map[gardencorev1beta1.ErrorCode]func(string) bool,
+) reconcile.Reconciler {
+	kind := kindOf[T]()
+	if finalizerName == "" {
+		finalizerName = DefaultFinalizerName(kind)
+	}
+
+	return reconcilerutils.OperationAnnotationWrapper(
+		mgr,
+		func() client.Object { return newObject[T]() },
+		&reconciler[T]{
+			actuator:      actuator,
+			kind:          kind,
+			finalizerName: finalizerName,
+			knownCodes:    knownCodes,
+			client:        mgr.GetClient(),
+			statusUpdater: extensionscontroller.NewStatusUpdater(mgr.GetClient()),
+		},
+	)
+}
+
+// DefaultFinalizerName returns the default finalizer name for the given extension kind.
+func DefaultFinalizerName(kind string) string {
+	return "extensions.gardener.cloud/" + strings.ToLower(kind)
+}
+
+func (r *reconciler[T]) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	obj := newObject[T]()
+	if err := r.client.Get(ctx, request.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	cluster, err := r.getCluster(ctx, obj)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if cluster != nil && extensionscontroller.IsFailed(cluster) {
+		log.Info("Skipping the reconciliation of extension resource of failed shoot", "kind", r.kind)
+		return reconcile.Result{}, nil
+	}
+
+	operationType := v1beta1helper.ComputeOperationType(
+		metav1.ObjectMeta{Annotations: obj.GetAnnotations(), DeletionTimestamp: obj.GetDeletionTimestamp()},
+		obj.GetExtensionStatus().GetLastOperation(),
+	)
+
+	switch {
+	case extensionscontroller.ShouldSkipOperation(operationType, obj):
+		return reconcile.Result{}, nil
+	case operationType == gardencorev1beta1.LastOperationTypeMigrate:
+		return r.observe(ctx, obj, string(operationType), func(ctx context.Context) error {
+			return r.migrate(ctx, log.WithValues("operation", "migrate"), obj, cluster)
+		})
+	case obj.GetDeletionTimestamp() != nil:
+		return r.observe(ctx, obj, string(gardencorev1beta1.LastOperationTypeDelete), func(ctx context.Context) error {
+			return r.delete(ctx, log.WithValues("operation", "delete"), obj, cluster)
+		})
+	case operationType == gardencorev1beta1.LastOperationTypeRestore:
+		return r.observe(ctx, obj, string(operationType), func(ctx context.Context) error {
+			return r.restore(ctx, log.WithValues("operation", "restore"), obj, cluster)
+		})
+	case obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan:
+		return r.observe(ctx, obj, operationPlan, func(ctx context.Context) error {
+			return r.plan(ctx, log.WithValues("operation", "plan"), obj, cluster)
+		})
+	default:
+		return r.observe(ctx, obj, string(operationType), func(ctx context.Context) error {
+			return r.reconcile(ctx, log.WithValues("operation", "reconcile"), obj, cluster, operationType)
+		})
+	}
+}
+
+func (r *reconciler[T]) getCluster(ctx context.Context, obj T) (*extensionscontroller.Cluster, error) {
+	if obj.GetNamespace() == "" {
+		return nil, nil
+	}
+
+	if isShootNamespace, err := gardenerutils.IsShootNamespace(ctx, r.client, obj.GetNamespace()); err != nil {
+		return nil, fmt.Errorf("error checking if %s is in a shoot namespace: %w", r.kind, err)
+	} else if !isShootNamespace {
+		return nil, nil
+	}
+
+	return extensionscontroller.GetCluster(ctx, r.client, obj.GetNamespace())
+}
+
+// observe executes the given operation within a tracing span and records its duration. If the object carries the trace
+// context of the Gardener reconciliation which deployed it, the span becomes part of that trace.
+func (r *reconciler[T]) observe(ctx context.Context, obj T, operation string, fn func(context.Context) error) (reconcile.Result, error) {
+	ctx, span := otel.Tracer(TracerName).Start(tracing.ExtractFromAnnotations(ctx, obj), r.kind+"/"+operation)
+	defer span.End()
+
+	extensionType := obj.GetExtensionSpec().GetExtensionType()
+	span.SetAttributes(
+		attribute.String("extension.kind", r.kind),
+		attribute.String("extension.type", extensionType),
+		attribute.String("extension.namespace", obj.GetNamespace()),
+		attribute.String("extension.name", obj.GetName()),
+	)
+
+	var (
+		start  = time.Now()
+		err    = fn(ctx)
+		result = resultSuccess
+	)
+
+	if err != nil {
+		result = resultError
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	OperationDurationSeconds.WithLabelValues(r.kind, extensionType, operation, result).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		return reconcilerutils.ReconcileErr(err)
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler[T]) reconcile(
+	ctx context.Context,
+	log logr.Logger,
+	obj T,
+	cluster *extensionscontroller.Cluster,
+	operationType gardencorev1beta1.LastOperationType,
+) error {
+	if err := r.addFinalizer(ctx, log, obj); err != nil {
+		return err
+	}
+
+	planner, ok := r.actuator.(Planner[T])
+	if ok && planOf(obj) != nil && extensionscontroller.IsPlanApprovalRequired(cluster) {
+		if pending, err := r.awaitPlanApproval(ctx, log, planner, obj, cluster, operationType); err != nil || pending {
+			return err
+		}
+	}
+
+	return r.runPhase(ctx, log, obj, operationType, "reconciling", "reconciled", func(ctx context.Context) error {
+		if err := r.actuator.Reconcile(ctx, log, obj, cluster); err != nil {
+			return err
+		}
+		if ok {
+			recordAppliedShootSpec(obj, cluster)
+		}
+		return nil
+	})
+}
+
+func (r *reconciler[T]) delete(
+	ctx context.Context,
+	log logr.Logger,
+	obj T,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if !controllerutil.ContainsFinalizer(obj, r.finalizerName) {
+		log.Info("Deleting extension resource causes a no-op as there is no finalizer", "kind", r.kind)
+		return nil
+	}
+
+	if err := r.runPhase(ctx, log, obj, gardencorev1beta1.LastOperationTypeDelete, "deleting", "deleted", func(ctx context.Context) error {
+		if forceDeleter, ok := r.actuator.(ForceDeleter[T]); ok && cluster != nil && v1beta1helper.ShootNeedsForceDeletion(cluster.Shoot) {
+			return forceDeleter.ForceDelete(ctx, log, obj, cluster)
+		}
+		return r.actuator.Delete(ctx, log, obj, cluster)
+	}); err != nil {
+		return err
+	}
+
+	return r.removeFinalizer(ctx, log, obj)
+}
+
+func (r *reconciler[T]) migrate(
+	ctx context.Context,
+	log logr.Logger,
+	obj T,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if err := r.runPhase(ctx, log, obj, gardencorev1beta1.LastOperationTypeMigrate, "migrating", "migrated", func(ctx context.Context) error {
+		if migrator, ok := r.actuator.(Migrator[T]); ok {
+			return migrator.Migrate(ctx, log, obj, cluster)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := r.removeFinalizer(ctx, log, obj); err != nil {
+		return err
+	}
+
+	return r.removeAnnotation(ctx, log, obj)
+}
+
+func (r *reconciler[T]) restore(
+	ctx context.Context,
+	log logr.Logger,
+	obj T,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if err := r.addFinalizer(ctx, log, obj); err != nil {
+		return err
+	}
+
+	if err := r.runPhase(ctx, log, obj, gardencorev1beta1.LastOperationTypeRestore, "restoring", "restored", func(ctx context.Context) error {
+		var err error
+		if restorer, ok := r.actuator.(Restorer[T]); ok {
+			err = restorer.Restore(ctx, log, obj, cluster)
+		} else {
+			err = r.actuator.Reconcile(ctx, log, obj, cluster)
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := r.actuator.(Planner[T]); ok {
+			recordAppliedShootSpec(obj, cluster)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return r.removeAnnotation(ctx, log, obj)
+}
+
+func (r *reconciler[T]) plan(
+	ctx context.Context,
+	log logr.Logger,
+	obj T,
+	cluster *extensionscontroller.Cluster,
+) error {
+	// The annotation is removed before computing the plan since a plan is only computed on request. Errors are reported
+	// in the plan instead of being retried.
+	if err := r.removeAnnotation(ctx, log, obj); err != nil {
+		return err
+	}
+
+	planner, ok := r.actuator.(Planner[T])
+	if !ok {
+		log.Info("Actuator does not support computing plans, skipping")
+		return nil
+	}
+
+	planField := planOf(obj)
+	if planField == nil {
+		log.Info("Extension kind does not support plans, skipping", "kind", r.kind)
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	*planField = r.computePlan(ctx, log, planner, obj, cluster)
+	if err := r.client.Status().Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed updating plan in status: %w", err)
+	}
+
+	return nil
+}
+
+// awaitPlanApproval computes the plan of the object and returns true if its changes must not be applied before the plan
+// is approved. In this case, the plan is stored in the status unless the stored plan contains the same changes (which
+// keeps its approval valid), and the last operation is set to Pending. The object is reconciled again once the Shoot is
+// annotated with the approved generation and gardenlet requests the reconciliation.
+func (r *reconciler[T]) awaitPlanApproval(
+	ctx context.Context,
+	log logr.Logger,
+	planner Planner[T],
+	obj T,
+	cluster *extensionscontroller.Cluster,
+	operationType gardencorev1beta1.LastOperationType,
+) (
+	bool,
+	error,
+) {
+	planField := planOf(obj)
+	plan := r.computePlan(ctx, log, planner, obj, cluster)
+	if !extensionscontroller.NeedsPlanApproval(plan, *planField, *appliedShootSpecHashOf(obj), cluster) {
+		return false, nil
+	}
+
+	base := obj.DeepCopyObject().(client.Object)
+	if !extensionscontroller.HasSameChanges(plan, *planField) {
+		*planField = plan
+	}
+	description := fmt.Sprintf("Waiting for approval of plan: changes are only applied after the plan for generation %d of the Shoot has been approved via the %s annotation", ptr.Deref((*planField).ShootGeneration, 0), v1beta1constants.AnnotationShootApprovedPlanGeneration)
+	log.Info(description) //nolint:logcheck
+	if err := r.patchStatus(ctx, obj, base, extensionscontroller.LastOperation(operationType, gardencorev1beta1.LastOperationStatePending, 1, description), nil); err != nil {
+		return false, fmt.Errorf("failed updating plan in status: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *reconciler[T]) computePlan(ctx context.Context, log logr.Logger, planner Planner[T], obj T, cluster *extensionscontroller.Cluster) *extensionsv1alpha1.Plan {
+	plan := extensionscontroller.NewPlan(obj.GetGeneration(), cluster)
+
+	log.Info("Computing plan", "kind", r.kind)
+	if changes, err := planner.Plan(ctx, log, obj, cluster); err != nil {
+		plan.Error = ptr.To(fmt.Sprintf("Error computing plan of %s: %v", r.kind, err))
+	} else {
+		plan.Changes = changes
+	}
+
+	log.Info("Successfully computed plan", "kind", r.kind, "changes", len(plan.Changes), "failed", plan.Error != nil)
+	return plan
+}
+
+// runPhase reports the given operation as processing, executes it and reports its outcome in the last operation and
+// last error of the object. Changes the operation applies to the status of the object are persisted as well. The
+// gerund and past participle of the operation are used for the descriptions of the last operation.
+func (r *reconciler[T]) runPhase(
+	ctx context.Context,
+	log logr.Logger,
+	obj T,
+	operationType gardencorev1beta1.LastOperationType,
+	gerund, pastParticiple string,
+	fn func(context.Context) error,
+) error {
+	if err := r.statusUpdater.Processing(ctx, log, obj, operationType, fmt.Sprintf("%s the %s", capitalize(gerund), r.kind)); err != nil {
+		return err
+	}
+
+	base := obj.DeepCopyObject().(client.Object)
+
+	if err := fn(ctx); err != nil {
+		classifiedErr := util.DetermineError(reconcilerutils.ReconcileErrCauseOrErr(err), r.knownCodes)
+		r.recordErrorCodes(obj, operationType, classifiedErr)
+
+		errDescription := v1beta1helper.FormatLastErrDescription(fmt.Errorf("Error %s %s: %v", gerund, r.kind, classifiedErr))
+		log.Error(errors.New(errDescription), "Error")
+
+		lastOperation, lastError := extensionscontroller.ReconcileError(operationType, errDescription, 50, v1beta1helper.ExtractErrorCodes(classifiedErr)...)
+		_ = r.patchStatus(ctx, obj, base, lastOperation, lastError)
+		return err
+	}
+
+	description := fmt.Sprintf("Successfully %s %s", pastParticiple, r.kind)
+	log.Info(description) //nolint:logcheck
+
+	lastOperation, lastError := extensionscontroller.ReconcileSucceeded(operationType, description)
+	return r.patchStatus(ctx, obj, base, lastOperation, lastError)
+}
+
+func (r *reconciler[T]) patchStatus(ctx context.Context, obj T, base client.Object, lastOperation *gardencorev1beta1.LastOperation, lastError *gardencorev1beta1.LastError) error {
+	status := obj.GetExtensionStatus()
+	status.SetObservedGeneration(obj.GetGeneration())
+	status.SetLastOperation(lastOperation)
+	status.SetLastError(lastError)
+	return r.client.Status().Patch(ctx, obj, client.MergeFrom(base))
+}
+
+func (r *reconciler[T]) recordErrorCodes(obj T, operationType gardencorev1beta1.LastOperationType, err error) {
+	errorCodes := v1beta1helper.ExtractErrorCodes(err)
+	if len(errorCodes) == 0 {
+		OperationErrorsTotal.WithLabelValues(r.kind, obj.GetExtensionSpec().GetExtensionType(), string(operationType), codeUnknown).Inc()
+		return
+	}
+
+	for _, code := range errorCodes {
+		OperationErrorsTotal.WithLabelValues(r.kind, obj.GetExtensionSpec().GetExtensionType(), string(operationType), string(code)).Inc()
+	}
+}
+
+func (r *reconciler[T]) addFinalizer(ctx context.Context, log logr.Logger, obj T) error {
+	if !controllerutil.ContainsFinalizer(obj, r.finalizerName) {
+		log.Info("Adding finalizer")
+		if err := controllerutils.AddFinalizers(ctx, r.client, obj, r.finalizerName); err != nil {
+			return fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *reconciler[T]) removeFinalizer(ctx context.Context, log logr.Logger, obj T) error {
+	if controllerutil.ContainsFinalizer(obj, r.finalizerName) {
+		log.Info("Removing finalizer")
+		if err := controllerutils.RemoveFinalizers(ctx, r.client, obj, r.finalizerName); err != nil {
+			return fmt.Errorf("failed to remove finalizer: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *reconciler[T]) removeAnnotation(ctx context.Context, log logr.Logger, obj T) error {
+	log.Info("Removing operation annotation")
+	if err := extensionscontroller.RemoveAnnotation(ctx, r.client, obj, v1beta1constants.GardenerOperation); err != nil {
+		return fmt.Errorf("error removing annotation from %s: %w", r.kind, err)
+	}
+	return nil
+}
+
+// planOf returns a pointer to the .status.plan field of the given object or nil if the extension kind does not support
+// plans.
+func planOf(obj extensionsv1alpha1.Object) **extensionsv1alpha1.Plan {
+	switch o := obj.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		return &o.Status.Plan
+	case *extensionsv1alpha1.Worker:
+		return &o.Status.Plan
+	default:
+		return nil
+	}
+}
+
+// appliedShootSpecHashOf returns a pointer to the .status.appliedShootSpecHash field of the given object or nil if the
+// extension kind does not support plans.
+func appliedShootSpecHashOf(obj extensionsv1alpha1.Object) **string {
+	switch o := obj.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		return &o.Status.AppliedShootSpecHash
+	case *extensionsv1alpha1.Worker:
+		return &o.Status.AppliedShootSpecHash
+	default:
+		return nil
+	}
+}
+
+// recordAppliedShootSpec stores the hash of the Shoot specification whose changes were applied in the status of the
+// given object. If the Shoot requires approving plans, the stored plan is replaced by a plan without changes since the
+// object reached its desired state.
+func recordAppliedShootSpec(obj extensionsv1alpha1.Object, cluster *extensionscontroller.Cluster) {
+	hashField := appliedShootSpecHashOf(obj)
+	if hashField == nil || cluster == nil || cluster.Shoot == nil {
+		return
+	}
+
+	*hashField = ptr.To(extensionscontroller.ComputeShootSpecHash(cluster))
+	if extensionscontroller.IsPlanApprovalRequired(cluster) {
+		*planOf(obj) = extensionscontroller.NewPlan(obj.GetGeneration(), cluster)
+	}
+}
+
+// newObject returns a new empty object of type T. T must be a pointer to a struct.
+func newObject[T extensionsv1alpha1.Object]() T {
+	var obj T
+	return reflect.New(reflect.TypeOf(obj).Elem()).Interface().(T)
+}
+
+// kindOf returns the kind of the extension resources of type T.
+func kindOf[T extensionsv1alpha1.Object]() string {
+	var obj T
+	return reflect.TypeOf(obj).Elem().Name()
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,473 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package generic_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	. "github.com/gardener/gardener/extensions/pkg/controller/generic"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace     = "shoot--foo--bar"
+		finalizerName = "extensions.gardener.cloud/network"
+	)
+
+	var (
+		ctx = context.Background()
+
+		request reconcile.Request
+		ctrl    *gomock.Controller
+		mgr     *mockmanager.MockManager
+		c       client.Client
+
+		shoot    *gardencorev1beta1.Shoot
+		network  *extensionsv1alpha1.Network
+		actuator *fakeActuator[*extensionsv1alpha1.Network]
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mgr = mockmanager.NewMockManager(ctrl)
+
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "network"}}
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot"},
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+		}
+		network = &extensionsv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: namespace, Generation: 1},
+			Spec:       extensionsv1alpha1.NetworkSpec{DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local"}},
+		}
+		actuator = &fakeActuator[*extensionsv1alpha1.Network]{}
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.GardenNamespace}},
+				cluster(namespace, shoot),
+				network,
+			).
+			WithStatusSubresource(&extensionsv1alpha1.Network{}, &extensionsv1alpha1.Infrastructure{}).
+			Build()
+
+		mgr.EXPECT().GetClient().Return(c).AnyTimes()
+	})
+
+	reconcileNetwork := func(a Actuator[*extensionsv1alpha1.Network], knownCodes map[gardencorev1beta1.ErrorCode]func(string) bool) (reconcile.Result, error) {
+		return NewReconciler(mgr, a, "", knownCodes).Reconcile(ctx, request)
+	}
+
+	getNetwork := func() *extensionsv1alpha1.Network {
+		obj := &extensionsv1alpha1.Network{}
+		ExpectWithOffset(1, c.Get(ctx, request.NamespacedName, obj)).To(Succeed())
+		return obj
+	}
+
+	Describe("reconcile", func() {
+		It("should add the finalizer, reconcile and report success", func() {
+			actuator.mutateStatus = func(obj *extensionsv1alpha1.Network) {
+				obj.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+			}
+
+			Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+			Expect(actuator.calls).To(Equal([]string{"Reconcile"}))
+			Expect(actuator.cluster).NotTo(BeNil())
+
+			obj := getNetwork()
+			Expect(obj.Finalizers).To(ConsistOf(finalizerName))
+			Expect(obj.Status.ObservedGeneration).To(Equal(int64(1)))
+			Expect(obj.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":        Equal(gardencorev1beta1.LastOperationTypeCreate),
+				"State":       Equal(gardencorev1beta1.LastOperationStateSucceeded),
+				"Description": Equal("Successfully reconciled Network"),
+			})))
+			Expect(obj.Status.LastError).To(BeNil())
+			Expect(obj.Status.ProviderStatus).NotTo(BeNil())
+			Expect(obj.Status.ProviderStatus.Raw).To(MatchJSON(`{"foo":"bar"}`))
+		})
+
+		It("should report the classified error", func() {
+			actuator.err = errors.New("quota exceeded for resource")
+			knownCodes := map[gardencorev1beta1.ErrorCode]func(string) bool{
+				gardencorev1beta1.ErrorInfraQuotaExceeded: func(s string) bool { return strings.Contains(s, "quota exceeded") },
+			}
+			errorsBefore := testutil.ToFloat64(OperationErrorsTotal.WithLabelValues("Network", "local", "Create", string(gardencorev1beta1.ErrorInfraQuotaExceeded)))
+
+			_, err := reconcileNetwork(actuator, knownCodes)
+			Expect(err).To(MatchError("quota exceeded for resource"))
+
+			obj := getNetwork()
+			Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateError))
+			Expect(obj.Status.LastError).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Description": Equal("Error reconciling Network: quota exceeded for resource"),
+				"Codes":       ConsistOf(gardencorev1beta1.ErrorInfraQuotaExceeded),
+			})))
+			Expect(testutil.ToFloat64(OperationErrorsTotal.WithLabelValues("Network", "local", "Create", string(gardencorev1beta1.ErrorInfraQuotaExceeded)))).To(Equal(errorsBefore + 1))
+		})
+
+		Context("shoot is failed", func() {
+			BeforeEach(func() {
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateFailed}
+			})
+
+			It("should skip the reconciliation", func() {
+				Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+				Expect(actuator.calls).To(BeEmpty())
+			})
+		})
+
+		Context("object is migrated", func() {
+			BeforeEach(func() {
+				network.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeMigrate, State: gardencorev1beta1.LastOperationStateSucceeded}
+			})
+
+			It("should skip the reconciliation", func() {
+				Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+				Expect(actuator.calls).To(BeEmpty())
+				Expect(getNetwork().Finalizers).To(BeEmpty())
+			})
+		})
+
+		It("should not pass a cluster if the object is not in a shoot namespace", func() {
+			obj := network.DeepCopy()
+			obj.Namespace = v1beta1constants.GardenNamespace
+			obj.ResourceVersion = ""
+			Expect(c.Create(ctx, obj)).To(Succeed())
+			request.Namespace = v1beta1constants.GardenNamespace
+
+			Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+			Expect(actuator.calls).To(Equal([]string{"Reconcile"}))
+			Expect(actuator.cluster).To(BeNil())
+		})
+	})
+
+	Describe("delete", func() {
+		BeforeEach(func() {
+			network.Finalizers = []string{finalizerName}
+			network.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+		})
+
+		It("should delete and remove the finalizer", func() {
+			Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+			Expect(actuator.calls).To(Equal([]string{"Delete"}))
+			Expect(c.Get(ctx, request.NamespacedName, &extensionsv1alpha1.Network{})).To(BeNotFoundError())
+		})
+
+		It("should keep the finalizer if the deletion fails", func() {
+			actuator.err = errors.New("fake")
+
+			_, err := reconcileNetwork(actuator, nil)
+			Expect(err).To(MatchError("fake"))
+
+			obj := getNetwork()
+			Expect(obj.Finalizers).To(ConsistOf(finalizerName))
+			Expect(obj.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeDelete))
+			Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateError))
+		})
+
+		Context("shoot needs force deletion", func() {
+			BeforeEach(func() {
+				shoot.Annotations = map[string]string{v1beta1constants.AnnotationConfirmationForceDeletion: "true"}
+			})
+
+			It("should force delete if the actuator supports it", func() {
+				Expect(reconcileNetwork(&forceDeletingActuator[*extensionsv1alpha1.Network]{fakeActuator: actuator}, nil)).To(Equal(reconcile.Result{}))
+				Expect(actuator.calls).To(Equal([]string{"ForceDelete"}))
+			})
+
+			It("should delete if the actuator does not support force deletion", func() {
+				Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+				Expect(actuator.calls).To(Equal([]string{"Delete"}))
+			})
+		})
+	})
+
+	Describe("migrate", func() {
+		BeforeEach(func() {
+			network.Finalizers = []string{finalizerName}
+			network.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationMigrate}
+		})
+
+		It("should migrate, remove the finalizer and the annotation", func() {
+			Expect(reconcileNetwork(&migratingActuator[*extensionsv1alpha1.Network]{fakeActuator: actuator}, nil)).To(Equal(reconcile.Result{}))
+			Expect(actuator.calls).To(Equal([]string{"Migrate"}))
+
+			obj := getNetwork()
+			Expect(obj.Finalizers).To(BeEmpty())
+			Expect(obj.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(obj.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeMigrate))
+			Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+
+		It("should only remove the finalizer and the annotation if the actuator does not support migration", func() {
+			Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+			Expect(actuator.calls).To(BeEmpty())
+
+			obj := getNetwork()
+			Expect(obj.Finalizers).To(BeEmpty())
+			Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+	})
+
+	Describe("restore", func() {
+		BeforeEach(func() {
+			network.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationRestore}
+		})
+
+		It("should restore if the actuator supports it", func() {
+			Expect(reconcileNetwork(&restoringActuator[*extensionsv1alpha1.Network]{fakeActuator: actuator}, nil)).To(Equal(reconcile.Result{}))
+			Expect(actuator.calls).To(Equal([]string{"Restore"}))
+
+			obj := getNetwork()
+			Expect(obj.Finalizers).To(ConsistOf(finalizerName))
+			Expect(obj.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(obj.Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeRestore))
+			Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+		})
+
+		It("should reconcile if the actuator does not support restoration", func() {
+			Expect(reconcileNetwork(actuator, nil)).To(Equal(reconcile.Result{}))
+			Expect(actuator.calls).To(Equal([]string{"Reconcile"}))
+			Expect(getNetwork().Status.LastOperation.Type).To(Equal(gardencorev1beta1.LastOperationTypeRestore))
+		})
+	})
+
+	Describe("plan", func() {
+		var infrastructure *extensionsv1alpha1.Infrastructure
+
+		BeforeEach(func() {
+			infrastructure = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "infrastructure",
+					Namespace:   namespace,
+					Generation:  2,
+					Annotations: map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationPlan},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			Expect(c.Create(ctx, infrastructure)).To(Succeed())
+		})
+
+		It("should store the plan in the status", func() {
+			changes := []extensionsv1alpha1.PlannedChange{{Kind: "Network", Name: "foo", Action: extensionsv1alpha1.PlannedChangeActionCreate}}
+			infrastructureActuator := &planningActuator[*extensionsv1alpha1.Infrastructure]{fakeActuator: &fakeActuator[*extensionsv1alpha1.Infrastructure]{}, changes: changes}
+
+			Expect(NewReconciler(mgr, infrastructureActuator, "", nil).Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(infrastructure)})).To(Equal(reconcile.Result{}))
+			Expect(infrastructureActuator.calls).To(Equal([]string{"Plan"}))
+
+			obj := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infrastructure), obj)).To(Succeed())
+			Expect(obj.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(obj.Status.LastOperation).To(BeNil())
+			Expect(obj.Status.Plan).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"ObservedGeneration": Equal(int64(2)),
+				"Changes":            Equal(changes),
+				"Error":              BeNil(),
+			})))
+		})
+
+		It("should only remove the annotation if the actuator does not support computing plans", func() {
+			infrastructureActuator := &fakeActuator[*extensionsv1alpha1.Infrastructure]{}
+
+			Expect(NewReconciler(mgr, infrastructureActuator, "", nil).Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(infrastructure)})).To(Equal(reconcile.Result{}))
+			Expect(infrastructureActuator.calls).To(BeEmpty())
+
+			obj := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infrastructure), obj)).To(Succeed())
+			Expect(obj.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(obj.Status.Plan).To(BeNil())
+		})
+
+		Context("shoot requires plan approval", func() {
+			var (
+				infrastructureActuator *planningActuator[*extensionsv1alpha1.Infrastructure]
+				changes                []extensionsv1alpha1.PlannedChange
+				status                 *extensionsv1alpha1.InfrastructureStatus
+			)
+
+			BeforeEach(func() {
+				delete(infrastructure.Annotations, v1beta1constants.GardenerOperation)
+				shoot.Generation = 5
+				shoot.Annotations = map[string]string{v1beta1constants.AnnotationShootRequirePlanApproval: "true"}
+				changes = []extensionsv1alpha1.PlannedChange{{Kind: "Network", Name: "foo", Action: extensionsv1alpha1.PlannedChangeActionCreate}}
+				infrastructureActuator = &planningActuator[*extensionsv1alpha1.Infrastructure]{fakeActuator: &fakeActuator[*extensionsv1alpha1.Infrastructure]{}, changes: changes}
+				status = nil
+			})
+
+			JustBeforeEach(func() {
+				if status != nil {
+					infrastructure.Status = *status
+					Expect(c.Status().Update(ctx, infrastructure)).To(Succeed())
+				}
+			})
+
+			reconcileInfrastructure := func() *extensionsv1alpha1.Infrastructure {
+				result, err := NewReconciler(mgr, infrastructureActuator, "", nil).Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(infrastructure)})
+				ExpectWithOffset(1, err).NotTo(HaveOccurred())
+				ExpectWithOffset(1, result).To(Equal(reconcile.Result{}))
+
+				obj := &extensionsv1alpha1.Infrastructure{}
+				ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(infrastructure), obj)).To(Succeed())
+				return obj
+			}
+
+			expectReconciled := func(obj *extensionsv1alpha1.Infrastructure) {
+				ExpectWithOffset(1, infrastructureActuator.calls).To(Equal([]string{"Plan", "Reconcile"}))
+				ExpectWithOffset(1, obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStateSucceeded))
+				ExpectWithOffset(1, obj.Status.AppliedShootSpecHash).To(PointTo(Equal(extensionscontroller.ComputeShootSpecHash(&extensionscontroller.Cluster{Shoot: shoot}))))
+				ExpectWithOffset(1, obj.Status.Plan).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Changes":         BeEmpty(),
+					"ShootGeneration": PointTo(Equal(int64(5))),
+				})))
+			}
+
+			It("should store the plan and wait for its approval if the plan has changes", func() {
+				obj := reconcileInfrastructure()
+				Expect(infrastructureActuator.calls).To(Equal([]string{"Plan"}))
+				Expect(obj.Status.Plan).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"ObservedGeneration": Equal(int64(2)),
+					"ShootGeneration":    PointTo(Equal(int64(5))),
+					"Changes":            Equal(changes),
+				})))
+				Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+				Expect(obj.Status.LastOperation.Description).To(ContainSubstring("Waiting for approval of plan"))
+				Expect(obj.Status.LastError).To(BeNil())
+			})
+
+			It("should reconcile if the plan has no changes", func() {
+				infrastructureActuator.changes = nil
+
+				expectReconciled(reconcileInfrastructure())
+			})
+
+			Context("Shoot specification did not change since changes were applied last", func() {
+				BeforeEach(func() {
+					status = &extensionsv1alpha1.InfrastructureStatus{AppliedShootSpecHash: ptr.To(extensionscontroller.ComputeShootSpecHash(&extensionscontroller.Cluster{Shoot: shoot}))}
+				})
+
+				It("should reconcile system-driven changes without approval", func() {
+					expectReconciled(reconcileInfrastructure())
+				})
+			})
+
+			Context("stored plan is approved", func() {
+				BeforeEach(func() {
+					plan := extensionscontroller.NewPlan(2, &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: 4}}})
+					plan.Changes = changes
+					status = &extensionsv1alpha1.InfrastructureStatus{Plan: plan}
+					shoot.Annotations[v1beta1constants.AnnotationShootApprovedPlanGeneration] = "4"
+				})
+
+				It("should reconcile if the changes did not change", func() {
+					expectReconciled(reconcileInfrastructure())
+				})
+			})
+		})
+	})
+})
+
+func cluster(name string, shoot *gardencorev1beta1.Shoot) *extensionsv1alpha1.Cluster {
+	raw, err := json.Marshal(shoot)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	return &extensionsv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: extensionsv1alpha1.ClusterSpec{
+			Shoot: runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func BeNotFoundError() OmegaMatcher {
+	return WithTransform(apierrors.IsNotFound, BeTrue())
+}
+
+type fakeActuator[T extensionsv1alpha1.Object] struct {
+	calls        []string
+	cluster      *extensionscontroller.Cluster
+	err          error
+	mutateStatus func(T)
+}
+
+func (a *fakeActuator[T]) record(call string, obj T, cluster *extensionscontroller.Cluster) error {
+	a.calls = append(a.calls, call)
+	a.cluster = cluster
+	if a.mutateStatus != nil {
+		a.mutateStatus(obj)
+	}
+	return a.err
+}
+
+func (a *fakeActuator[T]) Reconcile(_ context.Context, _ logr.Logger, obj T, cluster *extensionscontroller.Cluster) error {
+	return a.record("Reconcile", obj, cluster)
+}
+
+func (a *fakeActuator[T]) Delete(_ context.Context, _ logr.Logger, obj T, cluster *extensionscontroller.Cluster) error {
+	return a.record("Delete", obj, cluster)
+}
+
+type forceDeletingActuator[T extensionsv1alpha1.Object] struct {
+	*fakeActuator[T]
+}
+
+func (a *forceDeletingActuator[T]) ForceDelete(_ context.Context, _ logr.Logger, obj T, cluster *extensionscontroller.Cluster) error {
+	return a.record("ForceDelete", obj, cluster)
+}
+
+type migratingActuator[T extensionsv1alpha1.Object] struct {
+	*fakeActuator[T]
+}
+
+func (a *migratingActuator[T]) Migrate(_ context.Context, _ logr.Logger, obj T, cluster *extensionscontroller.Cluster) error {
+	return a.record("Migrate", obj, cluster)
+}
+
+type restoringActuator[T extensionsv1alpha1.Object] struct {
+	*fakeActuator[T]
+}
+
+func (a *restoringActuator[T]) Restore(_ context.Context, _ logr.Logger, obj T, cluster *extensionscontroller.Cluster) error {
+	return a.record("Restore", obj, cluster)
+}
+
+type planningActuator[T extensionsv1alpha1.Object] struct {
+	*fakeActuator[T]
+	changes []extensionsv1alpha1.PlannedChange
+}
+
+func (a *planningActuator[T]) Plan(_ context.Context, _ logr.Logger, obj T, cluster *extensionscontroller.Cluster) ([]extensionsv1alpha1.PlannedChange, error) {
+	return a.changes, a.record("Plan", obj, cluster)
+}
@@ -90,6 +90,7 @@ type RegisteredExtension struct {
 // opts contain config for the healthcheck controller
 // custom predicates allow for fine-grained control which resources to watch
 // healthChecks defines the checks to execute mapped to the healthConditionTypes its contributing to (e.g checkDeployment in Seed -> ControlPlaneHealthy).
+// The declarative health checks of the HealthCheckConfig defined for the kind are added to the healthChecks.
 // register returns a runtime representation of the extension resource to register it with the controller-runtime
 func DefaultRegistration(
 	extensionType string,
@@ -105,6 +106,12 @@ func DefaultRegistration(
 	predicates := append(DefaultPredicates(), customPredicates...)
 	opts.Controller.RecoverPanic = ptr.To(true)
 
+	declarativeHealthChecks, err := NewDeclarativeHealthChecks(kind.Kind, opts.HealthCheckConfig.DeclarativeHealthChecks)
+	if err != nil {
+		return err
+	}
+	healthChecks = append(healthChecks, declarativeHealthChecks...)
+
 	args := AddArgs{
 		ControllerOptions:       opts.Controller,
 		Predicates:              predicates,
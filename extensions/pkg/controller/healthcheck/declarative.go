@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/library"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// VariableObject is the name of the CEL variable containing the checked object of a declarative health check.
+const VariableObject = "object"
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+)
+
+func declarativeHealthCheckEnv() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable(VariableObject, cel.DynType),
+			cel.OptionalTypes(),
+			cel.CrossTypeNumericComparisons(true),
+			cel.DefaultUTCTimeZone(true),
+			ext.Strings(ext.StringsVersion(2)),
+			library.Lists(),
+			library.Regex(),
+			library.Quantity(),
+		)
+	})
+	return celEnv, celEnvErr
+}
+
+func compileDeclarativeHealthCheckExpression(expression string, expectedType *cel.Type) (cel.Program, error) {
+	env, err := declarativeHealthCheckEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outputType := ast.OutputType(); outputType.Kind() != celtypes.DynKind && outputType.Kind() != expectedType.Kind() {
+		return nil, fmt.Errorf("expression must evaluate to %s but evaluates to %s", expectedType, outputType)
+	}
+
+	return env.Program(ast, cel.CostLimit(celconfig.PerCallLimit), cel.EvalOptions(cel.OptOptimize))
+}
+
+// NewDeclarativeHealthChecks returns the health checks for the given declarative health checks which are defined for
+// the given extension kind. Declarative health checks defined for other extension kinds are ignored.
+func NewDeclarativeHealthChecks(extensionKind string, declarativeHealthChecks []extensionsconfigv1alpha1.DeclarativeHealthCheck) ([]ConditionTypeToHealthCheck, error) {
+	var healthChecks []ConditionTypeToHealthCheck
+
+	for i, declarativeHealthCheck := range declarativeHealthChecks {
+		if declarativeHealthCheck.ExtensionKind != extensionKind {
+			continue
+		}
+
+		check, err := NewDeclarativeHealthChecker(declarativeHealthCheck)
+		if err != nil {
+			return nil, fmt.Errorf("invalid declarative health check %d: %w", i, err)
+		}
+
+		healthChecks = append(healthChecks, ConditionTypeToHealthCheck{
+			ConditionType: declarativeHealthCheck.ConditionType,
+			HealthCheck:   check,
+		})
+	}
+
+	return healthChecks, nil
+}
+
+// NewDeclarativeHealthChecker returns a health check for the given declarative health check. Depending on the
+// configured cluster, the returned health check gets either the source or the target client injected.
+func NewDeclarativeHealthChecker(declarativeHealthCheck extensionsconfigv1alpha1.DeclarativeHealthCheck) (HealthCheck, error) {
+	if declarativeHealthCheck.ConditionType == "" {
+		return nil, fmt.Errorf("condition type must be set")
+	}
+
+	gv, err := schema.ParseGroupVersion(declarativeHealthCheck.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API version: %w", err)
+	}
+	if declarativeHealthCheck.Kind == "" {
+		return nil, fmt.Errorf("kind must be set")
+	}
+
+	checker := &declarativeHealthChecker{
+		gvk:        gv.WithKind(declarativeHealthCheck.Kind),
+		namespace:  declarativeHealthCheck.Namespace,
+		name:       ptr.Deref(declarativeHealthCheck.Name, ""),
+		minObjects: int(ptr.Deref(declarativeHealthCheck.MinObjects, 1)),
+		ruleText:   declarativeHealthCheck.Rule,
+	}
+
+	switch {
+	case declarativeHealthCheck.Name != nil && declarativeHealthCheck.LabelSelector != nil:
+		return nil, fmt.Errorf("name and label selector must not be set both")
+	case declarativeHealthCheck.Name != nil:
+		if checker.name == "" {
+			return nil, fmt.Errorf("name must not be empty")
+		}
+	case declarativeHealthCheck.LabelSelector != nil:
+		if checker.selector, err = metav1.LabelSelectorAsSelector(declarativeHealthCheck.LabelSelector); err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("either name or label selector must be set")
+	}
+
+	if checker.rule, err = compileDeclarativeHealthCheckExpression(declarativeHealthCheck.Rule, cel.BoolType); err != nil {
+		return nil, fmt.Errorf("failed compiling rule: %w", err)
+	}
+
+	if declarativeHealthCheck.Message != nil {
+		if checker.message, err = compileDeclarativeHealthCheckExpression(*declarativeHealthCheck.Message, cel.StringType); err != nil {
+			return nil, fmt.Errorf("failed compiling message: %w", err)
+		}
+	}
+
+	switch ptr.Deref(declarativeHealthCheck.Cluster, extensionsconfigv1alpha1.HealthCheckClusterSeed) {
+	case extensionsconfigv1alpha1.HealthCheckClusterSeed:
+		return &seedDeclarativeHealthChecker{declarativeHealthChecker: checker}, nil
+	case extensionsconfigv1alpha1.HealthCheckClusterShoot:
+		if checker.namespace == nil {
+			checker.namespace = ptr.To(metav1.NamespaceSystem)
+		}
+		return &shootDeclarativeHealthChecker{declarativeHealthChecker: checker}, nil
+	default:
+		return nil, fmt.Errorf("unsupported cluster %q", *declarativeHealthCheck.Cluster)
+	}
+}
+
+// declarativeHealthChecker contains all the information for a declarative health check.
+type declarativeHealthChecker struct {
+	logger logr.Logger
+	client client.Client
+
+	gvk        schema.GroupVersionKind
+	namespace  *string
+	name       string
+	selector   labels.Selector
+	minObjects int
+	ruleText   string
+	rule       cel.Program
+	message    cel.Program
+}
+
+// seedDeclarativeHealthChecker is a declarative health check for objects in the seed cluster.
+type seedDeclarativeHealthChecker struct {
+	*declarativeHealthChecker
+}
+
+// shootDeclarativeHealthChecker is a declarative health check for objects in the shoot cluster.
+type shootDeclarativeHealthChecker struct {
+	*declarativeHealthChecker
+}
+
+var (
+	_ HealthCheck  = (*seedDeclarativeHealthChecker)(nil)
+	_ SourceClient = (*seedDeclarativeHealthChecker)(nil)
+	_ HealthCheck  = (*shootDeclarativeHealthChecker)(nil)
+	_ TargetClient = (*shootDeclarativeHealthChecker)(nil)
+)
+
+// InjectSourceClient injects the seed client
+func (h *seedDeclarativeHealthChecker) InjectSourceClient(sourceClient client.Client) {
+	h.client = sourceClient
+}
+
+// InjectTargetClient injects the shoot client
+func (h *shootDeclarativeHealthChecker) InjectTargetClient(targetClient client.Client) {
+	h.client = targetClient
+}
+
+// SetLoggerSuffix injects the logger
+func (h *declarativeHealthChecker) SetLoggerSuffix(provider, extension string) {
+	h.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-declarative", provider, extension))
+}
+
+// Check executes the health check
+func (h *declarativeHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*SingleCheckResult, error) {
+	namespace := ptr.Deref(h.namespace, request.Namespace)
+
+	objects, detail, err := h.getObjects(ctx, namespace)
+	if err != nil {
+		err := fmt.Errorf("failed to retrieve %s objects in namespace %q: %w", h.gvk.Kind, namespace, err)
+		h.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	if detail != "" {
+		return &SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: detail,
+		}, nil
+	}
+
+	var details []string
+	for _, obj := range objects {
+		healthy, err := h.evaluateRule(obj)
+		if err != nil {
+			err := fmt.Errorf("failed to evaluate rule for %s %q in namespace %q: %w", h.gvk.Kind, obj.GetName(), obj.GetNamespace(), err)
+			h.logger.Error(err, "Health check failed")
+			return nil, err
+		}
+
+		if !healthy {
+			details = append(details, h.evaluateMessage(obj))
+		}
+	}
+
+	if len(details) > 0 {
+		return &SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: strings.Join(details, ", "),
+		}, nil
+	}
+
+	return &SingleCheckResult{
+		Status: gardencorev1beta1.ConditionTrue,
+	}, nil
+}
+
+func (h *declarativeHealthChecker) getObjects(ctx context.Context, namespace string) ([]unstructured.Unstructured, string, error) {
+	if h.name != "" {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(h.gvk)
+		if err := h.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: h.name}, obj); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, "", err
+			}
+			if h.minObjects > 0 {
+				return nil, fmt.Sprintf("%s %q in namespace %q not found", h.gvk.Kind, h.name, namespace), nil
+			}
+			return nil, "", nil
+		}
+		return []unstructured.Unstructured{*obj}, "", nil
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(h.gvk.GroupVersion().WithKind(h.gvk.Kind + "List"))
+	if err := h.client.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: h.selector}); err != nil {
+		return nil, "", err
+	}
+
+	if len(list.Items) < h.minObjects {
+		return nil, fmt.Sprintf("found %d %s objects matching selector %q in namespace %q but expected at least %d", len(list.Items), h.gvk.Kind, h.selector.String(), namespace, h.minObjects), nil
+	}
+
+	return list.Items, "", nil
+}
+
+func (h *declarativeHealthChecker) evaluateRule(obj unstructured.Unstructured) (bool, error) {
+	result, _, err := h.rule.Eval(map[string]any{VariableObject: obj.Object})
+	if err != nil {
+		return false, err
+	}
+
+	healthy, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule must evaluate to a bool but evaluated to %s", result.Type())
+	}
+
+	return healthy, nil
+}
+
+func (h *declarativeHealthChecker) evaluateMessage(obj unstructured.Unstructured) string {
+	defaultMessage := fmt.Sprintf("%s %q in namespace %q is unhealthy: rule %q evaluated to false", h.gvk.Kind, obj.GetName(), obj.GetNamespace(), h.ruleText)
+	if h.message == nil {
+		return defaultMessage
+	}
+
+	result, _, err := h.message.Eval(map[string]any{VariableObject: obj.Object})
+	if err != nil {
+		h.logger.Error(err, "Failed to evaluate message, falling back to default message", "kind", h.gvk.Kind, "object", client.ObjectKeyFromObject(&obj))
+		return defaultMessage
+	}
+
+	message, ok := result.Value().(string)
+	if !ok {
+		return defaultMessage
+	}
+
+	return message
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("declarative", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+		request   = types.NamespacedName{Namespace: namespace, Name: "foo"}
+
+		c                      client.Client
+		declarativeHealthCheck extensionsconfigv1alpha1.DeclarativeHealthCheck
+	)
+
+	deployment := func(name string, replicas, readyReplicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"app": "foo"}},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: readyReplicas},
+		}
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		declarativeHealthCheck = extensionsconfigv1alpha1.DeclarativeHealthCheck{
+			ExtensionKind: "Extension",
+			ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+			APIVersion:    "apps/v1",
+			Kind:          "Deployment",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Rule:          "object.status.?readyReplicas.orValue(0) == object.spec.replicas",
+		}
+	})
+
+	check := func() *SingleCheckResult {
+		healthCheck, err := NewDeclarativeHealthChecker(declarativeHealthCheck)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		SourceClientInfo(c, healthCheck)
+
+		result, err := healthCheck.Check(ctx, request)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return result
+	}
+
+	Describe("#NewDeclarativeHealthChecks", func() {
+		It("should only return the health checks for the given extension kind", func() {
+			otherHealthCheck := *declarativeHealthCheck.DeepCopy()
+			otherHealthCheck.ExtensionKind = "Worker"
+
+			healthChecks, err := NewDeclarativeHealthChecks("Extension", []extensionsconfigv1alpha1.DeclarativeHealthCheck{declarativeHealthCheck, otherHealthCheck})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthChecks).To(ConsistOf(HaveField("ConditionType", "ControlPlaneHealthy")))
+		})
+
+		It("should return the health checker matching the cluster", func() {
+			declarativeHealthCheck.Cluster = ptr.To(extensionsconfigv1alpha1.HealthCheckClusterShoot)
+
+			healthChecks, err := NewDeclarativeHealthChecks("Extension", []extensionsconfigv1alpha1.DeclarativeHealthCheck{declarativeHealthCheck})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(healthChecks).To(HaveLen(1))
+			Expect(healthChecks[0].HealthCheck).To(BeAssignableToTypeOf(&shootDeclarativeHealthChecker{}))
+			Expect(healthChecks[0].HealthCheck.(*shootDeclarativeHealthChecker).namespace).To(PointTo(Equal("kube-system")))
+		})
+
+		DescribeTable("should fail for invalid health checks",
+			func(mutate func(*extensionsconfigv1alpha1.DeclarativeHealthCheck), errorMessage string) {
+				mutate(&declarativeHealthCheck)
+
+				_, err := NewDeclarativeHealthChecks("Extension", []extensionsconfigv1alpha1.DeclarativeHealthCheck{declarativeHealthCheck})
+				Expect(err).To(MatchError(ContainSubstring(errorMessage)))
+			},
+
+			Entry("no condition type", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) { h.ConditionType = "" }, "condition type must be set"),
+			Entry("no kind", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) { h.Kind = "" }, "kind must be set"),
+			Entry("name and selector", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) { h.Name = ptr.To("foo") }, "name and label selector must not be set both"),
+			Entry("neither name nor selector", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) { h.LabelSelector = nil }, "either name or label selector must be set"),
+			Entry("invalid rule", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) { h.Rule = "object.status ==" }, "failed compiling rule"),
+			Entry("rule not evaluating to bool", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) { h.Rule = `"foo"` }, "expression must evaluate to bool"),
+			Entry("message not evaluating to string", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) { h.Message = ptr.To("1") }, "expression must evaluate to string"),
+			Entry("unsupported cluster", func(h *extensionsconfigv1alpha1.DeclarativeHealthCheck) {
+				h.Cluster = ptr.To[extensionsconfigv1alpha1.HealthCheckCluster]("garden")
+			}, `unsupported cluster "garden"`),
+		)
+	})
+
+	Describe("#Check", func() {
+		It("should succeed if all selected objects are healthy", func() {
+			Expect(c.Create(ctx, deployment("foo", 2, 2))).To(Succeed())
+			Expect(c.Create(ctx, deployment("bar", 1, 1))).To(Succeed())
+
+			Expect(check()).To(Equal(&SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}))
+		})
+
+		It("should report all unhealthy objects", func() {
+			Expect(c.Create(ctx, deployment("foo", 2, 1))).To(Succeed())
+			Expect(c.Create(ctx, deployment("bar", 1, 0))).To(Succeed())
+			Expect(c.Create(ctx, deployment("baz", 1, 1))).To(Succeed())
+
+			Expect(check()).To(Equal(&SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: `Deployment "bar" in namespace "shoot--foo--bar" is unhealthy: rule "object.status.?readyReplicas.orValue(0) == object.spec.replicas" evaluated to false, ` +
+					`Deployment "foo" in namespace "shoot--foo--bar" is unhealthy: rule "object.status.?readyReplicas.orValue(0) == object.spec.replicas" evaluated to false`,
+			}))
+		})
+
+		It("should report the evaluated message", func() {
+			declarativeHealthCheck.Message = ptr.To(`"deployment " + object.metadata.name + " has only " + string(object.status.readyReplicas) + " ready replicas"`)
+			Expect(c.Create(ctx, deployment("foo", 2, 1))).To(Succeed())
+
+			Expect(check()).To(Equal(&SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: "deployment foo has only 1 ready replicas",
+			}))
+		})
+
+		It("should fail if less objects than expected are selected", func() {
+			Expect(check()).To(Equal(&SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: `found 0 Deployment objects matching selector "app=foo" in namespace "shoot--foo--bar" but expected at least 1`,
+			}))
+		})
+
+		It("should succeed if no objects are expected", func() {
+			declarativeHealthCheck.MinObjects = ptr.To[int32](0)
+
+			Expect(check()).To(Equal(&SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}))
+		})
+
+		Context("object selected by name", func() {
+			BeforeEach(func() {
+				declarativeHealthCheck.LabelSelector = nil
+				declarativeHealthCheck.Name = ptr.To("foo")
+			})
+
+			It("should check the object", func() {
+				Expect(c.Create(ctx, deployment("foo", 1, 0))).To(Succeed())
+
+				Expect(check().Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			})
+
+			It("should fail if the object does not exist", func() {
+				Expect(check()).To(Equal(&SingleCheckResult{
+					Status: gardencorev1beta1.ConditionFalse,
+					Detail: `Deployment "foo" in namespace "shoot--foo--bar" not found`,
+				}))
+			})
+		})
+
+		It("should return an error if the rule cannot be evaluated", func() {
+			declarativeHealthCheck.Rule = "object.status.foo == 1"
+			Expect(c.Create(ctx, deployment("foo", 1, 1))).To(Succeed())
+
+			healthCheck, err := NewDeclarativeHealthChecker(declarativeHealthCheck)
+			Expect(err).NotTo(HaveOccurred())
+			SourceClientInfo(c, healthCheck)
+
+			_, err = healthCheck.Check(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring(`failed to evaluate rule for Deployment "foo"`)))
+		})
+	})
+})
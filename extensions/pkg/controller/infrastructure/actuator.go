@@ -71,3 +71,18 @@ type Actuator interface {
 	// state.
 	Migrate(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error
 }
+
+// Planner can optionally be implemented by an [Actuator] in order to support
+// the `plan` operation, i.e., the computation of the changes a
+// reconciliation of the [extensionsv1alpha1.Infrastructure] resource would
+// perform. The operation is requested via the `gardener.cloud/operation=plan`
+// annotation and the result is stored in the .status.plan field.
+type Planner interface {
+	// Plan returns the changes which would be performed when reconciling the
+	// [extensionsv1alpha1.Infrastructure] resource, i.e., infrastructure
+	// resources (e.g. networks, subnets, etc.) which would be created,
+	// updated, replaced or deleted.
+	//
+	// Implementations must not change any resources.
+	Plan(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) ([]extensionsv1alpha1.PlannedChange, error)
+}
@@ -59,7 +59,7 @@ type AddArgs struct {
 
 // DefaultPredicates returns the default predicates for an infrastructure reconciler.
 func DefaultPredicates(ctx context.Context, mgr manager.Manager, ignoreOperationAnnotation bool) []predicate.Predicate {
-	return extensionspredicate.DefaultControllerPredicates(ignoreOperationAnnotation, extensionspredicate.ShootNotFailedPredicate(ctx, mgr))
+	return extensionspredicate.DefaultPlanningControllerPredicates(ignoreOperationAnnotation, extensionspredicate.ShootNotFailedPredicate(ctx, mgr))
 }
 
 // Add creates a new Infrastructure Controller and adds it to the Manager.
@@ -11,6 +11,7 @@ import (
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -87,6 +88,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.delete(ctx, log.WithValues("operation", "delete"), infrastructure, cluster)
 	case operationType == gardencorev1beta1.LastOperationTypeRestore:
 		return r.restore(ctx, log.WithValues("operation", "restore"), infrastructure, cluster)
+	case infrastructure.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan:
+		return r.plan(ctx, log.WithValues("operation", "plan"), infrastructure, cluster)
 	default:
 		return r.reconcile(ctx, log.WithValues("operation", "reconcile"), infrastructure, cluster, operationType)
 	}
@@ -109,6 +112,13 @@ func (r *reconciler) reconcile(
 		}
 	}
 
+	planner, ok := r.actuator.(Planner)
+	if ok && extensionscontroller.IsPlanApprovalRequired(cluster) {
+		if pending, err := r.awaitPlanApproval(ctx, log, planner, infrastructure, cluster, operationType); err != nil || pending {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if err := r.statusUpdater.Processing(ctx, log, infrastructure, operationType, "Reconciling the infrastructure"); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -124,6 +134,12 @@ func (r *reconciler) reconcile(
 		return reconcilerutils.ReconcileErr(err)
 	}
 
+	if ok {
+		if err := r.recordAppliedShootSpec(ctx, infrastructure, cluster); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed recording applied Shoot specification in status: %w", err)
+		}
+	}
+
 	if err := r.statusUpdater.Success(ctx, log, infrastructure, operationType, "Successfully reconciled infrastructure"); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -231,6 +247,12 @@ func (r *reconciler) restore(
 		return reconcilerutils.ReconcileErr(err)
 	}
 
+	if _, ok := r.actuator.(Planner); ok {
+		if err := r.recordAppliedShootSpec(ctx, infrastructure, cluster); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed recording applied Shoot specification in status: %w", err)
+		}
+	}
+
 	if err := r.removeAnnotation(ctx, log, infrastructure); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -239,6 +261,105 @@ func (r *reconciler) restore(
 	return reconcile.Result{}, err
 }
 
+func (r *reconciler) plan(
+	ctx context.Context,
+	log logr.Logger,
+	infrastructure *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster,
+) (
+	reconcile.Result,
+	error,
+) {
+	// The annotation is removed before computing the plan since a plan is only computed on request. Errors are reported
+	// in the plan instead of being retried.
+	if err := r.removeAnnotation(ctx, log, infrastructure); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	planner, ok := r.actuator.(Planner)
+	if !ok {
+		log.Info("Actuator does not support computing plans, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	plan := r.computePlan(ctx, log, planner, infrastructure, cluster)
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.Plan = plan
+	if err := r.client.Status().Patch(ctx, infrastructure, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating plan in status: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// awaitPlanApproval computes the plan of the infrastructure and returns true if its changes must not be applied before
+// the plan is approved. In this case, the plan is stored in the status unless the stored plan contains the same changes
+// (which keeps its approval valid), and the last operation is set to Pending. The infrastructure is reconciled again once
+// the Shoot is annotated with the approved generation and gardenlet requests the reconciliation.
+func (r *reconciler) awaitPlanApproval(
+	ctx context.Context,
+	log logr.Logger,
+	planner Planner,
+	infrastructure *extensionsv1alpha1.Infrastructure,
+	cluster *extensionscontroller.Cluster,
+	operationType gardencorev1beta1.LastOperationType,
+) (
+	bool,
+	error,
+) {
+	plan := r.computePlan(ctx, log, planner, infrastructure, cluster)
+	if !extensionscontroller.NeedsPlanApproval(plan, infrastructure.Status.Plan, infrastructure.Status.AppliedShootSpecHash, cluster) {
+		return false, nil
+	}
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	if !extensionscontroller.HasSameChanges(plan, infrastructure.Status.Plan) {
+		infrastructure.Status.Plan = plan
+	}
+	description := fmt.Sprintf("Waiting for approval of plan: changes are only applied after the plan for generation %d of the Shoot has been approved via the %s annotation", ptr.Deref(infrastructure.Status.Plan.ShootGeneration, 0), v1beta1constants.AnnotationShootApprovedPlanGeneration)
+	log.Info(description) //nolint:logcheck
+	infrastructure.Status.LastOperation = extensionscontroller.LastOperation(operationType, gardencorev1beta1.LastOperationStatePending, 1, description)
+	infrastructure.Status.LastError = nil
+	if err := r.client.Status().Patch(ctx, infrastructure, patch); err != nil {
+		return false, fmt.Errorf("failed updating plan in status: %w", err)
+	}
+
+	return true, nil
+}
+
+// recordAppliedShootSpec stores the hash of the Shoot specification whose changes were applied in the status of the
+// infrastructure. If the Shoot requires approving plans, the stored plan is replaced by a plan without changes since the
+// infrastructure reached its desired state.
+func (r *reconciler) recordAppliedShootSpec(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	if cluster == nil || cluster.Shoot == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	infrastructure.Status.AppliedShootSpecHash = ptr.To(extensionscontroller.ComputeShootSpecHash(cluster))
+	if extensionscontroller.IsPlanApprovalRequired(cluster) {
+		infrastructure.Status.Plan = extensionscontroller.NewPlan(infrastructure.Generation, cluster)
+	}
+	return r.client.Status().Patch(ctx, infrastructure, patch)
+}
+
+func (r *reconciler) computePlan(ctx context.Context, log logr.Logger, planner Planner, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) *extensionsv1alpha1.Plan {
+	plan := extensionscontroller.NewPlan(infrastructure.Generation, cluster)
+
+	log.Info("Computing plan of infrastructure")
+	if err := r.validateConfig(ctx, infrastructure); err != nil {
+		plan.Error = ptr.To(fmt.Sprintf("Error checking infrastructure config: %v", err))
+	} else if changes, err := planner.Plan(ctx, log, infrastructure, cluster); err != nil {
+		plan.Error = ptr.To(fmt.Sprintf("Error computing plan of infrastructure: %v", err))
+	} else {
+		plan.Changes = changes
+	}
+
+	log.Info("Successfully computed plan of infrastructure", "changes", len(plan.Changes), "failed", plan.Error != nil)
+	return plan
+}
+
 func (r *reconciler) removeFinalizerFromInfrastructure(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure) error {
 	if controllerutil.ContainsFinalizer(infrastructure, FinalizerName) {
 		log.Info("Removing finalizer")
@@ -5,10 +5,18 @@
 package controller
 
 import (
+	"strconv"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // ChartRendererFactory creates chartrenderer.Interface to be used by this actuator.
@@ -96,6 +104,61 @@ func IsShootFailed(shoot *gardencorev1beta1.Shoot) bool {
 	return lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateFailed
 }
 
+// IsPlanApprovalRequired returns true if the embedded shoot requires the approval of plans (see the 'plan' operation)
+// before changes to its infrastructure and machines are applied.
+func IsPlanApprovalRequired(cluster *Cluster) bool {
+	return cluster != nil && cluster.Shoot != nil &&
+		cluster.Shoot.Annotations[v1beta1constants.AnnotationShootRequirePlanApproval] == "true"
+}
+
+// ComputeShootSpecHash returns a hash of the specification of the embedded shoot. Extension controllers record the hash
+// of the specification whose changes they applied last in order to tell changes caused by the specification apart from
+// system-driven changes, e.g., caused by Gardener or extension upgrades or credentials rotations.
+func ComputeShootSpecHash(cluster *Cluster) string {
+	if cluster == nil || cluster.Shoot == nil {
+		return ""
+	}
+	return utils.ComputeChecksum(cluster.Shoot.Spec)
+}
+
+// NeedsPlanApproval returns true if the changes of the given plan must not be applied before they are approved. This
+// is the case if the embedded shoot requires the approval of plans, the plan contains changes (or could not be
+// computed), the specification of the shoot changed since changes were applied last (i.e., the changes are not
+// system-driven), and the plan is not approved. The plan is approved if the shoot is annotated with the shoot generation
+// of the stored plan and the changes did not change since the stored plan was computed.
+func NeedsPlanApproval(plan, storedPlan *extensionsv1alpha1.Plan, appliedShootSpecHash *string, cluster *Cluster) bool {
+	if !IsPlanApprovalRequired(cluster) || (plan.Error == nil && len(plan.Changes) == 0) {
+		return false
+	}
+
+	if ptr.Deref(appliedShootSpecHash, "") == ComputeShootSpecHash(cluster) {
+		return false
+	}
+
+	return storedPlan == nil || storedPlan.ShootGeneration == nil || !HasSameChanges(plan, storedPlan) ||
+		cluster.Shoot.Annotations[v1beta1constants.AnnotationShootApprovedPlanGeneration] != strconv.FormatInt(*storedPlan.ShootGeneration, 10)
+}
+
+// HasSameChanges returns true if both plans were computed successfully and contain the same changes.
+func HasSameChanges(plan, other *extensionsv1alpha1.Plan) bool {
+	return plan != nil && other != nil && plan.Error == nil && other.Error == nil &&
+		apiequality.Semantic.DeepEqual(plan.Changes, other.Changes)
+}
+
+// NewPlan returns a new plan without changes for the given generation of an extension resource and the embedded shoot.
+func NewPlan(generation int64, cluster *Cluster) *extensionsv1alpha1.Plan {
+	plan := &extensionsv1alpha1.Plan{
+		ObservedGeneration: generation,
+		LastUpdateTime:     metav1.Now(),
+	}
+
+	if cluster != nil && cluster.Shoot != nil {
+		plan.ShootGeneration = ptr.To(cluster.Shoot.Generation)
+	}
+
+	return plan
+}
+
 // IsUnmanagedDNSProvider returns true if the shoot uses an unmanaged DNS provider.
 func IsUnmanagedDNSProvider(cluster *Cluster) bool {
 	dns := cluster.Shoot.Spec.DNS
@@ -7,9 +7,13 @@ package controller_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/extensions/pkg/controller"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
 var _ = Describe("Shoot", func() {
@@ -278,4 +282,118 @@ var _ = Describe("Shoot", func() {
 		Entry("cluster is not failed", &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateError}, false),
 		Entry("cluster is not failed", nil, false),
 	)
+
+	DescribeTable("#IsPlanApprovalRequired",
+		func(cluster *Cluster, expected bool) {
+			Expect(IsPlanApprovalRequired(cluster)).To(Equal(expected))
+		},
+
+		Entry("cluster is nil", nil, false),
+		Entry("shoot does not require approval", &Cluster{Shoot: &gardencorev1beta1.Shoot{}}, false),
+		Entry("shoot requires approval", &Cluster{Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{"shoot.gardener.cloud/require-plan-approval": "true"},
+		}}}, true),
+	)
+
+	Describe("#ComputeShootSpecHash", func() {
+		It("should return an empty hash if there is no shoot", func() {
+			Expect(ComputeShootSpecHash(nil)).To(BeEmpty())
+		})
+
+		It("should only depend on the specification of the shoot", func() {
+			cluster := &Cluster{Shoot: &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Region: "local"}}}
+			hash := ComputeShootSpecHash(cluster)
+			Expect(hash).NotTo(BeEmpty())
+
+			cluster.Shoot.Generation = 2
+			cluster.Shoot.Annotations = map[string]string{"foo": "bar"}
+			Expect(ComputeShootSpecHash(cluster)).To(Equal(hash))
+
+			cluster.Shoot.Spec.Region = "other"
+			Expect(ComputeShootSpecHash(cluster)).NotTo(Equal(hash))
+		})
+	})
+
+	Describe("#NeedsPlanApproval", func() {
+		var (
+			cluster    *Cluster
+			changes    []extensionsv1alpha1.PlannedChange
+			plan       *extensionsv1alpha1.Plan
+			storedPlan *extensionsv1alpha1.Plan
+		)
+
+		BeforeEach(func() {
+			cluster = &Cluster{Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{
+				Generation:  3,
+				Annotations: map[string]string{"shoot.gardener.cloud/require-plan-approval": "true"},
+			}}}
+			changes = []extensionsv1alpha1.PlannedChange{{Kind: "MachineDeployment", Name: "pool-z1", Action: extensionsv1alpha1.PlannedChangeActionRollout}}
+			plan = &extensionsv1alpha1.Plan{ShootGeneration: ptr.To[int64](3), Changes: changes}
+			storedPlan = &extensionsv1alpha1.Plan{ShootGeneration: ptr.To[int64](2), Changes: changes}
+		})
+
+		It("should return false if the shoot does not require approval", func() {
+			delete(cluster.Shoot.Annotations, "shoot.gardener.cloud/require-plan-approval")
+			Expect(NeedsPlanApproval(plan, nil, nil, cluster)).To(BeFalse())
+		})
+
+		It("should return false if the plan has no changes", func() {
+			plan.Changes = nil
+			Expect(NeedsPlanApproval(plan, nil, nil, cluster)).To(BeFalse())
+		})
+
+		It("should return true if the plan has changes", func() {
+			Expect(NeedsPlanApproval(plan, nil, nil, cluster)).To(BeTrue())
+		})
+
+		It("should return true if the plan could not be computed", func() {
+			plan.Changes, plan.Error = nil, ptr.To("fake")
+			Expect(NeedsPlanApproval(plan, nil, nil, cluster)).To(BeTrue())
+		})
+
+		It("should return false if the shoot specification did not change since changes were applied last", func() {
+			Expect(NeedsPlanApproval(plan, nil, ptr.To(ComputeShootSpecHash(cluster)), cluster)).To(BeFalse())
+		})
+
+		It("should return true if the stored plan is not approved", func() {
+			cluster.Shoot.Annotations["shoot.gardener.cloud/approved-plan-generation"] = "1"
+			Expect(NeedsPlanApproval(plan, storedPlan, ptr.To("outdated"), cluster)).To(BeTrue())
+		})
+
+		It("should return false if the stored plan is approved and the changes did not change", func() {
+			cluster.Shoot.Annotations["shoot.gardener.cloud/approved-plan-generation"] = "2"
+			Expect(NeedsPlanApproval(plan, storedPlan, ptr.To("outdated"), cluster)).To(BeFalse())
+		})
+
+		It("should return true if the stored plan is approved but the changes changed", func() {
+			cluster.Shoot.Annotations["shoot.gardener.cloud/approved-plan-generation"] = "2"
+			plan.Changes = append(plan.Changes, extensionsv1alpha1.PlannedChange{Kind: "MachineDeployment", Name: "pool-z2", Action: extensionsv1alpha1.PlannedChangeActionRollout})
+			Expect(NeedsPlanApproval(plan, storedPlan, ptr.To("outdated"), cluster)).To(BeTrue())
+		})
+	})
+
+	DescribeTable("#HasSameChanges",
+		func(plan, other *extensionsv1alpha1.Plan, expected bool) {
+			Expect(HasSameChanges(plan, other)).To(Equal(expected))
+		},
+
+		Entry("other plan is nil", &extensionsv1alpha1.Plan{}, nil, false),
+		Entry("plans have no changes", &extensionsv1alpha1.Plan{ShootGeneration: ptr.To[int64](2)}, &extensionsv1alpha1.Plan{ShootGeneration: ptr.To[int64](3)}, true),
+		Entry("plans have the same changes", &extensionsv1alpha1.Plan{Changes: []extensionsv1alpha1.PlannedChange{{Name: "foo"}}}, &extensionsv1alpha1.Plan{Changes: []extensionsv1alpha1.PlannedChange{{Name: "foo"}}}, true),
+		Entry("plans have different changes", &extensionsv1alpha1.Plan{Changes: []extensionsv1alpha1.PlannedChange{{Name: "foo"}}}, &extensionsv1alpha1.Plan{Changes: []extensionsv1alpha1.PlannedChange{{Name: "bar"}}}, false),
+		Entry("plan could not be computed", &extensionsv1alpha1.Plan{Error: ptr.To("fake")}, &extensionsv1alpha1.Plan{Error: ptr.To("fake")}, false),
+	)
+
+	Describe("#NewPlan", func() {
+		It("should return a plan for the generation of the object and the shoot", func() {
+			plan := NewPlan(3, &Cluster{Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: 2}}})
+			Expect(plan.ObservedGeneration).To(Equal(int64(3)))
+			Expect(plan.ShootGeneration).To(PointTo(Equal(int64(2))))
+			Expect(plan.LastUpdateTime.IsZero()).To(BeFalse())
+		})
+
+		It("should return a plan without shoot generation if there is no cluster", func() {
+			Expect(NewPlan(3, nil).ShootGeneration).To(BeNil())
+		})
+	})
 })
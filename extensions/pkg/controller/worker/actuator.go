@@ -67,3 +67,18 @@ type Actuator interface {
 	// https://gardener.cloud/docs/gardener/extensions/migration/#implementation-details
 	Migrate(context.Context, logr.Logger, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) error
 }
+
+// Planner can optionally be implemented by an [Actuator] in order to support
+// the `plan` operation, i.e., the computation of the changes a
+// reconciliation of the [extensionsv1alpha1.Worker] resource would
+// perform. The operation is requested via the `gardener.cloud/operation=plan`
+// annotation and the result is stored in the .status.plan field.
+type Planner interface {
+	// Plan returns the changes which would be performed when reconciling the
+	// [extensionsv1alpha1.Worker] resource, e.g. machine deployments which
+	// would be created or deleted and worker pools whose machines would be
+	// rolled out.
+	//
+	// Implementations must not change any resources.
+	Plan(context.Context, logr.Logger, *extensionsv1alpha1.Worker, *extensionscontroller.Cluster) ([]extensionsv1alpha1.PlannedChange, error)
+}
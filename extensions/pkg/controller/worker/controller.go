@@ -60,7 +60,7 @@ type AddArgs struct {
 
 // DefaultPredicates returns the default predicates for a Worker reconciler.
 func DefaultPredicates(ctx context.Context, mgr manager.Manager, ignoreOperationAnnotation bool) []predicate.Predicate {
-	return extensionspredicate.DefaultControllerPredicates(ignoreOperationAnnotation, extensionspredicate.ShootNotFailedPredicate(ctx, mgr))
+	return extensionspredicate.DefaultPlanningControllerPredicates(ignoreOperationAnnotation, extensionspredicate.ShootNotFailedPredicate(ctx, mgr))
 }
 
 // Add creates a new Worker Controller and adds it to the Manager.
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package genericactuator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsworkercontroller "github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const kindMachineDeployment = "MachineDeployment"
+
+var _ extensionsworkercontroller.Planner = &genericActuator{}
+
+// Plan computes the machine deployments which would be created, updated or deleted and the ones whose machines would
+// be rolled out when reconciling the worker.
+func (a *genericActuator) Plan(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) ([]extensionsv1alpha1.PlannedChange, error) {
+	workerDelegate, err := a.delegateFactory.WorkerDelegate(ctx, worker, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("could not instantiate actuator context: %w", err)
+	}
+
+	log.Info("Generating machine deployments")
+	wantedMachineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the machine deployments: %w", err)
+	}
+
+	existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := a.seedClient.List(ctx, existingMachineDeployments, client.InNamespace(worker.Namespace)); err != nil {
+		return nil, err
+	}
+
+	return planMachineDeployments(worker, cluster, existingMachineDeployments, wantedMachineDeployments, extensionsv1alpha1helper.ClusterAutoscalerRequired(worker.Spec.Pools)), nil
+}
+
+// planMachineDeployments compares the existing with the wanted machine deployments. The wanted machine deployments are
+// rendered like during the reconciliation, so that all changes of their specification are detected. Changes of the
+// machine template result in a rollout of the machines unless the machine deployment is updated in-place. The machine
+// image, machine type and provider configuration of a worker pool are part of the hash in the machine class name, hence
+// changes to them are reported as changed machine class.
+func planMachineDeployments(
+	worker *extensionsv1alpha1.Worker,
+	cluster *extensionscontroller.Cluster,
+	existingMachineDeployments *machinev1alpha1.MachineDeploymentList,
+	wantedMachineDeployments extensionsworkercontroller.MachineDeployments,
+	clusterAutoscalerUsed bool,
+) []extensionsv1alpha1.PlannedChange {
+	var changes []extensionsv1alpha1.PlannedChange
+
+	for _, wantedMachineDeployment := range wantedMachineDeployments {
+		existingMachineDeployment := getExistingMachineDeployment(existingMachineDeployments, wantedMachineDeployment.Name)
+		if existingMachineDeployment == nil {
+			changes = append(changes, extensionsv1alpha1.PlannedChange{
+				Kind:        kindMachineDeployment,
+				Name:        wantedMachineDeployment.Name,
+				Action:      extensionsv1alpha1.PlannedChangeActionCreate,
+				Description: ptr.To(fmt.Sprintf("Machines of worker pool %q are created", wantedMachineDeployment.PoolName)),
+			})
+			continue
+		}
+
+		machineDeployment := existingMachineDeployment.DeepCopy()
+		setMachineDeploymentSpec(machineDeployment, wantedMachineDeployment, worker, cluster, existingMachineDeployments, clusterAutoscalerUsed)
+
+		var (
+			existingTemplate, wantedTemplate = existingMachineDeployment.Spec.Template.Spec, machineDeployment.Spec.Template.Spec
+			templateChanges, otherChanges    []string
+		)
+
+		if existingTemplate.Class.Name != wantedTemplate.Class.Name {
+			templateChanges = append(templateChanges, fmt.Sprintf("machine class changes from %q to %q", existingTemplate.Class.Name, wantedTemplate.Class.Name))
+		}
+		if !apiequality.Semantic.DeepEqual(existingTemplate.NodeTemplateSpec.Labels, wantedTemplate.NodeTemplateSpec.Labels) {
+			templateChanges = append(templateChanges, "node labels change")
+		}
+		if !apiequality.Semantic.DeepEqual(existingTemplate.NodeTemplateSpec.Annotations, wantedTemplate.NodeTemplateSpec.Annotations) {
+			templateChanges = append(templateChanges, "node annotations change")
+		}
+		if !apiequality.Semantic.DeepEqual(existingTemplate.NodeTemplateSpec.Spec.Taints, wantedTemplate.NodeTemplateSpec.Spec.Taints) {
+			templateChanges = append(templateChanges, "node taints change")
+		}
+		if !apiequality.Semantic.DeepEqual(existingTemplate.MachineConfiguration, wantedTemplate.MachineConfiguration) {
+			templateChanges = append(templateChanges, "machine configuration changes")
+		}
+		if !apiequality.Semantic.DeepEqual(existingMachineDeployment.Spec.Template.Labels, machineDeployment.Spec.Template.Labels) {
+			templateChanges = append(templateChanges, "machine labels change")
+		}
+
+		if existingMachineDeployment.Spec.Replicas != machineDeployment.Spec.Replicas {
+			otherChanges = append(otherChanges, fmt.Sprintf("replicas change from %d to %d", existingMachineDeployment.Spec.Replicas, machineDeployment.Spec.Replicas))
+		}
+		if !apiequality.Semantic.DeepEqual(existingMachineDeployment.Spec.Strategy, machineDeployment.Spec.Strategy) {
+			otherChanges = append(otherChanges, "update strategy changes")
+		}
+		if !apiequality.Semantic.DeepEqual(existingMachineDeployment.Labels, machineDeployment.Labels) ||
+			!apiequality.Semantic.DeepEqual(existingMachineDeployment.Annotations, machineDeployment.Annotations) ||
+			existingMachineDeployment.Spec.MinReadySeconds != machineDeployment.Spec.MinReadySeconds ||
+			!apiequality.Semantic.DeepEqual(existingMachineDeployment.Spec.RevisionHistoryLimit, machineDeployment.Spec.RevisionHistoryLimit) ||
+			!apiequality.Semantic.DeepEqual(existingMachineDeployment.Spec.Selector, machineDeployment.Spec.Selector) {
+			otherChanges = append(otherChanges, "metadata or settings of the machine deployment change")
+		}
+
+		if len(templateChanges) == 0 && len(otherChanges) == 0 {
+			continue
+		}
+
+		action := extensionsv1alpha1.PlannedChangeActionUpdate
+		if len(templateChanges) > 0 && wantedMachineDeployment.Strategy.Type != machinev1alpha1.InPlaceUpdateMachineDeploymentStrategyType {
+			action = extensionsv1alpha1.PlannedChangeActionRollout
+		}
+
+		changes = append(changes, extensionsv1alpha1.PlannedChange{
+			Kind:        kindMachineDeployment,
+			Name:        wantedMachineDeployment.Name,
+			Action:      action,
+			Description: ptr.To(fmt.Sprintf("Worker pool %q: %s", wantedMachineDeployment.PoolName, strings.Join(append(templateChanges, otherChanges...), ", "))),
+		})
+	}
+
+	for _, existingMachineDeployment := range existingMachineDeployments.Items {
+		if !wantedMachineDeployments.HasDeployment(existingMachineDeployment.Name) {
+			changes = append(changes, extensionsv1alpha1.PlannedChange{
+				Kind:   kindMachineDeployment,
+				Name:   existingMachineDeployment.Name,
+				Action: extensionsv1alpha1.PlannedChangeActionDelete,
+			})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package genericactuator
+
+import (
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsworkercontroller "github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("ActuatorPlan", func() {
+	Describe("#planMachineDeployments", func() {
+		var (
+			worker  *extensionsv1alpha1.Worker
+			cluster *extensionscontroller.Cluster
+		)
+
+		BeforeEach(func() {
+			worker = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "worker"}}
+			cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}}
+		})
+
+		wanted := func(name, poolName, className string) extensionsworkercontroller.MachineDeployment {
+			return extensionsworkercontroller.MachineDeployment{Name: name, PoolName: poolName, ClassName: className, Minimum: 1, Maximum: 3}
+		}
+
+		// existing renders the machine deployment like the reconciliation does, i.e., it is up-to-date.
+		existing := func(deployment extensionsworkercontroller.MachineDeployment) machinev1alpha1.MachineDeployment {
+			machineDeployment := machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: deployment.Name}}
+			setMachineDeploymentSpec(&machineDeployment, deployment, worker, cluster, &machinev1alpha1.MachineDeploymentList{}, true)
+			return machineDeployment
+		}
+
+		It("should return no changes if the machine deployments are up-to-date", func() {
+			deployment := wanted("pool1-z1", "pool1", "class-a")
+
+			Expect(planMachineDeployments(worker, cluster,
+				&machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{existing(deployment)}},
+				extensionsworkercontroller.MachineDeployments{deployment},
+				true,
+			)).To(BeEmpty())
+		})
+
+		It("should return the created, rolled out, updated and deleted machine deployments", func() {
+			pool1, pool2, pool3 := wanted("pool1-z1", "pool1", "class-a"), wanted("pool2-z1", "pool2", "class-b"), wanted("pool3-z1", "pool3", "class-c")
+			pool2.Strategy = machinev1alpha1.MachineDeploymentStrategy{Type: machinev1alpha1.InPlaceUpdateMachineDeploymentStrategyType}
+			existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{existing(pool1), existing(pool2), existing(pool3)}}
+
+			pool1.ClassName = "class-a2"
+			pool2.ClassName = "class-b2"
+
+			Expect(planMachineDeployments(worker, cluster, existingMachineDeployments,
+				extensionsworkercontroller.MachineDeployments{pool1, pool2, wanted("pool4-z1", "pool4", "class-d")},
+				true,
+			)).To(Equal([]extensionsv1alpha1.PlannedChange{
+				{Kind: "MachineDeployment", Name: "pool1-z1", Action: "Rollout", Description: ptr.To(`Worker pool "pool1": machine class changes from "class-a" to "class-a2"`)},
+				{Kind: "MachineDeployment", Name: "pool2-z1", Action: "Update", Description: ptr.To(`Worker pool "pool2": machine class changes from "class-b" to "class-b2"`)},
+				{Kind: "MachineDeployment", Name: "pool4-z1", Action: "Create", Description: ptr.To(`Machines of worker pool "pool4" are created`)},
+				{Kind: "MachineDeployment", Name: "pool3-z1", Action: "Delete"},
+			}))
+		})
+
+		It("should roll out the machines if the node template or the machine configuration changes", func() {
+			deployment := wanted("pool1-z1", "pool1", "class-a")
+			existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{existing(deployment)}}
+
+			deployment.Labels = map[string]string{"foo": "bar"}
+			deployment.Annotations = map[string]string{"foo": "bar"}
+			deployment.Taints = []corev1.Taint{{Key: "foo", Effect: corev1.TaintEffectNoSchedule}}
+			deployment.MachineConfiguration = &machinev1alpha1.MachineConfiguration{MaxEvictRetries: ptr.To[int32](5)}
+
+			Expect(planMachineDeployments(worker, cluster, existingMachineDeployments, extensionsworkercontroller.MachineDeployments{deployment}, true)).To(Equal([]extensionsv1alpha1.PlannedChange{
+				{Kind: "MachineDeployment", Name: "pool1-z1", Action: "Rollout", Description: ptr.To(`Worker pool "pool1": node labels change, node annotations change, node taints change, machine configuration changes`)},
+			}))
+		})
+
+		It("should update the machine deployment if only the replicas or the update strategy change", func() {
+			deployment := wanted("pool1-z1", "pool1", "class-a")
+			existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{existing(deployment)}}
+
+			deployment.Minimum = 2
+			deployment.Strategy = machinev1alpha1.MachineDeploymentStrategy{Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType}
+
+			Expect(planMachineDeployments(worker, cluster, existingMachineDeployments, extensionsworkercontroller.MachineDeployments{deployment}, true)).To(Equal([]extensionsv1alpha1.PlannedChange{
+				{Kind: "MachineDeployment", Name: "pool1-z1", Action: "Update", Description: ptr.To(`Worker pool "pool1": replicas change from 1 to 2, update strategy changes`)},
+			}))
+		})
+
+		It("should scale down the machine deployments if the shoot is hibernated", func() {
+			deployment := wanted("pool1-z1", "pool1", "class-a")
+			existingMachineDeployments := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{existing(deployment)}}
+
+			cluster.Shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+
+			Expect(planMachineDeployments(worker, cluster, existingMachineDeployments, extensionsworkercontroller.MachineDeployments{deployment}, true)).To(Equal([]extensionsv1alpha1.PlannedChange{
+				{Kind: "MachineDeployment", Name: "pool1-z1", Action: "Update", Description: ptr.To(`Worker pool "pool1": replicas change from 1 to 0`)},
+			}))
+		})
+	})
+})
@@ -186,11 +186,6 @@ func deployMachineDeployments(
 ) error {
 	log.Info("Deploying machine deployments")
 	for _, deployment := range wantedMachineDeployments {
-		var (
-			labels                    = map[string]string{extensionsworkercontroller.LabelKeyMachineDeploymentName: deployment.Name}
-			existingMachineDeployment = getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
-		)
-
 		machineDeployment := &machinev1alpha1.MachineDeployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      deployment.Name,
@@ -199,88 +194,14 @@ func deployMachineDeployments(
 		}
 
 		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, cl, machineDeployment, func() error {
-			metav1.SetMetaDataLabel(&machineDeployment.ObjectMeta, v1beta1constants.LabelWorkerPool, deployment.PoolName)
-			for k, v := range deployment.ClusterAutoscalerAnnotations {
-				if v == "" {
-					delete(machineDeployment.GetAnnotations(), k)
-				} else {
-					metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, k, v)
-				}
-			}
-
-			switch {
-			// If the Shoot is hibernated then the machine deployment's replicas should be zero.
-			// Also mark all machines for forceful deletion to avoid respecting of PDBs/SLAs in case of cluster hibernation.
-			case extensionscontroller.IsHibernationEnabled(cluster):
-				machineDeployment.Spec.Replicas = 0
+			// Mark all machines for forceful deletion to avoid respecting of PDBs/SLAs in case of cluster hibernation.
+			if extensionscontroller.IsHibernationEnabled(cluster) {
 				if err := markAllMachinesForcefulDeletion(ctx, log, cl, worker.Namespace); err != nil {
 					return fmt.Errorf("marking all machines for forceful deletion failed: %w", err)
 				}
-			// If the cluster autoscaler is not enabled then min=max (as per API validation), hence
-			// we can use either min or max.
-			case !clusterAutoscalerUsed:
-				machineDeployment.Spec.Replicas = deployment.Minimum
-			// If the machine deployment does not yet exist we set replicas to min so that the cluster
-			// autoscaler can scale them as required.
-			case existingMachineDeployment == nil:
-				if deployment.State != nil {
-					// During restoration the actual replica count is in the State.Replicas
-					// If wanted deployment has no corresponding existing deployment, but has State, then we are in restoration process
-					machineDeployment.Spec.Replicas = deployment.State.Replicas
-				} else {
-					machineDeployment.Spec.Replicas = deployment.Minimum
-				}
-			// If the Shoot was hibernated and is now woken up we set replicas to min so that the cluster
-			// autoscaler can scale them as required.
-			case shootIsAwake(extensionscontroller.IsHibernationEnabled(cluster), existingMachineDeployments):
-				machineDeployment.Spec.Replicas = deployment.Minimum
-			// If the shoot worker pool minimum was updated and if the current machine deployment replica
-			// count is less than minimum, we update the machine deployment replica count to updated minimum.
-			case machineDeployment.Spec.Replicas < deployment.Minimum:
-				machineDeployment.Spec.Replicas = deployment.Minimum
-			// If the shoot worker pool maximum was updated and if the current machine deployment replica
-			// count is greater than maximum, we update the machine deployment replica count to updated maximum.
-			case machineDeployment.Spec.Replicas > deployment.Maximum:
-				machineDeployment.Spec.Replicas = deployment.Maximum
 			}
 
-			// machineDeployment.Spec.Replicas is not explicitly set for default switch case,
-			// as it would have been already set by the client.Get() call in getAndCreateOrMergePatch().
-			// This is done to avoid overwriting the machineDeployment.Spec.Replicas value
-			// which is fetched from the client.Get() call in getAndCreateOrMergePatch()
-			// and hence causing unnecessary updates to the machineDeployment.Spec.Replicas
-			machineDeployment.Spec.RevisionHistoryLimit = ptr.To[int32](0)
-			machineDeployment.Spec.MinReadySeconds = 500
-			machineDeployment.Spec.Strategy = deployment.Strategy
-			machineDeployment.Spec.Selector = &metav1.LabelSelector{
-				MatchLabels: labels,
-			}
-			machineDeployment.Spec.Template = machinev1alpha1.MachineTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: getMachineLabels(deployment.Strategy, labels, worker.Name),
-				},
-				Spec: machinev1alpha1.MachineSpec{
-					Class: machinev1alpha1.ClassSpec{
-						Kind: "MachineClass",
-						Name: deployment.ClassName,
-					},
-					NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
-						ObjectMeta: metav1.ObjectMeta{
-							Annotations: deployment.Annotations,
-							Labels:      deployment.Labels,
-						},
-						Spec: corev1.NodeSpec{
-							Taints: deployment.Taints,
-						},
-					},
-					MachineConfiguration: deployment.MachineConfiguration,
-				},
-			}
-			if existingMachineDeployment != nil && existingMachineDeployment.Spec.Template.Annotations != nil {
-				for k, v := range existingMachineDeployment.Spec.Template.Annotations {
-					metav1.SetMetaDataAnnotation(&machineDeployment.Spec.Template.ObjectMeta, k, v)
-				}
-			}
+			setMachineDeploymentSpec(machineDeployment, deployment, worker, cluster, existingMachineDeployments, clusterAutoscalerUsed)
 
 			log.Info("Deploying machine deployment", "machineDeploymentName", machineDeployment.Name, "replicas", machineDeployment.Spec.Replicas)
 			return nil
@@ -292,6 +213,102 @@ func deployMachineDeployments(
 	return nil
 }
 
+// setMachineDeploymentSpec sets the metadata and the specification of the given machine deployment according to the
+// wanted machine deployment. The replicas of the machine deployment are only changed if required, e.g., if they are out
+// of the bounds of the worker pool or if the shoot is hibernated or woken up.
+func setMachineDeploymentSpec(
+	machineDeployment *machinev1alpha1.MachineDeployment,
+	deployment extensionsworkercontroller.MachineDeployment,
+	worker *extensionsv1alpha1.Worker,
+	cluster *extensionscontroller.Cluster,
+	existingMachineDeployments *machinev1alpha1.MachineDeploymentList,
+	clusterAutoscalerUsed bool,
+) {
+	var (
+		labels                    = map[string]string{extensionsworkercontroller.LabelKeyMachineDeploymentName: deployment.Name}
+		existingMachineDeployment = getExistingMachineDeployment(existingMachineDeployments, deployment.Name)
+	)
+
+	metav1.SetMetaDataLabel(&machineDeployment.ObjectMeta, v1beta1constants.LabelWorkerPool, deployment.PoolName)
+	for k, v := range deployment.ClusterAutoscalerAnnotations {
+		if v == "" {
+			delete(machineDeployment.GetAnnotations(), k)
+		} else {
+			metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, k, v)
+		}
+	}
+
+	switch {
+	// If the Shoot is hibernated then the machine deployment's replicas should be zero.
+	case extensionscontroller.IsHibernationEnabled(cluster):
+		machineDeployment.Spec.Replicas = 0
+	// If the cluster autoscaler is not enabled then min=max (as per API validation), hence
+	// we can use either min or max.
+	case !clusterAutoscalerUsed:
+		machineDeployment.Spec.Replicas = deployment.Minimum
+	// If the machine deployment does not yet exist we set replicas to min so that the cluster
+	// autoscaler can scale them as required.
+	case existingMachineDeployment == nil:
+		if deployment.State != nil {
+			// During restoration the actual replica count is in the State.Replicas
+			// If wanted deployment has no corresponding existing deployment, but has State, then we are in restoration process
+			machineDeployment.Spec.Replicas = deployment.State.Replicas
+		} else {
+			machineDeployment.Spec.Replicas = deployment.Minimum
+		}
+	// If the Shoot was hibernated and is now woken up we set replicas to min so that the cluster
+	// autoscaler can scale them as required.
+	case shootIsAwake(extensionscontroller.IsHibernationEnabled(cluster), existingMachineDeployments):
+		machineDeployment.Spec.Replicas = deployment.Minimum
+	// If the shoot worker pool minimum was updated and if the current machine deployment replica
+	// count is less than minimum, we update the machine deployment replica count to updated minimum.
+	case machineDeployment.Spec.Replicas < deployment.Minimum:
+		machineDeployment.Spec.Replicas = deployment.Minimum
+	// If the shoot worker pool maximum was updated and if the current machine deployment replica
+	// count is greater than maximum, we update the machine deployment replica count to updated maximum.
+	case machineDeployment.Spec.Replicas > deployment.Maximum:
+		machineDeployment.Spec.Replicas = deployment.Maximum
+	}
+
+	// machineDeployment.Spec.Replicas is not explicitly set for default switch case,
+	// as it would have been already set by the client.Get() call in getAndCreateOrMergePatch().
+	// This is done to avoid overwriting the machineDeployment.Spec.Replicas value
+	// which is fetched from the client.Get() call in getAndCreateOrMergePatch()
+	// and hence causing unnecessary updates to the machineDeployment.Spec.Replicas
+	machineDeployment.Spec.RevisionHistoryLimit = ptr.To[int32](0)
+	machineDeployment.Spec.MinReadySeconds = 500
+	machineDeployment.Spec.Strategy = deployment.Strategy
+	machineDeployment.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: labels,
+	}
+	machineDeployment.Spec.Template = machinev1alpha1.MachineTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: getMachineLabels(deployment.Strategy, labels, worker.Name),
+		},
+		Spec: machinev1alpha1.MachineSpec{
+			Class: machinev1alpha1.ClassSpec{
+				Kind: "MachineClass",
+				Name: deployment.ClassName,
+			},
+			NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: deployment.Annotations,
+					Labels:      deployment.Labels,
+				},
+				Spec: corev1.NodeSpec{
+					Taints: deployment.Taints,
+				},
+			},
+			MachineConfiguration: deployment.MachineConfiguration,
+		},
+	}
+	if existingMachineDeployment != nil && existingMachineDeployment.Spec.Template.Annotations != nil {
+		for k, v := range existingMachineDeployment.Spec.Template.Annotations {
+			metav1.SetMetaDataAnnotation(&machineDeployment.Spec.Template.ObjectMeta, k, v)
+		}
+	}
+}
+
 func getMachineLabels(strategy machinev1alpha1.MachineDeploymentStrategy, labels map[string]string, workerName string) map[string]string {
 	if strategy.Type != machinev1alpha1.InPlaceUpdateMachineDeploymentStrategyType {
 		return labels
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener/extensions/pkg/controller/worker (interfaces: Actuator)
+// Source: github.com/gardener/gardener/extensions/pkg/controller/worker (interfaces: Actuator,Planner)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks.go -package=mock github.com/gardener/gardener/extensions/pkg/controller/worker Actuator
+//	mockgen -destination=mocks.go -package=mock github.com/gardener/gardener/extensions/pkg/controller/worker Actuator,Planner
 //
 
 // Package mock is a generated GoMock package.
@@ -112,3 +112,42 @@ func (mr *MockActuatorMockRecorder) Restore(arg0, arg1, arg2, arg3 any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockActuator)(nil).Restore), arg0, arg1, arg2, arg3)
 }
+
+// MockPlanner is a mock of Planner interface.
+type MockPlanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlannerMockRecorder
+	isgomock struct{}
+}
+
+// MockPlannerMockRecorder is the mock recorder for MockPlanner.
+type MockPlannerMockRecorder struct {
+	mock *MockPlanner
+}
+
+// NewMockPlanner creates a new mock instance.
+func NewMockPlanner(ctrl *gomock.Controller) *MockPlanner {
+	mock := &MockPlanner{ctrl: ctrl}
+	mock.recorder = &MockPlannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanner) EXPECT() *MockPlannerMockRecorder {
+	return m.recorder
+}
+
+// Plan mocks base method.
+func (m *MockPlanner) Plan(arg0 context.Context, arg1 logr.Logger, arg2 *v1alpha1.Worker, arg3 *controller.Cluster) ([]v1alpha1.PlannedChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]v1alpha1.PlannedChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockPlannerMockRecorder) Plan(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockPlanner)(nil).Plan), arg0, arg1, arg2, arg3)
+}
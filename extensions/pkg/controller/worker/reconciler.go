@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -81,6 +82,8 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.delete(ctx, log.WithValues("operation", "delete"), worker, cluster)
 	case operationType == gardencorev1beta1.LastOperationTypeRestore:
 		return r.restore(ctx, log.WithValues("operation", "restore"), worker, cluster)
+	case worker.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan:
+		return r.plan(ctx, log.WithValues("operation", "plan"), worker, cluster)
 	default:
 		return r.reconcile(ctx, log.WithValues("operation", "reconcile"), worker, cluster, operationType)
 	}
@@ -100,6 +103,103 @@ func (r *reconciler) removeAnnotation(ctx context.Context, worker *extensionsv1a
 	return extensionscontroller.RemoveAnnotation(ctx, r.client, worker, v1beta1constants.GardenerOperation)
 }
 
+func (r *reconciler) plan(
+	ctx context.Context,
+	log logr.Logger,
+	worker *extensionsv1alpha1.Worker,
+	cluster *extensionscontroller.Cluster,
+) (
+	reconcile.Result,
+	error,
+) {
+	// The annotation is removed before computing the plan since a plan is only computed on request. Errors are reported
+	// in the plan instead of being retried.
+	if err := r.removeAnnotation(ctx, worker); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error removing annotation from Worker: %+v", err)
+	}
+
+	planner, ok := r.actuator.(Planner)
+	if !ok {
+		log.Info("Actuator does not support computing plans, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	plan := computePlan(ctx, log, planner, worker, cluster)
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	worker.Status.Plan = plan
+	if err := r.client.Status().Patch(ctx, worker, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating plan in status: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// awaitPlanApproval computes the plan of the Worker and returns true if its changes must not be applied before the plan
+// is approved. In this case, the plan is stored in the status unless the stored plan contains the same changes (which
+// keeps its approval valid), and the last operation is set to Pending. The Worker is reconciled again once the Shoot is
+// annotated with the approved generation and gardenlet requests the reconciliation.
+func (r *reconciler) awaitPlanApproval(
+	ctx context.Context,
+	log logr.Logger,
+	planner Planner,
+	worker *extensionsv1alpha1.Worker,
+	cluster *extensionscontroller.Cluster,
+	operationType gardencorev1beta1.LastOperationType,
+) (
+	bool,
+	error,
+) {
+	plan := computePlan(ctx, log, planner, worker, cluster)
+	if !extensionscontroller.NeedsPlanApproval(plan, worker.Status.Plan, worker.Status.AppliedShootSpecHash, cluster) {
+		return false, nil
+	}
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	if !extensionscontroller.HasSameChanges(plan, worker.Status.Plan) {
+		worker.Status.Plan = plan
+	}
+	description := fmt.Sprintf("Waiting for approval of plan: changes are only applied after the plan for generation %d of the Shoot has been approved via the %s annotation", ptr.Deref(worker.Status.Plan.ShootGeneration, 0), v1beta1constants.AnnotationShootApprovedPlanGeneration)
+	log.Info(description) //nolint:logcheck
+	worker.Status.LastOperation = extensionscontroller.LastOperation(operationType, gardencorev1beta1.LastOperationStatePending, 1, description)
+	worker.Status.LastError = nil
+	if err := r.client.Status().Patch(ctx, worker, patch); err != nil {
+		return false, fmt.Errorf("failed updating plan in status: %w", err)
+	}
+
+	return true, nil
+}
+
+// recordAppliedShootSpec stores the hash of the Shoot specification whose changes were applied in the status of the
+// Worker. If the Shoot requires approving plans, the stored plan is replaced by a plan without changes since the Worker
+// reached its desired state.
+func (r *reconciler) recordAppliedShootSpec(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	if cluster == nil || cluster.Shoot == nil {
+		return nil
+	}
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	worker.Status.AppliedShootSpecHash = ptr.To(extensionscontroller.ComputeShootSpecHash(cluster))
+	if extensionscontroller.IsPlanApprovalRequired(cluster) {
+		worker.Status.Plan = extensionscontroller.NewPlan(worker.Generation, cluster)
+	}
+	return r.client.Status().Patch(ctx, worker, patch)
+}
+
+func computePlan(ctx context.Context, log logr.Logger, planner Planner, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) *extensionsv1alpha1.Plan {
+	plan := extensionscontroller.NewPlan(worker.Generation, cluster)
+
+	log.Info("Computing plan of Worker")
+	if changes, err := planner.Plan(ctx, log, worker, cluster); err != nil {
+		plan.Error = ptr.To(fmt.Sprintf("Error computing plan of Worker: %v", err))
+	} else {
+		plan.Changes = changes
+	}
+
+	log.Info("Successfully computed plan of Worker", "changes", len(plan.Changes), "failed", plan.Error != nil)
+	return plan
+}
+
 func (r *reconciler) migrate(
 	ctx context.Context,
 	log logr.Logger,
@@ -188,6 +288,13 @@ func (r *reconciler) reconcile(
 		}
 	}
 
+	planner, ok := r.actuator.(Planner)
+	if ok && extensionscontroller.IsPlanApprovalRequired(cluster) {
+		if pending, err := r.awaitPlanApproval(ctx, log, planner, worker, cluster, operationType); err != nil || pending {
+			return reconcile.Result{}, err
+		}
+	}
+
 	if err := r.statusUpdater.Processing(ctx, log, worker, operationType, "Reconciling the Worker"); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -197,6 +304,12 @@ func (r *reconciler) reconcile(
 		return reconcilerutils.ReconcileErr(err)
 	}
 
+	if ok {
+		if err := r.recordAppliedShootSpec(ctx, worker, cluster); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed recording applied Shoot specification in status: %w", err)
+		}
+	}
+
 	if err := r.statusUpdater.Success(ctx, log, worker, operationType, "Successfully reconciled Worker"); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -230,6 +343,12 @@ func (r *reconciler) restore(
 		return reconcilerutils.ReconcileErr(err)
 	}
 
+	if _, ok := r.actuator.(Planner); ok {
+		if err := r.recordAppliedShootSpec(ctx, worker, cluster); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed recording applied Shoot specification in status: %w", err)
+		}
+	}
+
 	if err := r.statusUpdater.Success(ctx, log, worker, gardencorev1beta1.LastOperationTypeRestore, "Successfully reconciled Worker"); err != nil {
 		return reconcile.Result{}, err
 	}
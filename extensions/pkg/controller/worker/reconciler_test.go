@@ -14,10 +14,12 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -336,8 +338,246 @@ var _ = Describe("Worker Reconcile", func() {
 			wantErr: true,
 		}),
 	)
+
+	Describe("plan operation", func() {
+		var (
+			c          client.Client
+			reconciler reconcile.Reconciler
+			mock       *extensionsmockworker.MockActuator
+			planner    *extensionsmockworker.MockPlanner
+		)
+
+		BeforeEach(func() {
+			c = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+				addOperationAnnotationToWorker(getWorker(), v1beta1constants.GardenerOperationPlan),
+				getCluster(),
+			).WithStatusSubresource(&extensionsv1alpha1.Worker{}).Build()
+
+			mgr.EXPECT().GetClient().Return(c).AnyTimes()
+			mgr.EXPECT().GetAPIReader().Return(mockclient.NewMockReader(ctrl)).AnyTimes()
+
+			mock = extensionsmockworker.NewMockActuator(ctrl)
+			planner = extensionsmockworker.NewMockPlanner(ctrl)
+			reconciler = worker.NewReconciler(mgr, &planningActuator{MockActuator: mock, MockPlanner: planner})
+		})
+
+		getUpdatedWorker := func() *extensionsv1alpha1.Worker {
+			obj := &extensionsv1alpha1.Worker{}
+			ExpectWithOffset(1, c.Get(ctx, arguments.request.NamespacedName, obj)).To(Succeed())
+			ExpectWithOffset(1, obj.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			return obj
+		}
+
+		It("should store the planned changes in the status without reconciling", func() {
+			changes := []extensionsv1alpha1.PlannedChange{{Kind: "MachineDeployment", Name: "pool-z1", Action: extensionsv1alpha1.PlannedChangeActionRollout}}
+			planner.EXPECT().Plan(ctx, gomock.Any(), gomock.AssignableToTypeOf(&extensionsv1alpha1.Worker{}), gomock.AssignableToTypeOf(&extensionscontroller.Cluster{})).Return(changes, nil)
+
+			Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+			obj := getUpdatedWorker()
+			Expect(obj.Status.Plan).NotTo(BeNil())
+			Expect(obj.Status.Plan.Changes).To(Equal(changes))
+			Expect(obj.Status.Plan.Error).To(BeNil())
+			Expect(obj.Status.LastOperation).To(BeNil())
+		})
+
+		It("should report the error in the plan", func() {
+			planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("fake"))
+
+			Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+			obj := getUpdatedWorker()
+			Expect(obj.Status.Plan).NotTo(BeNil())
+			Expect(obj.Status.Plan.Changes).To(BeEmpty())
+			Expect(obj.Status.Plan.Error).To(PointTo(Equal("Error computing plan of Worker: fake")))
+		})
+
+		It("should only remove the annotation if the actuator does not support computing plans", func() {
+			reconciler = worker.NewReconciler(mgr, mock)
+
+			Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+			Expect(getUpdatedWorker().Status.Plan).To(BeNil())
+		})
+	})
+
+	Describe("plan approval", func() {
+		var (
+			c          client.Client
+			reconciler reconcile.Reconciler
+			mock       *extensionsmockworker.MockActuator
+			planner    *extensionsmockworker.MockPlanner
+			changes    []extensionsv1alpha1.PlannedChange
+
+			shoot *gardencorev1beta1.Shoot
+			obj   *extensionsv1alpha1.Worker
+		)
+
+		BeforeEach(func() {
+			mock = extensionsmockworker.NewMockActuator(ctrl)
+			planner = extensionsmockworker.NewMockPlanner(ctrl)
+			changes = []extensionsv1alpha1.PlannedChange{{Kind: "MachineDeployment", Name: "pool-z1", Action: extensionsv1alpha1.PlannedChangeActionRollout}}
+
+			shoot = &gardencorev1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Shoot",
+					APIVersion: "core.gardener.cloud/v1beta1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Generation:  3,
+					Annotations: map[string]string{v1beta1constants.AnnotationShootRequirePlanApproval: "true"},
+				},
+				Spec: gardencorev1beta1.ShootSpec{Region: "local"},
+			}
+			obj = addFinalizerToWorker(getWorker(), worker.FinalizerName)
+			obj.Status.LastError = &gardencorev1beta1.LastError{Description: "previous error"}
+		})
+
+		JustBeforeEach(func() {
+			cluster := getCluster()
+			cluster.Spec.Shoot.Raw = encode(shoot)
+
+			c = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(obj, cluster).WithStatusSubresource(&extensionsv1alpha1.Worker{}).Build()
+			mgr.EXPECT().GetClient().Return(c).AnyTimes()
+			mgr.EXPECT().GetAPIReader().Return(mockclient.NewMockReader(ctrl)).AnyTimes()
+
+			reconciler = worker.NewReconciler(mgr, &planningActuator{MockActuator: mock, MockPlanner: planner})
+		})
+
+		getUpdatedWorker := func() *extensionsv1alpha1.Worker {
+			obj := &extensionsv1alpha1.Worker{}
+			ExpectWithOffset(1, c.Get(ctx, arguments.request.NamespacedName, obj)).To(Succeed())
+			return obj
+		}
+
+		storedPlan := func(shootGeneration int64, changes []extensionsv1alpha1.PlannedChange) *extensionsv1alpha1.Plan {
+			plan := extensionscontroller.NewPlan(obj.Generation, &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: shootGeneration}}})
+			plan.Changes = changes
+			return plan
+		}
+
+		expectReconciled := func() {
+			obj := getUpdatedWorker()
+			ExpectWithOffset(1, obj.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"State": Equal(gardencorev1beta1.LastOperationStateSucceeded),
+			})))
+			ExpectWithOffset(1, obj.Status.AppliedShootSpecHash).To(PointTo(Equal(extensionscontroller.ComputeShootSpecHash(&extensionscontroller.Cluster{Shoot: shoot}))))
+			ExpectWithOffset(1, obj.Status.Plan).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Changes":         BeEmpty(),
+				"ShootGeneration": PointTo(Equal(int64(3))),
+			})))
+		}
+
+		It("should compute the plan and wait for its approval", func() {
+			planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(changes, nil)
+
+			Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+			obj := getUpdatedWorker()
+			Expect(obj.Status.Plan).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Changes":         Equal(changes),
+				"ShootGeneration": PointTo(Equal(int64(3))),
+			})))
+			Expect(obj.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":        Equal(gardencorev1beta1.LastOperationTypeCreate),
+				"State":       Equal(gardencorev1beta1.LastOperationStatePending),
+				"Description": ContainSubstring("plan for generation 3 of the Shoot has been approved via the " + v1beta1constants.AnnotationShootApprovedPlanGeneration + " annotation"),
+			})))
+			Expect(obj.Status.LastError).To(BeNil())
+		})
+
+		It("should wait for the approval of a plan which could not be computed", func() {
+			planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("fake"))
+
+			Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+			obj := getUpdatedWorker()
+			Expect(obj.Status.Plan.Error).To(PointTo(Equal("Error computing plan of Worker: fake")))
+			Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+		})
+
+		It("should reconcile if the plan has no changes", func() {
+			planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any())
+			mock.EXPECT().Reconcile(ctx, gomock.Any(), gomock.Any(), gomock.Any())
+
+			Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+			expectReconciled()
+		})
+
+		Context("Shoot specification did not change since changes were applied last", func() {
+			BeforeEach(func() {
+				obj.Status.AppliedShootSpecHash = ptr.To(extensionscontroller.ComputeShootSpecHash(&extensionscontroller.Cluster{Shoot: shoot}))
+			})
+
+			It("should reconcile system-driven changes without approval", func() {
+				planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(changes, nil)
+				mock.EXPECT().Reconcile(ctx, gomock.Any(), gomock.Any(), gomock.Any())
+
+				Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+				expectReconciled()
+			})
+		})
+
+		Context("stored plan is approved", func() {
+			BeforeEach(func() {
+				obj.Status.AppliedShootSpecHash = ptr.To("outdated")
+				shoot.Annotations[v1beta1constants.AnnotationShootApprovedPlanGeneration] = "2"
+				obj.Status.Plan = storedPlan(2, changes)
+			})
+
+			It("should reconcile if the changes did not change", func() {
+				planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(changes, nil)
+				mock.EXPECT().Reconcile(ctx, gomock.Any(), gomock.Any(), gomock.Any())
+
+				Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+				expectReconciled()
+			})
+
+			It("should store the new plan and wait for its approval if the changes changed", func() {
+				newChanges := []extensionsv1alpha1.PlannedChange{{Kind: "MachineDeployment", Name: "pool-z2", Action: extensionsv1alpha1.PlannedChangeActionRollout}}
+				planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(append(changes, newChanges...), nil)
+
+				Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+				obj := getUpdatedWorker()
+				Expect(obj.Status.Plan).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Changes":         Equal(append(changes, newChanges...)),
+					"ShootGeneration": PointTo(Equal(int64(3))),
+				})))
+				Expect(obj.Status.LastOperation.State).To(Equal(gardencorev1beta1.LastOperationStatePending))
+			})
+		})
+
+		Context("stored plan is not approved", func() {
+			BeforeEach(func() {
+				obj.Status.Plan = storedPlan(2, changes)
+			})
+
+			It("should keep the stored plan if the changes did not change", func() {
+				planner.EXPECT().Plan(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(changes, nil)
+
+				Expect(reconciler.Reconcile(ctx, arguments.request)).To(Equal(reconcile.Result{}))
+
+				obj := getUpdatedWorker()
+				Expect(obj.Status.Plan.ShootGeneration).To(PointTo(Equal(int64(2))))
+				Expect(obj.Status.LastOperation).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"State":       Equal(gardencorev1beta1.LastOperationStatePending),
+					"Description": ContainSubstring("plan for generation 2 of the Shoot"),
+				})))
+			})
+		})
+	})
 })
 
+type planningActuator struct {
+	*extensionsmockworker.MockActuator
+	*extensionsmockworker.MockPlanner
+}
+
 func getWorker() *extensionsv1alpha1.Worker {
 	return &extensionsv1alpha1.Worker{
 		TypeMeta: metav1.TypeMeta{
@@ -81,6 +81,20 @@ var defaultControllerPredicate = predicate.Funcs{
 	GenericFunc: func(event.GenericEvent) bool { return false },
 }
 
+// DefaultPlanningControllerPredicates returns the default predicates for extension controllers whose actuators may
+// support computing plans (see the 'plan' operation). In addition to the predicates returned by
+// DefaultControllerPredicates, it admits objects having the 'plan' operation annotation.
+func DefaultPlanningControllerPredicates(ignoreOperationAnnotation bool, preconditions ...predicate.Predicate) []predicate.Predicate {
+	return append(preconditions, predicate.Or(append(DefaultControllerPredicates(ignoreOperationAnnotation), HasPlanOperationAnnotation())...))
+}
+
+// HasPlanOperationAnnotation is a predicate for the 'plan' operation annotation.
+func HasPlanOperationAnnotation() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationPlan
+	})
+}
+
 func hasOperationAnnotation(obj client.Object) bool {
 	return obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile ||
 		obj.GetAnnotations()[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationRestore ||
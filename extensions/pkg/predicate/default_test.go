@@ -7,6 +7,7 @@ package predicate_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -116,4 +117,54 @@ var _ = Describe("Default", func() {
 			})
 		})
 	})
+
+	Describe("DefaultPlanningControllerPredicates", func() {
+		var (
+			pred predicate.Predicate
+			obj  *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			obj = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Generation: 1}}
+		})
+
+		When("operation annotation is not ignored", func() {
+			BeforeEach(func() {
+				pred = DefaultPlanningControllerPredicates(false)[0]
+			})
+
+			DescribeTable("#Update",
+				func(operation string, matcher gomegatypes.GomegaMatcher) {
+					obj.SetAnnotations(map[string]string{"gardener.cloud/operation": operation})
+					Expect(pred.Update(event.UpdateEvent{ObjectNew: obj, ObjectOld: obj.DeepCopy()})).To(matcher)
+				},
+
+				Entry("plan", "plan", BeTrue()),
+				Entry("reconcile", "reconcile", BeTrue()),
+				Entry("other", "foo", BeFalse()),
+			)
+		})
+
+		When("operation annotation is ignored", func() {
+			BeforeEach(func() {
+				pred = DefaultPlanningControllerPredicates(true)[0]
+			})
+
+			It("should return true when the plan operation annotation is set", func() {
+				oldObj := obj.DeepCopy()
+				obj.SetAnnotations(map[string]string{"gardener.cloud/operation": "plan"})
+				Expect(pred.Update(event.UpdateEvent{ObjectNew: obj, ObjectOld: oldObj})).To(BeTrue())
+			})
+
+			It("should return true when the generation changed", func() {
+				oldObj := obj.DeepCopy()
+				obj.Generation++
+				Expect(pred.Update(event.UpdateEvent{ObjectNew: obj, ObjectOld: oldObj})).To(BeTrue())
+			})
+
+			It("should return false when neither the plan operation annotation is set nor the generation changed", func() {
+				Expect(pred.Update(event.UpdateEvent{ObjectNew: obj, ObjectOld: obj.DeepCopy()})).To(BeFalse())
+			})
+		})
+	})
 })
@@ -0,0 +1,615 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// CommandPlan is a constant for the "plan" command.
+const CommandPlan = "plan"
+
+const (
+	// defaultStateSyncInterval is the default interval in which the state is persisted while the subprocess is running.
+	defaultStateSyncInterval = 10 * time.Second
+	// defaultPodTerminationGracePeriod is the default termination grace period of the extension pod (Kubernetes default).
+	defaultPodTerminationGracePeriod = 30 * time.Second
+	// stateStoreTimeout is the time reserved for persisting the state after the subprocess was interrupted.
+	stateStoreTimeout = 10 * time.Second
+)
+
+type processFactory struct {
+	binary    string
+	pluginDir string
+}
+
+// NewProcessFactory returns a Factory producing Terraformers which execute the given OpenTofu (or Terraform) binary as
+// a supervised subprocess instead of deploying Terraformer pods. If pluginDir is not empty, the providers are only
+// installed from this directory, i.e., they are never downloaded. The image passed to the factory methods is ignored.
+func NewProcessFactory(binary, pluginDir string) Factory {
+	return processFactory{binary: binary, pluginDir: pluginDir}
+}
+
+func (f processFactory) NewForConfig(logger logr.Logger, config *rest.Config, purpose, namespace, name, _ string) (Terraformer, error) {
+	c, err := client.New(config, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	coreV1Client, err := corev1client.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.New(logger, c, coreV1Client, purpose, namespace, name, ""), nil
+}
+
+func (f processFactory) New(logger logr.Logger, c client.Client, coreV1Client corev1client.CoreV1Interface, purpose, namespace, name, _ string) Terraformer {
+	return NewProcess(logger, c, coreV1Client, purpose, namespace, name, f.binary, f.pluginDir)
+}
+
+func (f processFactory) DefaultInitializer(c client.Client, main, variables string, tfVars []byte, stateInitializer StateConfigMapInitializer) Initializer {
+	return DefaultInitializer(c, main, variables, tfVars, stateInitializer)
+}
+
+// processTerraformer executes OpenTofu (or Terraform) as a supervised subprocess. The configuration, variables and
+// state are stored in the same ConfigMaps and Secret as for the pod-based Terraformer, hence both implementations can be
+// exchanged for existing resources.
+type processTerraformer struct {
+	*terraformer
+
+	binary                    string
+	pluginDir                 string
+	messageHandler            MessageHandler
+	stateSyncInterval         time.Duration
+	podTerminationGracePeriod time.Duration
+}
+
+// NewProcess creates a new Terraformer which executes the given OpenTofu (or Terraform) binary as a supervised
+// subprocess. See New for the meaning of the other parameters. The deadline for the Terraformer pod is used as timeout
+// for the execution, and the termination grace period is the time the subprocess is given to persist its state after
+// it was interrupted. It is capped so that the extension can still store the state before its own pod is killed, see
+// SetPodTerminationGracePeriod.
+func NewProcess(
+	logger logr.Logger,
+	c client.Client,
+	coreV1Client corev1client.CoreV1Interface,
+	purpose,
+	namespace,
+	name,
+	binary,
+	pluginDir string,
+) ProcessTerraformer {
+	t := New(logger, c, coreV1Client, purpose, namespace, name, "").(*terraformer)
+	t.terminationGracePeriodSeconds = 15
+
+	return &processTerraformer{
+		terraformer:               t,
+		binary:                    binary,
+		pluginDir:                 pluginDir,
+		stateSyncInterval:         defaultStateSyncInterval,
+		podTerminationGracePeriod: defaultPodTerminationGracePeriod,
+	}
+}
+
+// SetMessageHandler configures a handler which is called for every structured log message of the subprocess.
+func (p *processTerraformer) SetMessageHandler(handler MessageHandler) ProcessTerraformer {
+	p.messageHandler = handler
+	return p
+}
+
+// SetStateSyncInterval configures the interval in which the state is persisted while the subprocess is running, if it
+// has changed.
+func (p *processTerraformer) SetStateSyncInterval(d time.Duration) ProcessTerraformer {
+	p.stateSyncInterval = d
+	return p
+}
+
+// SetPodTerminationGracePeriod configures the termination grace period of the pod of the extension. The subprocess is
+// killed early enough to persist its state within this period when the extension is terminated.
+func (p *processTerraformer) SetPodTerminationGracePeriod(d time.Duration) ProcessTerraformer {
+	p.podTerminationGracePeriod = d
+	return p
+}
+
+// SetLogLevel sets the log level of the Terraformer. For level 'debug', the debug logs of the subprocess are enabled.
+func (p *processTerraformer) SetLogLevel(level string) Terraformer {
+	p.terraformer.SetLogLevel(level)
+	return p
+}
+
+// SetEnvVars sets the provided environment variables for the subprocess. Values referring to keys of Secrets or
+// ConfigMaps are resolved from the namespace of the Terraformer.
+func (p *processTerraformer) SetEnvVars(envVars ...corev1.EnvVar) Terraformer {
+	p.terraformer.SetEnvVars(envVars...)
+	return p
+}
+
+// SetTerminationGracePeriodSeconds configures the time the subprocess is given to terminate after it was interrupted.
+func (p *processTerraformer) SetTerminationGracePeriodSeconds(terminationGracePeriodSeconds int64) Terraformer {
+	p.terraformer.SetTerminationGracePeriodSeconds(terminationGracePeriodSeconds)
+	return p
+}
+
+// SetDeadlineCleaning configures the deadline while waiting for a clean environment.
+func (p *processTerraformer) SetDeadlineCleaning(d time.Duration) Terraformer {
+	p.terraformer.SetDeadlineCleaning(d)
+	return p
+}
+
+// SetDeadlinePod configures the timeout for the execution of the subprocess.
+func (p *processTerraformer) SetDeadlinePod(d time.Duration) Terraformer {
+	p.terraformer.SetDeadlinePod(d)
+	return p
+}
+
+// SetDeadlinePodCreation has no effect since no pods are created.
+func (p *processTerraformer) SetDeadlinePodCreation(d time.Duration) Terraformer {
+	p.terraformer.SetDeadlinePodCreation(d)
+	return p
+}
+
+// SetOwnerRef configures the resource that will be used as owner of the secrets and configmaps.
+func (p *processTerraformer) SetOwnerRef(owner *metav1.OwnerReference) Terraformer {
+	p.terraformer.SetOwnerRef(owner)
+	return p
+}
+
+// UseProjectedTokenMount has no effect since no pods are created.
+func (p *processTerraformer) UseProjectedTokenMount(useProjectedTokenMount bool) Terraformer {
+	p.terraformer.UseProjectedTokenMount(useProjectedTokenMount)
+	return p
+}
+
+// InitializeWith initializes the Terraformer with the given Initializer.
+func (p *processTerraformer) InitializeWith(ctx context.Context, initializer Initializer) Terraformer {
+	p.terraformer.InitializeWith(ctx, initializer)
+	return p
+}
+
+// Apply executes 'apply' in a subprocess and stores the resulting state.
+func (p *processTerraformer) Apply(ctx context.Context) error {
+	if !p.configurationInitialized {
+		return errors.New("terraformer configuration has not been defined, cannot execute Terraformer")
+	}
+	_, err := p.run(ctx, CommandApply)
+	return err
+}
+
+// Destroy executes 'destroy' in a subprocess and cleans up the configuration afterwards.
+func (p *processTerraformer) Destroy(ctx context.Context) error {
+	if _, err := p.run(ctx, CommandDestroy); err != nil {
+		return err
+	}
+	return p.CleanupConfiguration(ctx)
+}
+
+// Plan executes 'plan' in a subprocess and returns the planned changes. Neither the infrastructure nor the stored
+// state are changed.
+func (p *processTerraformer) Plan(ctx context.Context) (*PlanResult, error) {
+	if !p.configurationInitialized {
+		return nil, errors.New("terraformer configuration has not been defined, cannot execute Terraformer")
+	}
+	return p.run(ctx, CommandPlan)
+}
+
+func (p *processTerraformer) run(ctx context.Context, command string) (*PlanResult, error) {
+	logger := p.logger.WithValues("command", command)
+
+	if canExecute, err := p.configurationExists(ctx, logger, command); err != nil || !canExecute {
+		return nil, err
+	}
+
+	if command == CommandDestroy && p.IsStateEmpty(ctx) {
+		logger.Info("Terraform state is empty, skipping execution")
+		return nil, nil
+	}
+
+	// Terraformer pods might still exist if the pod-based implementation was used before. They must not operate on the
+	// same state concurrently.
+	if err := p.EnsureCleanedUp(ctx); err != nil {
+		return nil, err
+	}
+
+	workDir, err := os.MkdirTemp("", fmt.Sprintf("%s.%s.", p.name, p.purpose))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating working directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			logger.Error(err, "Failed removing working directory", "workDir", workDir)
+		}
+	}()
+
+	if err := p.prepareWorkDir(ctx, workDir); err != nil {
+		return nil, err
+	}
+
+	env, err := p.environment(ctx, workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, p.deadlinePod)
+	defer cancel()
+
+	initArgs := []string{"init", "-input=false", "-no-color"}
+	if p.pluginDir != "" {
+		initArgs = append(initArgs, "-plugin-dir="+p.pluginDir)
+	}
+	if _, err := p.execute(execCtx, logger, workDir, env, initArgs...); err != nil {
+		return nil, err
+	}
+
+	var args []string
+	switch command {
+	case CommandPlan:
+		args = []string{CommandPlan, "-input=false", "-lock=false", "-json"}
+	default:
+		args = []string{command, "-auto-approve", "-input=false", "-json"}
+	}
+
+	if command == CommandPlan {
+		return p.execute(execCtx, logger, workDir, env, args...)
+	}
+
+	syncer := &stateSyncer{terraformer: p, workDir: workDir, lastStored: p.readState(workDir)}
+
+	// The state is persisted periodically while the subprocess is running, so that changed resources are not lost if
+	// the extension is killed before the subprocess has finished.
+	syncCtx, syncCancel := context.WithCancel(ctx)
+	syncDone := make(chan struct{})
+	go func() {
+		defer close(syncDone)
+		syncer.run(syncCtx, logger, p.stateSyncInterval)
+	}()
+
+	_, execErr := p.execute(execCtx, logger, workDir, env, args...)
+	syncCancel()
+	<-syncDone
+
+	// The state is stored even if the execution failed since some resources might have been changed already.
+	storeCtx := ctx
+	if ctx.Err() != nil {
+		var storeCancel context.CancelFunc
+		storeCtx, storeCancel = context.WithTimeout(context.Background(), stateStoreTimeout)
+		defer storeCancel()
+	}
+	if err := syncer.sync(storeCtx); err != nil {
+		return nil, errors.Join(execErr, err)
+	}
+
+	return nil, execErr
+}
+
+// stateSyncer persists the state written by the subprocess if it has changed since it was stored last.
+type stateSyncer struct {
+	terraformer *processTerraformer
+	workDir     string
+	lastStored  []byte
+}
+
+func (s *stateSyncer) run(ctx context.Context, logger logr.Logger, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sync(ctx); err != nil {
+				logger.Error(err, "Failed persisting intermediate Terraform state")
+			}
+		}
+	}
+}
+
+func (s *stateSyncer) sync(ctx context.Context) error {
+	state := s.terraformer.readState(s.workDir)
+	if len(state) == 0 || bytes.Equal(state, s.lastStored) {
+		return nil
+	}
+
+	if err := s.terraformer.storeState(ctx, state); err != nil {
+		return err
+	}
+
+	s.lastStored = state
+	return nil
+}
+
+func (p *processTerraformer) prepareWorkDir(ctx context.Context, workDir string) error {
+	configMap := &corev1.ConfigMap{}
+	if err := p.client.Get(ctx, client.ObjectKey{Namespace: p.namespace, Name: p.configName}, configMap); err != nil {
+		return fmt.Errorf("failed reading Terraform configuration: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, client.ObjectKey{Namespace: p.namespace, Name: p.variablesName}, secret); err != nil {
+		return fmt.Errorf("failed reading Terraform variables: %w", err)
+	}
+
+	state, err := p.GetState(ctx)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed reading Terraform state: %w", err)
+	}
+
+	files := map[string][]byte{
+		MainKey:      []byte(configMap.Data[MainKey]),
+		VariablesKey: []byte(configMap.Data[VariablesKey]),
+		TFVarsKey:    secret.Data[TFVarsKey],
+	}
+	if len(state) > 0 {
+		files[StateKey] = state
+	}
+
+	for fileName, content := range files {
+		if err := os.WriteFile(filepath.Join(workDir, fileName), content, 0600); err != nil {
+			return fmt.Errorf("failed writing %s: %w", fileName, err)
+		}
+	}
+
+	return nil
+}
+
+// readState returns the state in the working directory. The subprocess might be writing it concurrently, hence only
+// complete (i.e., valid JSON) states are returned.
+func (p *processTerraformer) readState(workDir string) []byte {
+	state, err := os.ReadFile(filepath.Join(workDir, StateKey)) // #nosec G304 -- The working directory is created by the Terraformer.
+	if err != nil || !json.Valid(state) {
+		return nil
+	}
+	return state
+}
+
+func (p *processTerraformer) storeState(ctx context.Context, state []byte) error {
+	if _, err := createOrUpdateConfigMap(ctx, p.client, p.namespace, p.stateName, map[string]string{StateKey: string(state)}, p.ownerRef); err != nil {
+		return fmt.Errorf("failed storing Terraform state: %w", err)
+	}
+	return nil
+}
+
+func (p *processTerraformer) environment(ctx context.Context, workDir string) ([]string, error) {
+	env := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + workDir,
+		"TF_IN_AUTOMATION=true",
+	}
+	if p.logLevel == "debug" {
+		env = append(env, "TF_LOG=DEBUG")
+	}
+
+	for _, envVar := range p.envVars {
+		value, ok, err := p.resolveEnvVar(ctx, envVar)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			env = append(env, envVar.Name+"="+value)
+		}
+	}
+
+	return env, nil
+}
+
+func (p *processTerraformer) resolveEnvVar(ctx context.Context, envVar corev1.EnvVar) (string, bool, error) {
+	switch {
+	case envVar.ValueFrom == nil:
+		return envVar.Value, true, nil
+
+	case envVar.ValueFrom.SecretKeyRef != nil:
+		ref := envVar.ValueFrom.SecretKeyRef
+		secret := &corev1.Secret{}
+		if err := p.client.Get(ctx, client.ObjectKey{Namespace: p.namespace, Name: ref.Name}, secret); err != nil {
+			if apierrors.IsNotFound(err) && ptr.Deref(ref.Optional, false) {
+				return "", false, nil
+			}
+			return "", false, fmt.Errorf("failed reading secret for environment variable %q: %w", envVar.Name, err)
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok && !ptr.Deref(ref.Optional, false) {
+			return "", false, fmt.Errorf("secret %s does not contain key %q for environment variable %q", ref.Name, ref.Key, envVar.Name)
+		}
+		return string(value), ok, nil
+
+	case envVar.ValueFrom.ConfigMapKeyRef != nil:
+		ref := envVar.ValueFrom.ConfigMapKeyRef
+		configMap := &corev1.ConfigMap{}
+		if err := p.client.Get(ctx, client.ObjectKey{Namespace: p.namespace, Name: ref.Name}, configMap); err != nil {
+			if apierrors.IsNotFound(err) && ptr.Deref(ref.Optional, false) {
+				return "", false, nil
+			}
+			return "", false, fmt.Errorf("failed reading config map for environment variable %q: %w", envVar.Name, err)
+		}
+		value, ok := configMap.Data[ref.Key]
+		if !ok && !ptr.Deref(ref.Optional, false) {
+			return "", false, fmt.Errorf("config map %s does not contain key %q for environment variable %q", ref.Name, ref.Key, envVar.Name)
+		}
+		return value, ok, nil
+	}
+
+	return "", false, fmt.Errorf("unsupported value source for environment variable %q", envVar.Name)
+}
+
+// execute runs the binary with the given arguments, waits until it has finished and returns the planned changes found
+// in its structured output. The subprocess is interrupted when the context is cancelled, so that it can persist its
+// state.
+func (p *processTerraformer) execute(ctx context.Context, logger logr.Logger, workDir string, env []string, args ...string) (*PlanResult, error) {
+	var (
+		stderr      bytes.Buffer
+		plainOutput bytes.Buffer
+		diagnostics []string
+		result      = &PlanResult{}
+	)
+
+	cmd := exec.CommandContext(ctx, p.binary, args...) // #nosec G204 -- The binary is configured by the extension.
+	cmd.Dir = workDir
+	cmd.Env = env
+	cmd.Stderr = &stderr
+	cmd.Cancel = func() error { return cmd.Process.Signal(os.Interrupt) }
+	cmd.WaitDelay = p.waitDelay()
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Starting Terraform", "args", args)
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed starting %s: %w", p.binary, err)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		var message Message
+		if err := json.Unmarshal(line, &message); err != nil || message.Type == "" {
+			plainOutput.Write(line)
+			plainOutput.WriteByte('\n')
+			logger.V(1).Info("Terraform output", "output", string(line))
+			continue
+		}
+
+		logger.Info("Terraform output", "type", message.Type, "level", message.Level, "message", message.Text)
+
+		switch {
+		case message.Type == "diagnostic" && message.Diagnostic != nil && message.Diagnostic.Severity == "error":
+			diagnostics = append(diagnostics, strings.TrimSpace(message.Diagnostic.Summary+"\n"+message.Diagnostic.Detail))
+		case message.Type == "planned_change" && message.Change != nil:
+			result.Changes = append(result.Changes, *message.Change)
+		case message.Type == "change_summary" && message.Changes != nil:
+			result.Summary = *message.Changes
+		}
+
+		if p.messageHandler != nil {
+			p.messageHandler(message)
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		logger.Info("Terraform finished with error", "err", err.Error())
+
+		errorMessage := fmt.Sprintf("Terraform execution for command '%s' could not be completed", args[0])
+		if len(diagnostics) > 0 {
+			slices.Sort(diagnostics)
+			errorMessage += ":\n\n* " + strings.Join(diagnostics, "\n* ")
+		} else if terraformErrors := findTerraformErrors(plainOutput.String() + stderr.String()); terraformErrors != "" {
+			errorMessage += fmt.Sprintf(":\n\n%s", terraformErrors)
+		} else if ctxErr := ctx.Err(); ctxErr != nil {
+			errorMessage += fmt.Sprintf(": %s", ctxErr)
+		}
+		return nil, errors.New(errorMessage)
+	}
+
+	logger.Info("Terraform finished successfully")
+	return result, nil
+}
+
+// waitDelay returns the time the subprocess is given to terminate after it was interrupted. It is the configured
+// termination grace period, but the subprocess must be killed early enough to store the state before the extension pod
+// itself is killed.
+func (p *processTerraformer) waitDelay() time.Duration {
+	waitDelay := time.Duration(p.terminationGracePeriodSeconds) * time.Second
+	if maxWaitDelay := p.podTerminationGracePeriod - stateStoreTimeout; waitDelay > maxWaitDelay {
+		waitDelay = max(maxWaitDelay, time.Second)
+	}
+	return waitDelay
+}
+
+var planActions = map[string]extensionsv1alpha1.PlannedChangeAction{
+	"create":  extensionsv1alpha1.PlannedChangeActionCreate,
+	"update":  extensionsv1alpha1.PlannedChangeActionUpdate,
+	"replace": extensionsv1alpha1.PlannedChangeActionReplace,
+	"delete":  extensionsv1alpha1.PlannedChangeActionDelete,
+}
+
+// PlannedChanges converts the result to the changes of an extension plan (see extensionsv1alpha1.Plan), e.g. for
+// implementing the Planner interface of the Infrastructure actuator. Changes which do not modify the infrastructure
+// (e.g. 'read' or 'move') are omitted.
+func (r *PlanResult) PlannedChanges() []extensionsv1alpha1.PlannedChange {
+	var changes []extensionsv1alpha1.PlannedChange
+
+	for _, change := range r.Changes {
+		action, ok := planActions[change.Action]
+		if !ok {
+			continue
+		}
+
+		changes = append(changes, extensionsv1alpha1.PlannedChange{
+			Kind:   change.Resource.ResourceType,
+			Name:   change.Resource.Address,
+			Action: action,
+		})
+	}
+
+	return changes
+}
+
+// NewLastOperationMessageHandler returns a MessageHandler which reports the progress of the execution in the
+// description of the last operation of the given extension object, e.g. an Infrastructure. Progress messages are
+// reported at most once per interval, errors and change summaries are always reported.
+func NewLastOperationMessageHandler(ctx context.Context, log logr.Logger, c client.Client, obj extensionsv1alpha1.Object, clock clock.Clock, interval time.Duration) MessageHandler {
+	var lastUpdate time.Time
+
+	return func(message Message) {
+		lastOperation := obj.GetExtensionStatus().GetLastOperation()
+		if lastOperation == nil || message.Text == "" {
+			return
+		}
+
+		switch message.Type {
+		case "change_summary":
+		case "diagnostic":
+			if message.Diagnostic == nil || message.Diagnostic.Severity != "error" {
+				return
+			}
+		case "apply_start", "apply_progress", "apply_complete", "apply_errored", "refresh_start", "refresh_complete":
+			if clock.Since(lastUpdate) < interval {
+				return
+			}
+		default:
+			return
+		}
+
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+		lastOperation.Description = "Terraform: " + message.Text
+		lastOperation.LastUpdateTime = metav1.NewTime(clock.Now().UTC())
+		if err := c.Status().Patch(ctx, obj.(client.Object), patch); err != nil {
+			log.Error(err, "Failed reporting Terraform progress in last operation")
+			return
+		}
+		lastUpdate = clock.Now()
+	}
+}
@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraformer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	. "github.com/gardener/gardener/extensions/pkg/terraformer"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+const fakeTofu = `#!/usr/bin/env bash
+case "$1" in
+  init)
+    echo "OpenTofu has been successfully initialized!"
+    ;;
+  plan)
+    echo '{"@level":"info","@message":"aws_vpc.vpc: Plan to create","type":"planned_change","change":{"resource":{"addr":"aws_vpc.vpc","resource_type":"aws_vpc"},"action":"create"}}'
+    echo '{"@level":"info","@message":"Plan: 1 to add, 0 to change, 0 to destroy.","type":"change_summary","changes":{"add":1,"change":0,"import":0,"remove":0,"operation":"plan"}}'
+    ;;
+  apply|destroy)
+    if [ "$FAKE_MODE" == "fail" ]; then
+      echo '{"@level":"error","@message":"Error: boom","type":"diagnostic","diagnostic":{"severity":"error","summary":"boom","detail":"something went wrong"}}'
+      echo '{"state":"partial"}' > terraform.tfstate
+      exit 1
+    fi
+    if [ "$FAKE_MODE" == "slow" ]; then
+      echo '{"state":"intermediate"}' > terraform.tfstate
+      sleep 2
+    fi
+    if [ "$FAKE_MODE" == "hang" ]; then
+      trap '' INT
+      echo '{"state":"interrupted"}' > terraform.tfstate
+      exec sleep 30
+    fi
+    grep -q "resource" main.tf || exit 2
+    echo '{"@level":"info","@message":"aws_vpc.vpc: Creation complete","type":"apply_complete"}'
+    echo "{\"command\":\"$1\",\"var\":\"$FAKE_VAR\",\"previous\":$(cat terraform.tfstate 2>/dev/null || echo null)}" > terraform.tfstate
+    ;;
+esac
+`
+
+var _ = Describe("Process", func() {
+	var (
+		ctx = context.Background()
+		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
+
+		c      client.Client
+		binary string
+
+		tf       ProcessTerraformer
+		messages []Message
+
+		stateKey = client.ObjectKey{Namespace: namespace, Name: name + "." + purpose + StateSuffix}
+	)
+
+	BeforeEach(func() {
+		binary = filepath.Join(GinkgoT().TempDir(), "tofu")
+		Expect(os.WriteFile(binary, []byte(fakeTofu), 0700)).To(Succeed())
+
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "cloudprovider"},
+			Data:       map[string][]byte{"var": []byte("from-secret")},
+		})).To(Succeed())
+
+		messages = nil
+		tf = NewProcess(log, c, nil, purpose, namespace, name, binary, "").
+			SetMessageHandler(func(message Message) { messages = append(messages, message) })
+		tf.SetEnvVars(corev1.EnvVar{
+			Name: "FAKE_VAR",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "cloudprovider"},
+				Key:                  "var",
+			}},
+		})
+	})
+
+	setMode := func(mode string) {
+		ExpectWithOffset(1, c.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "mode"},
+			Data:       map[string]string{"mode": mode},
+		})).To(Succeed())
+		tf.SetEnvVars(corev1.EnvVar{
+			Name: "FAKE_MODE",
+			ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "mode"},
+				Key:                  "mode",
+			}},
+		})
+	}
+
+	initialize := func() {
+		tf.InitializeWith(ctx, DefaultInitializer(c, `resource "aws_vpc" "vpc" {}`, "", []byte(`foo = "bar"`), StateConfigMapInitializerFunc(CreateState)))
+	}
+
+	Describe("#Apply", func() {
+		It("should fail if the configuration was not initialized", func() {
+			Expect(tf.Apply(ctx)).To(MatchError(ContainSubstring("terraformer configuration has not been defined")))
+		})
+
+		It("should execute the binary and store the state", func() {
+			initialize()
+
+			Expect(tf.Apply(ctx)).To(Succeed())
+
+			state, err := tf.GetState(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(state)).To(Equal(`{"command":"apply","var":"from-secret","previous":null}` + "\n"))
+			Expect(messages).To(ConsistOf(HaveField("Type", "apply_complete")))
+		})
+
+		It("should pass the existing state to the binary", func() {
+			initialize()
+			Expect(tf.Apply(ctx)).To(Succeed())
+
+			initialize()
+			Expect(tf.Apply(ctx)).To(Succeed())
+
+			state, err := tf.GetState(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(state)).To(ContainSubstring(`"previous":{"command":"apply"`))
+		})
+
+		It("should return the diagnostics and store the state if the execution fails", func() {
+			setMode("fail")
+			initialize()
+
+			Expect(tf.Apply(ctx)).To(MatchError("Terraform execution for command 'apply' could not be completed:\n\n* boom\nsomething went wrong"))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, stateKey, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue(StateKey, `{"state":"partial"}`+"\n"))
+		})
+
+		It("should persist the state while the binary is running", func() {
+			setMode("slow")
+			tf.SetStateSyncInterval(100 * time.Millisecond)
+			initialize()
+
+			done := make(chan error)
+			go func() { done <- tf.Apply(ctx) }()
+
+			Eventually(func(g Gomega) map[string]string {
+				configMap := &corev1.ConfigMap{}
+				g.Expect(c.Get(ctx, stateKey, configMap)).To(Succeed())
+				return configMap.Data
+			}).Should(HaveKeyWithValue(StateKey, `{"state":"intermediate"}`+"\n"))
+
+			Eventually(done).WithTimeout(10 * time.Second).Should(Receive(BeNil()))
+
+			state, err := tf.GetState(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(state)).To(ContainSubstring(`"previous":{"state":"intermediate"}`))
+		})
+
+		It("should kill the binary in time to store the state within the pod termination grace period", func() {
+			setMode("hang")
+			tf.SetStateSyncInterval(0).SetPodTerminationGracePeriod(11 * time.Second)
+			tf.SetTerminationGracePeriodSeconds(120)
+			initialize()
+
+			cancelCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			done := make(chan error)
+			go func() { done <- tf.Apply(cancelCtx) }()
+
+			time.Sleep(500 * time.Millisecond)
+			cancel()
+
+			Eventually(done).WithTimeout(5 * time.Second).Should(Receive(MatchError(ContainSubstring("could not be completed"))))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(c.Get(ctx, stateKey, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue(StateKey, `{"state":"interrupted"}`+"\n"))
+		})
+
+		It("should fail if a referenced secret does not exist", func() {
+			tf.SetEnvVars(corev1.EnvVar{
+				Name: "OTHER",
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "non-existing"},
+					Key:                  "key",
+				}},
+			})
+			initialize()
+
+			Expect(tf.Apply(ctx)).To(MatchError(ContainSubstring(`failed reading secret for environment variable "OTHER"`)))
+		})
+	})
+
+	Describe("#Plan", func() {
+		It("should return the planned changes without changing the state", func() {
+			initialize()
+
+			result, err := tf.Plan(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(&PlanResult{
+				Summary: ChangeSummary{Add: 1, Operation: "plan"},
+				Changes: []PlannedChange{{Resource: PlannedChangeResource{Address: "aws_vpc.vpc", ResourceType: "aws_vpc"}, Action: "create"}},
+			}))
+
+			Expect(tf.IsStateEmpty(ctx)).To(BeTrue())
+		})
+	})
+
+	Describe("#PlannedChanges", func() {
+		It("should convert the changes modifying the infrastructure", func() {
+			result := &PlanResult{Changes: []PlannedChange{
+				{Resource: PlannedChangeResource{Address: "aws_vpc.vpc", ResourceType: "aws_vpc"}, Action: "replace"},
+				{Resource: PlannedChangeResource{Address: "data.aws_ami.image", ResourceType: "aws_ami"}, Action: "read"},
+				{Resource: PlannedChangeResource{Address: "aws_subnet.nodes", ResourceType: "aws_subnet"}, Action: "delete"},
+			}}
+
+			Expect(result.PlannedChanges()).To(Equal([]extensionsv1alpha1.PlannedChange{
+				{Kind: "aws_vpc", Name: "aws_vpc.vpc", Action: extensionsv1alpha1.PlannedChangeActionReplace},
+				{Kind: "aws_subnet", Name: "aws_subnet.nodes", Action: extensionsv1alpha1.PlannedChangeActionDelete},
+			}))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should skip the execution if the state is empty and clean up the configuration", func() {
+			initialize()
+
+			Expect(tf.Destroy(ctx)).To(Succeed())
+			Expect(messages).To(BeEmpty())
+			Expect(tf.NumberOfResources(ctx)).To(BeZero())
+		})
+
+		It("should execute the binary and clean up the configuration", func() {
+			initialize()
+			Expect(tf.Apply(ctx)).To(Succeed())
+
+			Expect(tf.Destroy(ctx)).To(Succeed())
+			Expect(messages).To(HaveLen(2))
+			Expect(tf.NumberOfResources(ctx)).To(BeZero())
+		})
+	})
+
+	Describe("#NewLastOperationMessageHandler", func() {
+		var (
+			infra     *extensionsv1alpha1.Infrastructure
+			fakeClock *testclock.FakeClock
+			handler   MessageHandler
+		)
+
+		BeforeEach(func() {
+			infra = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						LastOperation: &gardencorev1beta1.LastOperation{Description: "Reconciling"},
+					},
+				},
+			}
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build()
+			fakeClock = testclock.NewFakeClock(time.Now())
+			handler = NewLastOperationMessageHandler(ctx, log, c, infra, fakeClock, time.Minute)
+		})
+
+		expectDescription := func(description string) {
+			ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			ExpectWithOffset(1, infra.Status.LastOperation.Description).To(Equal(description))
+		}
+
+		It("should report progress messages at most once per interval", func() {
+			handler(Message{Type: "apply_complete", Text: "vpc: Creation complete"})
+			expectDescription("Terraform: vpc: Creation complete")
+
+			handler(Message{Type: "apply_complete", Text: "subnet: Creation complete"})
+			expectDescription("Terraform: vpc: Creation complete")
+
+			fakeClock.Step(time.Minute)
+			handler(Message{Type: "apply_complete", Text: "subnet: Creation complete"})
+			expectDescription("Terraform: subnet: Creation complete")
+		})
+
+		It("should always report errors and change summaries", func() {
+			handler(Message{Type: "apply_complete", Text: "vpc: Creation complete"})
+			handler(Message{Type: "diagnostic", Text: "Error: boom", Diagnostic: &Diagnostic{Severity: "error"}})
+			expectDescription("Terraform: Error: boom")
+
+			handler(Message{Type: "change_summary", Text: "Apply complete! Resources: 1 added, 0 changed, 0 destroyed."})
+			expectDescription("Terraform: Apply complete! Resources: 1 added, 0 changed, 0 destroyed.")
+		})
+
+		It("should ignore other messages", func() {
+			handler(Message{Type: "version", Text: "OpenTofu 1.9.0"})
+			handler(Message{Type: "diagnostic", Text: "Warning: deprecated", Diagnostic: &Diagnostic{Severity: "warning"}})
+			expectDescription("Reconciling")
+		})
+	})
+})
@@ -145,29 +145,8 @@ func (t *terraformer) Destroy(ctx context.Context) error {
 func (t *terraformer) execute(ctx context.Context, command string) error {
 	logger := t.logger.WithValues("command", command)
 
-	// When not both configuration and state were freshly initialized then we should check whether all configuration
-	// resources still exist. If yes then we can safely continue. If nothing exists then we exit early and don't run the
-	// pod. Otherwise, we might return an error in case we don't tolerate that resources are missing. We only tolerate
-	// this when the command is 'destroy'. This is because the CleanupConfiguration() function could have already
-	// deleted some of the resources (but not all). Hence, without the toleration we would end up in a deadlock and
-	// manual action would be required.
-	if !t.configurationInitialized || !t.stateInitialized {
-		numberOfExistingResources, err := t.NumberOfResources(ctx)
-		if err != nil {
-			return err
-		}
-
-		switch {
-		case numberOfExistingResources == numberOfConfigResources:
-			logger.Info("All ConfigMaps and Secrets exist, will execute Terraformer pod")
-		case numberOfExistingResources == 0:
-			logger.Info("All ConfigMaps and Secrets missing, can not execute Terraformer pod")
-			return nil
-		case command != CommandDestroy:
-			errResourcesMissing := fmt.Errorf("%d/%d Terraform resources are missing", numberOfConfigResources-numberOfExistingResources, numberOfConfigResources)
-			logger.Error(errResourcesMissing, "Cannot execute Terraformer pod")
-			return errResourcesMissing
-		}
+	if canExecute, err := t.configurationExists(ctx, logger, command); err != nil || !canExecute {
+		return err
 	}
 
 	// Check if an existing Terraformer pod is still running. If yes, then adopt it. If no, then deploy a new pod (if
@@ -286,6 +265,36 @@ func (t *terraformer) execute(ctx context.Context, command string) error {
 	return nil
 }
 
+// configurationExists checks whether the configuration resources required for executing the given command exist.
+func (t *terraformer) configurationExists(ctx context.Context, logger logr.Logger, command string) (bool, error) {
+	// When not both configuration and state were freshly initialized then we should check whether all configuration
+	// resources still exist. If yes then we can safely continue. If nothing exists then we exit early and don't run the
+	// Terraform. Otherwise, we might return an error in case we don't tolerate that resources are missing. We only tolerate
+	// this when the command is 'destroy'. This is because the CleanupConfiguration() function could have already
+	// deleted some of the resources (but not all). Hence, without the toleration we would end up in a deadlock and
+	// manual action would be required.
+	if !t.configurationInitialized || !t.stateInitialized {
+		numberOfExistingResources, err := t.NumberOfResources(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		switch {
+		case numberOfExistingResources == numberOfConfigResources:
+			logger.Info("All ConfigMaps and Secrets exist, will execute Terraformer")
+		case numberOfExistingResources == 0:
+			logger.Info("All ConfigMaps and Secrets missing, can not execute Terraformer")
+			return false, nil
+		case command != CommandDestroy:
+			errResourcesMissing := fmt.Errorf("%d/%d Terraform resources are missing", numberOfConfigResources-numberOfExistingResources, numberOfConfigResources)
+			logger.Error(errResourcesMissing, "Cannot execute Terraformer")
+			return false, errResourcesMissing
+		}
+	}
+
+	return true, nil
+}
+
 const (
 	name     = "terraformer"
 	rbacName = "gardener.cloud:system:terraformer"
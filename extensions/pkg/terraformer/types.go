@@ -115,6 +115,91 @@ type Terraformer interface {
 	WaitForCleanEnvironment(ctx context.Context) error
 }
 
+// Planner is implemented by Terraformers which support plan-only (dry-run) executions.
+type Planner interface {
+	// Plan computes the changes which an Apply would perform without changing the infrastructure or the state.
+	Plan(ctx context.Context) (*PlanResult, error)
+}
+
+// ProcessTerraformer is a Terraformer which executes OpenTofu (or Terraform) as a supervised subprocess of the extension
+// instead of deploying Terraformer pods.
+type ProcessTerraformer interface {
+	Terraformer
+	Planner
+	SetMessageHandler(MessageHandler) ProcessTerraformer
+	SetStateSyncInterval(time.Duration) ProcessTerraformer
+	SetPodTerminationGracePeriod(time.Duration) ProcessTerraformer
+}
+
+// MessageHandler is called for every structured log message emitted by OpenTofu (or Terraform).
+type MessageHandler func(Message)
+
+// Message is a structured log message emitted by OpenTofu (or Terraform) when executed with the '-json' flag.
+type Message struct {
+	// Level is the log level of the message, e.g. 'info' or 'error'.
+	Level string `json:"@level"`
+	// Text is the human-readable message.
+	Text string `json:"@message"`
+	// Timestamp is the time when the message was emitted.
+	Timestamp time.Time `json:"@timestamp"`
+	// Type is the type of the message, e.g. 'apply_start', 'planned_change', 'change_summary' or 'diagnostic'.
+	Type string `json:"type"`
+	// Diagnostic contains the details of a warning or error (only set for type 'diagnostic').
+	Diagnostic *Diagnostic `json:"diagnostic,omitempty"`
+	// Changes contains the summary of the changes (only set for type 'change_summary').
+	Changes *ChangeSummary `json:"changes,omitempty"`
+	// Change contains a single planned change (only set for type 'planned_change').
+	Change *PlannedChange `json:"change,omitempty"`
+}
+
+// Diagnostic is a warning or error reported by OpenTofu (or Terraform).
+type Diagnostic struct {
+	// Severity is either 'warning' or 'error'.
+	Severity string `json:"severity"`
+	// Summary is a short description of the diagnostic.
+	Summary string `json:"summary"`
+	// Detail is a detailed description of the diagnostic.
+	Detail string `json:"detail"`
+}
+
+// ChangeSummary is the summary of the changes of a plan, apply or destroy operation.
+type ChangeSummary struct {
+	// Add is the number of resources to be added.
+	Add int `json:"add"`
+	// Change is the number of resources to be changed.
+	Change int `json:"change"`
+	// Import is the number of resources to be imported.
+	Import int `json:"import"`
+	// Remove is the number of resources to be removed.
+	Remove int `json:"remove"`
+	// Operation is the operation the summary refers to, i.e. 'plan', 'apply' or 'destroy'.
+	Operation string `json:"operation"`
+}
+
+// PlannedChange is a single change of a resource computed by a plan.
+type PlannedChange struct {
+	// Resource is the resource which is changed.
+	Resource PlannedChangeResource `json:"resource"`
+	// Action is the action performed on the resource, e.g. 'create', 'update', 'delete' or 'replace'.
+	Action string `json:"action"`
+}
+
+// PlannedChangeResource identifies the resource of a planned change.
+type PlannedChangeResource struct {
+	// Address is the address of the resource, e.g. 'aws_vpc.vpc'.
+	Address string `json:"addr"`
+	// ResourceType is the type of the resource, e.g. 'aws_vpc'.
+	ResourceType string `json:"resource_type"`
+}
+
+// PlanResult is the result of a plan-only execution.
+type PlanResult struct {
+	// Summary is the summary of the planned changes.
+	Summary ChangeSummary
+	// Changes is the list of planned changes.
+	Changes []PlannedChange
+}
+
 // Initializer can initialize a Terraformer.
 type Initializer interface {
 	Initialize(ctx context.Context, config *InitializerConfig, ownerRef *metav1.OwnerReference) error
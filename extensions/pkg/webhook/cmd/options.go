@@ -280,6 +280,8 @@ type AddToManagerConfig struct {
 // AddToManager instantiates all webhooks of this configuration. If there are any webhooks, it creates a
 // webhook server, registers the webhooks and adds the server to the manager. Otherwise, it is a no-op.
 // It generates and registers the seed targeted webhooks via a MutatingWebhookConfiguration.
+// Webhooks with a policy are registered as MutatingAdmissionPolicies. If all webhooks have a policy, neither a webhook
+// server is created nor certificates are managed.
 func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster cluster.Cluster) (*atomic.Value, error) {
 	if c.Clock == nil {
 		c.Clock = &clock.RealClock{}
@@ -289,6 +291,23 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 	if err != nil {
 		return nil, fmt.Errorf("could not create webhooks: %w", err)
 	}
+
+	policiesSupported, err := extensionswebhook.MutatingAdmissionPoliciesSupported(mgr.GetRESTMapper())
+	if err != nil {
+		return nil, err
+	}
+	if !policiesSupported {
+		webhooks = extensionswebhook.WithoutSeedPolicies(mgr.GetLogger(), webhooks)
+		// The shoot webhooks of self-hosted shoots are merged into the seed webhooks, see BuildWebhookConfigs.
+		if c.General.SelfHostedShootCluster {
+			webhooks = extensionswebhook.WithoutShootPolicies(mgr.GetLogger(), webhooks)
+		}
+	}
+
+	if !extensionswebhook.RequiresServer(webhooks) {
+		return c.addPoliciesToManager(mgr, webhooks, policiesSupported)
+	}
+
 	webhookServer := mgr.GetWebhookServer()
 
 	defaultServer, ok := webhookServer.(*webhook.DefaultServer)
@@ -302,6 +321,11 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 	}
 
 	for _, wh := range webhooks {
+		// Policies are only served by the webhook server if they have a fallback webhook handler, see RequiresServer.
+		if wh.Webhook == nil {
+			continue
+		}
+
 		path := wh.Path
 		if path == "" {
 			path = "/" + wh.Name
@@ -350,6 +374,7 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 		// also reconcile all shoot webhook configs to update the CA bundle
 		if err := mgr.Add(runOnceWithLeaderElection(flow.Sequential(
 			c.reconcileSeedWebhookConfig(mgr, seedWebhookConfigs, caBundle),
+			c.reconcileSeedPolicyConfigs(mgr, seedWebhookConfigs, policiesSupported),
 			c.reconcileShootWebhookConfigs(mgr, shootWebhookConfigs),
 		))); err != nil {
 			return nil, err
@@ -362,9 +387,10 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 	// We only care about registering the desired webhooks here, but not the CA bundle, it will be managed by the
 	// reconciler. That's why we also don't reconcile the shoot webhook configs here. They are registered in the
 	// ControlPlane actuator and our reconciler will update the included CA bundles if necessary.
-	if err := mgr.Add(runOnceWithLeaderElection(
+	if err := mgr.Add(runOnceWithLeaderElection(flow.Sequential(
 		c.reconcileSeedWebhookConfig(mgr, seedWebhookConfigs, nil),
-	)); err != nil {
+		c.reconcileSeedPolicyConfigs(mgr, seedWebhookConfigs, policiesSupported),
+	))); err != nil {
 		return nil, err
 	}
 
@@ -389,6 +415,37 @@ func (c *AddToManagerConfig) AddToManager(ctx context.Context, mgr manager.Manag
 	return atomicShootWebhookConfigs, nil
 }
 
+// addPoliciesToManager registers the given webhooks which are all realized as mutating admission policies. Neither a
+// webhook server nor certificates are required in this case.
+func (c *AddToManagerConfig) addPoliciesToManager(mgr manager.Manager, webhooks []*extensionswebhook.Webhook, policiesSupported bool) (*atomic.Value, error) {
+	seedWebhookConfigs, shootWebhookConfigs, err := extensionswebhook.BuildWebhookConfigs(
+		webhooks,
+		mgr.GetClient(),
+		c.Server.Namespace,
+		c.extensionName, false,
+		0,
+		c.Server.Mode,
+		c.Server.URL,
+		nil,
+		c.General.SelfHostedShootCluster,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create webhooks: %w", err)
+	}
+
+	atomicShootWebhookConfigs := &atomic.Value{}
+	atomicShootWebhookConfigs.Store(shootWebhookConfigs.DeepCopy())
+
+	if err := mgr.Add(runOnceWithLeaderElection(flow.Sequential(
+		c.reconcileSeedPolicyConfigs(mgr, seedWebhookConfigs, policiesSupported),
+		c.reconcileShootWebhookConfigs(mgr, shootWebhookConfigs),
+	))); err != nil {
+		return nil, err
+	}
+
+	return atomicShootWebhookConfigs, nil
+}
+
 func (c *AddToManagerConfig) reconcileSeedWebhookConfig(mgr manager.Manager, webhookConfigs extensionswebhook.Configs, caBundle []byte) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
 		timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -406,9 +463,40 @@ func (c *AddToManagerConfig) reconcileSeedWebhookConfig(mgr manager.Manager, web
 	}
 }
 
+func (c *AddToManagerConfig) reconcileSeedPolicyConfigs(mgr manager.Manager, webhookConfigs extensionswebhook.Configs, policiesSupported bool) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		// Neither desired nor stale policies can exist if the seed does not serve the API.
+		if !policiesSupported {
+			return nil
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		policyConfigs := webhookConfigs.GetPolicyConfigs()
+		for _, policyConfig := range policyConfigs {
+			if err := retry.Until(timeoutCtx, time.Second, func(ctx context.Context) (bool, error) {
+				if err := extensionswebhook.ReconcileSeedPolicyConfig(ctx, mgr.GetClient(), policyConfig, c.Server.OwnerNamespace); err != nil {
+					return retry.MinorError(fmt.Errorf("error reconciling seed policy config: %w", err))
+				}
+				return retry.Ok()
+			}); err != nil {
+				return err
+			}
+		}
+
+		return retry.Until(timeoutCtx, time.Second, func(ctx context.Context) (bool, error) {
+			if err := extensionswebhook.DeleteStaleSeedPolicyConfigs(ctx, mgr.GetClient(), extensionswebhook.PrefixedName(c.extensionName, false), policyConfigs); err != nil {
+				return retry.MinorError(fmt.Errorf("error deleting stale seed policy configs: %w", err))
+			}
+			return retry.Ok()
+		})
+	}
+}
+
 func (c *AddToManagerConfig) reconcileShootWebhookConfigs(mgr manager.Manager, shootWebhookConfigs extensionswebhook.Configs) func(ctx context.Context) error {
 	return func(ctx context.Context) error {
-		if shootWebhookConfigs.HasWebhookConfig() {
+		if shootWebhookConfigs.HasWebhookConfig() || shootWebhookConfigs.HasPolicyConfig() {
 			if err := extensionsshootwebhook.ReconcileWebhooksForAllNamespaces(ctx, mgr.GetClient(), c.shootWebhookManagedResourceName, c.shootNamespaceSelector, shootWebhookConfigs); err != nil {
 				return fmt.Errorf("error reconciling all shoot webhook configs: %w", err)
 			}
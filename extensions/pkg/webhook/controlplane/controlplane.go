@@ -80,6 +80,44 @@ func New(mgr manager.Manager, args Args) (*extensionswebhook.Webhook, error) {
 	}, nil
 }
 
+// PolicyArgs are arguments for creating a controlplane webhook whose CEL-based mutations are evaluated by the
+// kube-apiserver.
+type PolicyArgs struct {
+	// Kind is the kind of this webhook
+	Kind string
+	// Provider is the provider of this webhook.
+	Provider string
+	// Types is a list of resource types.
+	Types []extensionswebhook.Type
+	// Policy contains the CEL-based mutations.
+	Policy extensionswebhook.Policy
+	// ObjectSelector is the object selector of the underlying policy
+	ObjectSelector *metav1.LabelSelector
+	// Fallback is the webhook handler which is served if the seed does not support MutatingAdmissionPolicies.
+	Fallback *admission.Webhook
+}
+
+// NewPolicy creates a new controlplane webhook with the given args which is registered as a MutatingAdmissionPolicy
+// instead of being served by the webhook server.
+func NewPolicy(args PolicyArgs) (*extensionswebhook.Webhook, error) {
+	// Build namespace selector from the webhook kind and provider
+	namespaceSelector, err := buildNamespaceSelector(args.Kind, args.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return extensionswebhook.NewPolicy(extensionswebhook.PolicyArgs{
+		Name:              getName(args.Kind),
+		Provider:          args.Provider,
+		Target:            extensionswebhook.TargetSeed,
+		Types:             args.Types,
+		NamespaceSelector: namespaceSelector,
+		ObjectSelector:    args.ObjectSelector,
+		Policy:            args.Policy,
+		Fallback:          args.Fallback,
+	})
+}
+
 func getName(kind string) string {
 	switch kind {
 	case KindSeed:
@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// LabelPolicyProvider is the label key for mutating admission policies and their bindings whose value is the name of
+// the extension which registered them. It is used to delete policies and bindings which are no longer desired.
+const LabelPolicyProvider = "extensions.gardener.cloud/policy-provider"
+
+// MutatingAdmissionPoliciesSupported returns true if the cluster of the given REST mapper serves the
+// admissionregistration.k8s.io/v1beta1 API for mutating admission policies and their bindings.
+func MutatingAdmissionPoliciesSupported(restMapper meta.RESTMapper) (bool, error) {
+	for _, kind := range []string{"MutatingAdmissionPolicy", "MutatingAdmissionPolicyBinding"} {
+		if _, err := restMapper.RESTMapping(admissionregistrationv1beta1.SchemeGroupVersion.WithKind(kind).GroupKind(), admissionregistrationv1beta1.SchemeGroupVersion.Version); err != nil {
+			if meta.IsNoMatchError(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed checking whether %s is supported: %w", kind, err)
+		}
+	}
+
+	return true, nil
+}
+
+// ShootSupportsMutatingAdmissionPolicies returns true if the kube-apiserver of the given shoot serves the
+// admissionregistration.k8s.io/v1beta1 API for mutating admission policies, i.e., if its Kubernetes version is at least
+// 1.34 and both the `MutatingAdmissionPolicy` feature gate and the API are enabled explicitly.
+func ShootSupportsMutatingAdmissionPolicies(shoot *gardencorev1beta1.Shoot) bool {
+	version, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
+	if err != nil || !versionutils.ConstraintK8sGreaterEqual134.Check(version) {
+		return false
+	}
+
+	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+	if kubeAPIServer == nil {
+		return false
+	}
+
+	return kubeAPIServer.FeatureGates["MutatingAdmissionPolicy"] && kubeAPIServer.RuntimeConfig[admissionregistrationv1beta1.SchemeGroupVersion.String()]
+}
+
+// ConfigsForShoot returns a copy of the given shoot webhook configs containing only the configs which the kube-apiserver
+// of the given shoot can serve. If it serves mutating admission policies, the fallback webhooks of the policies are
+// removed. Otherwise, the policies and their bindings are removed, i.e., they are served by their fallback webhooks, if
+// they have one, or are skipped.
+func ConfigsForShoot(shoot *gardencorev1beta1.Shoot, shootWebhookConfigs Configs) Configs {
+	configs := shootWebhookConfigs.DeepCopy()
+
+	if !ShootSupportsMutatingAdmissionPolicies(shoot) {
+		configs.MutatingAdmissionPolicies, configs.MutatingAdmissionPolicyBindings = nil, nil
+		return *configs
+	}
+
+	if configs.MutatingWebhookConfig != nil && len(configs.FallbackWebhookNames) > 0 {
+		fallbackWebhookNames := sets.New(slices.Collect(maps.Values(configs.FallbackWebhookNames))...)
+		configs.MutatingWebhookConfig.Webhooks = slices.DeleteFunc(configs.MutatingWebhookConfig.Webhooks, func(webhook admissionregistrationv1.MutatingWebhook) bool {
+			return fallbackWebhookNames.Has(webhook.Name)
+		})
+		if len(configs.MutatingWebhookConfig.Webhooks) == 0 {
+			configs.MutatingWebhookConfig = nil
+		}
+	}
+
+	return *configs
+}
+
+// WithoutSeedPolicies returns the given webhooks for clusters which do not support mutating admission policies.
+// Seed-targeted webhooks with a policy are served by their fallback webhook handler instead, if they have one, or are
+// skipped otherwise.
+func WithoutSeedPolicies(log logr.Logger, webhooks []*Webhook) []*Webhook {
+	return withoutPolicies(log, webhooks, TargetSeed)
+}
+
+// WithoutShootPolicies returns the given webhooks without shoot-targeted mutating admission policies, e.g., for
+// extensions which must not rely on policies in shoot clusters. Shoot-targeted webhooks with a policy are served by
+// their fallback webhook handler instead, if they have one, or are skipped otherwise.
+func WithoutShootPolicies(log logr.Logger, webhooks []*Webhook) []*Webhook {
+	return withoutPolicies(log, webhooks, TargetShoot)
+}
+
+func withoutPolicies(log logr.Logger, webhooks []*Webhook, target string) []*Webhook {
+	out := make([]*Webhook, 0, len(webhooks))
+
+	for _, webhook := range webhooks {
+		if webhook.Policy == nil || webhook.Target != target {
+			out = append(out, webhook)
+			continue
+		}
+
+		if webhook.Webhook == nil {
+			log.Info("Skipping webhook with policy because mutating admission policies are not supported and no fallback is configured", "webhook", webhook.Name)
+			continue
+		}
+
+		log.Info("Serving fallback webhook because mutating admission policies are not supported", "webhook", webhook.Name)
+		fallback := *webhook
+		fallback.Policy = nil
+		out = append(out, &fallback)
+	}
+
+	return out
+}
+
+// addPolicyConfigs adds a mutating admission policy and its binding for the given webhook to the configs of the
+// webhook's target.
+func addPolicyConfigs(
+	webhook *Webhook,
+	name string,
+	rules []admissionregistrationv1.RuleWithOperations,
+	mergeShootWebhooksIntoSeedWebhooks bool,
+	seedWebhookConfigs, shootWebhookConfigs *Configs,
+) error {
+	if webhook.Action != ActionMutating {
+		return fmt.Errorf("invalid action %q for webhook %s with policy, only %q is supported", webhook.Action, webhook.Name, ActionMutating)
+	}
+
+	switch webhook.Target {
+	case TargetSeed:
+		addPolicyConfig(seedWebhookConfigs, name+"-"+webhook.Name, name, webhook, rules, admissionregistrationv1beta1.Fail)
+
+	case TargetShoot:
+		if mergeShootWebhooksIntoSeedWebhooks {
+			addPolicyConfig(seedWebhookConfigs, name+"-"+webhook.Name, name, webhook, rules, admissionregistrationv1beta1.Ignore)
+		}
+		addPolicyConfig(shootWebhookConfigs, name+"-"+webhook.Name+NameSuffixShoot, name, webhook, rules, admissionregistrationv1beta1.Ignore)
+
+	default:
+		return fmt.Errorf("invalid webhook target: %s", webhook.Target)
+	}
+
+	return nil
+}
+
+func addPolicyConfig(
+	webhookConfigs *Configs,
+	name, provider string,
+	webhook *Webhook,
+	rules []admissionregistrationv1.RuleWithOperations,
+	failurePolicy admissionregistrationv1beta1.FailurePolicyType,
+) {
+	if webhook.FailurePolicy != nil {
+		failurePolicy = admissionregistrationv1beta1.FailurePolicyType(*webhook.FailurePolicy)
+	}
+
+	reinvocationPolicy := admissionregistrationv1beta1.NeverReinvocationPolicy
+	if webhook.Policy.ReinvocationPolicy != nil {
+		reinvocationPolicy = *webhook.Policy.ReinvocationPolicy
+	}
+
+	resourceRules := make([]admissionregistrationv1beta1.NamedRuleWithOperations, 0, len(rules))
+	for _, rule := range rules {
+		resourceRules = append(resourceRules, admissionregistrationv1beta1.NamedRuleWithOperations{RuleWithOperations: rule})
+	}
+
+	webhookConfigs.MutatingAdmissionPolicies = append(webhookConfigs.MutatingAdmissionPolicies, &admissionregistrationv1beta1.MutatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{LabelPolicyProvider: provider}},
+		Spec: admissionregistrationv1beta1.MutatingAdmissionPolicySpec{
+			MatchConstraints: &admissionregistrationv1beta1.MatchResources{
+				NamespaceSelector: webhook.NamespaceSelector,
+				ObjectSelector:    webhook.ObjectSelector,
+				ResourceRules:     resourceRules,
+				MatchPolicy:       ptr.To(admissionregistrationv1beta1.Exact),
+			},
+			MatchConditions:    webhook.Policy.MatchConditions,
+			Variables:          webhook.Policy.Variables,
+			Mutations:          webhook.Policy.Mutations,
+			FailurePolicy:      &failurePolicy,
+			ReinvocationPolicy: reinvocationPolicy,
+		},
+	})
+
+	webhookConfigs.MutatingAdmissionPolicyBindings = append(webhookConfigs.MutatingAdmissionPolicyBindings, &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{LabelPolicyProvider: provider}},
+		Spec: admissionregistrationv1beta1.MutatingAdmissionPolicyBindingSpec{
+			PolicyName: name,
+		},
+	})
+}
+
+// ReconcileSeedPolicyConfig reconciles the given mutating admission policy or binding in the seed cluster.
+func ReconcileSeedPolicyConfig(ctx context.Context, c client.Client, policyConfig client.Object, ownerNamespace string) error {
+	ownerReference, err := namespaceOwnerReference(ctx, c, ownerNamespace)
+	if err != nil {
+		return err
+	}
+
+	desiredPolicyConfig := policyConfig.DeepCopyObject().(client.Object)
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, policyConfig, func() error {
+		if ownerReference != nil {
+			policyConfig.SetOwnerReferences([]metav1.OwnerReference{*ownerReference})
+		}
+		policyConfig.SetLabels(utils.MergeStringMaps(policyConfig.GetLabels(), desiredPolicyConfig.GetLabels()))
+
+		switch config := policyConfig.(type) {
+		case *admissionregistrationv1beta1.MutatingAdmissionPolicy:
+			config.Spec = *desiredPolicyConfig.(*admissionregistrationv1beta1.MutatingAdmissionPolicy).Spec.DeepCopy()
+		case *admissionregistrationv1beta1.MutatingAdmissionPolicyBinding:
+			config.Spec = *desiredPolicyConfig.(*admissionregistrationv1beta1.MutatingAdmissionPolicyBinding).Spec.DeepCopy()
+		default:
+			return fmt.Errorf("unexpected policy config type: %T", policyConfig)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error reconciling seed policy config: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleSeedPolicyConfigs deletes the mutating admission policies and bindings of the given provider in the seed
+// cluster which are not contained in the given desired policy configs.
+func DeleteStaleSeedPolicyConfigs(ctx context.Context, c client.Client, provider string, desiredPolicyConfigs []client.Object) error {
+	desired := make(map[string]struct{}, len(desiredPolicyConfigs))
+	for _, policyConfig := range desiredPolicyConfigs {
+		desired[fmt.Sprintf("%T/%s", policyConfig, policyConfig.GetName())] = struct{}{}
+	}
+
+	policyList := &admissionregistrationv1beta1.MutatingAdmissionPolicyList{}
+	if err := c.List(ctx, policyList, client.MatchingLabels{LabelPolicyProvider: provider}); err != nil {
+		return fmt.Errorf("failed listing mutating admission policies: %w", err)
+	}
+
+	bindingList := &admissionregistrationv1beta1.MutatingAdmissionPolicyBindingList{}
+	if err := c.List(ctx, bindingList, client.MatchingLabels{LabelPolicyProvider: provider}); err != nil {
+		return fmt.Errorf("failed listing mutating admission policy bindings: %w", err)
+	}
+
+	existing := make([]client.Object, 0, len(policyList.Items)+len(bindingList.Items))
+	for i := range bindingList.Items {
+		existing = append(existing, &bindingList.Items[i])
+	}
+	for i := range policyList.Items {
+		existing = append(existing, &policyList.Items[i])
+	}
+
+	for _, policyConfig := range existing {
+		if _, ok := desired[fmt.Sprintf("%T/%s", policyConfig, policyConfig.GetName())]; ok {
+			continue
+		}
+
+		if err := client.IgnoreNotFound(c.Delete(ctx, policyConfig)); err != nil {
+			return fmt.Errorf("failed deleting stale %T %q: %w", policyConfig, policyConfig.GetName(), err)
+		}
+	}
+
+	return nil
+}
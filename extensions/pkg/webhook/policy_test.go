@@ -0,0 +1,488 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Policy", func() {
+	var (
+		mutation = ApplyConfiguration(`Object{spec: Object.spec{replicas: 1}}`)
+
+		namespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+		rules             = []admissionregistrationv1beta1.NamedRuleWithOperations{{
+			RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+				Rule:       admissionregistrationv1.Rule{APIGroups: []string{"apps"}, APIVersions: []string{"v1"}, Resources: []string{"deployments"}},
+				Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+			},
+		}}
+	)
+
+	Describe("#NewPolicy", func() {
+		It("should create a mutating webhook with policy", func() {
+			webhook, err := NewPolicy(PolicyArgs{
+				Provider:          "provider-foo",
+				Name:              "replicas",
+				Target:            TargetSeed,
+				Types:             []Type{{Obj: &appsv1.Deployment{}}},
+				NamespaceSelector: namespaceSelector,
+				Policy:            Policy{Mutations: []admissionregistrationv1beta1.Mutation{mutation}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(webhook.Action).To(Equal(ActionMutating))
+			Expect(webhook.Webhook).To(BeNil())
+			Expect(webhook.Policy.Mutations).To(ConsistOf(mutation))
+			Expect(RequiresServer([]*Webhook{webhook})).To(BeFalse())
+		})
+
+		It("should set the fallback webhook handler", func() {
+			fallback := &admission.Webhook{}
+
+			webhook, err := NewPolicy(PolicyArgs{
+				Name:     "replicas",
+				Target:   TargetSeed,
+				Policy:   Policy{Mutations: []admissionregistrationv1beta1.Mutation{mutation}},
+				Fallback: fallback,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(webhook.Webhook).To(BeIdenticalTo(fallback))
+			Expect(RequiresServer([]*Webhook{webhook})).To(BeFalse())
+		})
+
+		It("should fail if no mutations are configured", func() {
+			_, err := NewPolicy(PolicyArgs{Name: "replicas", Target: TargetSeed})
+			Expect(err).To(MatchError(ContainSubstring("no mutations are configured")))
+		})
+	})
+
+	Describe("#RequiresServer", func() {
+		It("should return true if at least one webhook has no policy", func() {
+			Expect(RequiresServer([]*Webhook{{Policy: &Policy{}}, {}})).To(BeTrue())
+		})
+
+		It("should return true for shoot policies with fallback webhook handler", func() {
+			Expect(RequiresServer([]*Webhook{{Target: TargetShoot, Policy: &Policy{}, Webhook: &admission.Webhook{}}})).To(BeTrue())
+		})
+
+		It("should return false for shoot policies without fallback webhook handler", func() {
+			Expect(RequiresServer([]*Webhook{{Target: TargetShoot, Policy: &Policy{}}})).To(BeFalse())
+		})
+
+		It("should return false if there are no webhooks", func() {
+			Expect(RequiresServer(nil)).To(BeFalse())
+		})
+	})
+
+	Describe("#MutatingAdmissionPoliciesSupported", func() {
+		var restMapper *meta.DefaultRESTMapper
+
+		BeforeEach(func() {
+			restMapper = meta.NewDefaultRESTMapper([]schema.GroupVersion{admissionregistrationv1beta1.SchemeGroupVersion})
+		})
+
+		It("should return true if the API is served", func() {
+			restMapper.Add(admissionregistrationv1beta1.SchemeGroupVersion.WithKind("MutatingAdmissionPolicy"), meta.RESTScopeRoot)
+			restMapper.Add(admissionregistrationv1beta1.SchemeGroupVersion.WithKind("MutatingAdmissionPolicyBinding"), meta.RESTScopeRoot)
+
+			Expect(MutatingAdmissionPoliciesSupported(restMapper)).To(BeTrue())
+		})
+
+		It("should return false if the API is not served", func() {
+			Expect(MutatingAdmissionPoliciesSupported(restMapper)).To(BeFalse())
+		})
+
+		It("should return false if only the policies are served", func() {
+			restMapper.Add(admissionregistrationv1beta1.SchemeGroupVersion.WithKind("MutatingAdmissionPolicy"), meta.RESTScopeRoot)
+
+			Expect(MutatingAdmissionPoliciesSupported(restMapper)).To(BeFalse())
+		})
+	})
+
+	Describe("#WithoutSeedPolicies", func() {
+		It("should serve fallback webhooks and skip seed policies without fallback", func() {
+			var (
+				fallback            = &admission.Webhook{}
+				webhook             = &Webhook{Name: "webhook", Target: TargetSeed, Webhook: &admission.Webhook{}}
+				policyWithFallback  = &Webhook{Name: "policy-with-fallback", Target: TargetSeed, Webhook: fallback, Policy: &Policy{}}
+				policy              = &Webhook{Name: "policy", Target: TargetSeed, Policy: &Policy{}}
+				shootPolicy         = &Webhook{Name: "shoot-policy", Target: TargetShoot, Policy: &Policy{}}
+				webhooksWithoutSeed = WithoutSeedPolicies(logr.Discard(), []*Webhook{webhook, policyWithFallback, policy, shootPolicy})
+			)
+
+			Expect(webhooksWithoutSeed).To(HaveExactElements(
+				BeIdenticalTo(webhook),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Name":    Equal("policy-with-fallback"),
+					"Webhook": BeIdenticalTo(fallback),
+					"Policy":  BeNil(),
+				})),
+				BeIdenticalTo(shootPolicy),
+			))
+			Expect(policyWithFallback.Policy).NotTo(BeNil())
+			Expect(RequiresServer(webhooksWithoutSeed)).To(BeTrue())
+		})
+	})
+
+	Describe("#WithoutShootPolicies", func() {
+		It("should serve fallback webhooks and skip shoot policies without fallback", func() {
+			var (
+				fallback             = &admission.Webhook{}
+				seedPolicy           = &Webhook{Name: "seed-policy", Target: TargetSeed, Policy: &Policy{}}
+				policyWithFallback   = &Webhook{Name: "policy-with-fallback", Target: TargetShoot, Webhook: fallback, Policy: &Policy{}}
+				policy               = &Webhook{Name: "policy", Target: TargetShoot, Policy: &Policy{}}
+				webhooksWithoutShoot = WithoutShootPolicies(logr.Discard(), []*Webhook{seedPolicy, policyWithFallback, policy})
+			)
+
+			Expect(webhooksWithoutShoot).To(HaveExactElements(
+				BeIdenticalTo(seedPolicy),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Name":    Equal("policy-with-fallback"),
+					"Webhook": BeIdenticalTo(fallback),
+					"Policy":  BeNil(),
+				})),
+			))
+		})
+	})
+
+	Describe("#ShootSupportsMutatingAdmissionPolicies", func() {
+		DescribeTable("should check the version, feature gate and runtime config",
+			func(version string, featureGates, runtimeConfig map[string]bool, expected bool) {
+				shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: version,
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						KubernetesConfig: gardencorev1beta1.KubernetesConfig{FeatureGates: featureGates},
+						RuntimeConfig:    runtimeConfig,
+					},
+				}}}
+
+				Expect(ShootSupportsMutatingAdmissionPolicies(shoot)).To(Equal(expected))
+			},
+
+			Entry("supported", "1.34.0", map[string]bool{"MutatingAdmissionPolicy": true}, map[string]bool{"admissionregistration.k8s.io/v1beta1": true}, true),
+			Entry("version too old", "1.33.5", map[string]bool{"MutatingAdmissionPolicy": true}, map[string]bool{"admissionregistration.k8s.io/v1beta1": true}, false),
+			Entry("invalid version", "", map[string]bool{"MutatingAdmissionPolicy": true}, map[string]bool{"admissionregistration.k8s.io/v1beta1": true}, false),
+			Entry("feature gate not enabled", "1.34.0", nil, map[string]bool{"admissionregistration.k8s.io/v1beta1": true}, false),
+			Entry("feature gate disabled", "1.34.0", map[string]bool{"MutatingAdmissionPolicy": false}, map[string]bool{"admissionregistration.k8s.io/v1beta1": true}, false),
+			Entry("API not enabled", "1.34.0", map[string]bool{"MutatingAdmissionPolicy": true}, nil, false),
+		)
+
+		It("should return false if no kube-apiserver config is set", func() {
+			Expect(ShootSupportsMutatingAdmissionPolicies(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.34.0"}}})).To(BeFalse())
+		})
+	})
+
+	Describe("#BuildWebhookConfigs", func() {
+		var (
+			fakeClient client.Client
+			webhooks   []*Webhook
+
+			expectedPolicy = func(name string, failurePolicy admissionregistrationv1beta1.FailurePolicyType) *admissionregistrationv1beta1.MutatingAdmissionPolicy {
+				return &admissionregistrationv1beta1.MutatingAdmissionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"extensions.gardener.cloud/policy-provider": "gardener-extension-provider-foo"}},
+					Spec: admissionregistrationv1beta1.MutatingAdmissionPolicySpec{
+						MatchConstraints: &admissionregistrationv1beta1.MatchResources{
+							NamespaceSelector: namespaceSelector,
+							ResourceRules:     rules,
+							MatchPolicy:       ptr.To(admissionregistrationv1beta1.Exact),
+						},
+						Mutations:          []admissionregistrationv1beta1.Mutation{mutation},
+						FailurePolicy:      &failurePolicy,
+						ReinvocationPolicy: admissionregistrationv1beta1.NeverReinvocationPolicy,
+					},
+				}
+			}
+			expectedBinding = func(name string) *admissionregistrationv1beta1.MutatingAdmissionPolicyBinding {
+				return &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"extensions.gardener.cloud/policy-provider": "gardener-extension-provider-foo"}},
+					Spec:       admissionregistrationv1beta1.MutatingAdmissionPolicyBindingSpec{PolicyName: name},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Group: "apps", Version: "v1"}})
+			restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithRESTMapper(restMapper).Build()
+
+			webhooks = []*Webhook{
+				{
+					Action:            ActionMutating,
+					Name:              "seed-policy",
+					Target:            TargetSeed,
+					Types:             []Type{{Obj: &appsv1.Deployment{}}},
+					NamespaceSelector: namespaceSelector,
+					Policy:            &Policy{Mutations: []admissionregistrationv1beta1.Mutation{mutation}},
+				},
+				{
+					Action:            ActionMutating,
+					Name:              "shoot-policy",
+					Target:            TargetShoot,
+					Types:             []Type{{Obj: &appsv1.Deployment{}}},
+					NamespaceSelector: namespaceSelector,
+					Policy:            &Policy{Mutations: []admissionregistrationv1beta1.Mutation{mutation}},
+				},
+			}
+		})
+
+		It("should return the expected policies", func() {
+			seedConfigs, shootConfigs, err := BuildWebhookConfigs(webhooks, fakeClient, "extension-provider-foo", "provider-foo", false, 443, ModeService, "", nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(seedConfigs.HasWebhookConfig()).To(BeFalse())
+			Expect(seedConfigs.MutatingAdmissionPolicies).To(ConsistOf(expectedPolicy("gardener-extension-provider-foo-seed-policy", admissionregistrationv1beta1.Fail)))
+			Expect(seedConfigs.MutatingAdmissionPolicyBindings).To(ConsistOf(expectedBinding("gardener-extension-provider-foo-seed-policy")))
+
+			Expect(shootConfigs.HasWebhookConfig()).To(BeFalse())
+			Expect(shootConfigs.HasPolicyConfig()).To(BeTrue())
+			Expect(shootConfigs.MutatingAdmissionPolicies).To(ConsistOf(expectedPolicy("gardener-extension-provider-foo-shoot-policy-shoot", admissionregistrationv1beta1.Ignore)))
+			Expect(shootConfigs.MutatingAdmissionPolicyBindings).To(ConsistOf(expectedBinding("gardener-extension-provider-foo-shoot-policy-shoot")))
+			Expect(shootConfigs.GetPolicyConfigs()).To(HaveLen(2))
+		})
+
+		It("should add the fallback webhooks of shoot policies to the shoot webhook configs", func() {
+			webhooks[0].Webhook = &admission.Webhook{}
+			webhooks[1].Webhook = &admission.Webhook{}
+
+			seedConfigs, shootConfigs, err := BuildWebhookConfigs(webhooks, fakeClient, "extension-provider-foo", "provider-foo", false, 443, ModeService, "", nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(seedConfigs.HasWebhookConfig()).To(BeFalse())
+			Expect(shootConfigs.MutatingAdmissionPolicies).To(HaveLen(1))
+			Expect(shootConfigs.MutatingWebhookConfig.Name).To(Equal("gardener-extension-provider-foo-shoot"))
+			Expect(shootConfigs.MutatingWebhookConfig.Webhooks).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":          Equal("shoot-policy.foo.extensions.gardener.cloud"),
+				"FailurePolicy": PointTo(Equal(admissionregistrationv1.Ignore)),
+			})))
+			Expect(shootConfigs.FallbackWebhookNames).To(Equal(map[string]string{
+				"gardener-extension-provider-foo-shoot-policy-shoot": "shoot-policy.foo.extensions.gardener.cloud",
+			}))
+		})
+
+		It("should merge the shoot policies into the seed policies", func() {
+			seedConfigs, _, err := BuildWebhookConfigs(webhooks, fakeClient, "extension-provider-foo", "provider-foo", false, 443, ModeService, "", nil, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(seedConfigs.MutatingAdmissionPolicies).To(ConsistOf(
+				expectedPolicy("gardener-extension-provider-foo-seed-policy", admissionregistrationv1beta1.Fail),
+				expectedPolicy("gardener-extension-provider-foo-shoot-policy", admissionregistrationv1beta1.Ignore),
+			))
+		})
+
+		It("should respect the configured failure and reinvocation policy", func() {
+			webhooks[0].FailurePolicy = ptr.To(admissionregistrationv1.Ignore)
+			webhooks[0].Policy.ReinvocationPolicy = ptr.To(admissionregistrationv1.IfNeededReinvocationPolicy)
+
+			seedConfigs, _, err := BuildWebhookConfigs(webhooks[:1], fakeClient, "extension-provider-foo", "provider-foo", false, 443, ModeService, "", nil, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(seedConfigs.MutatingAdmissionPolicies[0].Spec.FailurePolicy).To(PointTo(Equal(admissionregistrationv1beta1.Ignore)))
+			Expect(seedConfigs.MutatingAdmissionPolicies[0].Spec.ReinvocationPolicy).To(Equal(admissionregistrationv1beta1.IfNeededReinvocationPolicy))
+		})
+
+		It("should fail for validating webhooks with policy", func() {
+			webhooks[0].Action = ActionValidating
+
+			_, _, err := BuildWebhookConfigs(webhooks, fakeClient, "extension-provider-foo", "provider-foo", false, 443, ModeService, "", nil, false)
+			Expect(err).To(MatchError(ContainSubstring("only \"mutating\" is supported")))
+		})
+	})
+
+	Describe("#ConfigsForShoot", func() {
+		var (
+			shoot        *gardencorev1beta1.Shoot
+			shootConfigs Configs
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{
+				Version: "1.34.0",
+				KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+					KubernetesConfig: gardencorev1beta1.KubernetesConfig{FeatureGates: map[string]bool{"MutatingAdmissionPolicy": true}},
+					RuntimeConfig:    map[string]bool{"admissionregistration.k8s.io/v1beta1": true},
+				},
+			}}}
+
+			shootConfigs = Configs{
+				MutatingWebhookConfig: &admissionregistrationv1.MutatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-foo-shoot"},
+					Webhooks:   []admissionregistrationv1.MutatingWebhook{{Name: "policy.foo.extensions.gardener.cloud"}},
+				},
+				MutatingAdmissionPolicies:       []*admissionregistrationv1beta1.MutatingAdmissionPolicy{{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-foo-policy-shoot"}}},
+				MutatingAdmissionPolicyBindings: []*admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-foo-policy-shoot"}}},
+				FallbackWebhookNames:            map[string]string{"gardener-extension-provider-foo-policy-shoot": "policy.foo.extensions.gardener.cloud"},
+			}
+		})
+
+		It("should remove the fallback webhooks if the shoot serves mutating admission policies", func() {
+			configs := ConfigsForShoot(shoot, shootConfigs)
+
+			Expect(configs.MutatingWebhookConfig).To(BeNil())
+			Expect(configs.GetPolicyConfigs()).To(HaveLen(2))
+			Expect(shootConfigs.MutatingWebhookConfig.Webhooks).To(HaveLen(1))
+		})
+
+		It("should keep other webhooks if the shoot serves mutating admission policies", func() {
+			shootConfigs.MutatingWebhookConfig.Webhooks = append(shootConfigs.MutatingWebhookConfig.Webhooks, admissionregistrationv1.MutatingWebhook{Name: "webhook.foo.extensions.gardener.cloud"})
+
+			configs := ConfigsForShoot(shoot, shootConfigs)
+
+			Expect(configs.MutatingWebhookConfig.Webhooks).To(ConsistOf(HaveField("Name", "webhook.foo.extensions.gardener.cloud")))
+		})
+
+		It("should remove the policies if the shoot does not serve mutating admission policies", func() {
+			shoot.Spec.Kubernetes.Version = "1.33.0"
+
+			configs := ConfigsForShoot(shoot, shootConfigs)
+
+			Expect(configs.MutatingWebhookConfig).To(Equal(shootConfigs.MutatingWebhookConfig))
+			Expect(configs.HasPolicyConfig()).To(BeFalse())
+			Expect(configs.GetPolicyConfigs()).To(BeEmpty())
+		})
+	})
+
+	Describe("#ReconcileSeedPolicyConfig", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+
+			ownerNamespaceName = "extension-provider-foo"
+
+			policy *admissionregistrationv1beta1.MutatingAdmissionPolicy
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+			policy = &admissionregistrationv1beta1.MutatingAdmissionPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-foo-replicas"},
+				Spec: admissionregistrationv1beta1.MutatingAdmissionPolicySpec{
+					Mutations: []admissionregistrationv1beta1.Mutation{mutation},
+				},
+			}
+		})
+
+		It("should create the policy w/ owner namespace", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ownerNamespaceName}})).To(Succeed())
+
+			Expect(ReconcileSeedPolicyConfig(ctx, fakeClient, policy.DeepCopy(), ownerNamespaceName)).To(Succeed())
+
+			obj := &admissionregistrationv1beta1.MutatingAdmissionPolicy{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), obj)).To(Succeed())
+			Expect(obj.Spec).To(Equal(policy.Spec))
+			Expect(obj.OwnerReferences).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Kind": Equal("Namespace"),
+				"Name": Equal(ownerNamespaceName),
+			})))
+		})
+
+		It("should overwrite the spec of an existing policy", func() {
+			existing := policy.DeepCopy()
+			existing.Spec.Mutations = []admissionregistrationv1beta1.Mutation{JSONPatch(`[JSONPatch{op: "remove", path: "/spec/replicas"}]`)}
+			Expect(fakeClient.Create(ctx, existing)).To(Succeed())
+
+			Expect(ReconcileSeedPolicyConfig(ctx, fakeClient, policy.DeepCopy(), "")).To(Succeed())
+
+			obj := &admissionregistrationv1beta1.MutatingAdmissionPolicy{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), obj)).To(Succeed())
+			Expect(obj.Spec.Mutations).To(ConsistOf(mutation))
+		})
+
+		It("should add the labels to an existing policy", func() {
+			existing := policy.DeepCopy()
+			existing.Labels = map[string]string{"foo": "bar"}
+			Expect(fakeClient.Create(ctx, existing)).To(Succeed())
+
+			desired := policy.DeepCopy()
+			desired.Labels = map[string]string{"extensions.gardener.cloud/policy-provider": "gardener-extension-provider-foo"}
+			Expect(ReconcileSeedPolicyConfig(ctx, fakeClient, desired, "")).To(Succeed())
+
+			obj := &admissionregistrationv1beta1.MutatingAdmissionPolicy{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), obj)).To(Succeed())
+			Expect(obj.Labels).To(Equal(map[string]string{"foo": "bar", "extensions.gardener.cloud/policy-provider": "gardener-extension-provider-foo"}))
+		})
+
+		It("should reconcile the binding", func() {
+			binding := &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: policy.Name},
+				Spec:       admissionregistrationv1beta1.MutatingAdmissionPolicyBindingSpec{PolicyName: policy.Name},
+			}
+
+			Expect(ReconcileSeedPolicyConfig(ctx, fakeClient, binding.DeepCopy(), "")).To(Succeed())
+
+			obj := &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(binding), obj)).To(Succeed())
+			Expect(obj.Spec.PolicyName).To(Equal(policy.Name))
+		})
+	})
+
+	Describe("#DeleteStaleSeedPolicyConfigs", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+
+			labels = map[string]string{"extensions.gardener.cloud/policy-provider": "gardener-extension-provider-foo"}
+
+			desiredPolicy, stalePolicy, foreignPolicy    *admissionregistrationv1beta1.MutatingAdmissionPolicy
+			desiredBinding, staleBinding, foreignBinding *admissionregistrationv1beta1.MutatingAdmissionPolicyBinding
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+			desiredPolicy = &admissionregistrationv1beta1.MutatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-foo-desired", Labels: labels}}
+			stalePolicy = &admissionregistrationv1beta1.MutatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-foo-stale", Labels: labels}}
+			foreignPolicy = &admissionregistrationv1beta1.MutatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "foreign"}}
+			desiredBinding = &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: desiredPolicy.Name, Labels: labels}}
+			staleBinding = &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: stalePolicy.Name, Labels: labels}}
+			foreignBinding = &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: foreignPolicy.Name}}
+
+			for _, obj := range []client.Object{desiredPolicy, stalePolicy, foreignPolicy, desiredBinding, staleBinding, foreignBinding} {
+				Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+			}
+		})
+
+		It("should delete the stale policies and bindings of the provider", func() {
+			Expect(DeleteStaleSeedPolicyConfigs(ctx, fakeClient, "gardener-extension-provider-foo", []client.Object{desiredPolicy.DeepCopy(), desiredBinding.DeepCopy()})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(desiredPolicy), desiredPolicy)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(desiredBinding), desiredBinding)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(foreignPolicy), foreignPolicy)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(foreignBinding), foreignBinding)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(stalePolicy), stalePolicy)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(staleBinding), staleBinding)).To(BeNotFoundError())
+		})
+
+		It("should only delete the stale binding if the policy is still desired", func() {
+			Expect(DeleteStaleSeedPolicyConfigs(ctx, fakeClient, "gardener-extension-provider-foo", []client.Object{desiredPolicy.DeepCopy(), stalePolicy.DeepCopy(), desiredBinding.DeepCopy()})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(stalePolicy), stalePolicy)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(staleBinding), staleBinding)).To(BeNotFoundError())
+		})
+	})
+})
@@ -7,6 +7,7 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -50,10 +51,16 @@ func PrefixedName(componentName string, doNotPrefix bool) string {
 	return NamePrefix + componentName
 }
 
-// Configs contains mutating and validating webhook configurations.
+// Configs contains mutating and validating webhook configurations as well as mutating admission policies.
 type Configs struct {
 	MutatingWebhookConfig   *admissionregistrationv1.MutatingWebhookConfiguration
 	ValidatingWebhookConfig *admissionregistrationv1.ValidatingWebhookConfiguration
+
+	MutatingAdmissionPolicies       []*admissionregistrationv1beta1.MutatingAdmissionPolicy
+	MutatingAdmissionPolicyBindings []*admissionregistrationv1beta1.MutatingAdmissionPolicyBinding
+	// FallbackWebhookNames maps the names of mutating admission policies to the names of the webhooks in
+	// MutatingWebhookConfig which serve them in clusters which do not support mutating admission policies.
+	FallbackWebhookNames map[string]string
 }
 
 // GetWebhookConfigs returns a slice of webhook configurations.
@@ -68,6 +75,18 @@ func (c *Configs) GetWebhookConfigs() []client.Object {
 	return configs
 }
 
+// GetPolicyConfigs returns a slice of mutating admission policies and their bindings.
+func (c *Configs) GetPolicyConfigs() []client.Object {
+	configs := make([]client.Object, 0, len(c.MutatingAdmissionPolicies)+len(c.MutatingAdmissionPolicyBindings))
+	for _, policy := range c.MutatingAdmissionPolicies {
+		configs = append(configs, policy)
+	}
+	for _, binding := range c.MutatingAdmissionPolicyBindings {
+		configs = append(configs, binding)
+	}
+	return configs
+}
+
 // DeepCopy returns a deep copy of the 'Configs' object.
 func (c *Configs) DeepCopy() *Configs {
 	deepCopy := Configs{}
@@ -77,6 +96,13 @@ func (c *Configs) DeepCopy() *Configs {
 	if c.ValidatingWebhookConfig != nil {
 		deepCopy.ValidatingWebhookConfig = c.ValidatingWebhookConfig.DeepCopy()
 	}
+	for _, policy := range c.MutatingAdmissionPolicies {
+		deepCopy.MutatingAdmissionPolicies = append(deepCopy.MutatingAdmissionPolicies, policy.DeepCopy())
+	}
+	for _, binding := range c.MutatingAdmissionPolicyBindings {
+		deepCopy.MutatingAdmissionPolicyBindings = append(deepCopy.MutatingAdmissionPolicyBindings, binding.DeepCopy())
+	}
+	deepCopy.FallbackWebhookNames = maps.Clone(c.FallbackWebhookNames)
 	return &deepCopy
 }
 
@@ -85,7 +111,15 @@ func (c *Configs) HasWebhookConfig() bool {
 	return c.MutatingWebhookConfig != nil || c.ValidatingWebhookConfig != nil
 }
 
-// BuildWebhookConfigs builds webhook.Configs for seed and shoot from the given webhooks slice.
+// HasPolicyConfig returns true if 'Configs' contains at least one mutating admission policy.
+func (c *Configs) HasPolicyConfig() bool {
+	return len(c.MutatingAdmissionPolicies) > 0
+}
+
+// BuildWebhookConfigs builds webhook.Configs for seed and shoot from the given webhooks slice. Webhooks with a policy
+// are added as mutating admission policies instead of webhook configurations. Since shoot clusters do not necessarily
+// serve mutating admission policies, the fallback webhooks of shoot-targeted policies are added to the shoot webhook
+// configs in addition, see ConfigsForShoot.
 func BuildWebhookConfigs(
 	webhooks []*Webhook,
 	c client.Client,
@@ -124,6 +158,32 @@ func BuildWebhookConfigs(
 			rules = append(rules, *rule)
 		}
 
+		if webhook.Policy != nil {
+			if err := addPolicyConfigs(webhook, name, rules, mergeShootWebhooksIntoSeedWebhooks, &seedWebhookConfigs, &shootWebhookConfigs); err != nil {
+				return seedWebhookConfigs, shootWebhookConfigs, err
+			}
+
+			if webhook.Target == TargetShoot && webhook.Webhook != nil {
+				createAndAddToWebhookConfig(
+					&shootWebhookConfigs,
+					name+NameSuffixShoot,
+					*webhook,
+					providerName,
+					rules,
+					getFailurePolicy(admissionregistrationv1.Ignore, webhook.FailurePolicy),
+					&exact,
+					BuildClientConfigFor(webhook.Path, namespace, providerName, doNotPrefixComponentName, servicePort, shootMode, url, caBundle),
+					&sideEffects,
+				)
+
+				if shootWebhookConfigs.FallbackWebhookNames == nil {
+					shootWebhookConfigs.FallbackWebhookNames = map[string]string{}
+				}
+				shootWebhookConfigs.FallbackWebhookNames[name+"-"+webhook.Name+NameSuffixShoot] = webhookName(webhook.Name, providerName)
+			}
+			continue
+		}
+
 		switch webhook.Target {
 		case TargetSeed:
 			// if all webhooks for one target are removed in a new version, extensions need to explicitly delete the respective
@@ -180,14 +240,9 @@ func BuildWebhookConfigs(
 // If a CA bundle is given, it is injected it into all desired webhooks. If not, the CA bundle from the webhook config
 // on the cluster (if any) is kept.
 func ReconcileSeedWebhookConfig(ctx context.Context, c client.Client, webhookConfig client.Object, ownerNamespace string, caBundle []byte) error {
-	var ownerReference *metav1.OwnerReference
-	if len(ownerNamespace) > 0 {
-		ns := &corev1.Namespace{}
-		if err := c.Get(ctx, client.ObjectKey{Name: ownerNamespace}, ns); err != nil {
-			return err
-		}
-		ownerReference = metav1.NewControllerRef(ns, corev1.SchemeGroupVersion.WithKind("Namespace"))
-		ownerReference.BlockOwnerDeletion = ptr.To(false)
+	ownerReference, err := namespaceOwnerReference(ctx, c, ownerNamespace)
+	if err != nil {
+		return err
 	}
 
 	desiredWebhookConfig := webhookConfig.DeepCopyObject().(client.Object)
@@ -217,6 +272,22 @@ func ReconcileSeedWebhookConfig(ctx context.Context, c client.Client, webhookCon
 	return nil
 }
 
+// namespaceOwnerReference returns an owner reference to the given namespace. It returns nil if no namespace is given.
+func namespaceOwnerReference(ctx context.Context, c client.Client, ownerNamespace string) (*metav1.OwnerReference, error) {
+	if len(ownerNamespace) == 0 {
+		return nil, nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: ownerNamespace}, ns); err != nil {
+		return nil, err
+	}
+
+	ownerReference := metav1.NewControllerRef(ns, corev1.SchemeGroupVersion.WithKind("Namespace"))
+	ownerReference.BlockOwnerDeletion = ptr.To(false)
+	return ownerReference, nil
+}
+
 // OverwriteWebhooks sets current.Webhooks to desired.Webhooks for all kinds and version of webhook configs.
 func OverwriteWebhooks(current, desired client.Object) error {
 	switch config := current.(type) {
@@ -402,7 +473,7 @@ func createAndAddToWebhookConfig(
 		}
 		webhookToRegister := admissionregistrationv1.ValidatingWebhook{
 			AdmissionReviewVersions: []string{"v1", "v1beta1"},
-			Name:                    fmt.Sprintf("%s.%s.extensions.gardener.cloud", webhook.Name, strings.TrimPrefix(providerName, "provider-")),
+			Name:                    webhookName(webhook.Name, providerName),
 			NamespaceSelector:       webhook.NamespaceSelector,
 			ObjectSelector:          webhook.ObjectSelector,
 			Rules:                   rules,
@@ -427,7 +498,7 @@ func createAndAddToWebhookConfig(
 
 		webhookToRegister := admissionregistrationv1.MutatingWebhook{
 			AdmissionReviewVersions: []string{"v1", "v1beta1"},
-			Name:                    fmt.Sprintf("%s.%s.extensions.gardener.cloud", webhook.Name, strings.TrimPrefix(providerName, "provider-")),
+			Name:                    webhookName(webhook.Name, providerName),
 			NamespaceSelector:       webhook.NamespaceSelector,
 			ObjectSelector:          webhook.ObjectSelector,
 			Rules:                   rules,
@@ -446,6 +517,10 @@ func createAndAddToWebhookConfig(
 	}
 }
 
+func webhookName(name, providerName string) string {
+	return fmt.Sprintf("%s.%s.extensions.gardener.cloud", name, strings.TrimPrefix(providerName, "provider-"))
+}
+
 // InitialWebhookConfig returns the initial object meta for a webhook configuration.
 func InitialWebhookConfig(name string) metav1.ObjectMeta {
 	return metav1.ObjectMeta{
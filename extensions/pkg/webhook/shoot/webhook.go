@@ -25,7 +25,9 @@ import (
 )
 
 // ReconcileWebhookConfig deploys the shoot webhook configuration, i.e., a network policy to allow the
-// kube-apiserver to talk to the extension, and a managed resource that contains the MutatingWebhookConfiguration.
+// kube-apiserver to talk to the extension, and a managed resource that contains the MutatingWebhookConfiguration and
+// the MutatingAdmissionPolicies. The MutatingAdmissionPolicies are only deployed if the kube-apiserver of the shoot
+// serves them, otherwise their fallback webhooks are deployed instead.
 func ReconcileWebhookConfig(
 	ctx context.Context,
 	c client.Client,
@@ -39,9 +41,11 @@ func ReconcileWebhookConfig(
 		return fmt.Errorf("no shoot found in cluster resource")
 	}
 
+	shootWebhookConfigs = webhook.ConfigsForShoot(cluster.Shoot, shootWebhookConfigs)
+
 	data, err := managedresources.
 		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
-		AddAllAndSerialize(shootWebhookConfigs.GetWebhookConfigs()...)
+		AddAllAndSerialize(append(shootWebhookConfigs.GetWebhookConfigs(), shootWebhookConfigs.GetPolicyConfigs()...)...)
 	if err != nil {
 		return err
 	}
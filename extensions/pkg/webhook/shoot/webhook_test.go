@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -74,6 +75,47 @@ var _ = Describe("Webhook", func() {
 			Expect(ReconcileWebhookConfig(ctx, fakeClient, namespace, managedResourceName, shootWebhookConfigs, cluster, true)).To(Succeed())
 			expectWebhookConfigReconciliation(ctx, fakeClient, namespace, managedResourceName, shootWebhookConfigs.MutatingWebhookConfig, consistOf)
 		})
+
+		Context("with mutating admission policies", func() {
+			var (
+				policy  *admissionregistrationv1beta1.MutatingAdmissionPolicy
+				binding *admissionregistrationv1beta1.MutatingAdmissionPolicyBinding
+			)
+
+			BeforeEach(func() {
+				policy = &admissionregistrationv1beta1.MutatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "policy"}}
+				binding = &admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{ObjectMeta: metav1.ObjectMeta{Name: "policy"}}
+
+				shootWebhookConfigs.MutatingWebhookConfig.Webhooks = append(shootWebhookConfigs.MutatingWebhookConfig.Webhooks, admissionregistrationv1.MutatingWebhook{Name: "policy-fallback"})
+				shootWebhookConfigs.MutatingAdmissionPolicies = []*admissionregistrationv1beta1.MutatingAdmissionPolicy{policy}
+				shootWebhookConfigs.MutatingAdmissionPolicyBindings = []*admissionregistrationv1beta1.MutatingAdmissionPolicyBinding{binding}
+				shootWebhookConfigs.FallbackWebhookNames = map[string]string{"policy": "policy-fallback"}
+			})
+
+			It("should deploy the fallback webhooks if the shoot does not serve mutating admission policies", func() {
+				Expect(ReconcileWebhookConfig(ctx, fakeClient, namespace, managedResourceName, shootWebhookConfigs, cluster, true)).To(Succeed())
+				expectWebhookConfigReconciliation(ctx, fakeClient, namespace, managedResourceName, shootWebhookConfigs.MutatingWebhookConfig, consistOf)
+			})
+
+			It("should deploy the policies if the shoot serves mutating admission policies", func() {
+				cluster.Shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{
+					Version: "1.34.0",
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						KubernetesConfig: gardencorev1beta1.KubernetesConfig{FeatureGates: map[string]bool{"MutatingAdmissionPolicy": true}},
+						RuntimeConfig:    map[string]bool{"admissionregistration.k8s.io/v1beta1": true},
+					},
+				}
+
+				Expect(ReconcileWebhookConfig(ctx, fakeClient, namespace, managedResourceName, shootWebhookConfigs, cluster, true)).To(Succeed())
+
+				managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: managedResourceName, Namespace: namespace}}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				mutatingWebhookConfig := shootWebhookConfigs.MutatingWebhookConfig.DeepCopy()
+				mutatingWebhookConfig.Webhooks = mutatingWebhookConfig.Webhooks[:1]
+				Expect(managedResource).To(consistOf(mutatingWebhookConfig, policy, binding))
+				Expect(shootWebhookConfigs.MutatingWebhookConfig.Webhooks).To(HaveLen(2))
+			})
+		})
 	})
 
 	Describe("#ReconcileWebhooksForAllNamespaces", func() {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +43,54 @@ type Webhook struct {
 	ObjectSelector    *metav1.LabelSelector
 	FailurePolicy     *admissionregistrationv1.FailurePolicyType
 	TimeoutSeconds    *int32
+	// Policy contains the CEL-based mutations of the webhook. If set, the webhook is registered as a
+	// MutatingAdmissionPolicy which is evaluated by the kube-apiserver, i.e. it is not served by the webhook server.
+	// If the seed does not support MutatingAdmissionPolicies, the Webhook handler is served instead, if it is set.
+	// Otherwise, the webhook is skipped.
+	Policy *Policy
+}
+
+// Policy contains CEL-based mutations which are registered as a MutatingAdmissionPolicy.
+type Policy struct {
+	// MatchConditions are CEL expressions which must evaluate to true for the mutations to be applied.
+	MatchConditions []admissionregistrationv1beta1.MatchCondition
+	// Variables are CEL expressions which can be referenced in the mutations via `variables.<name>`.
+	Variables []admissionregistrationv1beta1.Variable
+	// Mutations are the mutations applied to the matched objects.
+	Mutations []admissionregistrationv1beta1.Mutation
+	// ReinvocationPolicy specifies whether the mutations are re-applied if other admission plugins mutated the object.
+	// Defaults to `Never`.
+	ReinvocationPolicy *admissionregistrationv1.ReinvocationPolicyType
+}
+
+// ApplyConfiguration returns a mutation which merges the object returned by the given CEL expression into the
+// matched object, e.g. `Object{spec: Object.spec{replicas: 1}}`.
+func ApplyConfiguration(expression string) admissionregistrationv1beta1.Mutation {
+	return admissionregistrationv1beta1.Mutation{
+		PatchType:          admissionregistrationv1beta1.PatchTypeApplyConfiguration,
+		ApplyConfiguration: &admissionregistrationv1beta1.ApplyConfiguration{Expression: expression},
+	}
+}
+
+// JSONPatch returns a mutation which applies the JSON patch operations returned by the given CEL expression to the
+// matched object, e.g. `[JSONPatch{op: "add", path: "/spec/replicas", value: 1}]`.
+func JSONPatch(expression string) admissionregistrationv1beta1.Mutation {
+	return admissionregistrationv1beta1.Mutation{
+		PatchType: admissionregistrationv1beta1.PatchTypeJSONPatch,
+		JSONPatch: &admissionregistrationv1beta1.JSONPatch{Expression: expression},
+	}
+}
+
+// RequiresServer returns true if at least one of the given webhooks must be served by the webhook server, i.e. it is
+// not realized as a MutatingAdmissionPolicy, or it is a shoot-targeted policy with a fallback webhook handler which
+// serves shoots not supporting mutating admission policies.
+func RequiresServer(webhooks []*Webhook) bool {
+	for _, webhook := range webhooks {
+		if webhook.Policy == nil || (webhook.Target == TargetShoot && webhook.Webhook != nil) {
+			return true
+		}
+	}
+	return false
 }
 
 // Validator validates objects.
@@ -75,6 +124,21 @@ type Args struct {
 	Mutators          map[Mutator][]Type
 }
 
+// PolicyArgs contains arguments for creating a Webhook which is registered as a MutatingAdmissionPolicy.
+type PolicyArgs struct {
+	Provider          string
+	Name              string
+	Target            string
+	Types             []Type
+	NamespaceSelector *metav1.LabelSelector
+	ObjectSelector    *metav1.LabelSelector
+	FailurePolicy     *admissionregistrationv1.FailurePolicyType
+	Policy            Policy
+	// Fallback is the webhook handler which is served instead of the policy if the seed does not support
+	// MutatingAdmissionPolicies. If it is not set, the webhook is skipped in this case.
+	Fallback *admission.Webhook
+}
+
 // New creates a new Webhook with the given args.
 func New(mgr manager.Manager, args Args) (*Webhook, error) {
 	var (
@@ -129,3 +193,25 @@ func New(mgr manager.Manager, args Args) (*Webhook, error) {
 		Types:             objTypes,
 	}, nil
 }
+
+// NewPolicy creates a new Webhook with the given args whose CEL-based mutations are evaluated by the kube-apiserver.
+func NewPolicy(args PolicyArgs) (*Webhook, error) {
+	if len(args.Policy.Mutations) == 0 {
+		return nil, fmt.Errorf("failed to create webhook %q because no mutations are configured", args.Name)
+	}
+
+	log.Log.WithName(args.Name).WithValues("provider", args.Provider).Info("Creating webhook with mutating admission policy")
+
+	return &Webhook{
+		Name:              args.Name,
+		Provider:          args.Provider,
+		Action:            ActionMutating,
+		Target:            args.Target,
+		Types:             args.Types,
+		NamespaceSelector: args.NamespaceSelector,
+		ObjectSelector:    args.ObjectSelector,
+		FailurePolicy:     args.FailurePolicy,
+		Webhook:           args.Fallback,
+		Policy:            &args.Policy,
+	}, nil
+}
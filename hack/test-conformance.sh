@@ -0,0 +1,35 @@
+#!/usr/bin/env bash
+#
+# SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+source "$(dirname "$0")/prepare-envtest.sh"
+
+echo "> Extension Conformance Tests (provider-local)"
+
+tmp_dir="$(mktemp -d)"
+trap 'rm -rf "$tmp_dir"' EXIT
+
+if [ -z "${CONFORMANCE_EXTENSION_BINARY:-}" ]; then
+  echo "Building gardener-extension-provider-local"
+  go build -o "$tmp_dir/gardener-extension-provider-local" ./cmd/gardener-extension-provider-local
+  export CONFORMANCE_EXTENSION_BINARY="$tmp_dir/gardener-extension-provider-local"
+fi
+
+if [ -z "${CONFORMANCE_EXTENSION_ARGS:-}" ]; then
+  CONFORMANCE_EXTENSION_ARGS="--leader-election=false --local-dir=$tmp_dir/backupbuckets"
+  if [ "${USE_EXISTING_CLUSTER:-false}" != "true" ]; then
+    # The test environment only runs kube-apiserver and etcd, hence only the controllers for the kinds which do not
+    # require a real cluster are started, and no webhooks are served.
+    CONFORMANCE_EXTENSION_ARGS+=" --disable-controllers=bastion,controlplane,dnsrecord,worker,service,healthcheck,networkpolicy,heartbeat,local-ext-seed,local-ext-shoot-after-worker"
+    CONFORMANCE_EXTENSION_ARGS+=" --disable-webhooks=controlplane,shoot,rollout-speedup,networkpolicy,node,prometheus"
+  fi
+  export CONFORMANCE_EXTENSION_ARGS
+fi
+
+GO111MODULE=on go test -timeout=30m ./test/conformance/extensions/... -v $@
@@ -16,6 +16,7 @@ import (
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/authorizationconfig"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/internaldomainsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/kubeconfigsecret"
+	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/monitoringrules"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/namespacedeletion"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/providersecretlabels"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/resourcesize"
@@ -66,6 +67,10 @@ func AddToManager(
 		return fmt.Errorf("failed adding %s webhook handler: %w", kubeconfigsecret.HandlerName, err)
 	}
 
+	if err := monitoringrules.AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding %s webhook handler: %w", monitoringrules.HandlerName, err)
+	}
+
 	if err := (&namespacedeletion.Handler{
 		Logger:    mgr.GetLogger().WithName("webhook").WithName(namespacedeletion.HandlerName),
 		APIReader: mgr.GetAPIReader(),
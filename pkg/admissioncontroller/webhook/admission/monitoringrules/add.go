@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package monitoringrules
+
+import (
+	"fmt"
+	"net/http"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gardencorehelper "github.com/gardener/gardener/pkg/api/core/helper"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot/userrules"
+	"github.com/gardener/gardener/pkg/webhook/configvalidator"
+)
+
+const (
+	// HandlerName is the name of this admission webhook handler.
+	HandlerName = "monitoringrules_validator"
+	// WebhookPath is the HTTP handler path for this admission webhook handler.
+	WebhookPath = "/webhooks/monitoring-rules"
+)
+
+// AddToManager adds the webhook to the given manager.
+func AddToManager(mgr manager.Manager) error {
+	webhook := &admission.Webhook{
+		Handler: NewHandler(
+			mgr.GetAPIReader(),
+			mgr.GetClient(),
+			admission.NewDecoder(mgr.GetScheme()),
+		),
+		RecoverPanic: ptr.To(true),
+	}
+
+	mgr.GetWebhookServer().Register(WebhookPath, webhook)
+	return nil
+}
+
+// NewHandler returns a new handler for validating user-defined monitoring rules.
+func NewHandler(apiReader, c client.Reader, decoder admission.Decoder) admission.Handler {
+	return &configvalidator.Handler{
+		APIReader: apiReader,
+		Client:    c,
+		Decoder:   decoder,
+
+		ConfigMapPurpose: "monitoring rules",
+		ConfigMapDataKey: userrules.DataKey,
+		GetConfigMapNameFromShoot: func(shoot *gardencore.Shoot) string {
+			return gardencorehelper.GetShootMonitoringRulesConfigMapName(shoot.Spec.Monitoring)
+		},
+		AdmitConfig: admitConfig,
+	}
+}
+
+func admitConfig(rulesRaw string, _ []*gardencore.Shoot) (int32, error) {
+	spec, err := userrules.Parse(rulesRaw)
+	if err != nil {
+		return http.StatusUnprocessableEntity, err
+	}
+
+	if errList := userrules.Validate(spec); len(errList) != 0 {
+		return http.StatusUnprocessableEntity, fmt.Errorf("provided invalid monitoring rules: %v", errList)
+	}
+
+	return 0, nil
+}
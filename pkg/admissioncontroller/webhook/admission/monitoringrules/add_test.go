@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package monitoringrules_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/monitoringrules"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+)
+
+var _ = Describe("handler", func() {
+	var (
+		ctx = context.TODO()
+
+		request admission.Request
+		decoder admission.Decoder
+		handler admission.Handler
+
+		ctrl       *gomock.Controller
+		mockReader *mockclient.MockReader
+		fakeClient client.Client
+
+		statusCodeAllowed int32 = http.StatusOK
+		statusCodeInvalid int32 = http.StatusUnprocessableEntity
+
+		testEncoder runtime.Encoder
+
+		cmName         = "fake-cm-name"
+		cmNamespace    = "fake-cm-namespace"
+		shootName      = "fake-shoot-name"
+		shootNamespace = cmNamespace
+
+		cm           *corev1.ConfigMap
+		shootv1beta1 *gardencorev1beta1.Shoot
+
+		validRules = `groups:
+- name: etcd
+  rules:
+  - record: user:etcd_db_total_size:max
+    expr: max(etcd_mvcc_db_total_size_in_bytes)
+  - alert: EtcdDatabaseLarge
+    expr: user:etcd_db_total_size:max > 4e9
+    for: 15m
+    labels:
+      severity: warning
+`
+		anotherValidRules = `groups:
+- name: apiserver
+  rules:
+  - record: user:apiserver_request_duration_seconds:p99
+    expr: histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket[5m])) by (le, resource))
+`
+		invalidRules = `groups:
+- name: etcd
+  interval: 5s
+  rules:
+  - alert: EtcdDatabaseLarge
+    expr: max(etcd_mvcc_db_total_size_in_bytes) > 4e9
+`
+		unknownFieldRules = `groups:
+- name: etcd
+  evaluation: 1m
+`
+	)
+
+	BeforeEach(func() {
+		testEncoder = &jsonserializer.Serializer{}
+
+		ctrl = gomock.NewController(GinkgoT())
+		mockReader = mockclient.NewMockReader(ctrl)
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		decoder = admission.NewDecoder(kubernetes.GardenScheme)
+
+		handler = NewHandler(mockReader, fakeClient, decoder)
+
+		request = admission.Request{}
+
+		shootv1beta1 = &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+				Kind:       "Shoot",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      shootName,
+				Namespace: shootNamespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: "1.31.1",
+				},
+				Monitoring: &gardencorev1beta1.Monitoring{
+					Rules: &gardencorev1beta1.MonitoringRules{
+						ConfigMapName: cmName,
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	test := func(op admissionv1.Operation, oldObj runtime.Object, obj runtime.Object, expectedAllowed bool, expectedStatusCode int32, expectedMsg string) {
+		request.Operation = op
+
+		if oldObj != nil {
+			objData, err := runtime.Encode(testEncoder, oldObj)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			request.OldObject.Raw = objData
+		}
+
+		if obj != nil {
+			objData, err := runtime.Encode(testEncoder, obj)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			request.Object.Raw = objData
+		}
+
+		response := handler.Handle(ctx, request)
+		ExpectWithOffset(1, response).To(Not(BeNil()))
+		ExpectWithOffset(1, response.Allowed).To(Equal(expectedAllowed))
+		ExpectWithOffset(1, response.Result.Code).To(Equal(expectedStatusCode))
+		if expectedMsg != "" {
+			ExpectWithOffset(1, response.Result.Message).To(ContainSubstring(expectedMsg))
+		}
+		ExpectWithOffset(1, response.Patches).To(BeEmpty())
+	}
+
+	expectConfigMap := func(data map[string]string) {
+		mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmName}, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: shootNamespace, Name: cmName}}).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+			*cm = corev1.ConfigMap{Data: data}
+			return nil
+		})
+	}
+
+	Context("Shoots", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "core.gardener.cloud", Version: "v1beta1", Kind: "Shoot"}
+		})
+
+		Context("Allow", func() {
+			It("has no monitoring config", func() {
+				shootv1beta1.Spec.Monitoring = nil
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "Shoot resource does not specify any monitoring rules ConfigMap")
+			})
+
+			It("references valid rules (CREATE)", func() {
+				expectConfigMap(map[string]string{"rules.yaml": validRules})
+				test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "referenced monitoring rules is valid")
+			})
+
+			It("referenced rules name was not changed (UPDATE)", func() {
+				newShoot := shootv1beta1.DeepCopy()
+				newShoot.Spec.Monitoring.Alerting = &gardencorev1beta1.Alerting{EmailReceivers: []string{"foo@example.com"}}
+				test(admissionv1.Update, shootv1beta1, newShoot, true, statusCodeAllowed, "Neither monitoring rules ConfigMap nor Kubernetes version or other relevant fields were changed")
+			})
+		})
+
+		Context("Deny", func() {
+			It("references a configmap that does not exist", func() {
+				mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmName}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).Return(apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, cmName))
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "referenced monitoring rules ConfigMap fake-cm-namespace/fake-cm-name does not exist")
+			})
+
+			It("references a configmap without the rules key", func() {
+				expectConfigMap(nil)
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "missing monitoring rules key in rules.yaml ConfigMap data")
+			})
+
+			It("references rules which break validation rules", func() {
+				expectConfigMap(map[string]string{"rules.yaml": invalidRules})
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "provided invalid monitoring rules")
+			})
+
+			It("references rules with unknown fields", func() {
+				expectConfigMap(map[string]string{"rules.yaml": unknownFieldRules})
+				test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, `unknown field "evaluation"`)
+			})
+		})
+	})
+
+	Context("ConfigMaps", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"}
+			request.Name = cmName
+			request.Namespace = cmNamespace
+
+			cm = &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cmName,
+					Namespace: cmNamespace,
+				},
+				Data: map[string]string{
+					"rules.yaml": validRules,
+				},
+			}
+
+			Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+		})
+
+		It("should allow if the rules are changed to something valid", func() {
+			newCm := cm.DeepCopy()
+			newCm.Data["rules.yaml"] = anotherValidRules
+			test(admissionv1.Update, cm, newCm, true, statusCodeAllowed, "referenced monitoring rules is valid")
+		})
+
+		It("should deny if the rules are changed to something invalid", func() {
+			newCm := cm.DeepCopy()
+			newCm.Data["rules.yaml"] = invalidRules
+			test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "must be at least 1m0s")
+		})
+	})
+})
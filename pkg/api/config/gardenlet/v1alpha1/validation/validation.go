@@ -119,6 +119,26 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, validateTracingConfiguration(cfg.Tracing, fldPath.Child("tracing"))...)
+
+	return allErrs
+}
+
+func validateTracingConfiguration(conf *gardenletconfigv1alpha1.TracingConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), "must provide the OTLP endpoint"))
+	}
+
+	if percentage := ptr.Deref(conf.SamplingPercentage, 100); percentage < 0 || percentage > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("samplingPercentage"), percentage, "must be between 0 and 100"))
+	}
+
 	return allErrs
 }
 
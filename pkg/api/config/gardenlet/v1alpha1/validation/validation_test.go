@@ -800,6 +800,34 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("tracing", func() {
+			It("should pass with valid tracing options", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{
+					Endpoint:           "opentelemetry-collector:4317",
+					SamplingPercentage: ptr.To[int32](10),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with invalid tracing options", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{
+					SamplingPercentage: ptr.To[int32](101),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("tracing.endpoint"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("tracing.samplingPercentage"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
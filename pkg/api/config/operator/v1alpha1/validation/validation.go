@@ -37,6 +37,7 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
+	allErrs = append(allErrs, validateTracingConfiguration(conf.Tracing, field.NewPath("tracing"))...)
 
 	return allErrs
 }
@@ -122,3 +123,21 @@ func validateNodeTolerationConfiguration(conf *operatorconfigv1alpha1.NodeTolera
 
 	return allErrs
 }
+
+func validateTracingConfiguration(conf *operatorconfigv1alpha1.TracingConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), "must provide the OTLP endpoint"))
+	}
+
+	if percentage := ptr.Deref(conf.SamplingPercentage, 100); percentage < 0 || percentage > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("samplingPercentage"), percentage, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
@@ -292,4 +292,32 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("tracing", func() {
+		It("should pass with valid tracing options", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{
+				Endpoint:           "opentelemetry-collector:4317",
+				SamplingPercentage: ptr.To[int32](10),
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with invalid tracing options", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{
+				SamplingPercentage: ptr.To[int32](101),
+			}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("tracing.endpoint"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("tracing.samplingPercentage"),
+				})),
+			))
+		})
+	})
 })
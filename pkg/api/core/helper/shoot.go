@@ -182,6 +182,15 @@ func GetShootAuthorizationConfigurationConfigMapName(apiServerConfig *core.KubeA
 	return ""
 }
 
+// GetShootMonitoringRulesConfigMapName returns the Shoot's ConfigMap reference name for the user-defined monitoring
+// rules.
+func GetShootMonitoringRulesConfigMapName(monitoring *core.Monitoring) string {
+	if monitoring != nil && monitoring.Rules != nil {
+		return monitoring.Rules.ConfigMapName
+	}
+	return ""
+}
+
 // GetShootServiceAccountConfigIssuer returns the Shoot's ServiceAccountConfig Issuer.
 func GetShootServiceAccountConfigIssuer(apiServerConfig *core.KubeAPIServerConfig) *string {
 	if apiServerConfig != nil && apiServerConfig.ServiceAccountConfig != nil {
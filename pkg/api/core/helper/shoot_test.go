@@ -379,6 +379,20 @@ var _ = Describe("Helper", func() {
 		}, "foo"),
 	)
 
+	DescribeTable("#GetShootMonitoringRulesConfigMapName",
+		func(monitoring *core.Monitoring, expectedName string) {
+			Expect(GetShootMonitoringRulesConfigMapName(monitoring)).To(Equal(expectedName))
+		},
+
+		Entry("Monitoring = nil", nil, ""),
+		Entry("Rules = nil", &core.Monitoring{}, ""),
+		Entry("ConfigMapName set", &core.Monitoring{
+			Rules: &core.MonitoringRules{
+				ConfigMapName: "foo",
+			},
+		}, "foo"),
+	)
+
 	DescribeTable("#GetShootServiceAccountConfigIssuer",
 		func(kubeAPIServerConfig *core.KubeAPIServerConfig, expectedIssuer *string) {
 			Issuer := GetShootServiceAccountConfigIssuer(kubeAPIServerConfig)
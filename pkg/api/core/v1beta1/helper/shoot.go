@@ -53,7 +53,29 @@ func ShootIgnoresAlerts(shoot *gardencorev1beta1.Shoot) bool {
 
 // ShootWantsAlertManager checks if the given shoot specification requires an alert manager.
 func ShootWantsAlertManager(shoot *gardencorev1beta1.Shoot) bool {
-	return !ShootIgnoresAlerts(shoot) && shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil && len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0
+	return !ShootIgnoresAlerts(shoot) && shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil &&
+		(len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0 || len(shoot.Spec.Monitoring.Alerting.Receivers) > 0)
+}
+
+// ShootForwardsLogs checks if the given shoot specification requires forwarding logs of the given source to at least
+// one logging output.
+func ShootForwardsLogs(shoot *gardencorev1beta1.Shoot, source gardencorev1beta1.LoggingSource) bool {
+	if shoot.Spec.Logging == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(shoot.Spec.Logging.Outputs, func(output gardencorev1beta1.LoggingOutput) bool {
+		return slices.Contains(output.Sources, source)
+	})
+}
+
+// ShootAuditLogBackendEnabled checks if the given shoot specification enables the audit log backend of the
+// kube-apiserver.
+func ShootAuditLogBackendEnabled(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Spec.Logging != nil &&
+		shoot.Spec.Logging.AuditBackends != nil &&
+		shoot.Spec.Logging.AuditBackends.Log != nil &&
+		shoot.Spec.Logging.AuditBackends.Log.Enabled
 }
 
 // ShootUsesUnmanagedDNS returns true if the shoot's DNS section is marked as 'unmanaged'.
@@ -379,6 +401,15 @@ func GetShootAuthorizationConfigurationConfigMapName(apiServerConfig *gardencore
 	return ""
 }
 
+// GetShootMonitoringRulesConfigMapName returns the Shoot's ConfigMap reference name for the user-defined monitoring
+// rules.
+func GetShootMonitoringRulesConfigMapName(monitoring *gardencorev1beta1.Monitoring) string {
+	if monitoring != nil && monitoring.Rules != nil {
+		return monitoring.Rules.ConfigMapName
+	}
+	return ""
+}
+
 // GetShootAuthorizationConfiguration returns the Shoot's authorization configuration.
 func GetShootAuthorizationConfiguration(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) *gardencorev1beta1.StructuredAuthorization {
 	if apiServerConfig != nil {
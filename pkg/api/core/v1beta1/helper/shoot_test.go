@@ -138,7 +138,7 @@ var _ = Describe("Helper", func() {
 				}
 				Expect(ShootWantsAlertManager(shoot)).To(BeFalse())
 			})
-			It("should not want alert manager because of missing email configuration", func() {
+			It("should not want alert manager because of missing receiver configuration", func() {
 				shoot.Spec = gardencorev1beta1.ShootSpec{
 					Monitoring: &gardencorev1beta1.Monitoring{
 						Alerting: &gardencorev1beta1.Alerting{},
@@ -156,9 +156,52 @@ var _ = Describe("Helper", func() {
 				}
 				Expect(ShootWantsAlertManager(shoot)).To(BeTrue())
 			})
+			It("should want alert manager because of receivers", func() {
+				shoot.Spec = gardencorev1beta1.ShootSpec{
+					Monitoring: &gardencorev1beta1.Monitoring{
+						Alerting: &gardencorev1beta1.Alerting{
+							Receivers: []gardencorev1beta1.AlertReceiver{{Name: "oncall", Type: gardencorev1beta1.AlertReceiverTypePagerDuty, ResourceName: "pagerduty"}},
+						},
+					},
+				}
+				Expect(ShootWantsAlertManager(shoot)).To(BeTrue())
+			})
 		})
 	})
 
+	DescribeTable("#ShootForwardsLogs",
+		func(logging *gardencorev1beta1.Logging, source gardencorev1beta1.LoggingSource, expectation bool) {
+			shoot := &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Logging: logging,
+				},
+			}
+			Expect(ShootForwardsLogs(shoot, source)).To(Equal(expectation))
+		},
+
+		Entry("no logging", nil, gardencorev1beta1.LoggingSourceAudit, false),
+		Entry("no outputs", &gardencorev1beta1.Logging{}, gardencorev1beta1.LoggingSourceAudit, false),
+		Entry("outputs for other source", &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane}}}}, gardencorev1beta1.LoggingSourceAudit, false),
+		Entry("outputs for source", &gardencorev1beta1.Logging{Outputs: []gardencorev1beta1.LoggingOutput{{Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane}}, {Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceAudit}}}}, gardencorev1beta1.LoggingSourceAudit, true),
+	)
+
+	DescribeTable("#ShootAuditLogBackendEnabled",
+		func(logging *gardencorev1beta1.Logging, expectation bool) {
+			shoot := &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Logging: logging,
+				},
+			}
+			Expect(ShootAuditLogBackendEnabled(shoot)).To(Equal(expectation))
+		},
+
+		Entry("no logging config", nil, false),
+		Entry("no backends", &gardencorev1beta1.Logging{}, false),
+		Entry("no log backend", &gardencorev1beta1.Logging{AuditBackends: &gardencorev1beta1.AuditBackends{}}, false),
+		Entry("log backend disabled", &gardencorev1beta1.Logging{AuditBackends: &gardencorev1beta1.AuditBackends{Log: &gardencorev1beta1.AuditLogBackend{}}}, false),
+		Entry("log backend enabled", &gardencorev1beta1.Logging{AuditBackends: &gardencorev1beta1.AuditBackends{Log: &gardencorev1beta1.AuditLogBackend{Enabled: true}}}, true),
+	)
+
 	var (
 		unmanagedType = "unmanaged"
 		differentType = "foo"
@@ -921,6 +964,20 @@ var _ = Describe("Helper", func() {
 		}, "foo"),
 	)
 
+	DescribeTable("#GetShootMonitoringRulesConfigMapName",
+		func(monitoring *gardencorev1beta1.Monitoring, expectedName string) {
+			Expect(GetShootMonitoringRulesConfigMapName(monitoring)).To(Equal(expectedName))
+		},
+
+		Entry("Monitoring = nil", nil, ""),
+		Entry("Rules = nil", &gardencorev1beta1.Monitoring{}, ""),
+		Entry("ConfigMapName set", &gardencorev1beta1.Monitoring{
+			Rules: &gardencorev1beta1.MonitoringRules{
+				ConfigMapName: "foo",
+			},
+		}, "foo"),
+	)
+
 	DescribeTable("#GetShootAuthorizationConfiguration",
 		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedResult *gardencorev1beta1.StructuredAuthorization) {
 			Expect(GetShootAuthorizationConfiguration(kubeAPIServerConfig)).To(Equal(expectedResult))
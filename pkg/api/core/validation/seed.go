@@ -36,6 +36,8 @@ var (
 		v1beta1constants.GardenerOperationReconcile,
 		v1beta1constants.GardenerOperationRenewKubeconfig,
 		v1beta1constants.SeedOperationRenewWorkloadIdentityTokens,
+		v1beta1constants.SeedOperationDrain,
+		v1beta1constants.SeedOperationCancelDrain,
 	)
 )
 
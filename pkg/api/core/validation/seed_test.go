@@ -175,6 +175,8 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("drain", "drain"),
+				Entry("cancel-drain", "cancel-drain"),
 			)
 
 			DescribeTable("should do nothing if a valid operation annotation is added", func(operation string) {
@@ -187,6 +189,8 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("drain", "drain"),
+				Entry("cancel-drain", "cancel-drain"),
 			)
 
 			DescribeTable("should do nothing if a valid operation annotation is removed", func(operation string) {
@@ -200,6 +204,8 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("drain", "drain"),
+				Entry("cancel-drain", "cancel-drain"),
 			)
 
 			DescribeTable("should do nothing if a valid operation annotation does not change during an update", func(operation string) {
@@ -212,6 +218,8 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("drain", "drain"),
+				Entry("cancel-drain", "cancel-drain"),
 			)
 
 			It("should return an error if a valid operation should be overwritten with a different valid operation", func() {
@@ -43,6 +43,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	netutils "github.com/gardener/gardener/pkg/utils/net"
 	admissionpluginsvalidation "github.com/gardener/gardener/pkg/utils/validation/admissionplugins"
 	apigroupsvalidation "github.com/gardener/gardener/pkg/utils/validation/apigroups"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
@@ -322,7 +323,8 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, opts shootV
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, opts, workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
-	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, spec.Resources, fldPath.Child("monitoring"))...)
+	allErrs = append(allErrs, validateLogging(spec.Logging, spec.Resources, spec.Kubernetes.KubeAPIServer, fldPath.Child("logging"))...)
 	allErrs = append(allErrs, ValidateHibernation(meta.Annotations, spec.Hibernation, fldPath.Child("hibernation"))...)
 
 	if len(spec.Region) == 0 {
@@ -1791,6 +1793,7 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, kubernetesVe
 		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
 			allErrs = append(allErrs, ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
 		}
+		allErrs = append(allErrs, ValidateAuditConfig(auditConfig, auditPath)...)
 	}
 
 	if structuredAuthentication := kubeAPIServer.StructuredAuthentication; structuredAuthentication != nil {
@@ -2095,15 +2098,38 @@ func validateKubeProxy(kp *core.KubeProxyConfig, kubernetesVersion string, fldPa
 	return allErrs
 }
 
-func validateMonitoring(monitoring *core.Monitoring, fldPath *field.Path) field.ErrorList {
+func validateMonitoring(monitoring *core.Monitoring, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if monitoring != nil && monitoring.Alerting != nil {
-		allErrs = append(allErrs, validateAlerting(monitoring.Alerting, fldPath.Child("alerting"))...)
+		allErrs = append(allErrs, validateAlerting(monitoring.Alerting, resources, fldPath.Child("alerting"))...)
+	}
+	if monitoring != nil && monitoring.Rules != nil {
+		rulesPath := fldPath.Child("rules", "configMapName")
+		if len(monitoring.Rules.ConfigMapName) == 0 {
+			allErrs = append(allErrs, field.Required(rulesPath, "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(monitoring.Rules.ConfigMapName) {
+				allErrs = append(allErrs, field.Invalid(rulesPath, monitoring.Rules.ConfigMapName, msg))
+			}
+		}
 	}
 	return allErrs
 }
 
-func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorList {
+var (
+	availableAlertReceiverTypes = sets.New(
+		string(core.AlertReceiverTypeWebhook),
+		string(core.AlertReceiverTypeSlack),
+		string(core.AlertReceiverTypePagerDuty),
+		string(core.AlertReceiverTypeOpsgenie),
+		string(core.AlertReceiverTypeMSTeams),
+	)
+	availableAlertSeverities   = sets.New("info", "warning", "critical", "blocker")
+	availableAlertVisibilities = sets.New("all", "owner")
+	alertLabelNameRegex        = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+func validateAlerting(alerting *core.Alerting, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	emails := sets.New[string]()
 	for i, email := range alerting.EmailReceivers {
@@ -2117,6 +2143,237 @@ func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorL
 			emails.Insert(email)
 		}
 	}
+
+	receiverNames := sets.New[string]()
+	for i, receiver := range alerting.Receivers {
+		idxPath := fldPath.Child("receivers").Index(i)
+
+		allErrs = append(allErrs, validateUniqueName(receiver.Name, receiverNames, idxPath.Child("name"))...)
+
+		if !availableAlertReceiverTypes.Has(string(receiver.Type)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), receiver.Type, sets.List(availableAlertReceiverTypes)))
+		}
+
+		if len(receiver.ResourceName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("resourceName"), "must provide the name of a resource referring to a secret"))
+		} else if resource := helper.GetResourceByName(resources, receiver.ResourceName); resource == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("resourceName"), receiver.ResourceName, "must refer to a resource in spec.resources"))
+		} else if resource.ResourceRef.APIVersion != corev1.SchemeGroupVersion.String() || resource.ResourceRef.Kind != "Secret" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("resourceName"), receiver.ResourceName, "must refer to a resource of kind v1/Secret"))
+		}
+
+		allErrs = append(allErrs, validateUniqueSupportedValues(receiver.Severities, availableAlertSeverities, idxPath.Child("severities"))...)
+		allErrs = append(allErrs, validateUniqueSupportedValues(receiver.Visibilities, availableAlertVisibilities, idxPath.Child("visibilities"))...)
+	}
+
+	return allErrs
+}
+
+var availableAuditPolicyPresets = sets.New(
+	string(core.AuditPolicyPresetMinimal),
+	string(core.AuditPolicyPresetCIS),
+	string(core.AuditPolicyPresetPCI),
+)
+
+// ValidateAuditConfig validates the audit policy preset.
+func ValidateAuditConfig(auditConfig *core.AuditConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditPolicy.Preset != nil {
+		presetPath := fldPath.Child("auditPolicy", "preset")
+		if auditPolicy.ConfigMapRef != nil {
+			allErrs = append(allErrs, field.Forbidden(presetPath, "preset and configMapRef are mutually exclusive"))
+		}
+		if !availableAuditPolicyPresets.Has(string(*auditPolicy.Preset)) {
+			allErrs = append(allErrs, field.NotSupported(presetPath, *auditPolicy.Preset, sets.List(availableAuditPolicyPresets)))
+		}
+	}
+
+	return allErrs
+}
+
+func hasAuditPolicy(auditConfig *core.AuditConfig) bool {
+	return auditConfig != nil && auditConfig.AuditPolicy != nil && (auditConfig.AuditPolicy.ConfigMapRef != nil || auditConfig.AuditPolicy.Preset != nil)
+}
+
+func validateAuditBackends(backends *core.AuditBackends, resources []core.NamedResourceReference, kubeAPIServer *core.KubeAPIServerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if backends == nil {
+		return allErrs
+	}
+
+	if (kubeAPIServer == nil || !hasAuditPolicy(kubeAPIServer.AuditConfig)) && ((backends.Log != nil && backends.Log.Enabled) || backends.Webhook != nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "audit backends can only be configured if an audit policy is configured in spec.kubernetes.kubeAPIServer.auditConfig"))
+	}
+
+	webhook := backends.Webhook
+	if webhook == nil {
+		return allErrs
+	}
+	webhookPath := fldPath.Child("webhook")
+
+	if len(webhook.ResourceName) == 0 {
+		allErrs = append(allErrs, field.Required(webhookPath.Child("resourceName"), "must provide the name of a resource containing the kubeconfig"))
+	} else if resource := helper.GetResourceByName(resources, webhook.ResourceName); resource == nil {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("resourceName"), webhook.ResourceName, "must refer to a resource in spec.resources"))
+	} else if resource.ResourceRef.APIVersion != corev1.SchemeGroupVersion.String() || resource.ResourceRef.Kind != "Secret" {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("resourceName"), webhook.ResourceName, "must refer to a resource of kind v1/Secret"))
+	}
+	if webhook.BatchMaxSize != nil && *webhook.BatchMaxSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("batchMaxSize"), *webhook.BatchMaxSize, "must be greater than 0"))
+	}
+	if webhook.BatchBufferSize != nil && *webhook.BatchBufferSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(webhookPath.Child("batchBufferSize"), *webhook.BatchBufferSize, "must be greater than 0"))
+	}
+	if webhook.BatchMaxWait != nil {
+		allErrs = append(allErrs, ValidatePositiveDuration(webhook.BatchMaxWait, webhookPath.Child("batchMaxWait"))...)
+	}
+	if webhook.InitialBackoff != nil {
+		allErrs = append(allErrs, ValidatePositiveDuration(webhook.InitialBackoff, webhookPath.Child("initialBackoff"))...)
+	}
+
+	return allErrs
+}
+
+var (
+	availableLoggingOutputTypes = sets.New(
+		string(core.LoggingOutputTypeOTLP),
+		string(core.LoggingOutputTypeLoki),
+		string(core.LoggingOutputTypeHTTP),
+		string(core.LoggingOutputTypeSyslog),
+	)
+	availableLoggingSources = sets.New(
+		string(core.LoggingSourceAudit),
+		string(core.LoggingSourceControlPlane),
+	)
+	availableHTTPLoggingOutputSchemes   = sets.New("http", "https")
+	availableSyslogLoggingOutputSchemes = sets.New("tcp", "tls", "udp")
+)
+
+func validateLogging(logging *core.Logging, resources []core.NamedResourceReference, kubeAPIServer *core.KubeAPIServerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if logging == nil {
+		return allErrs
+	}
+
+	outputNames := sets.New[string]()
+	for i, output := range logging.Outputs {
+		idxPath := fldPath.Child("outputs").Index(i)
+
+		allErrs = append(allErrs, validateUniqueName(output.Name, outputNames, idxPath.Child("name"))...)
+
+		if !availableLoggingOutputTypes.Has(string(output.Type)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), output.Type, sets.List(availableLoggingOutputTypes)))
+		}
+
+		schemes := availableHTTPLoggingOutputSchemes
+		if output.Type == core.LoggingOutputTypeSyslog {
+			schemes = availableSyslogLoggingOutputSchemes
+		}
+
+		if len(output.URL) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("url"), "must provide the URL of the endpoint"))
+		} else if u, err := url.Parse(output.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), output.URL, fmt.Sprintf("must be a valid URL: %v", err)))
+		} else if !schemes.Has(u.Scheme) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), output.URL, fmt.Sprintf("scheme must be one of %v", sets.List(schemes))))
+		} else if len(u.Hostname()) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), output.URL, "must contain a host"))
+		} else if len(u.User.String()) > 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), output.URL, "must not contain credentials, use resourceName instead"))
+		} else if !netutils.IsPublicHost(u.Hostname()) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("url"), output.URL, "must not refer to a cluster-internal, loopback, link-local or private host"))
+		}
+
+		if len(output.Sources) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("sources"), "must provide at least one source"))
+		}
+		sources := make([]string, 0, len(output.Sources))
+		for _, source := range output.Sources {
+			sources = append(sources, string(source))
+		}
+		allErrs = append(allErrs, validateUniqueSupportedValues(sources, availableLoggingSources, idxPath.Child("sources"))...)
+
+		if slices.Contains(output.Sources, core.LoggingSourceAudit) && (kubeAPIServer == nil || !hasAuditPolicy(kubeAPIServer.AuditConfig)) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("sources"), "audit logs can only be forwarded if an audit policy is configured in spec.kubernetes.kubeAPIServer.auditConfig"))
+		}
+
+		if output.ResourceName == nil {
+			continue
+		}
+
+		if output.Type == core.LoggingOutputTypeSyslog {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("resourceName"), "credentials are not supported for syslog outputs"))
+		} else if resource := helper.GetResourceByName(resources, *output.ResourceName); resource == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("resourceName"), *output.ResourceName, "must refer to a resource in spec.resources"))
+		} else if resource.ResourceRef.APIVersion != corev1.SchemeGroupVersion.String() || resource.ResourceRef.Kind != "Secret" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("resourceName"), *output.ResourceName, "must refer to a resource of kind v1/Secret"))
+		}
+	}
+
+	allErrs = append(allErrs, validateAuditBackends(logging.AuditBackends, resources, kubeAPIServer, fldPath.Child("auditBackends"))...)
+
+	return allErrs
+}
+
+// validateUniqueName validates that the given name is a DNS label and that it is unique among the given names. The
+// name is added to the given names.
+func validateUniqueName(name string, names sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(name) == 0 {
+		return append(allErrs, field.Required(fldPath, "must provide a name"))
+	}
+
+	for _, msg := range validation.IsDNS1123Label(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+
+	if names.Has(name) {
+		allErrs = append(allErrs, field.Duplicate(fldPath, name))
+	}
+	names.Insert(name)
+
+	return allErrs
+}
+
+// validateUniqueSupportedValues validates that the given values are supported and not duplicated.
+func validateUniqueSupportedValues(values []string, availableValues sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[string]()
+
+	for i, value := range values {
+		if !availableValues.Has(value) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), value, sets.List(availableValues)))
+		}
+
+		if seen.Has(value) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), value))
+		}
+		seen.Insert(value)
+	}
+
+	return allErrs
+}
+
+// ValidateAlertMatchers validates the given alert matchers.
+func ValidateAlertMatchers(matchers []core.AlertMatcher, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, matcher := range matchers {
+		idxPath := fldPath.Index(i)
+
+		if !alertLabelNameRegex.MatchString(matcher.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), matcher.Name, fmt.Sprintf("must be a valid alert label name (regex used for validation is '%s')", alertLabelNameRegex.String())))
+		}
+
+		if matcher.Regex {
+			if _, err := regexp.Compile(matcher.Value); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), matcher.Value, fmt.Sprintf("must be a valid regular expression: %v", err)))
+			}
+		}
+	}
+
 	return allErrs
 }
 
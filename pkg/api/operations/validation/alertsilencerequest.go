@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	corevalidation "github.com/gardener/gardener/pkg/api/core/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+const maxAlertSilenceDuration = 7 * 24 * time.Hour
+
+// ValidateAlertSilenceRequest validates an AlertSilenceRequest.
+func ValidateAlertSilenceRequest(req *operations.AlertSilenceRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if len(req.Spec.Name) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("name"), "must provide a name"))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(req.Spec.Name) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("name"), req.Spec.Name, msg))
+		}
+	}
+
+	switch {
+	case req.Spec.Duration.Duration < 0:
+		allErrs = append(allErrs, field.Invalid(specPath.Child("duration"), req.Spec.Duration.Duration.String(), "may not specify a negative duration"))
+	case req.Spec.Duration.Duration > maxAlertSilenceDuration:
+		allErrs = append(allErrs, field.Invalid(specPath.Child("duration"), req.Spec.Duration.Duration.String(), "may not specify a duration more than 7 days"))
+	case req.Spec.Duration.Duration == 0:
+		if len(req.Spec.Matchers) > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("matchers"), "must not provide matchers when removing a silence"))
+		}
+	default:
+		if len(req.Spec.Matchers) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("matchers"), "must provide at least one matcher"))
+		}
+	}
+
+	allErrs = append(allErrs, corevalidation.ValidateAlertMatchers(req.Spec.Matchers, specPath.Child("matchers"))...)
+
+	return allErrs
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var _ = Describe("AlertSilenceRequest validation", func() {
+	var req *operations.AlertSilenceRequest
+
+	BeforeEach(func() {
+		req = &operations.AlertSilenceRequest{
+			Spec: operations.AlertSilenceRequestSpec{
+				Name:     "maintenance",
+				Matchers: []core.AlertMatcher{{Name: "alertname", Value: "KubeletTooManyPods"}},
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+		}
+	})
+
+	Describe("#ValidateAlertSilenceRequest", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateAlertSilenceRequest(req)).To(BeEmpty())
+		})
+
+		It("should allow removing a silence", func() {
+			req.Spec.Matchers = nil
+			req.Spec.Duration = metav1.Duration{}
+
+			Expect(ValidateAlertSilenceRequest(req)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid name", func() {
+			req.Spec.Name = "Maintenance"
+
+			Expect(ValidateAlertSilenceRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.name"),
+			}))))
+		})
+
+		It("should require matchers", func() {
+			req.Spec.Matchers = nil
+
+			Expect(ValidateAlertSilenceRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.matchers"),
+			}))))
+		})
+
+		It("should forbid matchers when removing a silence", func() {
+			req.Spec.Duration = metav1.Duration{}
+
+			Expect(ValidateAlertSilenceRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.matchers"),
+			}))))
+		})
+
+		It("should forbid invalid matchers", func() {
+			req.Spec.Matchers = []core.AlertMatcher{{Name: "alert-name", Value: "["}, {Name: "service", Value: "[", Regex: true}}
+
+			Expect(ValidateAlertSilenceRequest(req)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.matchers[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.matchers[1].value"),
+				})),
+			))
+		})
+
+		It("should forbid a negative duration", func() {
+			req.Spec.Duration = metav1.Duration{Duration: -time.Minute}
+
+			Expect(ValidateAlertSilenceRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.duration"),
+				"Detail": Equal("may not specify a negative duration"),
+			}))))
+		})
+
+		It("should forbid a duration longer than 7 days", func() {
+			req.Spec.Duration = metav1.Duration{Duration: 7*24*time.Hour + time.Minute}
+
+			Expect(ValidateAlertSilenceRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.duration"),
+				"Detail": Equal("may not specify a duration more than 7 days"),
+			}))))
+		})
+	})
+})
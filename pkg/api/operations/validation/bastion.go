@@ -10,6 +10,7 @@ import (
 
 	"golang.org/x/crypto/ssh"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -74,6 +75,7 @@ func ValidateBastionSpecUpdate(newSpec, oldSpec *operations.BastionSpec, fldPath
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootRef.Name, oldSpec.ShootRef.Name, fldPath.Child("shootRef.name"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SSHPublicKey, oldSpec.SSHPublicKey, fldPath.Child("sshPublicKey"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SessionRecording, oldSpec.SessionRecording, fldPath.Child("sessionRecording"))...)
 
 	return allErrs
 }
@@ -83,9 +85,25 @@ func ValidateBastionStatusUpdate(newBastion, _ *operations.Bastion) field.ErrorL
 	allErrs := field.ErrorList{}
 	now := time.Now()
 
-	if newBastion.Status.LastHeartbeatTimestamp.After(now) {
+	if newBastion.Status.LastHeartbeatTimestamp != nil && newBastion.Status.LastHeartbeatTimestamp.After(now) {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("status.lastHeartbeatTimestamp"), newBastion.Status.LastHeartbeatTimestamp, "last heartbeat must not be in the future"))
 	}
 
+	sessionIDs := sets.New[string]()
+	for i, session := range newBastion.Status.Sessions {
+		idxPath := field.NewPath("status", "sessions").Index(i)
+
+		if len(session.ID) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("id"), "session ID must not be empty"))
+		} else if sessionIDs.Has(session.ID) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("id"), session.ID))
+		}
+		sessionIDs.Insert(session.ID)
+
+		if session.EndTime != nil && session.EndTime.Before(&session.StartTime) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("endTime"), session.EndTime, "end time must not be before start time"))
+		}
+	}
+
 	return allErrs
 }
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -139,6 +141,65 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.sshPublicKey"),
 			}))))
 		})
+
+		It("should forbid changing session recording", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.SessionRecording = &operations.BastionSessionRecording{Transcripts: true}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.sessionRecording"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateBastionStatusUpdate", func() {
+		var startTime metav1.Time
+
+		BeforeEach(func() {
+			startTime = metav1.Now()
+		})
+
+		It("should allow valid sessions", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.Sessions = []operations.BastionSession{
+				{ID: "1", Source: "1.2.3.4", Node: "10.250.0.2", StartTime: startTime, EndTime: &metav1.Time{Time: startTime.Add(time.Minute)}},
+				{ID: "2", Source: "1.2.3.4", StartTime: startTime},
+			}
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(BeEmpty())
+		})
+
+		It("should forbid sessions with empty or duplicate IDs", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.Sessions = []operations.BastionSession{
+				{ID: "1", Source: "1.2.3.4", StartTime: startTime},
+				{ID: "1", Source: "1.2.3.4", StartTime: startTime},
+				{Source: "1.2.3.4", StartTime: startTime},
+			}
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("status.sessions[1].id"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("status.sessions[2].id"),
+			}))))
+		})
+
+		It("should forbid sessions ending before they started", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Status.Sessions = []operations.BastionSession{
+				{ID: "1", Source: "1.2.3.4", StartTime: startTime, EndTime: &metav1.Time{Time: startTime.Add(-time.Minute)}},
+			}
+
+			Expect(ValidateBastionStatusUpdate(newBastion, bastion)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("status.sessions[0].endTime"),
+			}))))
+		})
 	})
 })
 
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+)
+
+// ValidateCloneRequest validates a CloneRequest.
+func ValidateCloneRequest(req *operations.CloneRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if len(req.Spec.Name) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("name"), "must provide the name of the new shoot"))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(req.Spec.Name) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("name"), req.Spec.Name, msg))
+		}
+	}
+
+	if req.Spec.Region != nil && len(*req.Spec.Region) == 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("region"), *req.Spec.Region, "must not be empty if set"))
+	}
+
+	zones := sets.New[string]()
+	for i, zone := range req.Spec.Zones {
+		idxPath := specPath.Child("zones").Index(i)
+		if len(zone) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "zone must not be empty"))
+		} else if zones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, zone))
+		}
+		zones.Insert(zone)
+	}
+
+	if req.Spec.DNSDomain != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*req.Spec.DNSDomain) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("dnsDomain"), *req.Spec.DNSDomain, msg))
+		}
+	}
+
+	if networking := req.Spec.Networking; networking != nil {
+		networkingPath := specPath.Child("networking")
+		if networking.Nodes != nil {
+			allErrs = append(allErrs, cidrvalidation.NewCIDR(*networking.Nodes, networkingPath.Child("nodes")).ValidateParse()...)
+		}
+		if networking.Pods != nil {
+			allErrs = append(allErrs, cidrvalidation.NewCIDR(*networking.Pods, networkingPath.Child("pods")).ValidateParse()...)
+		}
+		if networking.Services != nil {
+			allErrs = append(allErrs, cidrvalidation.NewCIDR(*networking.Services, networkingPath.Child("services")).ValidateParse()...)
+		}
+	}
+
+	resourceNames := sets.New[string]()
+	for i, resource := range req.Spec.Resources {
+		idxPath := specPath.Child("resources").Index(i)
+		if len(resource.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the resource reference"))
+		} else if resourceNames.Has(resource.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), resource.Name))
+		}
+		resourceNames.Insert(resource.Name)
+
+		if len(resource.ResourceName) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("resourceName"), "must provide the name of the referenced resource"))
+		}
+	}
+
+	if req.Spec.RestoreFromBackup {
+		if req.Spec.Region != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("region"), "must not change the region when restoring from a backup since the new shoot is scheduled to the seed of the source shoot"))
+		}
+		if req.Spec.Networking != nil && req.Spec.Networking.Services != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("networking", "services"), "must not change the service network when restoring from a backup since the restored services keep their cluster IPs"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var _ = Describe("CloneRequest validation", func() {
+	var req *operations.CloneRequest
+
+	BeforeEach(func() {
+		req = &operations.CloneRequest{
+			Spec: operations.CloneRequestSpec{
+				Name:      "clone",
+				Region:    ptr.To("eu-west-1"),
+				Zones:     []string{"eu-west-1a", "eu-west-1b"},
+				DNSDomain: ptr.To("clone.example.com"),
+				Networking: &operations.CloneRequestNetworking{
+					Nodes:    ptr.To("10.250.0.0/16"),
+					Pods:     ptr.To("100.96.0.0/11"),
+					Services: ptr.To("100.64.0.0/13"),
+				},
+				Resources: []operations.CloneRequestResource{{Name: "foo", ResourceName: "bar"}},
+			},
+		}
+	})
+
+	Describe("#ValidateCloneRequest", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateCloneRequest(req)).To(BeEmpty())
+		})
+
+		It("should forbid an empty name", func() {
+			req.Spec.Name = ""
+
+			Expect(ValidateCloneRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.name"),
+			}))))
+		})
+
+		It("should forbid an invalid name", func() {
+			req.Spec.Name = "Foo.bar"
+
+			Expect(ValidateCloneRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.name"),
+			}))))
+		})
+
+		It("should forbid an empty region, empty and duplicate zones and an invalid domain", func() {
+			req.Spec.Region = ptr.To("")
+			req.Spec.Zones = []string{"a", "", "a"}
+			req.Spec.DNSDomain = ptr.To("-foo")
+
+			Expect(ValidateCloneRequest(req)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.region"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.zones[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.zones[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.dnsDomain"),
+				})),
+			))
+		})
+
+		It("should forbid invalid networks", func() {
+			req.Spec.Networking.Nodes = ptr.To("foo")
+			req.Spec.Networking.Services = ptr.To("100.64.0.0/130")
+
+			Expect(ValidateCloneRequest(req)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.nodes"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.networking.services"),
+				})),
+			))
+		})
+
+		It("should forbid incomplete and duplicate resource replacements", func() {
+			req.Spec.Resources = []operations.CloneRequestResource{
+				{Name: "foo", ResourceName: "bar"},
+				{Name: "foo"},
+				{ResourceName: "bar"},
+			}
+
+			Expect(ValidateCloneRequest(req)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.resources[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.resources[1].resourceName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.resources[2].name"),
+				})),
+			))
+		})
+
+		It("should forbid changing the region and the service network when restoring from a backup", func() {
+			req.Spec.RestoreFromBackup = true
+
+			Expect(ValidateCloneRequest(req)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.region"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.networking.services"),
+				})),
+			))
+		})
+
+		It("should allow restoring from a backup with other overrides", func() {
+			req.Spec.RestoreFromBackup = true
+			req.Spec.Region = nil
+			req.Spec.Networking.Services = nil
+
+			Expect(ValidateCloneRequest(req)).To(BeEmpty())
+		})
+	})
+})
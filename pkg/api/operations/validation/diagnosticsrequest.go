@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+const (
+	minDiagnosticsSince = time.Minute
+	maxDiagnosticsSince = 24 * time.Hour
+)
+
+// ValidateDiagnosticsRequest validates a DiagnosticsRequest.
+func ValidateDiagnosticsRequest(req *operations.DiagnosticsRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if req.Spec.SinceSeconds < int64(minDiagnosticsSince.Seconds()) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("sinceSeconds"), req.Spec.SinceSeconds, "may not specify a duration less than 1 minute"))
+	}
+	if req.Spec.SinceSeconds > int64(maxDiagnosticsSince.Seconds()) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("sinceSeconds"), req.Spec.SinceSeconds, "may not specify a duration more than 24 hours"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var _ = Describe("DiagnosticsRequest validation", func() {
+	var req *operations.DiagnosticsRequest
+
+	BeforeEach(func() {
+		req = &operations.DiagnosticsRequest{
+			Spec: operations.DiagnosticsRequestSpec{SinceSeconds: 3600},
+		}
+	})
+
+	Describe("#ValidateDiagnosticsRequest", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateDiagnosticsRequest(req)).To(BeEmpty())
+		})
+
+		It("should forbid a time window shorter than 1 minute", func() {
+			req.Spec.SinceSeconds = 59
+
+			Expect(ValidateDiagnosticsRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.sinceSeconds"),
+				"Detail": Equal("may not specify a duration less than 1 minute"),
+			}))))
+		})
+
+		It("should forbid a time window longer than 24 hours", func() {
+			req.Spec.SinceSeconds = 86401
+
+			Expect(ValidateDiagnosticsRequest(req)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.sinceSeconds"),
+				"Detail": Equal("may not specify a duration more than 24 hours"),
+			}))))
+		})
+	})
+})
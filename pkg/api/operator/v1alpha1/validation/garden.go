@@ -543,6 +543,13 @@ func validateGardenerAPIServerConfig(config *operatorv1alpha1.GardenerAPIServerC
 		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
 			allErrs = append(allErrs, gardencorevalidation.ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
 		}
+
+		coreAuditConfig := &gardencore.AuditConfig{}
+		if err := gardenCoreScheme.Convert(auditConfig, coreAuditConfig, nil); err != nil {
+			allErrs = append(allErrs, field.InternalError(auditPath, err))
+			return allErrs
+		}
+		allErrs = append(allErrs, gardencorevalidation.ValidateAuditConfig(coreAuditConfig, auditPath)...)
 	}
 
 	if config.WatchCacheSizes != nil {
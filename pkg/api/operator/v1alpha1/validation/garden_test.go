@@ -1773,6 +1773,29 @@ var _ = Describe("Validation Tests", func() {
 								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.auditConfig.auditPolicy.configMapRef.name"),
 							}))))
 						})
+
+						It("should allow a supported preset", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.AuditConfig = &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									Preset: ptr.To(gardencorev1beta1.AuditPolicyPresetCIS),
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+						})
+
+						It("should forbid an unsupported preset", func() {
+							garden.Spec.VirtualCluster.Gardener.APIServer.AuditConfig = &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									Preset: ptr.To(gardencorev1beta1.AuditPolicyPreset("foo")),
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeNotSupported),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerAPIServer.auditConfig.auditPolicy.preset"),
+							}))))
+						})
 					})
 
 					Context("EncryptionConfig", func() {
@@ -137,9 +137,41 @@ func validateUpdateStrategy(updateStrategy *seedmanagement.UpdateStrategy, fldPa
 			if updateStrategy.RollingUpdate != nil {
 				allErrs = append(allErrs, validateRollingUpdateStrategy(updateStrategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
 			}
+			if updateStrategy.Canary != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("canary"), "canary is forbidden if type is RollingUpdate"))
+			}
+		case seedmanagement.CanaryUpdateStrategyType:
+			if updateStrategy.Canary != nil {
+				allErrs = append(allErrs, validateCanaryUpdateStrategy(updateStrategy.Canary, fldPath.Child("canary"))...)
+			}
+			if updateStrategy.RollingUpdate != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollingUpdate"), "rollingUpdate is forbidden if type is Canary"))
+			}
 		default:
 			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"),
-				*updateStrategy.Type, []string{string(seedmanagement.RollingUpdateStrategyType)}))
+				*updateStrategy.Type, []string{string(seedmanagement.RollingUpdateStrategyType), string(seedmanagement.CanaryUpdateStrategyType)}))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCanaryUpdateStrategy(cus *seedmanagement.CanaryUpdateStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Ensure bakeTime and maxUnhealthyShoots are non-negative if specified
+	if cus.BakeTime != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cus.BakeTime.Duration), fldPath.Child("bakeTime"))...)
+	}
+	if cus.MaxUnhealthyShoots != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cus.MaxUnhealthyShoots), fldPath.Child("maxUnhealthyShoots"))...)
+	}
+
+	// Ensure failurePolicy is supported if specified
+	if cus.FailurePolicy != nil {
+		validValues := []string{string(seedmanagement.CanaryFailurePolicyPause), string(seedmanagement.CanaryFailurePolicyRollback)}
+		if !slices.Contains(validValues, string(*cus.FailurePolicy)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("failurePolicy"), *cus.FailurePolicy, validValues))
 		}
 	}
 
@@ -264,6 +296,10 @@ func ValidateManagedSeedSetStatus(status *seedmanagement.ManagedSeedSetStatus, n
 		allErrs = append(allErrs, validatePendingReplica(status.PendingReplica, name, fldPath.Child("pendingReplica"))...)
 	}
 
+	if status.Canary != nil {
+		allErrs = append(allErrs, validateCanaryStatus(status.Canary, name, fldPath.Child("canary"))...)
+	}
+
 	return allErrs
 }
 
@@ -295,6 +331,31 @@ func validatePendingReplica(pendingReplica *seedmanagement.PendingReplica, name
 	return allErrs
 }
 
+func validateCanaryStatus(canary *seedmanagement.CanaryStatus, name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if parentName, ordinal := getParentNameAndOrdinal(canary.Replica); parentName != name || ordinal < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replica"), canary.Replica, "must contain the parent name and a valid ordinal"))
+	}
+
+	if len(canary.Revision) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("revision"), "revision is required"))
+	}
+
+	validValues := []string{
+		string(seedmanagement.CanaryPhaseProgressing),
+		string(seedmanagement.CanaryPhaseBaking),
+		string(seedmanagement.CanaryPhaseSucceeded),
+		string(seedmanagement.CanaryPhasePaused),
+		string(seedmanagement.CanaryPhaseRolledBack),
+	}
+	if !slices.Contains(validValues, string(canary.Phase)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("phase"), canary.Phase, validValues))
+	}
+
+	return allErrs
+}
+
 func isDecremented(new, old *int32) bool {
 	if new == nil && old != nil {
 		return true
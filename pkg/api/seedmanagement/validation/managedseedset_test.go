@@ -235,6 +235,62 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			))
 		})
 
+		It("should allow valid canary update strategy", func() {
+			managedSeedSet.Spec.UpdateStrategy = &seedmanagement.UpdateStrategy{
+				Type: ptr.To(seedmanagement.CanaryUpdateStrategyType),
+				Canary: &seedmanagement.CanaryUpdateStrategy{
+					BakeTime:           &metav1.Duration{Duration: time.Hour},
+					MaxUnhealthyShoots: ptr.To[int32](1),
+					FailurePolicy:      ptr.To(seedmanagement.CanaryFailurePolicyRollback),
+				},
+			}
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(BeEmpty())
+		})
+
+		It("should forbid invalid canary update strategy", func() {
+			managedSeedSet.Spec.UpdateStrategy.Type = ptr.To(seedmanagement.CanaryUpdateStrategyType)
+			managedSeedSet.Spec.UpdateStrategy.Canary = &seedmanagement.CanaryUpdateStrategy{
+				BakeTime:           &metav1.Duration{Duration: -time.Hour},
+				MaxUnhealthyShoots: ptr.To[int32](-1),
+				FailurePolicy:      ptr.To(seedmanagement.CanaryFailurePolicy("Ignore")),
+			}
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.canary.bakeTime"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.updateStrategy.canary.maxUnhealthyShoots"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.updateStrategy.canary.failurePolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.updateStrategy.rollingUpdate"),
+				})),
+			))
+		})
+
+		It("should forbid canary parameters if type is RollingUpdate", func() {
+			managedSeedSet.Spec.UpdateStrategy.Canary = &seedmanagement.CanaryUpdateStrategy{}
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.updateStrategy.canary"),
+				})),
+			))
+		})
+
 		It("should forbid templates if labels don't match selector", func() {
 			managedSeedSet.Spec.Selector = *metav1.SetAsLabelSelector(labels.Set{
 				"bar": "baz",
@@ -640,5 +696,29 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 				})),
 			))
 		})
+
+		It("should forbid invalid canary status", func() {
+			newManagedSeedSet.Status.Canary = &seedmanagement.CanaryStatus{
+				Replica: "foo",
+				Phase:   "unknown",
+			}
+
+			errorList := ValidateManagedSeedSetStatusUpdate(newManagedSeedSet, managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.canary.replica"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.canary.revision"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.canary.phase"),
+				})),
+			))
+		})
 	})
 })
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/library"
+	"k8s.io/utils/lru"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+)
+
+const (
+	// VariableObject is the name of the CEL variable containing the Shoot.
+	VariableObject = "object"
+	// VariableOldObject is the name of the CEL variable containing the previous version of the Shoot.
+	VariableOldObject = "oldObject"
+
+	cacheSize = 1000
+)
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	programCache = lru.New(cacheSize)
+)
+
+func celEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable(VariableObject, cel.DynType),
+			cel.Variable(VariableOldObject, cel.DynType),
+			cel.OptionalTypes(),
+			cel.CrossTypeNumericComparisons(true),
+			cel.DefaultUTCTimeZone(true),
+			ext.Strings(ext.StringsVersion(2)),
+			ext.Sets(),
+			library.URLs(),
+			library.Regex(),
+			library.Lists(),
+			library.Quantity(),
+			library.IP(),
+			library.CIDR(),
+			library.Format(),
+			library.SemverLib(library.SemverVersion(1)),
+		)
+	})
+	return env, envErr
+}
+
+// CompileValidation compiles the given CEL expression of a validation rule. The expression must evaluate to a boolean.
+func CompileValidation(expression string) (cel.Program, error) {
+	return compile(expression, cel.BoolType)
+}
+
+// CompileMutation compiles the given CEL expression of a mutation rule. The expression must evaluate to an object.
+func CompileMutation(expression string) (cel.Program, error) {
+	return compile(expression, cel.MapType(cel.StringType, cel.DynType))
+}
+
+func compile(expression string, expectedType *cel.Type) (cel.Program, error) {
+	cacheKey := expectedType.String() + "/" + expression
+	if program, ok := programCache.Get(cacheKey); ok {
+		return program.(cel.Program), nil
+	}
+
+	e, err := celEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	ast, issues := e.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outputType := ast.OutputType(); outputType.Kind() != types.DynKind && outputType.Kind() != expectedType.Kind() {
+		return nil, fmt.Errorf("expression must evaluate to %s but evaluates to %s", expectedType, outputType)
+	}
+
+	program, err := e.Program(ast, cel.CostLimit(celconfig.PerCallLimit), cel.EvalOptions(cel.OptOptimize))
+	if err != nil {
+		return nil, err
+	}
+
+	programCache.Add(cacheKey, program)
+	return program, nil
+}
+
+// Program contains the compiled validation and mutation rules of a policy.
+type Program struct {
+	validations []validation
+	mutations   []cel.Program
+}
+
+type validation struct {
+	program cel.Program
+	message string
+}
+
+// Compile compiles all validation and mutation rules of the given policy specification.
+func Compile(spec *settingsv1alpha1.ShootPolicySpec) (*Program, error) {
+	p := &Program{}
+
+	for i, v := range spec.Validations {
+		program, err := CompileValidation(v.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("failed compiling validation %d: %w", i, err)
+		}
+		p.validations = append(p.validations, validation{
+			program: program,
+			message: ptr.Deref(v.Message, fmt.Sprintf("failed expression: %s", v.Expression)),
+		})
+	}
+
+	for i, m := range spec.Mutations {
+		program, err := CompileMutation(m.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("failed compiling mutation %d: %w", i, err)
+		}
+		p.mutations = append(p.mutations, program)
+	}
+
+	return p, nil
+}
+
+// HasMutations returns true if the program contains mutation rules.
+func (p *Program) HasMutations() bool {
+	return len(p.mutations) > 0
+}
+
+// Validate evaluates the validation rules for the given Shoot. It returns the messages of all violated rules. Rules
+// which cannot be evaluated (e.g., because a field is accessed which is not set) are considered to be violated.
+func (p *Program) Validate(shoot, oldShoot *gardencorev1beta1.Shoot) ([]string, error) {
+	activation, err := newActivation(shoot, oldShoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+	for _, v := range p.validations {
+		result, _, err := v.program.Eval(activation)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("%s (evaluation error: %v)", v.message, err))
+			continue
+		}
+
+		if valid, ok := result.Value().(bool); !ok || !valid {
+			messages = append(messages, v.message)
+		}
+	}
+
+	return messages, nil
+}
+
+// Mutate evaluates the mutation rules for the given Shoot and applies the results as JSON merge patches to it.
+func (p *Program) Mutate(shoot, oldShoot *gardencorev1beta1.Shoot) error {
+	if !p.HasMutations() {
+		return nil
+	}
+
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(shoot)
+	if err != nil {
+		return fmt.Errorf("failed converting Shoot: %w", err)
+	}
+	oldObject, err := toUnstructured(oldShoot)
+	if err != nil {
+		return err
+	}
+
+	for i, program := range p.mutations {
+		result, _, err := program.Eval(map[string]any{VariableObject: object, VariableOldObject: oldObject})
+		if err != nil {
+			return fmt.Errorf("failed evaluating mutation %d: %w", i, err)
+		}
+
+		patch, err := result.ConvertToNative(reflect.TypeFor[*structpb.Struct]())
+		if err != nil {
+			return fmt.Errorf("mutation %d does not evaluate to an object: %w", i, err)
+		}
+
+		object = mergePatch(object, patch.(*structpb.Struct).AsMap())
+	}
+
+	data, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed marshalling mutated Shoot: %w", err)
+	}
+
+	mutated := &gardencorev1beta1.Shoot{}
+	if err := json.Unmarshal(data, mutated); err != nil {
+		return fmt.Errorf("failed unmarshalling mutated Shoot: %w", err)
+	}
+
+	*shoot = *mutated
+	return nil
+}
+
+func newActivation(shoot, oldShoot *gardencorev1beta1.Shoot) (map[string]any, error) {
+	object, err := toUnstructured(shoot)
+	if err != nil {
+		return nil, err
+	}
+	oldObject, err := toUnstructured(oldShoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{VariableObject: object, VariableOldObject: oldObject}, nil
+}
+
+func toUnstructured(shoot *gardencorev1beta1.Shoot) (any, error) {
+	if shoot == nil {
+		return nil, nil
+	}
+
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting Shoot: %w", err)
+	}
+	return object, nil
+}
+
+// mergePatch applies the given patch to the target according to RFC 7386.
+func mergePatch(target, patch map[string]any) map[string]any {
+	if target == nil {
+		target = map[string]any{}
+	}
+
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+
+		patchValue, isObject := value.(map[string]any)
+		if !isObject {
+			target[key] = value
+			continue
+		}
+
+		targetValue, _ := target[key].(map[string]any)
+		target[key] = mergePatch(targetValue, patchValue)
+	}
+
+	return target
+}
+
+// Applicable is a policy which applies to a certain Shoot, together with its compiled program.
+type Applicable struct {
+	// Name is the name of the policy, prefixed with its kind.
+	Name string
+	// EnforcementAction is the enforcement action of the policy.
+	EnforcementAction settingsv1alpha1.ShootPolicyEnforcementAction
+	// Program is the compiled program of the policy.
+	Program *Program
+}
+
+// ApplicablePolicies returns the ShootPolicies and ClusterShootPolicies which apply to the given Shoot. The project
+// may be nil, in this case only ClusterShootPolicies with a project selector matching everything are considered.
+// The result is sorted by kind and name.
+func ApplicablePolicies(
+	shoot *gardencorev1beta1.Shoot,
+	project *gardencorev1beta1.Project,
+	policies []*settingsv1alpha1.ShootPolicy,
+	clusterPolicies []*settingsv1alpha1.ClusterShootPolicy,
+) ([]Applicable, error) {
+	var projectLabels labels.Set
+	if project != nil {
+		projectLabels = project.Labels
+	}
+
+	var result []Applicable
+
+	for _, clusterPolicy := range clusterPolicies {
+		projectSelector, err := metav1.LabelSelectorAsSelector(clusterPolicy.Spec.ProjectSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing project selector of ClusterShootPolicy %s: %w", clusterPolicy.Name, err)
+		}
+		if !projectSelector.Matches(projectLabels) {
+			continue
+		}
+
+		applicable, ok, err := newApplicable("ClusterShootPolicy/"+clusterPolicy.Name, &clusterPolicy.Spec.ShootPolicySpec, shoot)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, applicable)
+		}
+	}
+
+	for _, policy := range policies {
+		if policy.Namespace != shoot.Namespace {
+			continue
+		}
+
+		applicable, ok, err := newApplicable("ShootPolicy/"+policy.Name, &policy.Spec, shoot)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, applicable)
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b Applicable) int { return strings.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+func newApplicable(name string, spec *settingsv1alpha1.ShootPolicySpec, shoot *gardencorev1beta1.Shoot) (Applicable, bool, error) {
+	shootSelector, err := metav1.LabelSelectorAsSelector(spec.ShootSelector)
+	if err != nil {
+		return Applicable{}, false, fmt.Errorf("failed parsing shoot selector of %s: %w", name, err)
+	}
+	if !shootSelector.Matches(labels.Set(shoot.Labels)) {
+		return Applicable{}, false, nil
+	}
+
+	program, err := Compile(spec)
+	if err != nil {
+		return Applicable{}, false, fmt.Errorf("failed compiling %s: %w", name, err)
+	}
+
+	return Applicable{
+		Name:              name,
+		EnforcementAction: ptr.Deref(spec.EnforcementAction, settingsv1alpha1.ShootPolicyEnforcementActionDeny),
+		Program:           program,
+	}, true, nil
+}
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpolicy_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/api/settings/shootpolicy"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+)
+
+var _ = Describe("ShootPolicy", func() {
+	var shoot *gardencorev1beta1.Shoot
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Purpose: ptr.To(gardencorev1beta1.ShootPurposeProduction),
+				Provider: gardencorev1beta1.Provider{
+					Type: "local",
+					Workers: []gardencorev1beta1.Worker{
+						{Name: "worker1", Machine: gardencorev1beta1.Machine{Type: "large"}, Minimum: 1, Maximum: 3},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#CompileValidation", func() {
+		It("should compile a valid expression", func() {
+			Expect(CompileValidation("object.metadata.name == 'foo'")).NotTo(BeNil())
+		})
+
+		It("should fail for a syntactically invalid expression", func() {
+			_, err := CompileValidation("object.metadata.name ==")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for an expression not evaluating to a boolean", func() {
+			_, err := CompileValidation("'foo'")
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to bool")))
+		})
+	})
+
+	Describe("#CompileMutation", func() {
+		It("should compile a valid expression", func() {
+			Expect(CompileMutation("{'spec': {'purpose': 'evaluation'}}")).NotTo(BeNil())
+		})
+
+		It("should fail for an expression not evaluating to an object", func() {
+			_, err := CompileMutation("true")
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to map")))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should return no messages if all validations pass", func() {
+			program, err := Compile(&settingsv1alpha1.ShootPolicySpec{
+				Validations: []settingsv1alpha1.ShootPolicyValidation{
+					{Expression: "object.spec.provider.workers.all(w, w.machine.type in ['large', 'xlarge'])"},
+					{Expression: "oldObject == null"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(program.Validate(shoot, nil)).To(BeEmpty())
+		})
+
+		It("should return the messages of the violated validations", func() {
+			program, err := Compile(&settingsv1alpha1.ShootPolicySpec{
+				Validations: []settingsv1alpha1.ShootPolicyValidation{
+					{Expression: "object.spec.purpose != 'production' || object.spec.?controlPlane.?highAvailability.hasValue()", Message: ptr.To("production shoots must be highly available")},
+					{Expression: "object.spec.provider.workers.all(w, w.machine.type == 'small')"},
+					{Expression: "object.metadata.name == 'foo'"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(program.Validate(shoot, nil)).To(ConsistOf(
+				"production shoots must be highly available",
+				"failed expression: object.spec.provider.workers.all(w, w.machine.type == 'small')",
+			))
+		})
+
+		It("should consider validations with evaluation errors as violated", func() {
+			program, err := Compile(&settingsv1alpha1.ShootPolicySpec{
+				Validations: []settingsv1alpha1.ShootPolicyValidation{
+					{Expression: "object.spec.controlPlane.highAvailability.failureTolerance.type == 'zone'", Message: ptr.To("must tolerate zone failures")},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(program.Validate(shoot, nil)).To(ConsistOf(
+				ContainSubstring("must tolerate zone failures (evaluation error: no such key"),
+			))
+		})
+	})
+
+	Describe("#Mutate", func() {
+		It("should apply the mutations as merge patches in order", func() {
+			program, err := Compile(&settingsv1alpha1.ShootPolicySpec{
+				Mutations: []settingsv1alpha1.ShootPolicyMutation{
+					{Expression: "{'metadata': {'labels': {'purpose': object.spec.purpose}}}"},
+					{Expression: "has(object.spec.controlPlane) ? {} : {'spec': {'controlPlane': {'highAvailability': {'failureTolerance': {'type': 'zone'}}}}}"},
+					{Expression: "{'spec': {'provider': {'workers': object.spec.provider.workers.map(w, {'name': w.name, 'machine': {'type': 'xlarge'}, 'minimum': 2, 'maximum': w.maximum})}}}"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(program.Mutate(shoot, nil)).To(Succeed())
+			Expect(shoot.Labels).To(Equal(map[string]string{"purpose": "production"}))
+			Expect(shoot.Spec.ControlPlane).To(Equal(&gardencorev1beta1.ControlPlane{
+				HighAvailability: &gardencorev1beta1.HighAvailability{
+					FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone},
+				},
+			}))
+			Expect(shoot.Spec.Provider.Workers).To(Equal([]gardencorev1beta1.Worker{
+				{Name: "worker1", Machine: gardencorev1beta1.Machine{Type: "xlarge"}, Minimum: 2, Maximum: 3},
+			}))
+		})
+
+		It("should remove fields set to null", func() {
+			program, err := Compile(&settingsv1alpha1.ShootPolicySpec{
+				Mutations: []settingsv1alpha1.ShootPolicyMutation{{Expression: "{'spec': {'purpose': null}}"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(program.Mutate(shoot, nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should return an error if the mutation cannot be evaluated", func() {
+			program, err := Compile(&settingsv1alpha1.ShootPolicySpec{
+				Mutations: []settingsv1alpha1.ShootPolicyMutation{{Expression: "{'spec': {'purpose': object.spec.foo}}"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(program.Mutate(shoot, nil)).To(MatchError(ContainSubstring("failed evaluating mutation 0")))
+		})
+	})
+
+	Describe("#ApplicablePolicies", func() {
+		var (
+			project         *gardencorev1beta1.Project
+			policies        []*settingsv1alpha1.ShootPolicy
+			clusterPolicies []*settingsv1alpha1.ClusterShootPolicy
+			spec            settingsv1alpha1.ShootPolicySpec
+		)
+
+		BeforeEach(func() {
+			shoot.Labels = map[string]string{"team": "a"}
+			project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "bar", Labels: map[string]string{"tier": "gold"}}}
+			spec = settingsv1alpha1.ShootPolicySpec{
+				ShootSelector: &metav1.LabelSelector{},
+				Validations:   []settingsv1alpha1.ShootPolicyValidation{{Expression: "true"}},
+			}
+
+			policies = []*settingsv1alpha1.ShootPolicy{
+				{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "garden-bar"}, Spec: *spec.DeepCopy()},
+				{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "garden-other"}, Spec: *spec.DeepCopy()},
+			}
+			clusterPolicies = []*settingsv1alpha1.ClusterShootPolicy{
+				{ObjectMeta: metav1.ObjectMeta{Name: "gold"}, Spec: settingsv1alpha1.ClusterShootPolicySpec{
+					ShootPolicySpec: *spec.DeepCopy(),
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+				}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "silver"}, Spec: settingsv1alpha1.ClusterShootPolicySpec{
+					ShootPolicySpec: *spec.DeepCopy(),
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "silver"}},
+				}},
+			}
+		})
+
+		It("should return the policies matching the Shoot and its project", func() {
+			policies[0].Spec.EnforcementAction = ptr.To(settingsv1alpha1.ShootPolicyEnforcementActionWarn)
+
+			result, err := ApplicablePolicies(shoot, project, policies, clusterPolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].Name).To(Equal("ClusterShootPolicy/gold"))
+			Expect(result[0].EnforcementAction).To(Equal(settingsv1alpha1.ShootPolicyEnforcementActionDeny))
+			Expect(result[1].Name).To(Equal("ShootPolicy/b"))
+			Expect(result[1].EnforcementAction).To(Equal(settingsv1alpha1.ShootPolicyEnforcementActionWarn))
+		})
+
+		It("should skip policies whose shoot selector does not match", func() {
+			policies[0].Spec.ShootSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}}
+
+			result, err := ApplicablePolicies(shoot, project, policies, clusterPolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Name).To(Equal("ClusterShootPolicy/gold"))
+		})
+
+		It("should only consider cluster policies matching all projects if the project is unknown", func() {
+			clusterPolicies[1].Spec.ProjectSelector = &metav1.LabelSelector{}
+
+			result, err := ApplicablePolicies(shoot, nil, nil, clusterPolicies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Name).To(Equal("ClusterShootPolicy/silver"))
+		})
+
+		It("should return an error if a policy cannot be compiled", func() {
+			policies[0].Spec.Validations[0].Expression = "1 +"
+
+			_, err := ApplicablePolicies(shoot, project, policies, nil)
+			Expect(err).To(MatchError(ContainSubstring("failed compiling ShootPolicy/b")))
+		})
+	})
+})
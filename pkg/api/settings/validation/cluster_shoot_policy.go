@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/settings"
+)
+
+// ValidateClusterShootPolicy validates a ClusterShootPolicy object.
+func ValidateClusterShootPolicy(policy *settings.ClusterShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&policy.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateClusterShootPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateClusterShootPolicyUpdate validates a ClusterShootPolicy object before an update.
+func ValidateClusterShootPolicyUpdate(new, old *settings.ClusterShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateClusterShootPolicySpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateClusterShootPolicyStatusUpdate validates the status field of a ClusterShootPolicy object before an update.
+func ValidateClusterShootPolicyStatusUpdate(new, old *settings.ClusterShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicyStatus(&new.Status, field.NewPath("status"))...)
+
+	return allErrs
+}
+
+func validateClusterShootPolicySpec(spec *settings.ClusterShootPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ProjectSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("projectSelector"))...)
+	allErrs = append(allErrs, validateShootPolicySpec(&spec.ShootPolicySpec, fldPath)...)
+
+	return allErrs
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/api/settings/shootpolicy"
+	"github.com/gardener/gardener/pkg/apis/settings"
+)
+
+var availableShootPolicyEnforcementActions = sets.New(
+	string(settings.ShootPolicyEnforcementActionDeny),
+	string(settings.ShootPolicyEnforcementActionWarn),
+	string(settings.ShootPolicyEnforcementActionAudit),
+)
+
+// ValidateShootPolicy validates a ShootPolicy object.
+func ValidateShootPolicy(policy *settings.ShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&policy.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootPolicyUpdate validates a ShootPolicy object before an update.
+func ValidateShootPolicyUpdate(new, old *settings.ShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicySpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootPolicyStatusUpdate validates the status field of a ShootPolicy object before an update.
+func ValidateShootPolicyStatusUpdate(new, old *settings.ShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicyStatus(&new.Status, field.NewPath("status"))...)
+
+	return allErrs
+}
+
+func validateShootPolicySpec(spec *settings.ShootPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ShootSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("shootSelector"))...)
+
+	if spec.EnforcementAction == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("enforcementAction"), "must specify an enforcement action"))
+	} else if !availableShootPolicyEnforcementActions.Has(string(*spec.EnforcementAction)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("enforcementAction"), *spec.EnforcementAction, sets.List(availableShootPolicyEnforcementActions)))
+	}
+
+	if len(spec.Validations) == 0 && len(spec.Mutations) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify at least one validation or mutation"))
+	}
+
+	for i, validation := range spec.Validations {
+		idxPath := fldPath.Child("validations").Index(i)
+
+		if len(validation.Expression) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expression"), "must not be empty"))
+		} else if _, err := shootpolicy.CompileValidation(validation.Expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expression"), validation.Expression, err.Error()))
+		}
+
+		if validation.Message != nil && len(*validation.Message) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("message"), *validation.Message, "must not be empty"))
+		}
+	}
+
+	for i, mutation := range spec.Mutations {
+		idxPath := fldPath.Child("mutations").Index(i)
+
+		if len(mutation.Expression) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("expression"), "must not be empty"))
+		} else if _, err := shootpolicy.CompileMutation(mutation.Expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("expression"), mutation.Expression, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateShootPolicyStatus(status *settings.ShootPolicyStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(status.ObservedGeneration, fldPath.Child("observedGeneration"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.ViolationsCount), fldPath.Child("violationsCount"))...)
+
+	if int(status.ViolationsCount) < len(status.Violations) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("violationsCount"), status.ViolationsCount, "must not be less than the number of listed violations"))
+	}
+
+	for i, violation := range status.Violations {
+		idxPath := fldPath.Child("violations").Index(i)
+
+		if len(violation.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must not be empty"))
+		}
+		if len(violation.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/api/settings/validation"
+	"github.com/gardener/gardener/pkg/apis/settings"
+)
+
+var _ = Describe("ShootPolicy", func() {
+	var policy *settings.ShootPolicy
+
+	BeforeEach(func() {
+		policy = &settings.ShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "test",
+				Namespace:       "test-namespace",
+				ResourceVersion: "1",
+			},
+			Spec: settings.ShootPolicySpec{
+				ShootSelector:     &metav1.LabelSelector{},
+				EnforcementAction: ptr.To(settings.ShootPolicyEnforcementActionDeny),
+				Validations: []settings.ShootPolicyValidation{
+					{Expression: "object.spec.purpose != 'production' || object.spec.?controlPlane.?highAvailability.hasValue()", Message: ptr.To("production shoots must be highly available")},
+				},
+				Mutations: []settings.ShootPolicyMutation{
+					{Expression: "{'metadata': {'labels': {'foo': 'bar'}}}"},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateShootPolicy", func() {
+		It("should allow a valid policy", func() {
+			Expect(ValidateShootPolicy(policy)).To(BeEmpty())
+		})
+
+		It("should forbid an empty policy", func() {
+			policy.Name = ""
+			policy.Namespace = ""
+			policy.Spec = settings.ShootPolicySpec{}
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("metadata.name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("metadata.namespace")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec.enforcementAction")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec")})),
+			))
+		})
+
+		It("should forbid unsupported enforcement actions", func() {
+			policy.Spec.EnforcementAction = ptr.To(settings.ShootPolicyEnforcementAction("Ignore"))
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("spec.enforcementAction")})),
+			))
+		})
+
+		It("should forbid invalid shoot selectors", func() {
+			policy.Spec.ShootSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}}}
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.shootSelector.matchExpressions[0].operator")})),
+			))
+		})
+
+		It("should forbid empty and invalid expressions", func() {
+			policy.Spec.Validations = []settings.ShootPolicyValidation{
+				{Expression: ""},
+				{Expression: "object.spec.purpose =="},
+				{Expression: "object.spec.purpose"},
+				{Expression: "true", Message: ptr.To("")},
+			}
+			policy.Spec.Mutations = []settings.ShootPolicyMutation{
+				{Expression: ""},
+				{Expression: "'foo'"},
+			}
+
+			Expect(ValidateShootPolicy(policy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec.validations[0].expression")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.validations[1].expression")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.validations[3].message")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec.mutations[0].expression")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.mutations[1].expression")})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootPolicyUpdate", func() {
+		It("should allow a valid update", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.Spec.EnforcementAction = ptr.To(settings.ShootPolicyEnforcementActionAudit)
+
+			Expect(ValidateShootPolicyUpdate(newPolicy, policy)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidateShootPolicyStatusUpdate", func() {
+		It("should allow a valid status", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.Status = settings.ShootPolicyStatus{
+				ObservedGeneration: 1,
+				ViolationsCount:    2,
+				Violations:         []settings.ShootPolicyViolation{{Namespace: "garden-foo", Name: "bar", Messages: []string{"baz"}}},
+			}
+
+			Expect(ValidateShootPolicyStatusUpdate(newPolicy, policy)).To(BeEmpty())
+		})
+
+		It("should forbid an invalid status", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.Status = settings.ShootPolicyStatus{
+				ObservedGeneration: -1,
+				Violations:         []settings.ShootPolicyViolation{{}},
+			}
+
+			Expect(ValidateShootPolicyStatusUpdate(newPolicy, policy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("status.observedGeneration")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("status.violationsCount")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("status.violations[0].namespace")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("status.violations[0].name")})),
+			))
+		})
+	})
+})
+
+var _ = Describe("ClusterShootPolicy", func() {
+	var policy *settings.ClusterShootPolicy
+
+	BeforeEach(func() {
+		policy = &settings.ClusterShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "test",
+				ResourceVersion: "1",
+			},
+			Spec: settings.ClusterShootPolicySpec{
+				ShootPolicySpec: settings.ShootPolicySpec{
+					ShootSelector:     &metav1.LabelSelector{},
+					EnforcementAction: ptr.To(settings.ShootPolicyEnforcementActionWarn),
+					Validations:       []settings.ShootPolicyValidation{{Expression: "object.spec.provider.workers.all(w, w.machine.type in ['m5.large'])"}},
+				},
+				ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prod"}},
+			},
+		}
+	})
+
+	Describe("#ValidateClusterShootPolicy", func() {
+		It("should allow a valid policy", func() {
+			Expect(ValidateClusterShootPolicy(policy)).To(BeEmpty())
+		})
+
+		It("should forbid a namespace and invalid selectors", func() {
+			policy.Namespace = "garden"
+			policy.Spec.ProjectSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "prod?"}}
+			policy.Spec.Validations = nil
+
+			Expect(ValidateClusterShootPolicy(policy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("metadata.namespace")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("spec.projectSelector.matchLabels")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("spec")})),
+			))
+		})
+	})
+
+	Describe("#ValidateClusterShootPolicyUpdate", func() {
+		It("should allow a valid update", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.Spec.ProjectSelector = nil
+
+			Expect(ValidateClusterShootPolicyUpdate(newPolicy, policy)).To(BeEmpty())
+		})
+	})
+})
@@ -229,6 +229,22 @@ func SetDefaults_SeedReferenceControllerConfiguration(obj *SeedReferenceControll
 	}
 }
 
+// SetDefaults_SeedDrainControllerConfiguration sets defaults for the SeedDrainControllerConfiguration.
+func SetDefaults_SeedDrainControllerConfiguration(obj *SeedDrainControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.MaxParallelMigrations == nil {
+		obj.MaxParallelMigrations = ptr.To[int32](5)
+	}
+	if obj.RescheduleTimeout == nil {
+		obj.RescheduleTimeout = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -249,6 +265,18 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	}
 }
 
+// SetDefaults_ShootPolicyControllerConfiguration sets defaults for the ShootPolicyControllerConfiguration.
+func SetDefaults_ShootPolicyControllerConfiguration(obj *ShootPolicyControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{
+			Duration: 10 * time.Minute,
+		}
+	}
+}
+
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
 func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -363,6 +391,12 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedReference == nil {
 		obj.SeedReference = &SeedReferenceControllerConfiguration{}
 	}
+	if obj.SeedDrain == nil {
+		obj.SeedDrain = &SeedDrainControllerConfiguration{}
+	}
+	if obj.ShootPolicy == nil {
+		obj.ShootPolicy = &ShootPolicyControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
@@ -550,6 +550,37 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedDrainControllerConfiguration defaulting", func() {
+		It("should default SeedDrainControllerConfiguration correctly", func() {
+			expected := &SeedDrainControllerConfiguration{
+				ConcurrentSyncs:       ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:            &metav1.Duration{Duration: time.Minute},
+				MaxParallelMigrations: ptr.To[int32](5),
+				RescheduleTimeout:     &metav1.Duration{Duration: 30 * time.Minute},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedDrain: &SeedDrainControllerConfiguration{
+						ConcurrentSyncs:       ptr.To(10),
+						SyncPeriod:            &metav1.Duration{Duration: 5 * time.Minute},
+						MaxParallelMigrations: ptr.To[int32](2),
+						RescheduleTimeout:     &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.SeedDrain.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedDrain).To(Equal(expected))
+		})
+	})
+
 	Describe("SeedReferenceControllerConfiguration defaulting", func() {
 		It("should default SeedReferenceControllerConfiguration correctly", func() {
 			expected := &SeedReferenceControllerConfiguration{
@@ -629,6 +660,37 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootPolicyControllerConfiguration defaulting", func() {
+		It("should default ShootPolicyControllerConfiguration correctly", func() {
+			expected := &ShootPolicyControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod: &metav1.Duration{
+					Duration: 10 * time.Minute,
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootPolicy).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootPolicy: &ShootPolicyControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod: &metav1.Duration{
+							Duration: 30 * time.Minute,
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootPolicy.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootPolicy).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootQuotaControllerConfiguration defaulting", func() {
 		It("should default ShootQuotaControllerConfiguration correctly", func() {
 			expected := &ShootQuotaControllerConfiguration{
@@ -91,8 +91,14 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedReference defines the configuration of the SeedReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	SeedReference *SeedReferenceControllerConfiguration `json:"seedReference,omitempty"`
+	// SeedDrain defines the configuration of the SeedDrain controller.
+	// +optional
+	SeedDrain *SeedDrainControllerConfiguration `json:"seedDrain,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
+	// ShootPolicy defines the configuration of the ShootPolicy controller.
+	// +optional
+	ShootPolicy *ShootPolicyControllerConfiguration `json:"shootPolicy,omitempty"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
 	// +optional
 	ShootQuota *ShootQuotaControllerConfiguration `json:"shootQuota,omitempty"`
@@ -324,6 +330,27 @@ type SeedReferenceControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// SeedDrainControllerConfiguration defines the configuration of the
+// SeedDrain controller.
+type SeedDrainControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// seeds.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often seeds which are being drained are reconciled (how
+	// often new shoots are rescheduled to other seeds).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxParallelMigrations is the maximum number of shoots per seed which are migrated to other seeds
+	// at the same time.
+	// +optional
+	MaxParallelMigrations *int32 `json:"maxParallelMigrations,omitempty"`
+	// RescheduleTimeout is the duration after which a shoot which could not be rescheduled to another seed is
+	// considered unschedulable. Unschedulable shoots do not count against MaxParallelMigrations.
+	// +optional
+	RescheduleTimeout *metav1.Duration `json:"rescheduleTimeout,omitempty"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -339,6 +366,19 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
 }
 
+// ShootPolicyControllerConfiguration defines the configuration of the
+// ShootPolicy controller.
+type ShootPolicyControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the existing resources are reconciled
+	// (how often existing Shoots are evaluated against the policies).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // ShootQuotaControllerConfiguration defines the configuration of the
 // ShootQuota controller.
 type ShootQuotaControllerConfiguration struct {
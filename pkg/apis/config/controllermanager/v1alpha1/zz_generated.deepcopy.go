@@ -249,7 +249,17 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedReferenceControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedDrain != nil {
+		in, out := &in.SeedDrain, &out.SeedDrain
+		*out = new(SeedDrainControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
+	if in.ShootPolicy != nil {
+		in, out := &in.ShootPolicy, &out.ShootPolicy
+		*out = new(ShootPolicyControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
 		*out = new(ShootQuotaControllerConfiguration)
@@ -620,6 +630,42 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainControllerConfiguration) DeepCopyInto(out *SeedDrainControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxParallelMigrations != nil {
+		in, out := &in.MaxParallelMigrations, &out.MaxParallelMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RescheduleTimeout != nil {
+		in, out := &in.RescheduleTimeout, &out.RescheduleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainControllerConfiguration.
+func (in *SeedDrainControllerConfiguration) DeepCopy() *SeedDrainControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedExtensionsCheckControllerConfiguration) DeepCopyInto(out *SeedExtensionsCheckControllerConfiguration) {
 	*out = *in
@@ -813,6 +859,32 @@ func (in *ShootMigrationControllerConfiguration) DeepCopy() *ShootMigrationContr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicyControllerConfiguration) DeepCopyInto(out *ShootPolicyControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicyControllerConfiguration.
+func (in *ShootPolicyControllerConfiguration) DeepCopy() *ShootPolicyControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicyControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootQuotaControllerConfiguration) DeepCopyInto(out *ShootQuotaControllerConfiguration) {
 	*out = *in
@@ -75,7 +75,13 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedReference != nil {
 		SetDefaults_SeedReferenceControllerConfiguration(in.Controllers.SeedReference)
 	}
+	if in.Controllers.SeedDrain != nil {
+		SetDefaults_SeedDrainControllerConfiguration(in.Controllers.SeedDrain)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
+	if in.Controllers.ShootPolicy != nil {
+		SetDefaults_ShootPolicyControllerConfiguration(in.Controllers.ShootPolicy)
+	}
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
 	}
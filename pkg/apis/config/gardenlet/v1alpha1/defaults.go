@@ -165,6 +165,12 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
+	if obj.ShootDiagnostics == nil {
+		obj.ShootDiagnostics = &ShootDiagnosticsControllerConfiguration{}
+	}
+	if obj.ShootAlertSilence == nil {
+		obj.ShootAlertSilence = &ShootAlertSilenceControllerConfiguration{}
+	}
 	if obj.NetworkPolicy == nil {
 		obj.NetworkPolicy = &NetworkPolicyControllerConfiguration{}
 	}
@@ -246,6 +252,13 @@ func SetDefaults_ShootMonitoringConfig(obj *ShootMonitoringConfig) {
 	}
 }
 
+// SetDefaults_TracingConfiguration sets defaults for the tracing configuration.
+func SetDefaults_TracingConfiguration(obj *TracingConfiguration) {
+	if obj.SamplingPercentage == nil {
+		obj.SamplingPercentage = ptr.To[int32](100)
+	}
+}
+
 // SetDefaults_BastionControllerConfiguration sets defaults for the bastion controller.
 func SetDefaults_BastionControllerConfiguration(obj *BastionControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -384,6 +397,26 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_ShootDiagnosticsControllerConfiguration sets defaults for the shoot diagnostics controller.
+func SetDefaults_ShootDiagnosticsControllerConfiguration(obj *ShootDiagnosticsControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.BundleTTL == nil {
+		obj.BundleTTL = &metav1.Duration{Duration: 15 * time.Minute}
+	}
+}
+
+// SetDefaults_ShootAlertSilenceControllerConfiguration sets defaults for the shoot alert silence controller.
+func SetDefaults_ShootAlertSilenceControllerConfiguration(obj *ShootAlertSilenceControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
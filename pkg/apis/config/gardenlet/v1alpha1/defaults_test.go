@@ -46,6 +46,8 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootDiagnostics).NotTo(BeNil())
+			Expect(obj.Controllers.ShootAlertSilence).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(config.LogLevelInfo))
@@ -463,6 +465,54 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootDiagnosticsControllerConfiguration defaulting", func() {
+		It("should default the shoot diagnostics controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootDiagnostics.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootDiagnostics.BundleTTL).To(PointTo(Equal(metav1.Duration{Duration: 15 * time.Minute})))
+		})
+
+		It("should not overwrite already set values for the shoot diagnostics controller configuration", func() {
+			bundleTTL := metav1.Duration{Duration: time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootDiagnostics: &ShootDiagnosticsControllerConfiguration{
+					ConcurrentSyncs: ptr.To(10),
+					BundleTTL:       &bundleTTL,
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootDiagnostics.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootDiagnostics.BundleTTL).To(PointTo(Equal(bundleTTL)))
+		})
+	})
+
+	Describe("ShootAlertSilenceControllerConfiguration defaulting", func() {
+		It("should default the shoot alert silence controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAlertSilence.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootAlertSilence.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+		})
+
+		It("should not overwrite already set values for the shoot alert silence controller configuration", func() {
+			syncPeriod := metav1.Duration{Duration: time.Hour}
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootAlertSilence: &ShootAlertSilenceControllerConfiguration{
+					ConcurrentSyncs: ptr.To(10),
+					SyncPeriod:      &syncPeriod,
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootAlertSilence.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ShootAlertSilence.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+		})
+	})
+
 	Describe("NetworkPolicyControllerConfiguration defaulting", func() {
 		It("should default the network policy controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -877,4 +927,20 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.Monitoring.Shoot.Enabled).To(BeFalse())
 		})
 	})
+
+	Describe("TracingConfiguration defaulting", func() {
+		It("should default the sampling percentage", func() {
+			obj.Tracing = &TracingConfiguration{Endpoint: "foo:4317"}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(*obj.Tracing.SamplingPercentage).To(Equal(int32(100)))
+		})
+
+		It("should not overwrite the already set sampling percentage", func() {
+			obj.Tracing = &TracingConfiguration{Endpoint: "foo:4317", SamplingPercentage: ptr.To[int32](10)}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(*obj.Tracing.SamplingPercentage).To(Equal(int32(10)))
+		})
+	})
 })
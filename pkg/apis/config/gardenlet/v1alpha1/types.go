@@ -92,6 +92,9 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// Tracing contains optional settings for exporting traces of the reconciliation flows.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -200,6 +203,12 @@ type GardenletControllerConfiguration struct {
 	// ShootStatus defines the configuration of the ShootStatus controller.
 	// +optional
 	ShootStatus *ShootStatusControllerConfiguration `json:"shootStatus,omitempty"`
+	// ShootDiagnostics defines the configuration of the ShootDiagnostics controller.
+	// +optional
+	ShootDiagnostics *ShootDiagnosticsControllerConfiguration `json:"shootDiagnostics,omitempty"`
+	// ShootAlertSilence defines the configuration of the ShootAlertSilence controller.
+	// +optional
+	ShootAlertSilence *ShootAlertSilenceControllerConfiguration `json:"shootAlertSilence,omitempty"`
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller
 	// +optional
 	NetworkPolicy *NetworkPolicyControllerConfiguration `json:"networkPolicy,omitempty"`
@@ -390,6 +399,27 @@ type ShootStatusControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootDiagnosticsControllerConfiguration defines the configuration of the ShootDiagnostics controller.
+type ShootDiagnosticsControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// BundleTTL is the duration for which a collected diagnostics bundle is kept before it is deleted.
+	// +optional
+	BundleTTL *metav1.Duration `json:"bundleTTL,omitempty"`
+}
+
+// ShootAlertSilenceControllerConfiguration defines the configuration of the ShootAlertSilence controller.
+type ShootAlertSilenceControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the silences are pushed to the Alertmanager of a Shoot again, e.g., after it
+	// has lost its state.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -720,6 +750,20 @@ type MonitoringConfig struct {
 	Shoot *ShootMonitoringConfig `json:"shoot,omitempty"`
 }
 
+// TracingConfiguration contains settings for exporting traces of the reconciliation flows via OTLP.
+type TracingConfiguration struct {
+	// Endpoint is the address (host:port) of the OTLP gRPC receiver the traces are exported to, e.g. the `otlp`
+	// receiver of an OpenTelemetry collector.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// SamplingPercentage is the percentage of reconciliations for which traces are sampled.
+	// Defaults to 100.
+	// +optional
+	SamplingPercentage *int32 `json:"samplingPercentage,omitempty"`
+}
+
 // ShootMonitoringConfig contains settings for the shoot monitoring stack.
 type ShootMonitoringConfig struct {
 	// Enabled is used to enable or disable the shoot monitoring stack.
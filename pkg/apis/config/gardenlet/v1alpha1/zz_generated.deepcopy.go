@@ -522,6 +522,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -611,6 +616,16 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStatusControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootDiagnostics != nil {
+		in, out := &in.ShootDiagnostics, &out.ShootDiagnostics
+		*out = new(ShootDiagnosticsControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootAlertSilence != nil {
+		in, out := &in.ShootAlertSilence, &out.ShootAlertSilence
+		*out = new(ShootAlertSilenceControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -1129,6 +1144,32 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAlertSilenceControllerConfiguration) DeepCopyInto(out *ShootAlertSilenceControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAlertSilenceControllerConfiguration.
+func (in *ShootAlertSilenceControllerConfiguration) DeepCopy() *ShootAlertSilenceControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAlertSilenceControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
@@ -1243,6 +1284,32 @@ func (in *ShootControllerConfiguration) DeepCopy() *ShootControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDiagnosticsControllerConfiguration) DeepCopyInto(out *ShootDiagnosticsControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.BundleTTL != nil {
+		in, out := &in.BundleTTL, &out.BundleTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDiagnosticsControllerConfiguration.
+func (in *ShootDiagnosticsControllerConfiguration) DeepCopy() *ShootDiagnosticsControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDiagnosticsControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootEventLogging) DeepCopyInto(out *ShootEventLogging) {
 	*out = *in
@@ -1433,6 +1500,27 @@ func (in *TokenRequestorWorkloadIdentityControllerConfiguration) DeepCopy() *Tok
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	if in.SamplingPercentage != nil {
+		in, out := &in.SamplingPercentage, &out.SamplingPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPAEvictionRequirementsControllerConfiguration) DeepCopyInto(out *VPAEvictionRequirementsControllerConfiguration) {
 	*out = *in
@@ -77,6 +77,12 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
+		if in.Controllers.ShootDiagnostics != nil {
+			SetDefaults_ShootDiagnosticsControllerConfiguration(in.Controllers.ShootDiagnostics)
+		}
+		if in.Controllers.ShootAlertSilence != nil {
+			SetDefaults_ShootAlertSilenceControllerConfiguration(in.Controllers.ShootAlertSilence)
+		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
 		}
@@ -133,4 +139,7 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.Tracing != nil {
+		SetDefaults_TracingConfiguration(in.Tracing)
+	}
 }
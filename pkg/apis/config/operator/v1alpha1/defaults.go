@@ -136,3 +136,10 @@ func SetDefaults_ExtensionRequiredVirtualControllerConfiguration(obj *ExtensionR
 		obj.ConcurrentSyncs = ptr.To(5)
 	}
 }
+
+// SetDefaults_TracingConfiguration sets defaults for the tracing configuration.
+func SetDefaults_TracingConfiguration(obj *TracingConfiguration) {
+	if obj.SamplingPercentage == nil {
+		obj.SamplingPercentage = ptr.To[int32](100)
+	}
+}
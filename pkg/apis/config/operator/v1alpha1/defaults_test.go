@@ -352,4 +352,22 @@ var _ = Describe("Defaults", func() {
 			})
 		})
 	})
+
+	Describe("#SetDefaults_TracingConfiguration", func() {
+		It("should default the sampling percentage", func() {
+			obj := &TracingConfiguration{Endpoint: "foo:4317"}
+
+			SetDefaults_TracingConfiguration(obj)
+
+			Expect(obj.SamplingPercentage).To(PointTo(Equal(int32(100))))
+		})
+
+		It("should not overwrite the already set sampling percentage", func() {
+			obj := &TracingConfiguration{Endpoint: "foo:4317", SamplingPercentage: ptr.To[int32](10)}
+
+			SetDefaults_TracingConfiguration(obj)
+
+			Expect(obj.SamplingPercentage).To(PointTo(Equal(int32(10))))
+		})
+	})
 })
@@ -44,6 +44,9 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// Tracing contains optional settings for exporting traces of the reconciliation flows.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
@@ -193,6 +196,20 @@ type NodeTolerationConfiguration struct {
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
 
+// TracingConfiguration contains settings for exporting traces of the reconciliation flows via OTLP.
+type TracingConfiguration struct {
+	// Endpoint is the address (host:port) of the OTLP gRPC receiver the traces are exported to, e.g. the `otlp`
+	// receiver of an OpenTelemetry collector.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// SamplingPercentage is the percentage of reconciliations for which traces are sampled.
+	// Defaults to 100.
+	// +optional
+	SamplingPercentage *int32 `json:"samplingPercentage,omitempty"`
+}
+
 const (
 	// DefaultLockObjectNamespace is the default lock namespace for leader election.
 	DefaultLockObjectNamespace = "garden"
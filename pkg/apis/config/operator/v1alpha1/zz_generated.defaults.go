@@ -34,4 +34,7 @@ func SetObjectDefaults_OperatorConfiguration(in *OperatorConfiguration) {
 	SetDefaults_ExtensionCareControllerConfiguration(&in.Controllers.ExtensionCare)
 	SetDefaults_ExtensionRequiredRuntimeControllerConfiguration(&in.Controllers.ExtensionRequiredRuntime)
 	SetDefaults_ExtensionRequiredVirtualControllerConfiguration(&in.Controllers.ExtensionRequiredVirtual)
+	if in.Tracing != nil {
+		SetDefaults_TracingConfiguration(in.Tracing)
+	}
 }
@@ -99,8 +99,42 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// Drain contains information about the drain of the Seed, i.e., about the migration of all its Shoots to other
+	// Seeds. It is set when the Seed is annotated with `gardener.cloud/operation=drain`.
+	Drain *SeedDrainStatus
 }
 
+// SeedDrainStatus contains information about the drain of a Seed.
+type SeedDrainStatus struct {
+	// Phase is the phase of the drain.
+	Phase SeedDrainPhase
+	// StartTime is the time when the drain was started.
+	StartTime metav1.Time
+	// CompletionTime is the time when all Shoots have been migrated off the Seed.
+	CompletionTime *metav1.Time
+	// LastUpdateTime is the time when the progress of the drain was last updated.
+	LastUpdateTime metav1.Time
+	// RemainingShoots is the number of Shoots which have not been migrated off the Seed yet, including the ones which
+	// are currently being migrated.
+	RemainingShoots int32
+	// MigratingShoots is the list of Shoots (in the format `<namespace>/<name>`) which are currently being migrated off
+	// the Seed.
+	MigratingShoots []string
+	// UnschedulableShoots is the list of Shoots (in the format `<namespace>/<name>`) which could not be rescheduled to
+	// another Seed within the reschedule timeout. They are not rescheduled again while the drain is running.
+	UnschedulableShoots []string
+}
+
+// SeedDrainPhase is a string alias.
+type SeedDrainPhase string
+
+const (
+	// SeedDrainPhaseDraining is a constant for the phase of a drain while the Shoots are migrated off the Seed.
+	SeedDrainPhaseDraining SeedDrainPhase = "Draining"
+	// SeedDrainPhaseDrained is a constant for the phase of a drain after all Shoots have been migrated off the Seed.
+	SeedDrainPhaseDrained SeedDrainPhase = "Drained"
+)
+
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
 type Backup struct {
 	// Provider is a provider name. This field is immutable.
@@ -78,6 +78,8 @@ type ShootSpec struct {
 	// Maintenance contains information about the time window for maintenance operations and which
 	// operations should be performed.
 	Maintenance *Maintenance
+	// Logging contains information about custom logging configurations for the shoot.
+	Logging *Logging
 	// Monitoring contains information about custom monitoring configurations for the shoot.
 	Monitoring *Monitoring
 	// Provider contains all provider-specific and provider-relevant information.
@@ -172,6 +174,82 @@ type ShootStatus struct {
 	InPlaceUpdates *InPlaceUpdatesStatus
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout
+	// ControlPlaneSLO contains the service levels achieved by the Shoot's control plane.
+	ControlPlaneSLO *ControlPlaneSLO
+	// Plans contains the changes the extension controllers would perform on the infrastructure and the machines of the
+	// Shoot. Plans are computed if the `shoot.gardener.cloud/require-plan-approval` annotation is set.
+	Plans []ShootPlan
+}
+
+// ShootPlan contains the changes an extension controller would perform to reach the desired state of an extension
+// resource of the Shoot.
+type ShootPlan struct {
+	// Kind is the kind of the extension resource, i.e., `Infrastructure` or `Worker`.
+	Kind string
+	// Name is the name of the extension resource.
+	Name string
+	// ShootGeneration is the generation of the Shoot the plan was computed for. Plans are approved by setting the
+	// `shoot.gardener.cloud/approved-plan-generation` annotation to this generation.
+	ShootGeneration int64
+	// LastUpdateTime is the timestamp when the plan was computed.
+	LastUpdateTime metav1.Time
+	// Changes is the list of changes which would be performed. It is empty if the extension resource is already in its
+	// desired state.
+	Changes []ShootPlannedChange
+	// Error is the description of the error which prevented computing the plan.
+	Error *string
+}
+
+// ShootPlannedChange is a single change of a resource which would be performed by an extension controller.
+type ShootPlannedChange struct {
+	// Kind is the kind of the affected resource, e.g. `aws_vpc` or `MachineDeployment`.
+	Kind string
+	// Name is the name of the affected resource.
+	Name string
+	// Action is the action which would be performed on the resource, i.e., one of `Create`, `Update`, `Replace`,
+	// `Delete` or `Rollout`.
+	Action string
+	// Description is an optional human-readable description of the change.
+	Description *string
+}
+
+// ControlPlaneSLO contains the service levels achieved by the Shoot's control plane.
+type ControlPlaneSLO struct {
+	// Window is the duration of the rolling window over which the service levels are computed.
+	Window metav1.Duration
+	// Availability is the service level of the API server availability, i.e., the ratio of successful probes of the
+	// API server's health endpoint.
+	Availability *ControlPlaneServiceLevel
+	// Latency is the service level of the API server latency, i.e., the ratio of non-long-running requests which were
+	// served within one second.
+	Latency *ControlPlaneServiceLevel
+	// LastUpdateTime is the last time the service levels changed.
+	LastUpdateTime metav1.Time
+	// Reports contains the service levels achieved in past calendar months, the most recent month first.
+	Reports []ControlPlaneSLOReport
+}
+
+// ControlPlaneServiceLevel contains a service level objective together with the achieved service level.
+type ControlPlaneServiceLevel struct {
+	// Objective is the targeted service level in percent, e.g. "99.9".
+	Objective string
+	// Actual is the achieved service level in percent.
+	Actual string
+	// ErrorBudgetRemaining is the remaining error budget in percent. It becomes negative when the objective is missed.
+	ErrorBudgetRemaining string
+}
+
+// ControlPlaneSLOReport contains the service levels achieved by the Shoot's control plane in a calendar month.
+type ControlPlaneSLOReport struct {
+	// Month is the reported calendar month (UTC) in the format YYYY-MM.
+	Month string
+	// Availability is the service level of the API server availability in the reported month.
+	Availability *ControlPlaneServiceLevel
+	// Latency is the service level of the API server latency in the reported month.
+	Latency *ControlPlaneServiceLevel
+	// Coverage is the ratio of the recorded samples of the service level indicators to the expected number of samples
+	// in the reported month (since the creation of the Shoot) in percent.
+	Coverage *string
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -852,6 +930,51 @@ type AuditPolicy struct {
 	// ConfigMapRef is a reference to a ConfigMap object in the same namespace,
 	// which contains the audit policy for the kube-apiserver.
 	ConfigMapRef *corev1.ObjectReference
+	// Preset is the name of a predefined audit policy. It is mutually exclusive with ConfigMapRef.
+	Preset *AuditPolicyPreset
+}
+
+// AuditPolicyPreset is the name of a predefined audit policy.
+type AuditPolicyPreset string
+
+const (
+	// AuditPolicyPresetMinimal is a preset which records the metadata of all mutating requests and of all requests to
+	// secrets, configmaps and tokens.
+	AuditPolicyPresetMinimal AuditPolicyPreset = "Minimal"
+	// AuditPolicyPresetCIS is a preset which follows the recommendations of the CIS Kubernetes Benchmark.
+	AuditPolicyPresetCIS AuditPolicyPreset = "CIS"
+	// AuditPolicyPresetPCI is a preset which follows the requirements of PCI DSS.
+	AuditPolicyPresetPCI AuditPolicyPreset = "PCI"
+)
+
+// AuditBackends contains configuration for the backends the audit events are sent to.
+type AuditBackends struct {
+	// Log contains configuration for the log backend.
+	Log *AuditLogBackend
+	// Webhook contains configuration for the webhook backend.
+	Webhook *AuditWebhookBackend
+}
+
+// AuditLogBackend contains configuration for the log backend.
+type AuditLogBackend struct {
+	// Enabled indicates whether the audit events are written to the log of the kube-apiserver and are shipped into the
+	// logging stack of the shoot control plane.
+	Enabled bool
+}
+
+// AuditWebhookBackend contains configuration for the webhook backend.
+type AuditWebhookBackend struct {
+	// ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the kubeconfig
+	// of the webhook in the `kubeconfig` data key.
+	ResourceName string
+	// BatchMaxSize is the maximum number of audit events in a batch.
+	BatchMaxSize *int32
+	// BatchMaxWait is the amount of time to wait before sending a batch which has not reached the maximum size.
+	BatchMaxWait *metav1.Duration
+	// BatchBufferSize is the number of audit events buffered before they are batched and sent.
+	BatchBufferSize *int32
+	// InitialBackoff is the amount of time to wait before retrying a failed request.
+	InitialBackoff *metav1.Duration
 }
 
 // StructuredAuthentication contains authentication config for kube-apiserver.
@@ -1312,16 +1435,121 @@ type MaintenanceTimeWindow struct {
 	End string
 }
 
+// Logging contains information about the logging configuration for the shoot.
+type Logging struct {
+	// Outputs is a list of customer-owned endpoints to which logs of the shoot's control plane are forwarded.
+	Outputs []LoggingOutput
+	// AuditBackends contains configuration for the backends the audit events of the shoot's kube-apiserver are sent to.
+	// Multiple backends can be used simultaneously. An audit policy must be configured in
+	// `.spec.kubernetes.kubeAPIServer.auditConfig.auditPolicy` when backends are configured.
+	AuditBackends *AuditBackends
+}
+
+// LoggingOutput contains information about an endpoint to which logs of the shoot's control plane are forwarded.
+type LoggingOutput struct {
+	// Name is the name of the output.
+	Name string
+	// Type is the type of the output. Supported types are 'otlp', 'loki', 'http', and 'syslog'.
+	Type LoggingOutputType
+	// URL is the URL of the endpoint. The scheme must be 'http' or 'https' for 'otlp', 'loki', and 'http' outputs, and
+	// one of 'tcp', 'tls', or 'udp' for 'syslog' outputs.
+	URL string
+	// Sources is a list of log sources ('audit', 'control-plane') which are forwarded to the output.
+	Sources []LoggingSource
+	// ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the credentials
+	// of the endpoint. The Secret may contain the keys 'username' and 'password' (otlp, loki, http), and 'bearerToken'
+	// and 'tenantID' (loki).
+	ResourceName *string
+}
+
+// LoggingOutputType is a type alias for the type of a logging output.
+type LoggingOutputType string
+
+const (
+	// LoggingOutputTypeOTLP is the type of an OpenTelemetry protocol (OTLP/HTTP) endpoint.
+	LoggingOutputTypeOTLP LoggingOutputType = "otlp"
+	// LoggingOutputTypeLoki is the type of a Loki push API endpoint.
+	LoggingOutputTypeLoki LoggingOutputType = "loki"
+	// LoggingOutputTypeHTTP is the type of a generic HTTP endpoint accepting JSON.
+	LoggingOutputTypeHTTP LoggingOutputType = "http"
+	// LoggingOutputTypeSyslog is the type of a syslog server.
+	LoggingOutputTypeSyslog LoggingOutputType = "syslog"
+)
+
+// LoggingSource is a type alias for a source of logs which can be forwarded.
+type LoggingSource string
+
+const (
+	// LoggingSourceAudit is the source for the audit events of the kube-apiserver.
+	LoggingSourceAudit LoggingSource = "audit"
+	// LoggingSourceControlPlane is the source for the logs of all components in the shoot's control plane.
+	LoggingSourceControlPlane LoggingSource = "control-plane"
+)
+
 // Monitoring contains information about the monitoring configuration for the shoot.
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
 	Alerting *Alerting
+	// Rules contains information about additional user-defined recording and alerting rules which are evaluated by the
+	// shoot's control plane Prometheus.
+	Rules *MonitoringRules
+}
+
+// MonitoringRules contains information about additional user-defined Prometheus rules.
+type MonitoringRules struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains the Prometheus rule groups.
+	ConfigMapName string
 }
 
 // Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).
 type Alerting struct {
 	// MonitoringEmailReceivers is a list of recipients for alerts
 	EmailReceivers []string
+	// Receivers is a list of receivers (e.g., webhooks or incident management tools) to which alerts are sent.
+	Receivers []AlertReceiver
+}
+
+// AlertReceiver contains information about a receiver to which alerts are sent.
+type AlertReceiver struct {
+	// Name is the name of the receiver.
+	Name string
+	// Type is the type of the receiver.
+	Type AlertReceiverType
+	// ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the credentials
+	// of the receiver.
+	ResourceName string
+	// Severities is a list of alert severities which are sent to the receiver. If empty, alerts of all severities are
+	// sent.
+	Severities []string
+	// Visibilities is a list of alert visibilities which are sent to the receiver. If empty, alerts of all
+	// visibilities are sent.
+	Visibilities []string
+}
+
+// AlertReceiverType is a type alias for the type of an alert receiver.
+type AlertReceiverType string
+
+const (
+	// AlertReceiverTypeWebhook is the type of a generic webhook receiver.
+	AlertReceiverTypeWebhook AlertReceiverType = "webhook"
+	// AlertReceiverTypeSlack is the type of a Slack-compatible incoming webhook receiver.
+	AlertReceiverTypeSlack AlertReceiverType = "slack"
+	// AlertReceiverTypePagerDuty is the type of a PagerDuty receiver.
+	AlertReceiverTypePagerDuty AlertReceiverType = "pagerduty"
+	// AlertReceiverTypeOpsgenie is the type of an Opsgenie receiver.
+	AlertReceiverTypeOpsgenie AlertReceiverType = "opsgenie"
+	// AlertReceiverTypeMSTeams is the type of a Microsoft Teams receiver.
+	AlertReceiverTypeMSTeams AlertReceiverType = "msteams"
+)
+
+// AlertMatcher is a matcher for an alert label.
+type AlertMatcher struct {
+	// Name is the name of the alert label.
+	Name string
+	// Value is the value of the alert label.
+	Value string
+	// Regex specifies whether the value is a regular expression.
+	Regex bool
 }
 
 // Provider contains provider-specific information that are handed-over to the provider-specific
@@ -1674,6 +1902,9 @@ const (
 	ShootEveryNodeReady ConditionType = "EveryNodeReady"
 	// ShootSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	ShootSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// ShootVPNConnectivity is a constant for a condition type indicating the connectivity between the control plane
+	// and the shoot cluster through the VPN.
+	ShootVPNConnectivity ConditionType = "VPNConnectivity"
 	// ShootHibernationPossible is a constant for a condition type indicating whether the Shoot can be hibernated.
 	ShootHibernationPossible ConditionType = "HibernationPossible"
 	// ShootMaintenancePreconditionsSatisfied is a constant for a condition type indicating whether all preconditions
@@ -44,6 +44,9 @@ const (
 	// SecretNameCASeed is a constant for the name of a Kubernetes secret object that contains the CA
 	// certificate generated for a seed cluster.
 	SecretNameCASeed = "ca-seed"
+	// SecretNameCASSH is a constant for the name of a Kubernetes secret object that contains the key pair of the SSH
+	// certificate authority generated for a shoot cluster. It is used to sign short-lived SSH user certificates.
+	SecretNameCASSH = "ca-ssh"
 
 	// SecretNameCloudProvider is a constant for the name of a Kubernetes secret object that contains the provider
 	// specific credentials that shall be used to create/delete the shoot.
@@ -241,6 +244,9 @@ const (
 	// GardenerOperationRestore is a constant for the value of the operation annotation describing a restoration
 	// operation.
 	GardenerOperationRestore = "restore"
+	// GardenerOperationPlan is a constant for the value of the operation annotation describing a plan operation, i.e.,
+	// the computation of the changes a reconciliation would perform without performing them.
+	GardenerOperationPlan = "plan"
 	// GardenerOperationWaitForState is a constant for the value of the operation annotation describing a wait
 	// operation.
 	GardenerOperationWaitForState = "wait-for-state"
@@ -289,6 +295,10 @@ const (
 	GardenRoleCAKubelet = "ca-kubelet"
 	// GardenRoleCAClient is the value of the GardenRole key indicating type 'ca-client'.
 	GardenRoleCAClient = "ca-client"
+	// GardenRoleDiagnostics is the value of the GardenRole key indicating type 'diagnostics'.
+	GardenRoleDiagnostics = "diagnostics"
+	// GardenRoleAlertSilences is the value of the GardenRole key indicating type 'alert-silences'.
+	GardenRoleAlertSilences = "alert-silences"
 	// GardenRoleSSHKeyPair is the value of the GardenRole key indicating type 'ssh-keypair'.
 	GardenRoleSSHKeyPair = "ssh-keypair"
 	// GardenRoleDefaultDomain is the value of the GardenRole key indicating type 'default-domain'.
@@ -459,6 +469,12 @@ const (
 	// SeedOperationRenewWorkloadIdentityTokens is a constant for an annotation on a Seed indicating that
 	// all workload identity tokens on the seed shall be renewed.
 	SeedOperationRenewWorkloadIdentityTokens = "renew-workload-identity-tokens"
+	// SeedOperationDrain is a constant for an annotation on a Seed indicating that all its Shoots shall be migrated to
+	// other Seeds.
+	SeedOperationDrain = "drain"
+	// SeedOperationCancelDrain is a constant for an annotation on a Seed indicating that a running drain shall be
+	// cancelled.
+	SeedOperationCancelDrain = "cancel-drain"
 	// KubeconfigSecretOperationRenew is a constant for an annotation on the secret in a Seed containing the garden
 	// cluster kubeconfig of a gardenlet indicating that it should be renewed.
 	KubeconfigSecretOperationRenew = "renew"
@@ -723,6 +739,12 @@ const (
 	// AnnotationSchedulingCloudProfiles is a constant for an annotation key on a configmap which denotes
 	// the linked cloudprofiles containing the region distances.
 	AnnotationSchedulingCloudProfiles = "scheduling.gardener.cloud/cloudprofiles"
+	// AnnotationSchedulingReschedule is a constant for an annotation key on a Shoot which requests the scheduler to
+	// move it to another Seed. It is only respected while the current Seed of the Shoot is being drained.
+	AnnotationSchedulingReschedule = "scheduling.gardener.cloud/reschedule"
+	// AnnotationSchedulingRescheduleRequestTime is a constant for an annotation key on a Shoot which contains the time
+	// (in RFC3339 format) when the rescheduling of the Shoot was requested.
+	AnnotationSchedulingRescheduleRequestTime = "scheduling.gardener.cloud/reschedule-request-time"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -760,6 +782,49 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootDiagnosticsRequested is a key for an annotation on a Shoot resource that instructs gardenlet to
+	// collect a diagnostics bundle. Its value is the length of the time window in seconds for which events and logs are
+	// collected. The annotation is set by the gardener-apiserver when the 'shoots/diagnostics' subresource is requested
+	// and removed by gardenlet after the bundle was collected.
+	AnnotationShootDiagnosticsRequested = "shoot.gardener.cloud/diagnostics-requested"
+	// AnnotationDiagnosticsSinceSeconds is a key for an annotation on a diagnostics bundle InternalSecret that contains
+	// the length of the time window in seconds for which events and logs were collected.
+	AnnotationDiagnosticsSinceSeconds = "diagnostics.gardener.cloud/since-seconds"
+	// AnnotationDiagnosticsCollectionTimestamp is a key for an annotation on a diagnostics bundle InternalSecret that
+	// contains the time when the bundle was collected.
+	AnnotationDiagnosticsCollectionTimestamp = "diagnostics.gardener.cloud/collection-timestamp"
+	// AnnotationDiagnosticsExpirationTimestamp is a key for an annotation on a diagnostics bundle InternalSecret that
+	// contains the time after which the bundle is deleted.
+	AnnotationDiagnosticsExpirationTimestamp = "diagnostics.gardener.cloud/expiration-timestamp"
+	// DataKeyDiagnosticsBundle is the key in the data of a diagnostics bundle InternalSecret containing the gzip-compressed
+	// tarball.
+	DataKeyDiagnosticsBundle = "bundle.tar.gz"
+	// AnnotationShootAlertSilencesRevision is a key for an annotation on a Shoot resource that contains the resource
+	// version of the alert silences InternalSecret of the Shoot. The annotation is set by the gardener-apiserver when the
+	// 'shoots/alertsilence' subresource is requested and instructs gardenlet to push the silences to the Alertmanager of
+	// the Shoot.
+	AnnotationShootAlertSilencesRevision = "shoot.gardener.cloud/alert-silences-revision"
+	// DataKeyAlertSilences is the key in the data of an alert silences InternalSecret containing the JSON-encoded list of
+	// silences.
+	DataKeyAlertSilences = "silences"
+	// AnnotationShootCloneSource is a key for an annotation on a Shoot resource that contains the name of the Shoot (in
+	// the same namespace) whose latest etcd backup shall be restored when the Shoot is created. The annotation is set by
+	// the gardener-apiserver when the 'shoots/clone' subresource is requested with 'restoreFromBackup=true'.
+	AnnotationShootCloneSource = "shoot.gardener.cloud/clone-source"
+	// UserExtraShootCloneSource is a key for an extra of the user info on whose behalf the gardener-apiserver creates a
+	// Shoot when the 'shoots/clone' subresource is requested. It contains the name of the source Shoot and proves that
+	// the AnnotationShootCloneSource annotation was set by the gardener-apiserver, since users cannot set extras without
+	// being allowed to impersonate them.
+	UserExtraShootCloneSource = "shoot.gardener.cloud/clone-source"
+	// AnnotationShootRequirePlanApproval is a key for an annotation on a Shoot resource that instructs the extension
+	// controllers to only compute the changes of their Infrastructure and Worker resources (see the 'plan' operation)
+	// instead of applying them until they are approved via the AnnotationShootApprovedPlanGeneration annotation. Changes
+	// which are not caused by a change of the Shoot specification are applied without approval.
+	AnnotationShootRequirePlanApproval = "shoot.gardener.cloud/require-plan-approval"
+	// AnnotationShootApprovedPlanGeneration is a key for an annotation on a Shoot resource that contains the generation
+	// of the Shoot whose plans are approved (see AnnotationShootRequirePlanApproval). The approval is valid as long as the
+	// computed changes equal the approved plans.
+	AnnotationShootApprovedPlanGeneration = "shoot.gardener.cloud/approved-plan-generation"
 
 	// AnnotationAuthenticationIssuer is the key for an annotation applied to a Shoot which specifies
 	// if the shoot's issuer is managed by Gardener.
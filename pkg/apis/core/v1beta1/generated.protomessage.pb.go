@@ -23,12 +23,24 @@ func (*Addons) ProtoMessage() {}
 
 func (*AdmissionPlugin) ProtoMessage() {}
 
+func (*AlertMatcher) ProtoMessage() {}
+
+func (*AlertReceiver) ProtoMessage() {}
+
+func (*AlertSilence) ProtoMessage() {}
+
 func (*Alerting) ProtoMessage() {}
 
+func (*AuditBackends) ProtoMessage() {}
+
 func (*AuditConfig) ProtoMessage() {}
 
+func (*AuditLogBackend) ProtoMessage() {}
+
 func (*AuditPolicy) ProtoMessage() {}
 
+func (*AuditWebhookBackend) ProtoMessage() {}
+
 func (*AuthorizerKubeconfigReference) ProtoMessage() {}
 
 func (*AvailabilityZone) ProtoMessage() {}
@@ -91,6 +103,12 @@ func (*ControlPlane) ProtoMessage() {}
 
 func (*ControlPlaneAutoscaling) ProtoMessage() {}
 
+func (*ControlPlaneSLO) ProtoMessage() {}
+
+func (*ControlPlaneSLOReport) ProtoMessage() {}
+
+func (*ControlPlaneServiceLevel) ProtoMessage() {}
+
 func (*ControllerDeployment) ProtoMessage() {}
 
 func (*ControllerDeploymentList) ProtoMessage() {}
@@ -235,6 +253,10 @@ func (*Limits) ProtoMessage() {}
 
 func (*LoadBalancerServicesProxyProtocol) ProtoMessage() {}
 
+func (*Logging) ProtoMessage() {}
+
+func (*LoggingOutput) ProtoMessage() {}
+
 func (*Machine) ProtoMessage() {}
 
 func (*MachineControllerManagerSettings) ProtoMessage() {}
@@ -269,6 +291,8 @@ func (*MemorySwapConfiguration) ProtoMessage() {}
 
 func (*Monitoring) ProtoMessage() {}
 
+func (*MonitoringRules) ProtoMessage() {}
+
 func (*NamedResourceReference) ProtoMessage() {}
 
 func (*NamespacedCloudProfile) ProtoMessage() {}
@@ -341,6 +365,8 @@ func (*SeedDNSProvider) ProtoMessage() {}
 
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 
+func (*SeedDrainStatus) ProtoMessage() {}
+
 func (*SeedList) ProtoMessage() {}
 
 func (*SeedNetworks) ProtoMessage() {}
@@ -407,6 +433,10 @@ func (*ShootMachineImage) ProtoMessage() {}
 
 func (*ShootNetworks) ProtoMessage() {}
 
+func (*ShootPlan) ProtoMessage() {}
+
+func (*ShootPlannedChange) ProtoMessage() {}
+
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 
 func (*ShootSpec) ProtoMessage() {}
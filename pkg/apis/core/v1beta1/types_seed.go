@@ -125,8 +125,46 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// Drain contains information about the drain of the Seed, i.e., about the migration of all its Shoots to other
+	// Seeds. It is set when the Seed is annotated with `gardener.cloud/operation=drain`.
+	// +optional
+	Drain *SeedDrainStatus `json:"drain,omitempty" protobuf:"bytes,10,opt,name=drain"`
+}
+
+// SeedDrainStatus contains information about the drain of a Seed.
+type SeedDrainStatus struct {
+	// Phase is the phase of the drain.
+	Phase SeedDrainPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=SeedDrainPhase"`
+	// StartTime is the time when the drain was started.
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,2,opt,name=startTime"`
+	// CompletionTime is the time when all Shoots have been migrated off the Seed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,3,opt,name=completionTime"`
+	// LastUpdateTime is the time when the progress of the drain was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+	// RemainingShoots is the number of Shoots which have not been migrated off the Seed yet, including the ones which
+	// are currently being migrated.
+	RemainingShoots int32 `json:"remainingShoots" protobuf:"varint,5,opt,name=remainingShoots"`
+	// MigratingShoots is the list of Shoots (in the format `<namespace>/<name>`) which are currently being migrated off
+	// the Seed.
+	// +optional
+	MigratingShoots []string `json:"migratingShoots,omitempty" protobuf:"bytes,6,rep,name=migratingShoots"`
+	// UnschedulableShoots is the list of Shoots (in the format `<namespace>/<name>`) which could not be rescheduled to
+	// another Seed within the reschedule timeout. They are not rescheduled again while the drain is running.
+	// +optional
+	UnschedulableShoots []string `json:"unschedulableShoots,omitempty" protobuf:"bytes,7,rep,name=unschedulableShoots"`
 }
 
+// SeedDrainPhase is a string alias.
+type SeedDrainPhase string
+
+const (
+	// SeedDrainPhaseDraining is a constant for the phase of a drain while the Shoots are migrated off the Seed.
+	SeedDrainPhaseDraining SeedDrainPhase = "Draining"
+	// SeedDrainPhaseDrained is a constant for the phase of a drain after all Shoots have been migrated off the Seed.
+	SeedDrainPhaseDrained SeedDrainPhase = "Drained"
+)
+
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
 type Backup struct {
 	// Provider is a provider name. This field is immutable.
@@ -150,6 +150,9 @@ type ShootSpec struct {
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	// +optional
 	AccessRestrictions []AccessRestrictionWithOptions `json:"accessRestrictions,omitempty" protobuf:"bytes,24,rep,name=accessRestrictions"`
+	// Logging contains information about custom logging configurations for the shoot.
+	// +optional
+	Logging *Logging `json:"logging,omitempty" protobuf:"bytes,25,opt,name=logging"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -228,6 +231,94 @@ type ShootStatus struct {
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	// +optional
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout `json:"manualWorkerPoolRollout,omitempty" protobuf:"bytes,21,opt,name=manualWorkerPoolRollout"`
+	// ControlPlaneSLO contains the service levels achieved by the Shoot's control plane.
+	// +optional
+	ControlPlaneSLO *ControlPlaneSLO `json:"controlPlaneSLO,omitempty" protobuf:"bytes,22,opt,name=controlPlaneSLO"`
+	// Plans contains the changes the extension controllers would perform on the infrastructure and the machines of the
+	// Shoot. Plans are computed if the `shoot.gardener.cloud/require-plan-approval` annotation is set.
+	// +optional
+	Plans []ShootPlan `json:"plans,omitempty" protobuf:"bytes,23,rep,name=plans"`
+}
+
+// ShootPlan contains the changes an extension controller would perform to reach the desired state of an extension
+// resource of the Shoot.
+type ShootPlan struct {
+	// Kind is the kind of the extension resource, i.e., `Infrastructure` or `Worker`.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name is the name of the extension resource.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// ShootGeneration is the generation of the Shoot the plan was computed for. Plans are approved by setting the
+	// `shoot.gardener.cloud/approved-plan-generation` annotation to this generation.
+	ShootGeneration int64 `json:"shootGeneration" protobuf:"varint,3,opt,name=shootGeneration"`
+	// LastUpdateTime is the timestamp when the plan was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+	// Changes is the list of changes which would be performed. It is empty if the extension resource is already in its
+	// desired state.
+	// +optional
+	Changes []ShootPlannedChange `json:"changes,omitempty" protobuf:"bytes,5,rep,name=changes"`
+	// Error is the description of the error which prevented computing the plan.
+	// +optional
+	Error *string `json:"error,omitempty" protobuf:"bytes,6,opt,name=error"`
+}
+
+// ShootPlannedChange is a single change of a resource which would be performed by an extension controller.
+type ShootPlannedChange struct {
+	// Kind is the kind of the affected resource, e.g. `aws_vpc` or `MachineDeployment`.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name is the name of the affected resource.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Action is the action which would be performed on the resource, i.e., one of `Create`, `Update`, `Replace`,
+	// `Delete` or `Rollout`.
+	Action string `json:"action" protobuf:"bytes,3,opt,name=action"`
+	// Description is an optional human-readable description of the change.
+	// +optional
+	Description *string `json:"description,omitempty" protobuf:"bytes,4,opt,name=description"`
+}
+
+// ControlPlaneSLO contains the service levels achieved by the Shoot's control plane.
+type ControlPlaneSLO struct {
+	// Window is the duration of the rolling window over which the service levels are computed.
+	Window metav1.Duration `json:"window" protobuf:"bytes,1,opt,name=window"`
+	// Availability is the service level of the API server availability, i.e., the ratio of successful probes of the
+	// API server's health endpoint.
+	// +optional
+	Availability *ControlPlaneServiceLevel `json:"availability,omitempty" protobuf:"bytes,2,opt,name=availability"`
+	// Latency is the service level of the API server latency, i.e., the ratio of non-long-running requests which were
+	// served within one second.
+	// +optional
+	Latency *ControlPlaneServiceLevel `json:"latency,omitempty" protobuf:"bytes,3,opt,name=latency"`
+	// LastUpdateTime is the last time the service levels changed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+	// Reports contains the service levels achieved in past calendar months, the most recent month first.
+	// +optional
+	Reports []ControlPlaneSLOReport `json:"reports,omitempty" protobuf:"bytes,5,rep,name=reports"`
+}
+
+// ControlPlaneServiceLevel contains a service level objective together with the achieved service level.
+type ControlPlaneServiceLevel struct {
+	// Objective is the targeted service level in percent, e.g. "99.9".
+	Objective string `json:"objective" protobuf:"bytes,1,opt,name=objective"`
+	// Actual is the achieved service level in percent.
+	Actual string `json:"actual" protobuf:"bytes,2,opt,name=actual"`
+	// ErrorBudgetRemaining is the remaining error budget in percent. It becomes negative when the objective is missed.
+	ErrorBudgetRemaining string `json:"errorBudgetRemaining" protobuf:"bytes,3,opt,name=errorBudgetRemaining"`
+}
+
+// ControlPlaneSLOReport contains the service levels achieved by the Shoot's control plane in a calendar month.
+type ControlPlaneSLOReport struct {
+	// Month is the reported calendar month (UTC) in the format YYYY-MM.
+	Month string `json:"month" protobuf:"bytes,1,opt,name=month"`
+	// Availability is the service level of the API server availability in the reported month.
+	// +optional
+	Availability *ControlPlaneServiceLevel `json:"availability,omitempty" protobuf:"bytes,2,opt,name=availability"`
+	// Latency is the service level of the API server latency in the reported month.
+	// +optional
+	Latency *ControlPlaneServiceLevel `json:"latency,omitempty" protobuf:"bytes,3,opt,name=latency"`
+	// Coverage is the ratio of the recorded samples of the service level indicators to the expected number of samples
+	// in the reported month (since the creation of the Shoot) in percent. A coverage below 100 means that the service
+	// levels are based on incomplete data, e.g. because the Shoot was hibernated or the data was no longer retained.
+	// +optional
+	Coverage *string `json:"coverage,omitempty" protobuf:"bytes,4,opt,name=coverage"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -1127,6 +1218,63 @@ type AuditPolicy struct {
 	// which contains the audit policy for the kube-apiserver.
 	// +optional
 	ConfigMapRef *corev1.ObjectReference `json:"configMapRef,omitempty" protobuf:"bytes,1,opt,name=configMapRef"`
+	// Preset is the name of a predefined audit policy. It is mutually exclusive with ConfigMapRef.
+	// Possible values are `Minimal`, `CIS` and `PCI`.
+	// +optional
+	Preset *AuditPolicyPreset `json:"preset,omitempty" protobuf:"bytes,2,opt,name=preset,casttype=AuditPolicyPreset"`
+}
+
+// AuditPolicyPreset is the name of a predefined audit policy.
+type AuditPolicyPreset string
+
+const (
+	// AuditPolicyPresetMinimal is a preset which records the metadata of all mutating requests and of all requests to
+	// secrets, configmaps and tokens.
+	AuditPolicyPresetMinimal AuditPolicyPreset = "Minimal"
+	// AuditPolicyPresetCIS is a preset which follows the recommendations of the CIS Kubernetes Benchmark. It records
+	// the request bodies of read requests and the request and response bodies of mutating requests.
+	AuditPolicyPresetCIS AuditPolicyPreset = "CIS"
+	// AuditPolicyPresetPCI is a preset which follows the requirements of PCI DSS. It records all requests including
+	// reads, the request and response bodies of changes to identities and permissions, and never the bodies of secrets.
+	AuditPolicyPresetPCI AuditPolicyPreset = "PCI"
+)
+
+// AuditBackends contains configuration for the backends the audit events are sent to.
+type AuditBackends struct {
+	// Log contains configuration for the log backend.
+	// +optional
+	Log *AuditLogBackend `json:"log,omitempty" protobuf:"bytes,1,opt,name=log"`
+	// Webhook contains configuration for the webhook backend.
+	// +optional
+	Webhook *AuditWebhookBackend `json:"webhook,omitempty" protobuf:"bytes,2,opt,name=webhook"`
+}
+
+// AuditLogBackend contains configuration for the log backend.
+type AuditLogBackend struct {
+	// Enabled indicates whether the audit events are written to the log of the kube-apiserver and are shipped into the
+	// logging stack of the shoot control plane.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// AuditWebhookBackend contains configuration for the webhook backend.
+type AuditWebhookBackend struct {
+	// ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the kubeconfig
+	// of the webhook in the `kubeconfig` data key.
+	ResourceName string `json:"resourceName" protobuf:"bytes,1,opt,name=resourceName"`
+	// BatchMaxSize is the maximum number of audit events in a batch.
+	// +optional
+	BatchMaxSize *int32 `json:"batchMaxSize,omitempty" protobuf:"varint,2,opt,name=batchMaxSize"`
+	// BatchMaxWait is the amount of time to wait before sending a batch which has not reached the maximum size.
+	// +optional
+	BatchMaxWait *metav1.Duration `json:"batchMaxWait,omitempty" protobuf:"bytes,3,opt,name=batchMaxWait"`
+	// BatchBufferSize is the number of audit events buffered before they are batched and sent. Events are dropped if
+	// the buffer is full.
+	// +optional
+	BatchBufferSize *int32 `json:"batchBufferSize,omitempty" protobuf:"varint,4,opt,name=batchBufferSize"`
+	// InitialBackoff is the amount of time to wait before retrying a failed request. Subsequent retries back off
+	// exponentially.
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty" protobuf:"bytes,5,opt,name=initialBackoff"`
 }
 
 // StructuredAuthentication contains authentication config for kube-apiserver.
@@ -1710,11 +1858,76 @@ type MaintenanceTimeWindow struct {
 	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
 }
 
+// Logging contains information about the logging configuration for the shoot.
+type Logging struct {
+	// Outputs is a list of customer-owned endpoints to which logs of the shoot's control plane are forwarded.
+	// +optional
+	Outputs []LoggingOutput `json:"outputs,omitempty" protobuf:"bytes,1,rep,name=outputs"`
+	// AuditBackends contains configuration for the backends the audit events of the shoot's kube-apiserver are sent to.
+	// Multiple backends can be used simultaneously. An audit policy must be configured in
+	// `.spec.kubernetes.kubeAPIServer.auditConfig.auditPolicy` when backends are configured.
+	// +optional
+	AuditBackends *AuditBackends `json:"auditBackends,omitempty" protobuf:"bytes,2,opt,name=auditBackends"`
+}
+
+// LoggingOutput contains information about an endpoint to which logs of the shoot's control plane are forwarded.
+type LoggingOutput struct {
+	// Name is the name of the output.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the output. Supported types are 'otlp', 'loki', 'http', and 'syslog'.
+	Type LoggingOutputType `json:"type" protobuf:"bytes,2,opt,name=type,casttype=LoggingOutputType"`
+	// URL is the URL of the endpoint. The scheme must be 'http' or 'https' for 'otlp', 'loki', and 'http' outputs, and
+	// one of 'tcp', 'tls', or 'udp' for 'syslog' outputs.
+	URL string `json:"url" protobuf:"bytes,3,opt,name=url"`
+	// Sources is a list of log sources ('audit', 'control-plane') which are forwarded to the output.
+	Sources []LoggingSource `json:"sources" protobuf:"bytes,4,rep,name=sources,casttype=LoggingSource"`
+	// ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the credentials
+	// of the endpoint. The Secret may contain the keys 'username' and 'password' (otlp, loki, http), and 'bearerToken'
+	// and 'tenantID' (loki).
+	// +optional
+	ResourceName *string `json:"resourceName,omitempty" protobuf:"bytes,5,opt,name=resourceName"`
+}
+
+// LoggingOutputType is a type alias for the type of a logging output.
+type LoggingOutputType string
+
+const (
+	// LoggingOutputTypeOTLP is the type of an OpenTelemetry protocol (OTLP/HTTP) endpoint.
+	LoggingOutputTypeOTLP LoggingOutputType = "otlp"
+	// LoggingOutputTypeLoki is the type of a Loki push API endpoint.
+	LoggingOutputTypeLoki LoggingOutputType = "loki"
+	// LoggingOutputTypeHTTP is the type of a generic HTTP endpoint accepting JSON.
+	LoggingOutputTypeHTTP LoggingOutputType = "http"
+	// LoggingOutputTypeSyslog is the type of a syslog server.
+	LoggingOutputTypeSyslog LoggingOutputType = "syslog"
+)
+
+// LoggingSource is a type alias for a source of logs which can be forwarded.
+type LoggingSource string
+
+const (
+	// LoggingSourceAudit is the source for the audit events of the kube-apiserver.
+	LoggingSourceAudit LoggingSource = "audit"
+	// LoggingSourceControlPlane is the source for the logs of all components in the shoot's control plane.
+	LoggingSourceControlPlane LoggingSource = "control-plane"
+)
+
 // Monitoring contains information about the monitoring configuration for the shoot.
 type Monitoring struct {
 	// Alerting contains information about the alerting configuration for the shoot cluster.
 	// +optional
 	Alerting *Alerting `json:"alerting,omitempty" protobuf:"bytes,1,opt,name=alerting"`
+	// Rules contains information about additional user-defined recording and alerting rules which are evaluated by the
+	// shoot's control plane Prometheus.
+	// +optional
+	Rules *MonitoringRules `json:"rules,omitempty" protobuf:"bytes,2,opt,name=rules"`
+}
+
+// MonitoringRules contains information about additional user-defined Prometheus rules.
+type MonitoringRules struct {
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains the Prometheus rule groups.
+	// The rule groups must be stored in the data key `rules.yaml` using the Prometheus rule file format.
+	ConfigMapName string `json:"configMapName" protobuf:"bytes,1,opt,name=configMapName"`
 }
 
 // Alerting contains information about how alerting will be done (i.e. who will receive alerts and how).
@@ -1722,6 +1935,78 @@ type Alerting struct {
 	// MonitoringEmailReceivers is a list of recipients for alerts
 	// +optional
 	EmailReceivers []string `json:"emailReceivers,omitempty" protobuf:"bytes,1,rep,name=emailReceivers"`
+	// Receivers is a list of receivers (e.g., webhooks or incident management tools) to which alerts are sent.
+	// +optional
+	Receivers []AlertReceiver `json:"receivers,omitempty" protobuf:"bytes,2,rep,name=receivers"`
+}
+
+// AlertReceiver contains information about a receiver to which alerts are sent.
+type AlertReceiver struct {
+	// Name is the name of the receiver.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the receiver. Supported types are 'webhook', 'slack', 'pagerduty', 'opsgenie', and 'msteams'.
+	Type AlertReceiverType `json:"type" protobuf:"bytes,2,opt,name=type,casttype=AlertReceiverType"`
+	// ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the credentials
+	// of the receiver. Depending on the type, the Secret must contain the key 'url' (webhook, slack, msteams),
+	// 'routingKey' (pagerduty), or 'apiKey' (opsgenie).
+	ResourceName string `json:"resourceName" protobuf:"bytes,3,opt,name=resourceName"`
+	// Severities is a list of alert severities ('info', 'warning', 'critical', 'blocker') which are sent to the
+	// receiver. If empty, alerts of all severities are sent.
+	// +optional
+	Severities []string `json:"severities,omitempty" protobuf:"bytes,4,rep,name=severities"`
+	// Visibilities is a list of alert visibilities ('all', 'owner') which are sent to the receiver. If empty, alerts of
+	// all visibilities are sent.
+	// +optional
+	Visibilities []string `json:"visibilities,omitempty" protobuf:"bytes,5,rep,name=visibilities"`
+}
+
+// AlertReceiverType is a type alias for the type of an alert receiver.
+type AlertReceiverType string
+
+const (
+	// AlertReceiverTypeWebhook is the type of a generic webhook receiver.
+	AlertReceiverTypeWebhook AlertReceiverType = "webhook"
+	// AlertReceiverTypeSlack is the type of a Slack-compatible incoming webhook receiver.
+	AlertReceiverTypeSlack AlertReceiverType = "slack"
+	// AlertReceiverTypePagerDuty is the type of a PagerDuty receiver.
+	AlertReceiverTypePagerDuty AlertReceiverType = "pagerduty"
+	// AlertReceiverTypeOpsgenie is the type of an Opsgenie receiver.
+	AlertReceiverTypeOpsgenie AlertReceiverType = "opsgenie"
+	// AlertReceiverTypeMSTeams is the type of a Microsoft Teams receiver.
+	AlertReceiverTypeMSTeams AlertReceiverType = "msteams"
+)
+
+// AlertSilence contains information about a silence which mutes notifications for matching alerts. Silences are not
+// part of the Shoot specification. They are managed via the `shoots/alertsilence` subresource, stored in the
+// `<shoot-name>.alert-silences` InternalSecret in the project namespace, and pushed to the Alertmanager of the Shoot
+// by gardenlet.
+type AlertSilence struct {
+	// Name is the name of the silence.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Matchers is a list of label matchers which all have to match an alert to be silenced.
+	Matchers []AlertMatcher `json:"matchers" protobuf:"bytes,2,rep,name=matchers"`
+	// StartsAt is the time when the silence starts. If not set, the silence starts immediately.
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty" protobuf:"bytes,3,opt,name=startsAt"`
+	// EndsAt is the time when the silence ends.
+	EndsAt metav1.Time `json:"endsAt" protobuf:"bytes,4,opt,name=endsAt"`
+	// CreatedBy is the name of the user who created the silence.
+	// +optional
+	CreatedBy *string `json:"createdBy,omitempty" protobuf:"bytes,5,opt,name=createdBy"`
+	// Comment is a comment describing the silence.
+	// +optional
+	Comment *string `json:"comment,omitempty" protobuf:"bytes,6,opt,name=comment"`
+}
+
+// AlertMatcher is a matcher for an alert label.
+type AlertMatcher struct {
+	// Name is the name of the alert label.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the alert label.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+	// Regex specifies whether the value is a regular expression.
+	// +optional
+	Regex bool `json:"regex,omitempty" protobuf:"varint,3,opt,name=regex"`
 }
 
 // Provider contains provider-specific information that are handed-over to the provider-specific
@@ -2148,6 +2433,9 @@ const (
 	ShootEveryNodeReady ConditionType = "EveryNodeReady"
 	// ShootSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	ShootSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// ShootVPNConnectivity is a constant for a condition type indicating the connectivity between the control plane
+	// and the shoot cluster through the VPN.
+	ShootVPNConnectivity ConditionType = "VPNConnectivity"
 	// ShootHibernationPossible is a constant for a condition type indicating whether the Shoot can be hibernated.
 	ShootHibernationPossible ConditionType = "HibernationPossible"
 	// ShootMaintenancePreconditionsSatisfied is a constant for a condition type indicating whether all preconditions
@@ -2162,6 +2450,9 @@ const (
 	// ShootManualInPlaceWorkersUpdated is a constant for a condition type indicating that the Shoot cluster does not have
 	// any worker pools with update strategy "ManualInPlaceUpdate" and pending update.
 	ShootManualInPlaceWorkersUpdated ConditionType = "ManualInPlaceWorkersUpdated"
+	// ShootUpgradeReadiness is a constant for a condition type indicating whether the Shoot cluster is ready for an
+	// update to the next Kubernetes minor version, i.e., whether no APIs are requested which are removed in that version.
+	ShootUpgradeReadiness ConditionType = "UpgradeReadiness"
 	// ShootReadyForMigration is a constant for a condition type indicating whether the Shoot can be migrated.
 	ShootReadyForMigration ConditionType = "ReadyForMigration"
 	// ShootDualStackNodesMigrationReady is a constant for a condition type indicating whether all nodes are migrated to dual-stack .
@@ -102,6 +102,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AlertMatcher)(nil), (*core.AlertMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertMatcher_To_core_AlertMatcher(a.(*AlertMatcher), b.(*core.AlertMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertMatcher)(nil), (*AlertMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertMatcher_To_v1beta1_AlertMatcher(a.(*core.AlertMatcher), b.(*AlertMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertReceiver)(nil), (*core.AlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AlertReceiver_To_core_AlertReceiver(a.(*AlertReceiver), b.(*core.AlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AlertReceiver)(nil), (*AlertReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AlertReceiver_To_v1beta1_AlertReceiver(a.(*core.AlertReceiver), b.(*AlertReceiver), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*core.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Alerting_To_core_Alerting(a.(*Alerting), b.(*core.Alerting), scope)
 	}); err != nil {
@@ -112,6 +132,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditBackends)(nil), (*core.AuditBackends)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditBackends_To_core_AuditBackends(a.(*AuditBackends), b.(*core.AuditBackends), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditBackends)(nil), (*AuditBackends)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditBackends_To_v1beta1_AuditBackends(a.(*core.AuditBackends), b.(*AuditBackends), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditConfig)(nil), (*core.AuditConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuditConfig_To_core_AuditConfig(a.(*AuditConfig), b.(*core.AuditConfig), scope)
 	}); err != nil {
@@ -122,6 +152,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditLogBackend)(nil), (*core.AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(a.(*AuditLogBackend), b.(*core.AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditLogBackend)(nil), (*AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(a.(*core.AuditLogBackend), b.(*AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditPolicy)(nil), (*core.AuditPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuditPolicy_To_core_AuditPolicy(a.(*AuditPolicy), b.(*core.AuditPolicy), scope)
 	}); err != nil {
@@ -132,6 +172,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditWebhookBackend)(nil), (*core.AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(a.(*AuditWebhookBackend), b.(*core.AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditWebhookBackend)(nil), (*AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(a.(*core.AuditWebhookBackend), b.(*AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuthorizerKubeconfigReference)(nil), (*core.AuthorizerKubeconfigReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(a.(*AuthorizerKubeconfigReference), b.(*core.AuthorizerKubeconfigReference), scope)
 	}); err != nil {
@@ -432,6 +482,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneSLO)(nil), (*core.ControlPlaneSLO)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneSLO_To_core_ControlPlaneSLO(a.(*ControlPlaneSLO), b.(*core.ControlPlaneSLO), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneSLO)(nil), (*ControlPlaneSLO)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneSLO_To_v1beta1_ControlPlaneSLO(a.(*core.ControlPlaneSLO), b.(*ControlPlaneSLO), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneSLOReport)(nil), (*core.ControlPlaneSLOReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneSLOReport_To_core_ControlPlaneSLOReport(a.(*ControlPlaneSLOReport), b.(*core.ControlPlaneSLOReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneSLOReport)(nil), (*ControlPlaneSLOReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneSLOReport_To_v1beta1_ControlPlaneSLOReport(a.(*core.ControlPlaneSLOReport), b.(*ControlPlaneSLOReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneServiceLevel)(nil), (*core.ControlPlaneServiceLevel)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneServiceLevel_To_core_ControlPlaneServiceLevel(a.(*ControlPlaneServiceLevel), b.(*core.ControlPlaneServiceLevel), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneServiceLevel)(nil), (*ControlPlaneServiceLevel)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneServiceLevel_To_v1beta1_ControlPlaneServiceLevel(a.(*core.ControlPlaneServiceLevel), b.(*ControlPlaneServiceLevel), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentList)(nil), (*core.ControllerDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerDeploymentList_To_core_ControllerDeploymentList(a.(*ControllerDeploymentList), b.(*core.ControllerDeploymentList), scope)
 	}); err != nil {
@@ -1127,6 +1207,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Logging)(nil), (*core.Logging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Logging_To_core_Logging(a.(*Logging), b.(*core.Logging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Logging)(nil), (*Logging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Logging_To_v1beta1_Logging(a.(*core.Logging), b.(*Logging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoggingOutput)(nil), (*core.LoggingOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LoggingOutput_To_core_LoggingOutput(a.(*LoggingOutput), b.(*core.LoggingOutput), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LoggingOutput)(nil), (*LoggingOutput)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LoggingOutput_To_v1beta1_LoggingOutput(a.(*core.LoggingOutput), b.(*LoggingOutput), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*core.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Machine_To_core_Machine(a.(*Machine), b.(*core.Machine), scope)
 	}); err != nil {
@@ -1297,6 +1397,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringRules)(nil), (*core.MonitoringRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MonitoringRules_To_core_MonitoringRules(a.(*MonitoringRules), b.(*core.MonitoringRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MonitoringRules)(nil), (*MonitoringRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MonitoringRules_To_v1beta1_MonitoringRules(a.(*core.MonitoringRules), b.(*MonitoringRules), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedResourceReference)(nil), (*core.NamedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_NamedResourceReference_To_core_NamedResourceReference(a.(*NamedResourceReference), b.(*core.NamedResourceReference), scope)
 	}); err != nil {
@@ -1637,6 +1747,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDrainStatus)(nil), (*core.SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(a.(*SeedDrainStatus), b.(*core.SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedDrainStatus)(nil), (*SeedDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(a.(*core.SeedDrainStatus), b.(*SeedDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*core.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_core_SeedList(a.(*SeedList), b.(*core.SeedList), scope)
 	}); err != nil {
@@ -1967,6 +2087,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootPlan)(nil), (*core.ShootPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootPlan_To_core_ShootPlan(a.(*ShootPlan), b.(*core.ShootPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootPlan)(nil), (*ShootPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootPlan_To_v1beta1_ShootPlan(a.(*core.ShootPlan), b.(*ShootPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPlannedChange)(nil), (*core.ShootPlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootPlannedChange_To_core_ShootPlannedChange(a.(*ShootPlannedChange), b.(*core.ShootPlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootPlannedChange)(nil), (*ShootPlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootPlannedChange_To_v1beta1_ShootPlannedChange(a.(*core.ShootPlannedChange), b.(*ShootPlannedChange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSSHKeypairRotation)(nil), (*core.ShootSSHKeypairRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(a.(*ShootSSHKeypairRotation), b.(*core.ShootSSHKeypairRotation), scope)
 	}); err != nil {
@@ -2373,8 +2513,61 @@ func Convert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *core.AdmissionP
 	return autoConvert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in, out, s)
 }
 
+func autoConvert_v1beta1_AlertMatcher_To_core_AlertMatcher(in *AlertMatcher, out *core.AlertMatcher, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_v1beta1_AlertMatcher_To_core_AlertMatcher is an autogenerated conversion function.
+func Convert_v1beta1_AlertMatcher_To_core_AlertMatcher(in *AlertMatcher, out *core.AlertMatcher, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertMatcher_To_core_AlertMatcher(in, out, s)
+}
+
+func autoConvert_core_AlertMatcher_To_v1beta1_AlertMatcher(in *core.AlertMatcher, out *AlertMatcher, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_core_AlertMatcher_To_v1beta1_AlertMatcher is an autogenerated conversion function.
+func Convert_core_AlertMatcher_To_v1beta1_AlertMatcher(in *core.AlertMatcher, out *AlertMatcher, s conversion.Scope) error {
+	return autoConvert_core_AlertMatcher_To_v1beta1_AlertMatcher(in, out, s)
+}
+
+func autoConvert_v1beta1_AlertReceiver_To_core_AlertReceiver(in *AlertReceiver, out *core.AlertReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = core.AlertReceiverType(in.Type)
+	out.ResourceName = in.ResourceName
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.Visibilities = *(*[]string)(unsafe.Pointer(&in.Visibilities))
+	return nil
+}
+
+// Convert_v1beta1_AlertReceiver_To_core_AlertReceiver is an autogenerated conversion function.
+func Convert_v1beta1_AlertReceiver_To_core_AlertReceiver(in *AlertReceiver, out *core.AlertReceiver, s conversion.Scope) error {
+	return autoConvert_v1beta1_AlertReceiver_To_core_AlertReceiver(in, out, s)
+}
+
+func autoConvert_core_AlertReceiver_To_v1beta1_AlertReceiver(in *core.AlertReceiver, out *AlertReceiver, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = AlertReceiverType(in.Type)
+	out.ResourceName = in.ResourceName
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.Visibilities = *(*[]string)(unsafe.Pointer(&in.Visibilities))
+	return nil
+}
+
+// Convert_core_AlertReceiver_To_v1beta1_AlertReceiver is an autogenerated conversion function.
+func Convert_core_AlertReceiver_To_v1beta1_AlertReceiver(in *core.AlertReceiver, out *AlertReceiver, s conversion.Scope) error {
+	return autoConvert_core_AlertReceiver_To_v1beta1_AlertReceiver(in, out, s)
+}
+
 func autoConvert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
+	out.Receivers = *(*[]core.AlertReceiver)(unsafe.Pointer(&in.Receivers))
 	return nil
 }
 
@@ -2385,6 +2578,7 @@ func Convert_v1beta1_Alerting_To_core_Alerting(in *Alerting, out *core.Alerting,
 
 func autoConvert_core_Alerting_To_v1beta1_Alerting(in *core.Alerting, out *Alerting, s conversion.Scope) error {
 	out.EmailReceivers = *(*[]string)(unsafe.Pointer(&in.EmailReceivers))
+	out.Receivers = *(*[]AlertReceiver)(unsafe.Pointer(&in.Receivers))
 	return nil
 }
 
@@ -2393,6 +2587,28 @@ func Convert_core_Alerting_To_v1beta1_Alerting(in *core.Alerting, out *Alerting,
 	return autoConvert_core_Alerting_To_v1beta1_Alerting(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditBackends_To_core_AuditBackends(in *AuditBackends, out *core.AuditBackends, s conversion.Scope) error {
+	out.Log = (*core.AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*core.AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1beta1_AuditBackends_To_core_AuditBackends is an autogenerated conversion function.
+func Convert_v1beta1_AuditBackends_To_core_AuditBackends(in *AuditBackends, out *core.AuditBackends, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditBackends_To_core_AuditBackends(in, out, s)
+}
+
+func autoConvert_core_AuditBackends_To_v1beta1_AuditBackends(in *core.AuditBackends, out *AuditBackends, s conversion.Scope) error {
+	out.Log = (*AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_core_AuditBackends_To_v1beta1_AuditBackends is an autogenerated conversion function.
+func Convert_core_AuditBackends_To_v1beta1_AuditBackends(in *core.AuditBackends, out *AuditBackends, s conversion.Scope) error {
+	return autoConvert_core_AuditBackends_To_v1beta1_AuditBackends(in, out, s)
+}
+
 func autoConvert_v1beta1_AuditConfig_To_core_AuditConfig(in *AuditConfig, out *core.AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*core.AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
 	return nil
@@ -2413,8 +2629,29 @@ func Convert_core_AuditConfig_To_v1beta1_AuditConfig(in *core.AuditConfig, out *
 	return autoConvert_core_AuditConfig_To_v1beta1_AuditConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(in *AuditLogBackend, out *core.AuditLogBackend, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_AuditLogBackend_To_core_AuditLogBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(in *AuditLogBackend, out *core.AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditLogBackend_To_core_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(in *core.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_core_AuditLogBackend_To_v1beta1_AuditLogBackend is an autogenerated conversion function.
+func Convert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(in *core.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_core_AuditLogBackend_To_v1beta1_AuditLogBackend(in, out, s)
+}
+
 func autoConvert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.AuditPolicy, s conversion.Scope) error {
 	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.Preset = (*core.AuditPolicyPreset)(unsafe.Pointer(in.Preset))
 	return nil
 }
 
@@ -2425,6 +2662,7 @@ func Convert_v1beta1_AuditPolicy_To_core_AuditPolicy(in *AuditPolicy, out *core.
 
 func autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *AuditPolicy, s conversion.Scope) error {
 	out.ConfigMapRef = (*v1.ObjectReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.Preset = (*AuditPolicyPreset)(unsafe.Pointer(in.Preset))
 	return nil
 }
 
@@ -2433,6 +2671,34 @@ func Convert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *
 	return autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(in *AuditWebhookBackend, out *core.AuditWebhookBackend, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.BatchMaxSize = (*int32)(unsafe.Pointer(in.BatchMaxSize))
+	out.BatchMaxWait = (*metav1.Duration)(unsafe.Pointer(in.BatchMaxWait))
+	out.BatchBufferSize = (*int32)(unsafe.Pointer(in.BatchBufferSize))
+	out.InitialBackoff = (*metav1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	return nil
+}
+
+// Convert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(in *AuditWebhookBackend, out *core.AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditWebhookBackend_To_core_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *core.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.BatchMaxSize = (*int32)(unsafe.Pointer(in.BatchMaxSize))
+	out.BatchMaxWait = (*metav1.Duration)(unsafe.Pointer(in.BatchMaxWait))
+	out.BatchBufferSize = (*int32)(unsafe.Pointer(in.BatchBufferSize))
+	out.InitialBackoff = (*metav1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	return nil
+}
+
+// Convert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in *core.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_core_AuditWebhookBackend_To_v1beta1_AuditWebhookBackend(in, out, s)
+}
+
 func autoConvert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(in *AuthorizerKubeconfigReference, out *core.AuthorizerKubeconfigReference, s conversion.Scope) error {
 	out.AuthorizerName = in.AuthorizerName
 	out.SecretName = in.SecretName
@@ -3231,6 +3497,84 @@ func Convert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in
 	return autoConvert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlaneSLO_To_core_ControlPlaneSLO(in *ControlPlaneSLO, out *core.ControlPlaneSLO, s conversion.Scope) error {
+	out.Window = in.Window
+	out.Availability = (*core.ControlPlaneServiceLevel)(unsafe.Pointer(in.Availability))
+	out.Latency = (*core.ControlPlaneServiceLevel)(unsafe.Pointer(in.Latency))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Reports = *(*[]core.ControlPlaneSLOReport)(unsafe.Pointer(&in.Reports))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneSLO_To_core_ControlPlaneSLO is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneSLO_To_core_ControlPlaneSLO(in *ControlPlaneSLO, out *core.ControlPlaneSLO, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneSLO_To_core_ControlPlaneSLO(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneSLO_To_v1beta1_ControlPlaneSLO(in *core.ControlPlaneSLO, out *ControlPlaneSLO, s conversion.Scope) error {
+	out.Window = in.Window
+	out.Availability = (*ControlPlaneServiceLevel)(unsafe.Pointer(in.Availability))
+	out.Latency = (*ControlPlaneServiceLevel)(unsafe.Pointer(in.Latency))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Reports = *(*[]ControlPlaneSLOReport)(unsafe.Pointer(&in.Reports))
+	return nil
+}
+
+// Convert_core_ControlPlaneSLO_To_v1beta1_ControlPlaneSLO is an autogenerated conversion function.
+func Convert_core_ControlPlaneSLO_To_v1beta1_ControlPlaneSLO(in *core.ControlPlaneSLO, out *ControlPlaneSLO, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneSLO_To_v1beta1_ControlPlaneSLO(in, out, s)
+}
+
+func autoConvert_v1beta1_ControlPlaneSLOReport_To_core_ControlPlaneSLOReport(in *ControlPlaneSLOReport, out *core.ControlPlaneSLOReport, s conversion.Scope) error {
+	out.Month = in.Month
+	out.Availability = (*core.ControlPlaneServiceLevel)(unsafe.Pointer(in.Availability))
+	out.Latency = (*core.ControlPlaneServiceLevel)(unsafe.Pointer(in.Latency))
+	out.Coverage = (*string)(unsafe.Pointer(in.Coverage))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneSLOReport_To_core_ControlPlaneSLOReport is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneSLOReport_To_core_ControlPlaneSLOReport(in *ControlPlaneSLOReport, out *core.ControlPlaneSLOReport, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneSLOReport_To_core_ControlPlaneSLOReport(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneSLOReport_To_v1beta1_ControlPlaneSLOReport(in *core.ControlPlaneSLOReport, out *ControlPlaneSLOReport, s conversion.Scope) error {
+	out.Month = in.Month
+	out.Availability = (*ControlPlaneServiceLevel)(unsafe.Pointer(in.Availability))
+	out.Latency = (*ControlPlaneServiceLevel)(unsafe.Pointer(in.Latency))
+	out.Coverage = (*string)(unsafe.Pointer(in.Coverage))
+	return nil
+}
+
+// Convert_core_ControlPlaneSLOReport_To_v1beta1_ControlPlaneSLOReport is an autogenerated conversion function.
+func Convert_core_ControlPlaneSLOReport_To_v1beta1_ControlPlaneSLOReport(in *core.ControlPlaneSLOReport, out *ControlPlaneSLOReport, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneSLOReport_To_v1beta1_ControlPlaneSLOReport(in, out, s)
+}
+
+func autoConvert_v1beta1_ControlPlaneServiceLevel_To_core_ControlPlaneServiceLevel(in *ControlPlaneServiceLevel, out *core.ControlPlaneServiceLevel, s conversion.Scope) error {
+	out.Objective = in.Objective
+	out.Actual = in.Actual
+	out.ErrorBudgetRemaining = in.ErrorBudgetRemaining
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneServiceLevel_To_core_ControlPlaneServiceLevel is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneServiceLevel_To_core_ControlPlaneServiceLevel(in *ControlPlaneServiceLevel, out *core.ControlPlaneServiceLevel, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneServiceLevel_To_core_ControlPlaneServiceLevel(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneServiceLevel_To_v1beta1_ControlPlaneServiceLevel(in *core.ControlPlaneServiceLevel, out *ControlPlaneServiceLevel, s conversion.Scope) error {
+	out.Objective = in.Objective
+	out.Actual = in.Actual
+	out.ErrorBudgetRemaining = in.ErrorBudgetRemaining
+	return nil
+}
+
+// Convert_core_ControlPlaneServiceLevel_To_v1beta1_ControlPlaneServiceLevel is an autogenerated conversion function.
+func Convert_core_ControlPlaneServiceLevel_To_v1beta1_ControlPlaneServiceLevel(in *core.ControlPlaneServiceLevel, out *ControlPlaneServiceLevel, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneServiceLevel_To_v1beta1_ControlPlaneServiceLevel(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Type = in.Type
@@ -5168,6 +5512,56 @@ func Convert_core_LoadBalancerServicesProxyProtocol_To_v1beta1_LoadBalancerServi
 	return autoConvert_core_LoadBalancerServicesProxyProtocol_To_v1beta1_LoadBalancerServicesProxyProtocol(in, out, s)
 }
 
+func autoConvert_v1beta1_Logging_To_core_Logging(in *Logging, out *core.Logging, s conversion.Scope) error {
+	out.Outputs = *(*[]core.LoggingOutput)(unsafe.Pointer(&in.Outputs))
+	out.AuditBackends = (*core.AuditBackends)(unsafe.Pointer(in.AuditBackends))
+	return nil
+}
+
+// Convert_v1beta1_Logging_To_core_Logging is an autogenerated conversion function.
+func Convert_v1beta1_Logging_To_core_Logging(in *Logging, out *core.Logging, s conversion.Scope) error {
+	return autoConvert_v1beta1_Logging_To_core_Logging(in, out, s)
+}
+
+func autoConvert_core_Logging_To_v1beta1_Logging(in *core.Logging, out *Logging, s conversion.Scope) error {
+	out.Outputs = *(*[]LoggingOutput)(unsafe.Pointer(&in.Outputs))
+	out.AuditBackends = (*AuditBackends)(unsafe.Pointer(in.AuditBackends))
+	return nil
+}
+
+// Convert_core_Logging_To_v1beta1_Logging is an autogenerated conversion function.
+func Convert_core_Logging_To_v1beta1_Logging(in *core.Logging, out *Logging, s conversion.Scope) error {
+	return autoConvert_core_Logging_To_v1beta1_Logging(in, out, s)
+}
+
+func autoConvert_v1beta1_LoggingOutput_To_core_LoggingOutput(in *LoggingOutput, out *core.LoggingOutput, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = core.LoggingOutputType(in.Type)
+	out.URL = in.URL
+	out.Sources = *(*[]core.LoggingSource)(unsafe.Pointer(&in.Sources))
+	out.ResourceName = (*string)(unsafe.Pointer(in.ResourceName))
+	return nil
+}
+
+// Convert_v1beta1_LoggingOutput_To_core_LoggingOutput is an autogenerated conversion function.
+func Convert_v1beta1_LoggingOutput_To_core_LoggingOutput(in *LoggingOutput, out *core.LoggingOutput, s conversion.Scope) error {
+	return autoConvert_v1beta1_LoggingOutput_To_core_LoggingOutput(in, out, s)
+}
+
+func autoConvert_core_LoggingOutput_To_v1beta1_LoggingOutput(in *core.LoggingOutput, out *LoggingOutput, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = LoggingOutputType(in.Type)
+	out.URL = in.URL
+	out.Sources = *(*[]LoggingSource)(unsafe.Pointer(&in.Sources))
+	out.ResourceName = (*string)(unsafe.Pointer(in.ResourceName))
+	return nil
+}
+
+// Convert_core_LoggingOutput_To_v1beta1_LoggingOutput is an autogenerated conversion function.
+func Convert_core_LoggingOutput_To_v1beta1_LoggingOutput(in *core.LoggingOutput, out *LoggingOutput, s conversion.Scope) error {
+	return autoConvert_core_LoggingOutput_To_v1beta1_LoggingOutput(in, out, s)
+}
+
 func autoConvert_v1beta1_Machine_To_core_Machine(in *Machine, out *core.Machine, s conversion.Scope) error {
 	out.Type = in.Type
 	if in.Image != nil {
@@ -5578,6 +5972,7 @@ func Convert_core_MemorySwapConfiguration_To_v1beta1_MemorySwapConfiguration(in
 
 func autoConvert_v1beta1_Monitoring_To_core_Monitoring(in *Monitoring, out *core.Monitoring, s conversion.Scope) error {
 	out.Alerting = (*core.Alerting)(unsafe.Pointer(in.Alerting))
+	out.Rules = (*core.MonitoringRules)(unsafe.Pointer(in.Rules))
 	return nil
 }
 
@@ -5588,6 +5983,7 @@ func Convert_v1beta1_Monitoring_To_core_Monitoring(in *Monitoring, out *core.Mon
 
 func autoConvert_core_Monitoring_To_v1beta1_Monitoring(in *core.Monitoring, out *Monitoring, s conversion.Scope) error {
 	out.Alerting = (*Alerting)(unsafe.Pointer(in.Alerting))
+	out.Rules = (*MonitoringRules)(unsafe.Pointer(in.Rules))
 	return nil
 }
 
@@ -5596,6 +5992,26 @@ func Convert_core_Monitoring_To_v1beta1_Monitoring(in *core.Monitoring, out *Mon
 	return autoConvert_core_Monitoring_To_v1beta1_Monitoring(in, out, s)
 }
 
+func autoConvert_v1beta1_MonitoringRules_To_core_MonitoringRules(in *MonitoringRules, out *core.MonitoringRules, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_v1beta1_MonitoringRules_To_core_MonitoringRules is an autogenerated conversion function.
+func Convert_v1beta1_MonitoringRules_To_core_MonitoringRules(in *MonitoringRules, out *core.MonitoringRules, s conversion.Scope) error {
+	return autoConvert_v1beta1_MonitoringRules_To_core_MonitoringRules(in, out, s)
+}
+
+func autoConvert_core_MonitoringRules_To_v1beta1_MonitoringRules(in *core.MonitoringRules, out *MonitoringRules, s conversion.Scope) error {
+	out.ConfigMapName = in.ConfigMapName
+	return nil
+}
+
+// Convert_core_MonitoringRules_To_v1beta1_MonitoringRules is an autogenerated conversion function.
+func Convert_core_MonitoringRules_To_v1beta1_MonitoringRules(in *core.MonitoringRules, out *MonitoringRules, s conversion.Scope) error {
+	return autoConvert_core_MonitoringRules_To_v1beta1_MonitoringRules(in, out, s)
+}
+
 func autoConvert_v1beta1_NamedResourceReference_To_core_NamedResourceReference(in *NamedResourceReference, out *core.NamedResourceReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceRef = in.ResourceRef
@@ -6571,6 +6987,38 @@ func Convert_core_SeedDNSProviderConfig_To_v1beta1_SeedDNSProviderConfig(in *cor
 	return autoConvert_core_SeedDNSProviderConfig_To_v1beta1_SeedDNSProviderConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	out.Phase = core.SeedDrainPhase(in.Phase)
+	out.StartTime = in.StartTime
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = *(*[]string)(unsafe.Pointer(&in.MigratingShoots))
+	out.UnschedulableShoots = *(*[]string)(unsafe.Pointer(&in.UnschedulableShoots))
+	return nil
+}
+
+// Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus is an autogenerated conversion function.
+func Convert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in *SeedDrainStatus, out *core.SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedDrainStatus_To_core_SeedDrainStatus(in, out, s)
+}
+
+func autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	out.Phase = SeedDrainPhase(in.Phase)
+	out.StartTime = in.StartTime
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.LastUpdateTime = in.LastUpdateTime
+	out.RemainingShoots = in.RemainingShoots
+	out.MigratingShoots = *(*[]string)(unsafe.Pointer(&in.MigratingShoots))
+	out.UnschedulableShoots = *(*[]string)(unsafe.Pointer(&in.UnschedulableShoots))
+	return nil
+}
+
+// Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus is an autogenerated conversion function.
+func Convert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in *core.SeedDrainStatus, out *SeedDrainStatus, s conversion.Scope) error {
+	return autoConvert_core_SeedDrainStatus_To_v1beta1_SeedDrainStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_core_SeedList(in *SeedList, out *core.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -7053,6 +7501,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*core.SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -7071,6 +7520,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.Drain = (*SeedDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -7447,6 +7897,62 @@ func Convert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks,
 	return autoConvert_core_ShootNetworks_To_v1beta1_ShootNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootPlan_To_core_ShootPlan(in *ShootPlan, out *core.ShootPlan, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.ShootGeneration = in.ShootGeneration
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Changes = *(*[]core.ShootPlannedChange)(unsafe.Pointer(&in.Changes))
+	out.Error = (*string)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_v1beta1_ShootPlan_To_core_ShootPlan is an autogenerated conversion function.
+func Convert_v1beta1_ShootPlan_To_core_ShootPlan(in *ShootPlan, out *core.ShootPlan, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootPlan_To_core_ShootPlan(in, out, s)
+}
+
+func autoConvert_core_ShootPlan_To_v1beta1_ShootPlan(in *core.ShootPlan, out *ShootPlan, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.ShootGeneration = in.ShootGeneration
+	out.LastUpdateTime = in.LastUpdateTime
+	out.Changes = *(*[]ShootPlannedChange)(unsafe.Pointer(&in.Changes))
+	out.Error = (*string)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_core_ShootPlan_To_v1beta1_ShootPlan is an autogenerated conversion function.
+func Convert_core_ShootPlan_To_v1beta1_ShootPlan(in *core.ShootPlan, out *ShootPlan, s conversion.Scope) error {
+	return autoConvert_core_ShootPlan_To_v1beta1_ShootPlan(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootPlannedChange_To_core_ShootPlannedChange(in *ShootPlannedChange, out *core.ShootPlannedChange, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Action = in.Action
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_v1beta1_ShootPlannedChange_To_core_ShootPlannedChange is an autogenerated conversion function.
+func Convert_v1beta1_ShootPlannedChange_To_core_ShootPlannedChange(in *ShootPlannedChange, out *core.ShootPlannedChange, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootPlannedChange_To_core_ShootPlannedChange(in, out, s)
+}
+
+func autoConvert_core_ShootPlannedChange_To_v1beta1_ShootPlannedChange(in *core.ShootPlannedChange, out *ShootPlannedChange, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Action = in.Action
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_core_ShootPlannedChange_To_v1beta1_ShootPlannedChange is an autogenerated conversion function.
+func Convert_core_ShootPlannedChange_To_v1beta1_ShootPlannedChange(in *core.ShootPlannedChange, out *ShootPlannedChange, s conversion.Scope) error {
+	return autoConvert_core_ShootPlannedChange_To_v1beta1_ShootPlannedChange(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in *ShootSSHKeypairRotation, out *core.ShootSSHKeypairRotation, s conversion.Scope) error {
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -7498,6 +8004,7 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]core.AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Logging = (*core.Logging)(unsafe.Pointer(in.Logging))
 	return nil
 }
 
@@ -7517,6 +8024,7 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	}
 	out.Networking = (*Networking)(unsafe.Pointer(in.Networking))
 	out.Maintenance = (*Maintenance)(unsafe.Pointer(in.Maintenance))
+	out.Logging = (*Logging)(unsafe.Pointer(in.Logging))
 	out.Monitoring = (*Monitoring)(unsafe.Pointer(in.Monitoring))
 	if err := Convert_core_Provider_To_v1beta1_Provider(&in.Provider, &out.Provider, s); err != nil {
 		return err
@@ -7638,6 +8146,8 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*core.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*core.ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.ControlPlaneSLO = (*core.ControlPlaneSLO)(unsafe.Pointer(in.ControlPlaneSLO))
+	out.Plans = *(*[]core.ShootPlan)(unsafe.Pointer(&in.Plans))
 	return nil
 }
 
@@ -7669,6 +8179,8 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.ControlPlaneSLO = (*ControlPlaneSLO)(unsafe.Pointer(in.ControlPlaneSLO))
+	out.Plans = *(*[]ShootPlan)(unsafe.Pointer(&in.Plans))
 	return nil
 }
 
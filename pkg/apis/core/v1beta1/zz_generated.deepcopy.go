@@ -184,6 +184,84 @@ func (in *AdmissionPlugin) DeepCopy() *AdmissionPlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertMatcher) DeepCopyInto(out *AlertMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertMatcher.
+func (in *AlertMatcher) DeepCopy() *AlertMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertReceiver) DeepCopyInto(out *AlertReceiver) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Visibilities != nil {
+		in, out := &in.Visibilities, &out.Visibilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertReceiver.
+func (in *AlertReceiver) DeepCopy() *AlertReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilence) DeepCopyInto(out *AlertSilence) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]AlertMatcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	in.EndsAt.DeepCopyInto(&out.EndsAt)
+	if in.CreatedBy != nil {
+		in, out := &in.CreatedBy, &out.CreatedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilence.
+func (in *AlertSilence) DeepCopy() *AlertSilence {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alerting) DeepCopyInto(out *Alerting) {
 	*out = *in
@@ -192,6 +270,13 @@ func (in *Alerting) DeepCopyInto(out *Alerting) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]AlertReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -205,6 +290,32 @@ func (in *Alerting) DeepCopy() *Alerting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditBackends) DeepCopyInto(out *AuditBackends) {
+	*out = *in
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(AuditLogBackend)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuditWebhookBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditBackends.
+func (in *AuditBackends) DeepCopy() *AuditBackends {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditBackends)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 	*out = *in
@@ -226,6 +337,22 @@ func (in *AuditConfig) DeepCopy() *AuditConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogBackend) DeepCopyInto(out *AuditLogBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogBackend.
+func (in *AuditLogBackend) DeepCopy() *AuditLogBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuditPolicy) DeepCopyInto(out *AuditPolicy) {
 	*out = *in
@@ -234,6 +361,11 @@ func (in *AuditPolicy) DeepCopyInto(out *AuditPolicy) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.Preset != nil {
+		in, out := &in.Preset, &out.Preset
+		*out = new(AuditPolicyPreset)
+		**out = **in
+	}
 	return
 }
 
@@ -247,6 +379,42 @@ func (in *AuditPolicy) DeepCopy() *AuditPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBackend) DeepCopyInto(out *AuditWebhookBackend) {
+	*out = *in
+	if in.BatchMaxSize != nil {
+		in, out := &in.BatchMaxSize, &out.BatchMaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchMaxWait != nil {
+		in, out := &in.BatchMaxWait, &out.BatchMaxWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BatchBufferSize != nil {
+		in, out := &in.BatchBufferSize, &out.BatchBufferSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBackend.
+func (in *AuditWebhookBackend) DeepCopy() *AuditWebhookBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizerKubeconfigReference) DeepCopyInto(out *AuthorizerKubeconfigReference) {
 	*out = *in
@@ -1241,6 +1409,88 @@ func (in *ControlPlaneAutoscaling) DeepCopy() *ControlPlaneAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneSLO) DeepCopyInto(out *ControlPlaneSLO) {
+	*out = *in
+	out.Window = in.Window
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(ControlPlaneServiceLevel)
+		**out = **in
+	}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(ControlPlaneServiceLevel)
+		**out = **in
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Reports != nil {
+		in, out := &in.Reports, &out.Reports
+		*out = make([]ControlPlaneSLOReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSLO.
+func (in *ControlPlaneSLO) DeepCopy() *ControlPlaneSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneSLOReport) DeepCopyInto(out *ControlPlaneSLOReport) {
+	*out = *in
+	if in.Availability != nil {
+		in, out := &in.Availability, &out.Availability
+		*out = new(ControlPlaneServiceLevel)
+		**out = **in
+	}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(ControlPlaneServiceLevel)
+		**out = **in
+	}
+	if in.Coverage != nil {
+		in, out := &in.Coverage, &out.Coverage
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSLOReport.
+func (in *ControlPlaneSLOReport) DeepCopy() *ControlPlaneSLOReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneSLOReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneServiceLevel) DeepCopyInto(out *ControlPlaneServiceLevel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneServiceLevel.
+func (in *ControlPlaneServiceLevel) DeepCopy() *ControlPlaneServiceLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneServiceLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -3439,6 +3689,60 @@ func (in *LoadBalancerServicesProxyProtocol) DeepCopy() *LoadBalancerServicesPro
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]LoggingOutput, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AuditBackends != nil {
+		in, out := &in.AuditBackends, &out.AuditBackends
+		*out = new(AuditBackends)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingOutput) DeepCopyInto(out *LoggingOutput) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]LoggingSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceName != nil {
+		in, out := &in.ResourceName, &out.ResourceName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingOutput.
+func (in *LoggingOutput) DeepCopy() *LoggingOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Machine) DeepCopyInto(out *Machine) {
 	*out = *in
@@ -3920,6 +4224,11 @@ func (in *Monitoring) DeepCopyInto(out *Monitoring) {
 		*out = new(Alerting)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = new(MonitoringRules)
+		**out = **in
+	}
 	return
 }
 
@@ -3933,6 +4242,22 @@ func (in *Monitoring) DeepCopy() *Monitoring {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringRules) DeepCopyInto(out *MonitoringRules) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringRules.
+func (in *MonitoringRules) DeepCopy() *MonitoringRules {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedResourceReference) DeepCopyInto(out *NamedResourceReference) {
 	*out = *in
@@ -5060,6 +5385,38 @@ func (in *SeedDNSProviderConfig) DeepCopy() *SeedDNSProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainStatus) DeepCopyInto(out *SeedDrainStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.MigratingShoots != nil {
+		in, out := &in.MigratingShoots, &out.MigratingShoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnschedulableShoots != nil {
+		in, out := &in.UnschedulableShoots, &out.UnschedulableShoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainStatus.
+func (in *SeedDrainStatus) DeepCopy() *SeedDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
@@ -5644,6 +6001,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(SeedDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6038,6 +6400,56 @@ func (in *ShootNetworks) DeepCopy() *ShootNetworks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPlan) DeepCopyInto(out *ShootPlan) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ShootPlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPlan.
+func (in *ShootPlan) DeepCopy() *ShootPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPlannedChange) DeepCopyInto(out *ShootPlannedChange) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPlannedChange.
+func (in *ShootPlannedChange) DeepCopy() *ShootPlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSSHKeypairRotation) DeepCopyInto(out *ShootSSHKeypairRotation) {
 	*out = *in
@@ -6178,6 +6590,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6372,6 +6789,18 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(ManualWorkerPoolRollout)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ControlPlaneSLO != nil {
+		in, out := &in.ControlPlaneSLO, &out.ControlPlaneSLO
+		*out = new(ControlPlaneSLO)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]ShootPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -44,21 +44,51 @@ func (in AdmissionPlugin) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AdmissionPlugin"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AlertMatcher) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AlertMatcher"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AlertReceiver) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AlertReceiver"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AlertSilence) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AlertSilence"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Alerting) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Alerting"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AuditBackends) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AuditBackends"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in AuditConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AuditConfig"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AuditLogBackend) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AuditLogBackend"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in AuditPolicy) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AuditPolicy"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AuditWebhookBackend) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AuditWebhookBackend"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in AuthorizerKubeconfigReference) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.AuthorizerKubeconfigReference"
@@ -209,6 +239,21 @@ func (in ControlPlaneAutoscaling) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneAutoscaling"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControlPlaneSLO) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneSLO"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControlPlaneSLOReport) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneSLOReport"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ControlPlaneServiceLevel) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneServiceLevel"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ControllerDeployment) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeployment"
@@ -569,6 +614,16 @@ func (in LoadBalancerServicesProxyProtocol) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.LoadBalancerServicesProxyProtocol"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in Logging) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Logging"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LoggingOutput) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.LoggingOutput"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Machine) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Machine"
@@ -654,6 +709,11 @@ func (in Monitoring) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.Monitoring"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MonitoringRules) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MonitoringRules"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NamedResourceReference) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.NamedResourceReference"
@@ -834,6 +894,11 @@ func (in SeedDNSProviderConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedDNSProviderConfig"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SeedDrainStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedDrainStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in SeedList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.SeedList"
@@ -999,6 +1064,16 @@ func (in ShootNetworks) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootNetworks"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPlan) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootPlan"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPlannedChange) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootPlannedChange"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ShootSSHKeypairRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ShootSSHKeypairRotation"
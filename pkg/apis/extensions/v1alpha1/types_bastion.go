@@ -15,6 +15,15 @@ var _ Object = (*Bastion)(nil)
 // BastionResource is a constant for the name of the Bastion resource.
 const BastionResource = "Bastion"
 
+const (
+	// BastionSessionLogPath is the path of the file on the bastion host to which the records of SSH sessions are
+	// appended if session recording is enabled.
+	BastionSessionLogPath = "/var/log/gardener-bastion/sessions.log"
+	// BastionTranscriptsDirectory is the directory on the bastion host in which the terminal transcripts of interactive
+	// SSH sessions are stored if transcripts are enabled.
+	BastionTranscriptsDirectory = "/var/log/gardener-bastion/transcripts"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:scope=Namespaced,path=bastions,singular=bastion
@@ -59,6 +68,22 @@ type BastionSpec struct {
 	UserData []byte `json:"userData"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress"`
+	// SessionRecording configures the recording of SSH sessions through the bastion host. If set, the UserData
+	// configures the bastion host to record sessions, and the extension is supposed to store the session log and
+	// transcripts in the bucket of the given BackupEntry and to report the recorded sessions in the status.
+	// This field is immutable.
+	// +optional
+	SessionRecording *BastionSessionRecording `json:"sessionRecording,omitempty"`
+}
+
+// BastionSessionRecording configures the recording of SSH sessions through a bastion host.
+type BastionSessionRecording struct {
+	// BackupEntryName is the name of the BackupEntry of the shoot, in whose bucket the session log and transcripts
+	// are stored.
+	BackupEntryName string `json:"backupEntryName"`
+	// Transcripts indicates whether terminal transcripts of interactive sessions on the bastion host are recorded.
+	// +optional
+	Transcripts bool `json:"transcripts,omitempty"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -75,6 +100,29 @@ type BastionStatus struct {
 	// Ingress is the external IP and/or hostname of the bastion host.
 	// +optional
 	Ingress *corev1.LoadBalancerIngress `json:"ingress,omitempty"`
+	// Sessions is the list of SSH sessions that have been recorded by the bastion host.
+	// +optional
+	Sessions []BastionSession `json:"sessions,omitempty"`
+}
+
+// BastionSession contains the metadata of an SSH session recorded by a bastion host.
+type BastionSession struct {
+	// ID is the unique identifier of the session.
+	ID string `json:"id"`
+	// Source is the address from which the session has been established.
+	Source string `json:"source"`
+	// Node is the address of the node to which the session has been forwarded. It is empty for interactive sessions on
+	// the bastion host.
+	// +optional
+	Node string `json:"node,omitempty"`
+	// StartTime is the time when the session has been established.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the time when the session has been closed. It is not set for sessions which are still active.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Transcript is the key of the object in the backup bucket containing the terminal transcript of the session.
+	// +optional
+	Transcript *string `json:"transcript,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
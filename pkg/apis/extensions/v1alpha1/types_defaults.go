@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -148,3 +149,54 @@ func (d *DefaultStatus) GetResources() []gardencorev1beta1.NamedResourceReferenc
 func (d *DefaultStatus) SetResources(namedResourceReference []gardencorev1beta1.NamedResourceReference) {
 	d.Resources = namedResourceReference
 }
+
+// Plan contains the changes an extension controller would perform on the infrastructure or on the machines in order
+// to reach the desired state of the resource. It is computed on request, see the `plan` value of the
+// `gardener.cloud/operation` annotation.
+type Plan struct {
+	// ObservedGeneration is the generation of the resource the plan was computed for.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// ShootGeneration is the generation of the Shoot the plan was computed for. It is only set for resources belonging
+	// to a Shoot.
+	// +optional
+	ShootGeneration *int64 `json:"shootGeneration,omitempty"`
+	// LastUpdateTime is the timestamp when the plan was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Changes is the list of changes which would be performed. It is empty if the resource is already in its desired
+	// state.
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+	// Error is the description of the error which prevented computing the plan.
+	// +optional
+	Error *string `json:"error,omitempty"`
+}
+
+// PlannedChange is a single change of a resource which would be performed by an extension controller.
+type PlannedChange struct {
+	// Kind is the kind of the affected resource, e.g. `aws_vpc` or `MachineDeployment`.
+	Kind string `json:"kind"`
+	// Name is the name of the affected resource.
+	Name string `json:"name"`
+	// Action is the action which would be performed on the resource.
+	Action PlannedChangeAction `json:"action"`
+	// Description is an optional human-readable description of the change.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// PlannedChangeAction is the action of a planned change.
+type PlannedChangeAction string
+
+const (
+	// PlannedChangeActionCreate indicates that the resource would be created.
+	PlannedChangeActionCreate PlannedChangeAction = "Create"
+	// PlannedChangeActionUpdate indicates that the resource would be updated without replacing it.
+	PlannedChangeActionUpdate PlannedChangeAction = "Update"
+	// PlannedChangeActionReplace indicates that the resource would be deleted and created again.
+	PlannedChangeActionReplace PlannedChangeAction = "Replace"
+	// PlannedChangeActionDelete indicates that the resource would be deleted.
+	PlannedChangeActionDelete PlannedChangeAction = "Delete"
+	// PlannedChangeActionRollout indicates that the machines of the resource would be rolled, i.e., replaced by new
+	// machines.
+	PlannedChangeActionRollout PlannedChangeAction = "Rollout"
+)
@@ -89,6 +89,16 @@ type InfrastructureStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *InfrastructureStatusNetworking `json:"networking,omitempty"`
+	// Plan contains the infrastructure changes the extension controller would perform to reach the desired state. It
+	// is only set if the `plan` operation was requested or the Shoot requires approving plans, and the extension
+	// controller supports computing plans.
+	// +optional
+	Plan *Plan `json:"plan,omitempty"`
+	// AppliedShootSpecHash is the hash of the Shoot specification whose changes were applied last. It is only set if
+	// the extension controller supports computing plans. Changes are applied without approval of the plan as long as
+	// the Shoot specification does not change, since they are not caused by the Shoot owner.
+	// +optional
+	AppliedShootSpecHash *string `json:"appliedShootSpecHash,omitempty"`
 }
 
 // InfrastructureStatusNetworking is a structure containing information about the node, service and pod network ranges.
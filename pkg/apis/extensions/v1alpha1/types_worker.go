@@ -259,6 +259,16 @@ type WorkerStatus struct {
 	// InPlaceUpdates contains the status for in-place updates.
 	// +optional
 	InPlaceUpdates *InPlaceUpdatesWorkerStatus `json:"inPlaceUpdates,omitempty"`
+	// Plan contains the machine changes (e.g., rollouts of worker pools) the extension controller would perform to
+	// reach the desired state. It is only set if the `plan` operation was requested or the Shoot requires approving
+	// plans, and the extension controller supports computing plans.
+	// +optional
+	Plan *Plan `json:"plan,omitempty"`
+	// AppliedShootSpecHash is the hash of the Shoot specification whose changes were applied last. It is only set if
+	// the extension controller supports computing plans. Changes are applied without approval of the plan as long as
+	// the Shoot specification does not change, since they are not caused by the Shoot owner.
+	// +optional
+	AppliedShootSpecHash *string `json:"appliedShootSpecHash,omitempty"`
 }
 
 // InPlaceUpdatesWorkerStatus contains the configuration for in-place updates.
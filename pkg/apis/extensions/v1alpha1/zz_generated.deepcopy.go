@@ -297,6 +297,48 @@ func (in *BastionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSession) DeepCopyInto(out *BastionSession) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Transcript != nil {
+		in, out := &in.Transcript, &out.Transcript
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSession.
+func (in *BastionSession) DeepCopy() *BastionSession {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSessionRecording) DeepCopyInto(out *BastionSessionRecording) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSessionRecording.
+func (in *BastionSessionRecording) DeepCopy() *BastionSessionRecording {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSessionRecording)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 	*out = *in
@@ -313,6 +355,11 @@ func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SessionRecording != nil {
+		in, out := &in.SessionRecording, &out.SessionRecording
+		*out = new(BastionSessionRecording)
+		**out = **in
+	}
 	return
 }
 
@@ -335,6 +382,13 @@ func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 		*out = new(v1.LoadBalancerIngress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = make([]BastionSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1433,6 +1487,16 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 		*out = new(InfrastructureStatusNetworking)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(Plan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedShootSpecHash != nil {
+		in, out := &in.AppliedShootSpecHash, &out.AppliedShootSpecHash
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1813,6 +1877,61 @@ func (in *OperatingSystemConfigStatus) DeepCopy() *OperatingSystemConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Plan) DeepCopyInto(out *Plan) {
+	*out = *in
+	if in.ShootGeneration != nil {
+		in, out := &in.ShootGeneration, &out.ShootGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Plan.
+func (in *Plan) DeepCopy() *Plan {
+	if in == nil {
+		return nil
+	}
+	out := new(Plan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PluginConfig) DeepCopyInto(out *PluginConfig) {
 	*out = *in
@@ -2343,6 +2462,16 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 		*out = new(InPlaceUpdatesWorkerStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(Plan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedShootSpecHash != nil {
+		in, out := &in.AppliedShootSpecHash, &out.AppliedShootSpecHash
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
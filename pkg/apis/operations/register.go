@@ -37,6 +37,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&CloneRequest{},
+		&AlertSilenceRequest{},
+		&DiagnosticsRequest{},
 	)
 
 	return nil
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertSilenceRequest can be used to silence alerts of a Shoot cluster.
+type AlertSilenceRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of the AlertSilenceRequest.
+	Spec AlertSilenceRequestSpec
+	// Status is the status of the AlertSilenceRequest.
+	Status AlertSilenceRequestStatus
+}
+
+// AlertSilenceRequestSpec contains the specification of the silence.
+type AlertSilenceRequestSpec struct {
+	// Name is the name of the silence. An existing silence with the same name is replaced.
+	Name string
+	// Matchers is a list of label matchers which all have to match an alert to be silenced.
+	Matchers []core.AlertMatcher
+	// Duration is the duration for which matching alerts are silenced, starting now. A duration of zero removes the
+	// silence with the given name.
+	Duration metav1.Duration
+	// Comment is a comment describing the silence.
+	Comment *string
+}
+
+// AlertSilenceRequestStatus is the status of the AlertSilenceRequest.
+type AlertSilenceRequestStatus struct {
+	// StartsAt is the time when the silence starts.
+	StartsAt *metav1.Time
+	// EndsAt is the time when the silence ends.
+	EndsAt *metav1.Time
+}
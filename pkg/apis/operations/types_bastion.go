@@ -52,6 +52,18 @@ type BastionSpec struct {
 	SSHPublicKey string
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy
+	// SessionRecording configures the recording of SSH sessions through the bastion host. If set, the bastion host only
+	// allows recorded sessions, and the session log (and optionally terminal transcripts) is written on the bastion host.
+	// Gardener does not ship these records anywhere, it is up to the provider extension to store them in the backup
+	// bucket of the referenced Shoot (not all provider extensions do). This field is immutable.
+	SessionRecording *BastionSessionRecording
+}
+
+// BastionSessionRecording configures the recording of SSH sessions through a bastion host.
+type BastionSessionRecording struct {
+	// Transcripts indicates whether terminal transcripts of interactive sessions on the bastion host are recorded in
+	// addition to the session log.
+	Transcripts bool
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -76,4 +88,30 @@ type BastionStatus struct {
 	// ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the
 	// Bastion's generation, which is updated on mutation by the API Server.
 	ObservedGeneration *int64
+	// Sessions is the list of SSH sessions that have been recorded by the bastion host.
+	Sessions []BastionSession
+	// SSHCertificate is an SSH user certificate (in authorized_keys format) for the public key of the Bastion which is
+	// signed by the SSH certificate authority of the shoot. It is only valid for the "gardener" user until the
+	// ExpirationTimestamp of the Bastion and is renewed whenever the Bastion is kept alive.
+	SSHCertificate *string
+}
+
+// BastionSession contains the metadata of an SSH session recorded by a bastion host.
+type BastionSession struct {
+	// ID is the unique identifier of the session.
+	ID string
+	// User is the name of the user who created the Bastion and thereby owns the SSH key used for the session.
+	User string
+	// Source is the address from which the session has been established.
+	Source string
+	// Node is the address of the node to which the session has been forwarded. It is empty for interactive sessions on
+	// the bastion host.
+	Node string
+	// StartTime is the time when the session has been established.
+	StartTime metav1.Time
+	// EndTime is the time when the session has been closed. It is not set for sessions which are still active.
+	EndTime *metav1.Time
+	// Transcript is the key of the object in the backup bucket of the Shoot containing the terminal transcript of the
+	// session. It is only set for interactive sessions if transcripts are recorded.
+	Transcript *string
 }
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CloneRequest can be used to create a new Shoot cluster as a copy of an existing Shoot cluster.
+type CloneRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of the CloneRequest.
+	Spec CloneRequestSpec
+	// Status is the status of the CloneRequest.
+	Status CloneRequestStatus
+}
+
+// CloneRequestSpec contains the name of the new Shoot and the fields of the source Shoot which shall be overwritten.
+type CloneRequestSpec struct {
+	// Name is the name of the new Shoot. It is created in the namespace of the source Shoot.
+	Name string
+	// Region is the region of the new Shoot. If not set, the region of the source Shoot is used.
+	Region *string
+	// Zones are the zones used for all worker pools of the new Shoot. They must be set if the region is changed.
+	Zones []string
+	// DNSDomain is the external domain of the new Shoot. If not set, the new Shoot gets a default domain assigned.
+	DNSDomain *string
+	// Networking contains the networks of the new Shoot. Networks which are not set are copied from the source Shoot.
+	Networking *CloneRequestNetworking
+	// Resources contains replacements for resources referenced by the source Shoot.
+	Resources []CloneRequestResource
+	// RestoreFromBackup specifies whether the etcd of the new Shoot shall be restored from the latest backup of the
+	// source Shoot.
+	RestoreFromBackup bool
+}
+
+// CloneRequestNetworking contains the networks of the new Shoot.
+type CloneRequestNetworking struct {
+	// Nodes is the CIDR of the node network.
+	Nodes *string
+	// Pods is the CIDR of the pod network.
+	Pods *string
+	// Services is the CIDR of the service network.
+	Services *string
+}
+
+// CloneRequestResource contains a replacement for a resource referenced by the source Shoot.
+type CloneRequestResource struct {
+	// Name is the name of the resource reference in the source Shoot.
+	Name string
+	// ResourceName is the name of the resource which shall be referenced by the new Shoot instead.
+	ResourceName string
+}
+
+// CloneRequestStatus is the status of the CloneRequest.
+type CloneRequestStatus struct {
+	// UID is the UID of the new Shoot.
+	UID types.UID
+}
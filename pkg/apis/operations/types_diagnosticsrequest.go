@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DiagnosticsRequest can be used to request a diagnostics bundle for a Shoot cluster.
+type DiagnosticsRequest struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of the DiagnosticsRequest.
+	Spec DiagnosticsRequestSpec
+	// Status is the status of the DiagnosticsRequest.
+	Status DiagnosticsRequestStatus
+}
+
+// DiagnosticsRequestSpec contains the time window for which diagnostics shall be collected.
+type DiagnosticsRequestSpec struct {
+	// SinceSeconds is the length of the time window (ending at the time of the collection) for which events and logs
+	// are collected.
+	// Defaults to 1 hour.
+	SinceSeconds int64
+}
+
+// DiagnosticsRequestStatus is the status of the DiagnosticsRequest containing the diagnostics bundle once it was
+// collected.
+type DiagnosticsRequestStatus struct {
+	// State is the state of the diagnostics bundle collection.
+	State DiagnosticsRequestState
+	// Bundle is a gzip-compressed tarball containing the collected (and redacted) diagnostics. It is only set if the
+	// state is 'Succeeded'.
+	Bundle []byte
+	// CollectionTimestamp is the time when the diagnostics bundle was collected.
+	CollectionTimestamp *metav1.Time
+	// ExpirationTimestamp is the time until the diagnostics bundle can be downloaded.
+	ExpirationTimestamp *metav1.Time
+}
+
+// DiagnosticsRequestState is a type alias for the state of a diagnostics bundle collection.
+type DiagnosticsRequestState string
+
+const (
+	// DiagnosticsRequestStatePending indicates that the diagnostics bundle is being collected.
+	DiagnosticsRequestStatePending DiagnosticsRequestState = "Pending"
+	// DiagnosticsRequestStateSucceeded indicates that the diagnostics bundle was collected and can be downloaded.
+	DiagnosticsRequestStateSucceeded DiagnosticsRequestState = "Succeeded"
+)
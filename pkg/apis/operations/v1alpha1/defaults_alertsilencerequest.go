@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SetDefaults_AlertSilenceRequestSpec sets default values for AlertSilenceRequestSpec objects.
+func SetDefaults_AlertSilenceRequestSpec(obj *AlertSilenceRequestSpec) {
+	if obj.Duration == nil {
+		obj.Duration = &metav1.Duration{Duration: time.Hour}
+	}
+}
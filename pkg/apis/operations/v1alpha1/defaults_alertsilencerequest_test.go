@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("AlertSilenceRequest defaulting", func() {
+	var obj *AlertSilenceRequest
+
+	BeforeEach(func() {
+		obj = &AlertSilenceRequest{}
+	})
+
+	Describe("Duration defaulting", func() {
+		It("should default duration field", func() {
+			SetObjectDefaults_AlertSilenceRequest(obj)
+
+			Expect(obj.Spec.Duration).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+
+		It("should not default duration field if it is already set", func() {
+			obj.Spec.Duration = &metav1.Duration{}
+
+			SetObjectDefaults_AlertSilenceRequest(obj)
+
+			Expect(obj.Spec.Duration).To(PointTo(Equal(metav1.Duration{})))
+		})
+	})
+})
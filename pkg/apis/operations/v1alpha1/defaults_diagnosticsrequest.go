@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/utils/ptr"
+)
+
+// SetDefaults_DiagnosticsRequestSpec sets default values for DiagnosticsRequestSpec objects.
+func SetDefaults_DiagnosticsRequestSpec(obj *DiagnosticsRequestSpec) {
+	if obj.SinceSeconds == nil {
+		obj.SinceSeconds = ptr.To(int64(60 * 60))
+	}
+}
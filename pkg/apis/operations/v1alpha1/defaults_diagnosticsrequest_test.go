@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("DiagnosticsRequest defaulting", func() {
+	var obj *DiagnosticsRequest
+
+	BeforeEach(func() {
+		obj = &DiagnosticsRequest{}
+	})
+
+	Describe("SinceSeconds defaulting", func() {
+		It("should default sinceSeconds field", func() {
+			SetObjectDefaults_DiagnosticsRequest(obj)
+
+			Expect(obj.Spec.SinceSeconds).To(PointTo(Equal(int64(60 * 60))))
+		})
+
+		It("should not default sinceSeconds field if it is already set", func() {
+			obj.Spec.SinceSeconds = ptr.To(int64(10 * 60))
+
+			SetObjectDefaults_DiagnosticsRequest(obj)
+
+			Expect(obj.Spec.SinceSeconds).To(PointTo(Equal(int64(10 * 60))))
+		})
+	})
+})
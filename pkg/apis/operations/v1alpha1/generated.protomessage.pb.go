@@ -9,12 +9,38 @@
 
 package v1alpha1
 
+func (*AlertSilenceRequest) ProtoMessage() {}
+
+func (*AlertSilenceRequestSpec) ProtoMessage() {}
+
+func (*AlertSilenceRequestStatus) ProtoMessage() {}
+
 func (*Bastion) ProtoMessage() {}
 
 func (*BastionIngressPolicy) ProtoMessage() {}
 
 func (*BastionList) ProtoMessage() {}
 
+func (*BastionSession) ProtoMessage() {}
+
+func (*BastionSessionRecording) ProtoMessage() {}
+
 func (*BastionSpec) ProtoMessage() {}
 
 func (*BastionStatus) ProtoMessage() {}
+
+func (*CloneRequest) ProtoMessage() {}
+
+func (*CloneRequestNetworking) ProtoMessage() {}
+
+func (*CloneRequestResource) ProtoMessage() {}
+
+func (*CloneRequestSpec) ProtoMessage() {}
+
+func (*CloneRequestStatus) ProtoMessage() {}
+
+func (*DiagnosticsRequest) ProtoMessage() {}
+
+func (*DiagnosticsRequestSpec) ProtoMessage() {}
+
+func (*DiagnosticsRequestStatus) ProtoMessage() {}
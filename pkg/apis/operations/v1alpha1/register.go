@@ -39,6 +39,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&CloneRequest{},
+		&AlertSilenceRequest{},
+		&DiagnosticsRequest{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
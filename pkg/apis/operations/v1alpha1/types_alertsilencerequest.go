@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertSilenceRequest can be used to silence alerts of a Shoot cluster. The silence is stored on behalf of the
+// requesting user in the `<shoot-name>.alert-silences` InternalSecret in the project namespace (expired silences are
+// removed), and gardenlet pushes it to the Alertmanager of the Shoot right away.
+type AlertSilenceRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the AlertSilenceRequest.
+	Spec AlertSilenceRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the AlertSilenceRequest.
+	Status AlertSilenceRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// AlertSilenceRequestSpec contains the specification of the silence.
+type AlertSilenceRequestSpec struct {
+	// Name is the name of the silence. An existing silence with the same name is replaced.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Matchers is a list of label matchers which all have to match an alert to be silenced. They must not be set if the
+	// duration is zero.
+	// +optional
+	Matchers []gardencorev1beta1.AlertMatcher `json:"matchers,omitempty" protobuf:"bytes,2,rep,name=matchers"`
+	// Duration is the duration for which matching alerts are silenced, starting now. The value must not exceed 7 days.
+	// A duration of zero removes the silence with the given name.
+	// Defaults to 1 hour.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty" protobuf:"bytes,3,opt,name=duration"`
+	// Comment is a comment describing the silence.
+	// +optional
+	Comment *string `json:"comment,omitempty" protobuf:"bytes,4,opt,name=comment"`
+}
+
+// AlertSilenceRequestStatus is the status of the AlertSilenceRequest.
+type AlertSilenceRequestStatus struct {
+	// StartsAt is the time when the silence starts. It is not set if the silence was removed.
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty" protobuf:"bytes,1,opt,name=startsAt"`
+	// EndsAt is the time when the silence ends. It is not set if the silence was removed.
+	// +optional
+	EndsAt *metav1.Time `json:"endsAt,omitempty" protobuf:"bytes,2,opt,name=endsAt"`
+}
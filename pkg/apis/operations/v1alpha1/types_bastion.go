@@ -62,6 +62,20 @@ type BastionSpec struct {
 	SSHPublicKey string `json:"sshPublicKey" protobuf:"bytes,4,opt,name=sshPublicKey"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress" protobuf:"bytes,5,opt,name=ingress"`
+	// SessionRecording configures the recording of SSH sessions through the bastion host. If set, the bastion host only
+	// allows recorded sessions, and the session log (and optionally terminal transcripts) is written on the bastion host.
+	// Gardener does not ship these records anywhere, it is up to the provider extension to store them in the backup
+	// bucket of the referenced Shoot (not all provider extensions do). This field is immutable.
+	// +optional
+	SessionRecording *BastionSessionRecording `json:"sessionRecording,omitempty" protobuf:"bytes,6,opt,name=sessionRecording"`
+}
+
+// BastionSessionRecording configures the recording of SSH sessions through a bastion host.
+type BastionSessionRecording struct {
+	// Transcripts indicates whether terminal transcripts of interactive sessions on the bastion host are recorded in
+	// addition to the session log.
+	// +optional
+	Transcripts bool `json:"transcripts,omitempty" protobuf:"varint,1,opt,name=transcripts"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
@@ -93,4 +107,36 @@ type BastionStatus struct {
 	// Bastion's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty" protobuf:"varint,5,opt,name=observedGeneration"`
+	// Sessions is the list of SSH sessions that have been recorded by the bastion host.
+	// +optional
+	Sessions []BastionSession `json:"sessions,omitempty" protobuf:"bytes,6,rep,name=sessions"`
+	// SSHCertificate is an SSH user certificate (in authorized_keys format) for the public key of the Bastion which is
+	// signed by the SSH certificate authority of the shoot. It is only valid for the "gardener" user until the
+	// ExpirationTimestamp of the Bastion and is renewed whenever the Bastion is kept alive.
+	// +optional
+	SSHCertificate *string `json:"sshCertificate,omitempty" protobuf:"bytes,7,opt,name=sshCertificate"`
+}
+
+// BastionSession contains the metadata of an SSH session recorded by a bastion host.
+type BastionSession struct {
+	// ID is the unique identifier of the session.
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// User is the name of the user who created the Bastion and thereby owns the SSH key used for the session.
+	// +optional
+	User string `json:"user,omitempty" protobuf:"bytes,2,opt,name=user"`
+	// Source is the address from which the session has been established.
+	Source string `json:"source" protobuf:"bytes,3,opt,name=source"`
+	// Node is the address of the node to which the session has been forwarded. It is empty for interactive sessions on
+	// the bastion host.
+	// +optional
+	Node string `json:"node,omitempty" protobuf:"bytes,4,opt,name=node"`
+	// StartTime is the time when the session has been established.
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,5,opt,name=startTime"`
+	// EndTime is the time when the session has been closed. It is not set for sessions which are still active.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty" protobuf:"bytes,6,opt,name=endTime"`
+	// Transcript is the key of the object in the backup bucket of the Shoot containing the terminal transcript of the
+	// session. It is only set for interactive sessions if transcripts are recorded.
+	// +optional
+	Transcript *string `json:"transcript,omitempty" protobuf:"bytes,7,opt,name=transcript"`
 }
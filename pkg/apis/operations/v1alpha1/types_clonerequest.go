@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CloneRequest can be used to create a new Shoot cluster as a copy of an existing Shoot cluster. The specification of
+// the source Shoot is copied while fields which cannot be shared between clusters (e.g., the seed and the DNS domain)
+// are reset or replaced by the values given in the request. The new Shoot is created on behalf of the requesting user.
+type CloneRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the CloneRequest.
+	Spec CloneRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the CloneRequest.
+	Status CloneRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// CloneRequestSpec contains the name of the new Shoot and the fields of the source Shoot which shall be overwritten.
+type CloneRequestSpec struct {
+	// Name is the name of the new Shoot. It is created in the namespace of the source Shoot.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Region is the region of the new Shoot. If not set, the region of the source Shoot is used.
+	// +optional
+	Region *string `json:"region,omitempty" protobuf:"bytes,2,opt,name=region"`
+	// Zones are the zones used for all worker pools of the new Shoot. They must be set if the region is changed.
+	// +optional
+	Zones []string `json:"zones,omitempty" protobuf:"bytes,3,rep,name=zones"`
+	// DNSDomain is the external domain of the new Shoot. If not set, the new Shoot gets a default domain assigned.
+	// +optional
+	DNSDomain *string `json:"dnsDomain,omitempty" protobuf:"bytes,4,opt,name=dnsDomain"`
+	// Networking contains the networks of the new Shoot. Networks which are not set are copied from the source Shoot.
+	// +optional
+	Networking *CloneRequestNetworking `json:"networking,omitempty" protobuf:"bytes,5,opt,name=networking"`
+	// Resources contains replacements for resources referenced by the source Shoot.
+	// +optional
+	Resources []CloneRequestResource `json:"resources,omitempty" protobuf:"bytes,6,rep,name=resources"`
+	// RestoreFromBackup specifies whether the etcd of the new Shoot shall be restored from the latest backup of the
+	// source Shoot. If set, the new Shoot is scheduled to the seed of the source Shoot.
+	// +optional
+	RestoreFromBackup bool `json:"restoreFromBackup,omitempty" protobuf:"varint,7,opt,name=restoreFromBackup"`
+}
+
+// CloneRequestNetworking contains the networks of the new Shoot.
+type CloneRequestNetworking struct {
+	// Nodes is the CIDR of the node network.
+	// +optional
+	Nodes *string `json:"nodes,omitempty" protobuf:"bytes,1,opt,name=nodes"`
+	// Pods is the CIDR of the pod network.
+	// +optional
+	Pods *string `json:"pods,omitempty" protobuf:"bytes,2,opt,name=pods"`
+	// Services is the CIDR of the service network. It must not be set if the new Shoot is restored from a backup of the
+	// source Shoot.
+	// +optional
+	Services *string `json:"services,omitempty" protobuf:"bytes,3,opt,name=services"`
+}
+
+// CloneRequestResource contains a replacement for a resource referenced by the source Shoot.
+type CloneRequestResource struct {
+	// Name is the name of the resource reference in the source Shoot.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ResourceName is the name of the resource which shall be referenced by the new Shoot instead.
+	ResourceName string `json:"resourceName" protobuf:"bytes,2,opt,name=resourceName"`
+}
+
+// CloneRequestStatus is the status of the CloneRequest.
+type CloneRequestStatus struct {
+	// UID is the UID of the new Shoot.
+	// +optional
+	UID types.UID `json:"uid,omitempty" protobuf:"bytes,1,opt,name=uid,casttype=k8s.io/apimachinery/pkg/types.UID"`
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DiagnosticsRequest can be used to request a diagnostics bundle for a Shoot cluster. The bundle is collected
+// asynchronously by the gardenlet responsible for the Shoot, hence the request has to be repeated until the state
+// reported in the status is 'Succeeded'.
+type DiagnosticsRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the DiagnosticsRequest.
+	Spec DiagnosticsRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the DiagnosticsRequest.
+	Status DiagnosticsRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// DiagnosticsRequestSpec contains the time window for which diagnostics shall be collected.
+type DiagnosticsRequestSpec struct {
+	// SinceSeconds is the length of the time window (ending at the time of the collection) for which events and logs
+	// are collected. The value must be between 1 minute and 24 hours.
+	// Defaults to 1 hour.
+	// +optional
+	SinceSeconds *int64 `json:"sinceSeconds,omitempty" protobuf:"varint,1,opt,name=sinceSeconds"`
+}
+
+// DiagnosticsRequestStatus is the status of the DiagnosticsRequest containing the diagnostics bundle once it was
+// collected.
+type DiagnosticsRequestStatus struct {
+	// State is the state of the diagnostics bundle collection.
+	State DiagnosticsRequestState `json:"state" protobuf:"bytes,1,opt,name=state,casttype=DiagnosticsRequestState"`
+	// Bundle is a gzip-compressed tarball containing the collected (and redacted) diagnostics. It is only set if the
+	// state is 'Succeeded'.
+	// +optional
+	Bundle []byte `json:"bundle,omitempty" protobuf:"bytes,2,opt,name=bundle"`
+	// CollectionTimestamp is the time when the diagnostics bundle was collected.
+	// +optional
+	CollectionTimestamp *metav1.Time `json:"collectionTimestamp,omitempty" protobuf:"bytes,3,opt,name=collectionTimestamp"`
+	// ExpirationTimestamp is the time until the diagnostics bundle can be downloaded.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,4,opt,name=expirationTimestamp"`
+}
+
+// DiagnosticsRequestState is a type alias for the state of a diagnostics bundle collection.
+type DiagnosticsRequestState string
+
+const (
+	// DiagnosticsRequestStatePending indicates that the diagnostics bundle is being collected.
+	DiagnosticsRequestStatePending DiagnosticsRequestState = "Pending"
+	// DiagnosticsRequestStateSucceeded indicates that the diagnostics bundle was collected and can be downloaded.
+	DiagnosticsRequestStateSucceeded DiagnosticsRequestState = "Succeeded"
+)
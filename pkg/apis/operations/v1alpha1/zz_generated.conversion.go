@@ -15,10 +15,11 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operations "github.com/gardener/gardener/pkg/apis/operations"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	types "k8s.io/apimachinery/pkg/types"
 )
 
 func init() {
@@ -28,6 +29,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AlertSilenceRequest)(nil), (*operations.AlertSilenceRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertSilenceRequest_To_operations_AlertSilenceRequest(a.(*AlertSilenceRequest), b.(*operations.AlertSilenceRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AlertSilenceRequest)(nil), (*AlertSilenceRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AlertSilenceRequest_To_v1alpha1_AlertSilenceRequest(a.(*operations.AlertSilenceRequest), b.(*AlertSilenceRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertSilenceRequestSpec)(nil), (*operations.AlertSilenceRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertSilenceRequestSpec_To_operations_AlertSilenceRequestSpec(a.(*AlertSilenceRequestSpec), b.(*operations.AlertSilenceRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AlertSilenceRequestSpec)(nil), (*AlertSilenceRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AlertSilenceRequestSpec_To_v1alpha1_AlertSilenceRequestSpec(a.(*operations.AlertSilenceRequestSpec), b.(*AlertSilenceRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertSilenceRequestStatus)(nil), (*operations.AlertSilenceRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertSilenceRequestStatus_To_operations_AlertSilenceRequestStatus(a.(*AlertSilenceRequestStatus), b.(*operations.AlertSilenceRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.AlertSilenceRequestStatus)(nil), (*AlertSilenceRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_AlertSilenceRequestStatus_To_v1alpha1_AlertSilenceRequestStatus(a.(*operations.AlertSilenceRequestStatus), b.(*AlertSilenceRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Bastion)(nil), (*operations.Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Bastion_To_operations_Bastion(a.(*Bastion), b.(*operations.Bastion), scope)
 	}); err != nil {
@@ -58,6 +89,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionSession)(nil), (*operations.BastionSession)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionSession_To_operations_BastionSession(a.(*BastionSession), b.(*operations.BastionSession), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionSession)(nil), (*BastionSession)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionSession_To_v1alpha1_BastionSession(a.(*operations.BastionSession), b.(*BastionSession), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionSessionRecording)(nil), (*operations.BastionSessionRecording)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(a.(*BastionSessionRecording), b.(*operations.BastionSessionRecording), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionSessionRecording)(nil), (*BastionSessionRecording)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(a.(*operations.BastionSessionRecording), b.(*BastionSessionRecording), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionSpec)(nil), (*operations.BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(a.(*BastionSpec), b.(*operations.BastionSpec), scope)
 	}); err != nil {
@@ -78,9 +129,173 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloneRequest)(nil), (*operations.CloneRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloneRequest_To_operations_CloneRequest(a.(*CloneRequest), b.(*operations.CloneRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.CloneRequest)(nil), (*CloneRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_CloneRequest_To_v1alpha1_CloneRequest(a.(*operations.CloneRequest), b.(*CloneRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloneRequestNetworking)(nil), (*operations.CloneRequestNetworking)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloneRequestNetworking_To_operations_CloneRequestNetworking(a.(*CloneRequestNetworking), b.(*operations.CloneRequestNetworking), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.CloneRequestNetworking)(nil), (*CloneRequestNetworking)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_CloneRequestNetworking_To_v1alpha1_CloneRequestNetworking(a.(*operations.CloneRequestNetworking), b.(*CloneRequestNetworking), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloneRequestResource)(nil), (*operations.CloneRequestResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloneRequestResource_To_operations_CloneRequestResource(a.(*CloneRequestResource), b.(*operations.CloneRequestResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.CloneRequestResource)(nil), (*CloneRequestResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_CloneRequestResource_To_v1alpha1_CloneRequestResource(a.(*operations.CloneRequestResource), b.(*CloneRequestResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloneRequestSpec)(nil), (*operations.CloneRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloneRequestSpec_To_operations_CloneRequestSpec(a.(*CloneRequestSpec), b.(*operations.CloneRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.CloneRequestSpec)(nil), (*CloneRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_CloneRequestSpec_To_v1alpha1_CloneRequestSpec(a.(*operations.CloneRequestSpec), b.(*CloneRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloneRequestStatus)(nil), (*operations.CloneRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloneRequestStatus_To_operations_CloneRequestStatus(a.(*CloneRequestStatus), b.(*operations.CloneRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.CloneRequestStatus)(nil), (*CloneRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_CloneRequestStatus_To_v1alpha1_CloneRequestStatus(a.(*operations.CloneRequestStatus), b.(*CloneRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiagnosticsRequest)(nil), (*operations.DiagnosticsRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiagnosticsRequest_To_operations_DiagnosticsRequest(a.(*DiagnosticsRequest), b.(*operations.DiagnosticsRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.DiagnosticsRequest)(nil), (*DiagnosticsRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_DiagnosticsRequest_To_v1alpha1_DiagnosticsRequest(a.(*operations.DiagnosticsRequest), b.(*DiagnosticsRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiagnosticsRequestSpec)(nil), (*operations.DiagnosticsRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiagnosticsRequestSpec_To_operations_DiagnosticsRequestSpec(a.(*DiagnosticsRequestSpec), b.(*operations.DiagnosticsRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.DiagnosticsRequestSpec)(nil), (*DiagnosticsRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_DiagnosticsRequestSpec_To_v1alpha1_DiagnosticsRequestSpec(a.(*operations.DiagnosticsRequestSpec), b.(*DiagnosticsRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiagnosticsRequestStatus)(nil), (*operations.DiagnosticsRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiagnosticsRequestStatus_To_operations_DiagnosticsRequestStatus(a.(*DiagnosticsRequestStatus), b.(*operations.DiagnosticsRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.DiagnosticsRequestStatus)(nil), (*DiagnosticsRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_DiagnosticsRequestStatus_To_v1alpha1_DiagnosticsRequestStatus(a.(*operations.DiagnosticsRequestStatus), b.(*DiagnosticsRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
+func autoConvert_v1alpha1_AlertSilenceRequest_To_operations_AlertSilenceRequest(in *AlertSilenceRequest, out *operations.AlertSilenceRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AlertSilenceRequestSpec_To_operations_AlertSilenceRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AlertSilenceRequestStatus_To_operations_AlertSilenceRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AlertSilenceRequest_To_operations_AlertSilenceRequest is an autogenerated conversion function.
+func Convert_v1alpha1_AlertSilenceRequest_To_operations_AlertSilenceRequest(in *AlertSilenceRequest, out *operations.AlertSilenceRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertSilenceRequest_To_operations_AlertSilenceRequest(in, out, s)
+}
+
+func autoConvert_operations_AlertSilenceRequest_To_v1alpha1_AlertSilenceRequest(in *operations.AlertSilenceRequest, out *AlertSilenceRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_AlertSilenceRequestSpec_To_v1alpha1_AlertSilenceRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_AlertSilenceRequestStatus_To_v1alpha1_AlertSilenceRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_AlertSilenceRequest_To_v1alpha1_AlertSilenceRequest is an autogenerated conversion function.
+func Convert_operations_AlertSilenceRequest_To_v1alpha1_AlertSilenceRequest(in *operations.AlertSilenceRequest, out *AlertSilenceRequest, s conversion.Scope) error {
+	return autoConvert_operations_AlertSilenceRequest_To_v1alpha1_AlertSilenceRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertSilenceRequestSpec_To_operations_AlertSilenceRequestSpec(in *AlertSilenceRequestSpec, out *operations.AlertSilenceRequestSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Matchers = *(*[]core.AlertMatcher)(unsafe.Pointer(&in.Matchers))
+	if err := v1.Convert_Pointer_v1_Duration_To_v1_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	out.Comment = (*string)(unsafe.Pointer(in.Comment))
+	return nil
+}
+
+// Convert_v1alpha1_AlertSilenceRequestSpec_To_operations_AlertSilenceRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_AlertSilenceRequestSpec_To_operations_AlertSilenceRequestSpec(in *AlertSilenceRequestSpec, out *operations.AlertSilenceRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertSilenceRequestSpec_To_operations_AlertSilenceRequestSpec(in, out, s)
+}
+
+func autoConvert_operations_AlertSilenceRequestSpec_To_v1alpha1_AlertSilenceRequestSpec(in *operations.AlertSilenceRequestSpec, out *AlertSilenceRequestSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Matchers = *(*[]v1beta1.AlertMatcher)(unsafe.Pointer(&in.Matchers))
+	if err := v1.Convert_v1_Duration_To_Pointer_v1_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	out.Comment = (*string)(unsafe.Pointer(in.Comment))
+	return nil
+}
+
+// Convert_operations_AlertSilenceRequestSpec_To_v1alpha1_AlertSilenceRequestSpec is an autogenerated conversion function.
+func Convert_operations_AlertSilenceRequestSpec_To_v1alpha1_AlertSilenceRequestSpec(in *operations.AlertSilenceRequestSpec, out *AlertSilenceRequestSpec, s conversion.Scope) error {
+	return autoConvert_operations_AlertSilenceRequestSpec_To_v1alpha1_AlertSilenceRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertSilenceRequestStatus_To_operations_AlertSilenceRequestStatus(in *AlertSilenceRequestStatus, out *operations.AlertSilenceRequestStatus, s conversion.Scope) error {
+	out.StartsAt = (*v1.Time)(unsafe.Pointer(in.StartsAt))
+	out.EndsAt = (*v1.Time)(unsafe.Pointer(in.EndsAt))
+	return nil
+}
+
+// Convert_v1alpha1_AlertSilenceRequestStatus_To_operations_AlertSilenceRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AlertSilenceRequestStatus_To_operations_AlertSilenceRequestStatus(in *AlertSilenceRequestStatus, out *operations.AlertSilenceRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertSilenceRequestStatus_To_operations_AlertSilenceRequestStatus(in, out, s)
+}
+
+func autoConvert_operations_AlertSilenceRequestStatus_To_v1alpha1_AlertSilenceRequestStatus(in *operations.AlertSilenceRequestStatus, out *AlertSilenceRequestStatus, s conversion.Scope) error {
+	out.StartsAt = (*v1.Time)(unsafe.Pointer(in.StartsAt))
+	out.EndsAt = (*v1.Time)(unsafe.Pointer(in.EndsAt))
+	return nil
+}
+
+// Convert_operations_AlertSilenceRequestStatus_To_v1alpha1_AlertSilenceRequestStatus is an autogenerated conversion function.
+func Convert_operations_AlertSilenceRequestStatus_To_v1alpha1_AlertSilenceRequestStatus(in *operations.AlertSilenceRequestStatus, out *AlertSilenceRequestStatus, s conversion.Scope) error {
+	return autoConvert_operations_AlertSilenceRequestStatus_To_v1alpha1_AlertSilenceRequestStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Bastion_To_operations_Bastion(in *Bastion, out *operations.Bastion, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -155,12 +370,65 @@ func Convert_operations_BastionList_To_v1alpha1_BastionList(in *operations.Basti
 	return autoConvert_operations_BastionList_To_v1alpha1_BastionList(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionSession_To_operations_BastionSession(in *BastionSession, out *operations.BastionSession, s conversion.Scope) error {
+	out.ID = in.ID
+	out.User = in.User
+	out.Source = in.Source
+	out.Node = in.Node
+	out.StartTime = in.StartTime
+	out.EndTime = (*v1.Time)(unsafe.Pointer(in.EndTime))
+	out.Transcript = (*string)(unsafe.Pointer(in.Transcript))
+	return nil
+}
+
+// Convert_v1alpha1_BastionSession_To_operations_BastionSession is an autogenerated conversion function.
+func Convert_v1alpha1_BastionSession_To_operations_BastionSession(in *BastionSession, out *operations.BastionSession, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionSession_To_operations_BastionSession(in, out, s)
+}
+
+func autoConvert_operations_BastionSession_To_v1alpha1_BastionSession(in *operations.BastionSession, out *BastionSession, s conversion.Scope) error {
+	out.ID = in.ID
+	out.User = in.User
+	out.Source = in.Source
+	out.Node = in.Node
+	out.StartTime = in.StartTime
+	out.EndTime = (*v1.Time)(unsafe.Pointer(in.EndTime))
+	out.Transcript = (*string)(unsafe.Pointer(in.Transcript))
+	return nil
+}
+
+// Convert_operations_BastionSession_To_v1alpha1_BastionSession is an autogenerated conversion function.
+func Convert_operations_BastionSession_To_v1alpha1_BastionSession(in *operations.BastionSession, out *BastionSession, s conversion.Scope) error {
+	return autoConvert_operations_BastionSession_To_v1alpha1_BastionSession(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(in *BastionSessionRecording, out *operations.BastionSessionRecording, s conversion.Scope) error {
+	out.Transcripts = in.Transcripts
+	return nil
+}
+
+// Convert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording is an autogenerated conversion function.
+func Convert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(in *BastionSessionRecording, out *operations.BastionSessionRecording, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionSessionRecording_To_operations_BastionSessionRecording(in, out, s)
+}
+
+func autoConvert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(in *operations.BastionSessionRecording, out *BastionSessionRecording, s conversion.Scope) error {
+	out.Transcripts = in.Transcripts
+	return nil
+}
+
+// Convert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording is an autogenerated conversion function.
+func Convert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(in *operations.BastionSessionRecording, out *BastionSessionRecording, s conversion.Scope) error {
+	return autoConvert_operations_BastionSessionRecording_To_v1alpha1_BastionSessionRecording(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionSpec_To_operations_BastionSpec(in *BastionSpec, out *operations.BastionSpec, s conversion.Scope) error {
 	out.ShootRef = in.ShootRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]operations.BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.SessionRecording = (*operations.BastionSessionRecording)(unsafe.Pointer(in.SessionRecording))
 	return nil
 }
 
@@ -175,6 +443,7 @@ func autoConvert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.B
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.SessionRecording = (*BastionSessionRecording)(unsafe.Pointer(in.SessionRecording))
 	return nil
 }
 
@@ -184,11 +453,13 @@ func Convert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.Basti
 }
 
 func autoConvert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatus, out *operations.BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.Sessions = *(*[]operations.BastionSession)(unsafe.Pointer(&in.Sessions))
+	out.SSHCertificate = (*string)(unsafe.Pointer(in.SSHCertificate))
 	return nil
 }
 
@@ -198,11 +469,13 @@ func Convert_v1alpha1_BastionStatus_To_operations_BastionStatus(in *BastionStatu
 }
 
 func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
-	out.Ingress = (*v1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
+	out.Ingress = (*corev1.LoadBalancerIngress)(unsafe.Pointer(in.Ingress))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
-	out.LastHeartbeatTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
-	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.LastHeartbeatTimestamp = (*v1.Time)(unsafe.Pointer(in.LastHeartbeatTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
 	out.ObservedGeneration = (*int64)(unsafe.Pointer(in.ObservedGeneration))
+	out.Sessions = *(*[]BastionSession)(unsafe.Pointer(&in.Sessions))
+	out.SSHCertificate = (*string)(unsafe.Pointer(in.SSHCertificate))
 	return nil
 }
 
@@ -210,3 +483,215 @@ func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operatio
 func Convert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
 	return autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_CloneRequest_To_operations_CloneRequest(in *CloneRequest, out *operations.CloneRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_CloneRequestSpec_To_operations_CloneRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CloneRequestStatus_To_operations_CloneRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CloneRequest_To_operations_CloneRequest is an autogenerated conversion function.
+func Convert_v1alpha1_CloneRequest_To_operations_CloneRequest(in *CloneRequest, out *operations.CloneRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloneRequest_To_operations_CloneRequest(in, out, s)
+}
+
+func autoConvert_operations_CloneRequest_To_v1alpha1_CloneRequest(in *operations.CloneRequest, out *CloneRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_CloneRequestSpec_To_v1alpha1_CloneRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_CloneRequestStatus_To_v1alpha1_CloneRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_CloneRequest_To_v1alpha1_CloneRequest is an autogenerated conversion function.
+func Convert_operations_CloneRequest_To_v1alpha1_CloneRequest(in *operations.CloneRequest, out *CloneRequest, s conversion.Scope) error {
+	return autoConvert_operations_CloneRequest_To_v1alpha1_CloneRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloneRequestNetworking_To_operations_CloneRequestNetworking(in *CloneRequestNetworking, out *operations.CloneRequestNetworking, s conversion.Scope) error {
+	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*string)(unsafe.Pointer(in.Pods))
+	out.Services = (*string)(unsafe.Pointer(in.Services))
+	return nil
+}
+
+// Convert_v1alpha1_CloneRequestNetworking_To_operations_CloneRequestNetworking is an autogenerated conversion function.
+func Convert_v1alpha1_CloneRequestNetworking_To_operations_CloneRequestNetworking(in *CloneRequestNetworking, out *operations.CloneRequestNetworking, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloneRequestNetworking_To_operations_CloneRequestNetworking(in, out, s)
+}
+
+func autoConvert_operations_CloneRequestNetworking_To_v1alpha1_CloneRequestNetworking(in *operations.CloneRequestNetworking, out *CloneRequestNetworking, s conversion.Scope) error {
+	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
+	out.Pods = (*string)(unsafe.Pointer(in.Pods))
+	out.Services = (*string)(unsafe.Pointer(in.Services))
+	return nil
+}
+
+// Convert_operations_CloneRequestNetworking_To_v1alpha1_CloneRequestNetworking is an autogenerated conversion function.
+func Convert_operations_CloneRequestNetworking_To_v1alpha1_CloneRequestNetworking(in *operations.CloneRequestNetworking, out *CloneRequestNetworking, s conversion.Scope) error {
+	return autoConvert_operations_CloneRequestNetworking_To_v1alpha1_CloneRequestNetworking(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloneRequestResource_To_operations_CloneRequestResource(in *CloneRequestResource, out *operations.CloneRequestResource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1alpha1_CloneRequestResource_To_operations_CloneRequestResource is an autogenerated conversion function.
+func Convert_v1alpha1_CloneRequestResource_To_operations_CloneRequestResource(in *CloneRequestResource, out *operations.CloneRequestResource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloneRequestResource_To_operations_CloneRequestResource(in, out, s)
+}
+
+func autoConvert_operations_CloneRequestResource_To_v1alpha1_CloneRequestResource(in *operations.CloneRequestResource, out *CloneRequestResource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_operations_CloneRequestResource_To_v1alpha1_CloneRequestResource is an autogenerated conversion function.
+func Convert_operations_CloneRequestResource_To_v1alpha1_CloneRequestResource(in *operations.CloneRequestResource, out *CloneRequestResource, s conversion.Scope) error {
+	return autoConvert_operations_CloneRequestResource_To_v1alpha1_CloneRequestResource(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloneRequestSpec_To_operations_CloneRequestSpec(in *CloneRequestSpec, out *operations.CloneRequestSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.DNSDomain = (*string)(unsafe.Pointer(in.DNSDomain))
+	out.Networking = (*operations.CloneRequestNetworking)(unsafe.Pointer(in.Networking))
+	out.Resources = *(*[]operations.CloneRequestResource)(unsafe.Pointer(&in.Resources))
+	out.RestoreFromBackup = in.RestoreFromBackup
+	return nil
+}
+
+// Convert_v1alpha1_CloneRequestSpec_To_operations_CloneRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_CloneRequestSpec_To_operations_CloneRequestSpec(in *CloneRequestSpec, out *operations.CloneRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloneRequestSpec_To_operations_CloneRequestSpec(in, out, s)
+}
+
+func autoConvert_operations_CloneRequestSpec_To_v1alpha1_CloneRequestSpec(in *operations.CloneRequestSpec, out *CloneRequestSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.DNSDomain = (*string)(unsafe.Pointer(in.DNSDomain))
+	out.Networking = (*CloneRequestNetworking)(unsafe.Pointer(in.Networking))
+	out.Resources = *(*[]CloneRequestResource)(unsafe.Pointer(&in.Resources))
+	out.RestoreFromBackup = in.RestoreFromBackup
+	return nil
+}
+
+// Convert_operations_CloneRequestSpec_To_v1alpha1_CloneRequestSpec is an autogenerated conversion function.
+func Convert_operations_CloneRequestSpec_To_v1alpha1_CloneRequestSpec(in *operations.CloneRequestSpec, out *CloneRequestSpec, s conversion.Scope) error {
+	return autoConvert_operations_CloneRequestSpec_To_v1alpha1_CloneRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloneRequestStatus_To_operations_CloneRequestStatus(in *CloneRequestStatus, out *operations.CloneRequestStatus, s conversion.Scope) error {
+	out.UID = types.UID(in.UID)
+	return nil
+}
+
+// Convert_v1alpha1_CloneRequestStatus_To_operations_CloneRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CloneRequestStatus_To_operations_CloneRequestStatus(in *CloneRequestStatus, out *operations.CloneRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloneRequestStatus_To_operations_CloneRequestStatus(in, out, s)
+}
+
+func autoConvert_operations_CloneRequestStatus_To_v1alpha1_CloneRequestStatus(in *operations.CloneRequestStatus, out *CloneRequestStatus, s conversion.Scope) error {
+	out.UID = types.UID(in.UID)
+	return nil
+}
+
+// Convert_operations_CloneRequestStatus_To_v1alpha1_CloneRequestStatus is an autogenerated conversion function.
+func Convert_operations_CloneRequestStatus_To_v1alpha1_CloneRequestStatus(in *operations.CloneRequestStatus, out *CloneRequestStatus, s conversion.Scope) error {
+	return autoConvert_operations_CloneRequestStatus_To_v1alpha1_CloneRequestStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiagnosticsRequest_To_operations_DiagnosticsRequest(in *DiagnosticsRequest, out *operations.DiagnosticsRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_DiagnosticsRequestSpec_To_operations_DiagnosticsRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_DiagnosticsRequestStatus_To_operations_DiagnosticsRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DiagnosticsRequest_To_operations_DiagnosticsRequest is an autogenerated conversion function.
+func Convert_v1alpha1_DiagnosticsRequest_To_operations_DiagnosticsRequest(in *DiagnosticsRequest, out *operations.DiagnosticsRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiagnosticsRequest_To_operations_DiagnosticsRequest(in, out, s)
+}
+
+func autoConvert_operations_DiagnosticsRequest_To_v1alpha1_DiagnosticsRequest(in *operations.DiagnosticsRequest, out *DiagnosticsRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_DiagnosticsRequestSpec_To_v1alpha1_DiagnosticsRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_DiagnosticsRequestStatus_To_v1alpha1_DiagnosticsRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_DiagnosticsRequest_To_v1alpha1_DiagnosticsRequest is an autogenerated conversion function.
+func Convert_operations_DiagnosticsRequest_To_v1alpha1_DiagnosticsRequest(in *operations.DiagnosticsRequest, out *DiagnosticsRequest, s conversion.Scope) error {
+	return autoConvert_operations_DiagnosticsRequest_To_v1alpha1_DiagnosticsRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiagnosticsRequestSpec_To_operations_DiagnosticsRequestSpec(in *DiagnosticsRequestSpec, out *operations.DiagnosticsRequestSpec, s conversion.Scope) error {
+	if err := v1.Convert_Pointer_int64_To_int64(&in.SinceSeconds, &out.SinceSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DiagnosticsRequestSpec_To_operations_DiagnosticsRequestSpec is an autogenerated conversion function.
+func Convert_v1alpha1_DiagnosticsRequestSpec_To_operations_DiagnosticsRequestSpec(in *DiagnosticsRequestSpec, out *operations.DiagnosticsRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiagnosticsRequestSpec_To_operations_DiagnosticsRequestSpec(in, out, s)
+}
+
+func autoConvert_operations_DiagnosticsRequestSpec_To_v1alpha1_DiagnosticsRequestSpec(in *operations.DiagnosticsRequestSpec, out *DiagnosticsRequestSpec, s conversion.Scope) error {
+	if err := v1.Convert_int64_To_Pointer_int64(&in.SinceSeconds, &out.SinceSeconds, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_DiagnosticsRequestSpec_To_v1alpha1_DiagnosticsRequestSpec is an autogenerated conversion function.
+func Convert_operations_DiagnosticsRequestSpec_To_v1alpha1_DiagnosticsRequestSpec(in *operations.DiagnosticsRequestSpec, out *DiagnosticsRequestSpec, s conversion.Scope) error {
+	return autoConvert_operations_DiagnosticsRequestSpec_To_v1alpha1_DiagnosticsRequestSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiagnosticsRequestStatus_To_operations_DiagnosticsRequestStatus(in *DiagnosticsRequestStatus, out *operations.DiagnosticsRequestStatus, s conversion.Scope) error {
+	out.State = operations.DiagnosticsRequestState(in.State)
+	out.Bundle = *(*[]byte)(unsafe.Pointer(&in.Bundle))
+	out.CollectionTimestamp = (*v1.Time)(unsafe.Pointer(in.CollectionTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1alpha1_DiagnosticsRequestStatus_To_operations_DiagnosticsRequestStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DiagnosticsRequestStatus_To_operations_DiagnosticsRequestStatus(in *DiagnosticsRequestStatus, out *operations.DiagnosticsRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiagnosticsRequestStatus_To_operations_DiagnosticsRequestStatus(in, out, s)
+}
+
+func autoConvert_operations_DiagnosticsRequestStatus_To_v1alpha1_DiagnosticsRequestStatus(in *operations.DiagnosticsRequestStatus, out *DiagnosticsRequestStatus, s conversion.Scope) error {
+	out.State = DiagnosticsRequestState(in.State)
+	out.Bundle = *(*[]byte)(unsafe.Pointer(&in.Bundle))
+	out.CollectionTimestamp = (*v1.Time)(unsafe.Pointer(in.CollectionTimestamp))
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_operations_DiagnosticsRequestStatus_To_v1alpha1_DiagnosticsRequestStatus is an autogenerated conversion function.
+func Convert_operations_DiagnosticsRequestStatus_To_v1alpha1_DiagnosticsRequestStatus(in *operations.DiagnosticsRequestStatus, out *DiagnosticsRequestStatus, s conversion.Scope) error {
+	return autoConvert_operations_DiagnosticsRequestStatus_To_v1alpha1_DiagnosticsRequestStatus(in, out, s)
+}
@@ -17,5 +17,15 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&AlertSilenceRequest{}, func(obj interface{}) { SetObjectDefaults_AlertSilenceRequest(obj.(*AlertSilenceRequest)) })
+	scheme.AddTypeDefaultingFunc(&DiagnosticsRequest{}, func(obj interface{}) { SetObjectDefaults_DiagnosticsRequest(obj.(*DiagnosticsRequest)) })
 	return nil
 }
+
+func SetObjectDefaults_AlertSilenceRequest(in *AlertSilenceRequest) {
+	SetDefaults_AlertSilenceRequestSpec(&in.Spec)
+}
+
+func SetObjectDefaults_DiagnosticsRequest(in *DiagnosticsRequest) {
+	SetDefaults_DiagnosticsRequestSpec(&in.Spec)
+}
@@ -9,6 +9,21 @@
 
 package v1alpha1
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AlertSilenceRequest) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.AlertSilenceRequest"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AlertSilenceRequestSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.AlertSilenceRequestSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AlertSilenceRequestStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.AlertSilenceRequestStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Bastion) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.Bastion"
@@ -24,6 +39,16 @@ func (in BastionList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in BastionSession) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSession"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in BastionSessionRecording) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSessionRecording"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in BastionSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionSpec"
@@ -33,3 +58,43 @@ func (in BastionSpec) OpenAPIModelName() string {
 func (in BastionStatus) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.BastionStatus"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CloneRequest) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.CloneRequest"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CloneRequestNetworking) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.CloneRequestNetworking"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CloneRequestResource) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.CloneRequestResource"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CloneRequestSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.CloneRequestSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CloneRequestStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.CloneRequestStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DiagnosticsRequest) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.DiagnosticsRequest"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DiagnosticsRequestSpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.DiagnosticsRequestSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in DiagnosticsRequestStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.operations.v1alpha1.DiagnosticsRequestStatus"
+}
@@ -15,6 +15,85 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceRequest) DeepCopyInto(out *AlertSilenceRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceRequest.
+func (in *AlertSilenceRequest) DeepCopy() *AlertSilenceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AlertSilenceRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceRequestSpec) DeepCopyInto(out *AlertSilenceRequestSpec) {
+	*out = *in
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]core.AlertMatcher, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceRequestSpec.
+func (in *AlertSilenceRequestSpec) DeepCopy() *AlertSilenceRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertSilenceRequestStatus) DeepCopyInto(out *AlertSilenceRequestStatus) {
+	*out = *in
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	if in.EndsAt != nil {
+		in, out := &in.EndsAt, &out.EndsAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSilenceRequestStatus.
+func (in *AlertSilenceRequestStatus) DeepCopy() *AlertSilenceRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertSilenceRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bastion) DeepCopyInto(out *Bastion) {
 	*out = *in
@@ -93,6 +172,48 @@ func (in *BastionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSession) DeepCopyInto(out *BastionSession) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Transcript != nil {
+		in, out := &in.Transcript, &out.Transcript
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSession.
+func (in *BastionSession) DeepCopy() *BastionSession {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSessionRecording) DeepCopyInto(out *BastionSessionRecording) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSessionRecording.
+func (in *BastionSessionRecording) DeepCopy() *BastionSessionRecording {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSessionRecording)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 	*out = *in
@@ -114,6 +235,11 @@ func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SessionRecording != nil {
+		in, out := &in.SessionRecording, &out.SessionRecording
+		*out = new(BastionSessionRecording)
+		**out = **in
+	}
 	return
 }
 
@@ -155,6 +281,18 @@ func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = make([]BastionSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SSHCertificate != nil {
+		in, out := &in.SSHCertificate, &out.SSHCertificate
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -167,3 +305,208 @@ func (in *BastionStatus) DeepCopy() *BastionStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneRequest) DeepCopyInto(out *CloneRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneRequest.
+func (in *CloneRequest) DeepCopy() *CloneRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloneRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneRequestNetworking) DeepCopyInto(out *CloneRequestNetworking) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(string)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneRequestNetworking.
+func (in *CloneRequestNetworking) DeepCopy() *CloneRequestNetworking {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneRequestNetworking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneRequestResource) DeepCopyInto(out *CloneRequestResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneRequestResource.
+func (in *CloneRequestResource) DeepCopy() *CloneRequestResource {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneRequestResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneRequestSpec) DeepCopyInto(out *CloneRequestSpec) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSDomain != nil {
+		in, out := &in.DNSDomain, &out.DNSDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(CloneRequestNetworking)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]CloneRequestResource, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneRequestSpec.
+func (in *CloneRequestSpec) DeepCopy() *CloneRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneRequestStatus) DeepCopyInto(out *CloneRequestStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneRequestStatus.
+func (in *CloneRequestStatus) DeepCopy() *CloneRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiagnosticsRequest) DeepCopyInto(out *DiagnosticsRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticsRequest.
+func (in *DiagnosticsRequest) DeepCopy() *DiagnosticsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DiagnosticsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiagnosticsRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiagnosticsRequestSpec) DeepCopyInto(out *DiagnosticsRequestSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticsRequestSpec.
+func (in *DiagnosticsRequestSpec) DeepCopy() *DiagnosticsRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiagnosticsRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiagnosticsRequestStatus) DeepCopyInto(out *DiagnosticsRequestStatus) {
+	*out = *in
+	if in.Bundle != nil {
+		in, out := &in.Bundle, &out.Bundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.CollectionTimestamp != nil {
+		in, out := &in.CollectionTimestamp, &out.CollectionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticsRequestStatus.
+func (in *DiagnosticsRequestStatus) DeepCopy() *DiagnosticsRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiagnosticsRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
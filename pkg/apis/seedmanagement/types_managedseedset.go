@@ -67,6 +67,8 @@ type UpdateStrategy struct {
 	Type *UpdateStrategyType
 	// RollingUpdate is used to communicate parameters when Type is RollingUpdateStrategyType.
 	RollingUpdate *RollingUpdateStrategy
+	// Canary is used to communicate parameters when Type is CanaryUpdateStrategyType.
+	Canary *CanaryUpdateStrategy
 }
 
 // UpdateStrategyType is a string enumeration type that enumerates
@@ -78,6 +80,9 @@ const (
 	// applied to all ManagedSeeds / Shoots in the ManagedSeedSet with respect to the ManagedSeedSet
 	// ordering constraints.
 	RollingUpdateStrategyType UpdateStrategyType = "RollingUpdate"
+	// CanaryUpdateStrategyType indicates that update will first be applied to a single ManagedSeed (the canary) and
+	// rolled out to the remaining ManagedSeeds only after the canary has stayed healthy for the configured bake time.
+	CanaryUpdateStrategyType UpdateStrategyType = "Canary"
 )
 
 // RollingUpdateStrategy is used to communicate parameter for RollingUpdateStrategyType.
@@ -86,6 +91,31 @@ type RollingUpdateStrategy struct {
 	Partition *int32
 }
 
+// CanaryUpdateStrategy is used to communicate parameters for CanaryUpdateStrategyType.
+type CanaryUpdateStrategy struct {
+	// BakeTime is the duration for which the canary must stay healthy after it has become ready before the update is
+	// rolled out to the remaining replicas. It is also the maximum duration for the canary to become ready after it has
+	// been updated. Defaults to 1h.
+	BakeTime *metav1.Duration
+	// MaxUnhealthyShoots is the maximum number of Shoots scheduled on the canary's Seed which may become unhealthy while
+	// the canary is baking without failing the canary. Defaults to 0.
+	MaxUnhealthyShoots *int32
+	// FailurePolicy determines what happens if the canary fails. Defaults to Pause.
+	FailurePolicy *CanaryFailurePolicy
+}
+
+// CanaryFailurePolicy is a string enumeration type that enumerates all possible policies for failed canaries.
+type CanaryFailurePolicy string
+
+const (
+	// CanaryFailurePolicyPause indicates that the update is paused if the canary fails, i.e., the canary keeps the
+	// updated template and the remaining replicas are not updated.
+	CanaryFailurePolicyPause CanaryFailurePolicy = "Pause"
+	// CanaryFailurePolicyRollback indicates that the canary is rolled back to the current revision if it fails, and the
+	// remaining replicas are not updated.
+	CanaryFailurePolicyRollback CanaryFailurePolicy = "Rollback"
+)
+
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
 type ManagedSeedSetStatus struct {
 	// ObservedGeneration is the most recent generation observed for this ManagedSeedSet. It corresponds to the
@@ -118,6 +148,8 @@ type ManagedSeedSetStatus struct {
 	// PendingReplica, if not empty, indicates the replica that is currently pending creation, update, or deletion.
 	// This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.
 	PendingReplica *PendingReplica
+	// Canary, if not empty, indicates the progress of the canary of the last update when the update strategy is Canary.
+	Canary *CanaryStatus
 }
 
 // PendingReplicaReason is a string enumeration type that enumerates all possible reasons for a replica to be pending.
@@ -155,4 +187,37 @@ type PendingReplica struct {
 	Retries *int32
 }
 
+// CanaryPhase is a string enumeration type that enumerates all possible phases of a canary.
+type CanaryPhase string
+
+const (
+	// CanaryPhaseProgressing indicates that the canary has been updated and is not yet ready.
+	CanaryPhaseProgressing CanaryPhase = "Progressing"
+	// CanaryPhaseBaking indicates that the canary is ready and its health is observed for the configured bake time.
+	CanaryPhaseBaking CanaryPhase = "Baking"
+	// CanaryPhaseSucceeded indicates that the canary has stayed healthy for the bake time and the update is rolled out
+	// to the remaining replicas.
+	CanaryPhaseSucceeded CanaryPhase = "Succeeded"
+	// CanaryPhasePaused indicates that the canary has failed and the update has been paused.
+	CanaryPhasePaused CanaryPhase = "Paused"
+	// CanaryPhaseRolledBack indicates that the canary has failed and has been rolled back to the current revision.
+	CanaryPhaseRolledBack CanaryPhase = "RolledBack"
+)
+
+// CanaryStatus contains information about the canary of an update.
+type CanaryStatus struct {
+	// Replica is the name of the canary replica.
+	Replica string
+	// Revision is the revision the canary has been updated to.
+	Revision string
+	// Phase is the phase of the canary.
+	Phase CanaryPhase
+	// LastUpdateTime is the moment in time when the canary has been updated.
+	LastUpdateTime metav1.Time
+	// BakeStartTime is the moment in time when the canary has become ready and started baking.
+	BakeStartTime *metav1.Time
+	// Message is a human-readable message indicating details about the phase of the canary.
+	Message string
+}
+
 // TODO Condition constants
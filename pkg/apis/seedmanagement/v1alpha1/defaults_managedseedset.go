@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -33,6 +36,11 @@ func SetDefaults_UpdateStrategy(obj *UpdateStrategy) {
 		t := RollingUpdateStrategyType
 		obj.Type = &t
 	}
+
+	// Set default canary parameters
+	if *obj.Type == CanaryUpdateStrategyType && obj.Canary == nil {
+		obj.Canary = &CanaryUpdateStrategy{}
+	}
 }
 
 // SetDefaults_RollingUpdateStrategy sets default values for RollingUpdateStrategy objects.
@@ -42,3 +50,22 @@ func SetDefaults_RollingUpdateStrategy(obj *RollingUpdateStrategy) {
 		obj.Partition = ptr.To[int32](0)
 	}
 }
+
+// SetDefaults_CanaryUpdateStrategy sets default values for CanaryUpdateStrategy objects.
+func SetDefaults_CanaryUpdateStrategy(obj *CanaryUpdateStrategy) {
+	// Set default bake time
+	if obj.BakeTime == nil {
+		obj.BakeTime = &metav1.Duration{Duration: time.Hour}
+	}
+
+	// Set default max unhealthy shoots
+	if obj.MaxUnhealthyShoots == nil {
+		obj.MaxUnhealthyShoots = ptr.To[int32](0)
+	}
+
+	// Set default failure policy
+	if obj.FailurePolicy == nil {
+		p := CanaryFailurePolicyPause
+		obj.FailurePolicy = &p
+	}
+}
@@ -5,8 +5,11 @@
 package v1alpha1_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
@@ -51,6 +54,15 @@ var _ = Describe("Defaults", func() {
 			}))
 		})
 
+		It("should default canary if type is Canary", func() {
+			obj.Spec.UpdateStrategy = &UpdateStrategy{
+				Type: ptr.To(CanaryUpdateStrategyType),
+			}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.UpdateStrategy.Canary).NotTo(BeNil())
+		})
+
 		It("should not overwrite already set values for UpdateStrategy", func() {
 			obj.Spec.UpdateStrategy = &UpdateStrategy{
 				Type: ptr.To(UpdateStrategyType("foo")),
@@ -88,4 +100,36 @@ var _ = Describe("Defaults", func() {
 			}))
 		})
 	})
+
+	Describe("CanaryUpdateStrategy defaulting", func() {
+		It("should default bake time, max unhealthy shoots, and failure policy", func() {
+			obj.Spec.UpdateStrategy = &UpdateStrategy{
+				Canary: &CanaryUpdateStrategy{},
+			}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.UpdateStrategy.Canary).To(Equal(&CanaryUpdateStrategy{
+				BakeTime:           &metav1.Duration{Duration: time.Hour},
+				MaxUnhealthyShoots: ptr.To[int32](0),
+				FailurePolicy:      ptr.To(CanaryFailurePolicyPause),
+			}))
+		})
+
+		It("should not overwrite the already set values for CanaryUpdateStrategy", func() {
+			obj.Spec.UpdateStrategy = &UpdateStrategy{
+				Canary: &CanaryUpdateStrategy{
+					BakeTime:           &metav1.Duration{Duration: 10 * time.Minute},
+					MaxUnhealthyShoots: ptr.To[int32](2),
+					FailurePolicy:      ptr.To(CanaryFailurePolicyRollback),
+				},
+			}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.UpdateStrategy.Canary).To(Equal(&CanaryUpdateStrategy{
+				BakeTime:           &metav1.Duration{Duration: 10 * time.Minute},
+				MaxUnhealthyShoots: ptr.To[int32](2),
+				FailurePolicy:      ptr.To(CanaryFailurePolicyRollback),
+			}))
+		})
+	})
 })
@@ -9,6 +9,10 @@
 
 package v1alpha1
 
+func (*CanaryStatus) ProtoMessage() {}
+
+func (*CanaryUpdateStrategy) ProtoMessage() {}
+
 func (*Gardenlet) ProtoMessage() {}
 
 func (*GardenletConfig) ProtoMessage() {}
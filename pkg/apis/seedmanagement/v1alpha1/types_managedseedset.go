@@ -76,6 +76,9 @@ type UpdateStrategy struct {
 	// RollingUpdate is used to communicate parameters when Type is RollingUpdateStrategyType.
 	// +optional
 	RollingUpdate *RollingUpdateStrategy `json:"rollingUpdate,omitempty" protobuf:"bytes,2,opt,name=rollingUpdate"`
+	// Canary is used to communicate parameters when Type is CanaryUpdateStrategyType.
+	// +optional
+	Canary *CanaryUpdateStrategy `json:"canary,omitempty" protobuf:"bytes,3,opt,name=canary"`
 }
 
 // UpdateStrategyType is a string enumeration type that enumerates
@@ -87,6 +90,9 @@ const (
 	// applied to all ManagedSeeds / Shoots in the ManagedSeedSet with respect to the ManagedSeedSet
 	// ordering constraints.
 	RollingUpdateStrategyType UpdateStrategyType = "RollingUpdate"
+	// CanaryUpdateStrategyType indicates that update will first be applied to a single ManagedSeed (the canary) and
+	// rolled out to the remaining ManagedSeeds only after the canary has stayed healthy for the configured bake time.
+	CanaryUpdateStrategyType UpdateStrategyType = "Canary"
 )
 
 // RollingUpdateStrategy is used to communicate parameters for RollingUpdateStrategyType.
@@ -96,6 +102,34 @@ type RollingUpdateStrategy struct {
 	Partition *int32 `json:"partition,omitempty" protobuf:"varint,1,opt,name=partition"`
 }
 
+// CanaryUpdateStrategy is used to communicate parameters for CanaryUpdateStrategyType.
+type CanaryUpdateStrategy struct {
+	// BakeTime is the duration for which the canary must stay healthy after it has become ready before the update is
+	// rolled out to the remaining replicas. It is also the maximum duration for the canary to become ready after it has
+	// been updated. Defaults to 1h.
+	// +optional
+	BakeTime *metav1.Duration `json:"bakeTime,omitempty" protobuf:"bytes,1,opt,name=bakeTime"`
+	// MaxUnhealthyShoots is the maximum number of Shoots scheduled on the canary's Seed which may become unhealthy while
+	// the canary is baking without failing the canary. Defaults to 0.
+	// +optional
+	MaxUnhealthyShoots *int32 `json:"maxUnhealthyShoots,omitempty" protobuf:"varint,2,opt,name=maxUnhealthyShoots"`
+	// FailurePolicy determines what happens if the canary fails. Defaults to Pause.
+	// +optional
+	FailurePolicy *CanaryFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,3,opt,name=failurePolicy,casttype=CanaryFailurePolicy"`
+}
+
+// CanaryFailurePolicy is a string enumeration type that enumerates all possible policies for failed canaries.
+type CanaryFailurePolicy string
+
+const (
+	// CanaryFailurePolicyPause indicates that the update is paused if the canary fails, i.e., the canary keeps the
+	// updated template and the remaining replicas are not updated.
+	CanaryFailurePolicyPause CanaryFailurePolicy = "Pause"
+	// CanaryFailurePolicyRollback indicates that the canary is rolled back to the current revision if it fails, and the
+	// remaining replicas are not updated.
+	CanaryFailurePolicyRollback CanaryFailurePolicy = "Rollback"
+)
+
 // ManagedSeedSetStatus represents the current state of a ManagedSeedSet.
 type ManagedSeedSetStatus struct {
 	// ObservedGeneration is the most recent generation observed for this ManagedSeedSet. It corresponds to the
@@ -133,6 +167,9 @@ type ManagedSeedSetStatus struct {
 	// This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.
 	// +optional
 	PendingReplica *PendingReplica `json:"pendingReplica,omitempty" protobuf:"bytes,11,opt,name=pendingReplica"`
+	// Canary, if not empty, indicates the progress of the canary of the last update when the update strategy is Canary.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty" protobuf:"bytes,12,opt,name=canary"`
 }
 
 // PendingReplicaReason is a string enumeration type that enumerates all possible reasons for a replica to be pending.
@@ -171,4 +208,39 @@ type PendingReplica struct {
 	Retries *int32 `json:"retries,omitempty" protobuf:"varint,4,opt,name=retries"`
 }
 
+// CanaryPhase is a string enumeration type that enumerates all possible phases of a canary.
+type CanaryPhase string
+
+const (
+	// CanaryPhaseProgressing indicates that the canary has been updated and is not yet ready.
+	CanaryPhaseProgressing CanaryPhase = "Progressing"
+	// CanaryPhaseBaking indicates that the canary is ready and its health is observed for the configured bake time.
+	CanaryPhaseBaking CanaryPhase = "Baking"
+	// CanaryPhaseSucceeded indicates that the canary has stayed healthy for the bake time and the update is rolled out
+	// to the remaining replicas.
+	CanaryPhaseSucceeded CanaryPhase = "Succeeded"
+	// CanaryPhasePaused indicates that the canary has failed and the update has been paused.
+	CanaryPhasePaused CanaryPhase = "Paused"
+	// CanaryPhaseRolledBack indicates that the canary has failed and has been rolled back to the current revision.
+	CanaryPhaseRolledBack CanaryPhase = "RolledBack"
+)
+
+// CanaryStatus contains information about the canary of an update.
+type CanaryStatus struct {
+	// Replica is the name of the canary replica.
+	Replica string `json:"replica" protobuf:"bytes,1,opt,name=replica"`
+	// Revision is the revision the canary has been updated to.
+	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
+	// Phase is the phase of the canary.
+	Phase CanaryPhase `json:"phase" protobuf:"bytes,3,opt,name=phase,casttype=CanaryPhase"`
+	// LastUpdateTime is the moment in time when the canary has been updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+	// BakeStartTime is the moment in time when the canary has become ready and started baking.
+	// +optional
+	BakeStartTime *metav1.Time `json:"bakeStartTime,omitempty" protobuf:"bytes,5,opt,name=bakeStartTime"`
+	// Message is a human-readable message indicating details about the phase of the canary.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
 // TODO Condition constants
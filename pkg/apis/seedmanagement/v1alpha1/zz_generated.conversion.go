@@ -17,6 +17,7 @@ import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	seedmanagement "github.com/gardener/gardener/pkg/apis/seedmanagement"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -28,6 +29,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CanaryStatus)(nil), (*seedmanagement.CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryStatus_To_seedmanagement_CanaryStatus(a.(*CanaryStatus), b.(*seedmanagement.CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.CanaryStatus)(nil), (*CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_CanaryStatus_To_v1alpha1_CanaryStatus(a.(*seedmanagement.CanaryStatus), b.(*CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryUpdateStrategy)(nil), (*seedmanagement.CanaryUpdateStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryUpdateStrategy_To_seedmanagement_CanaryUpdateStrategy(a.(*CanaryUpdateStrategy), b.(*seedmanagement.CanaryUpdateStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.CanaryUpdateStrategy)(nil), (*CanaryUpdateStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_CanaryUpdateStrategy_To_v1alpha1_CanaryUpdateStrategy(a.(*seedmanagement.CanaryUpdateStrategy), b.(*CanaryUpdateStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Gardenlet)(nil), (*seedmanagement.Gardenlet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(a.(*Gardenlet), b.(*seedmanagement.Gardenlet), scope)
 	}); err != nil {
@@ -281,6 +302,60 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_CanaryStatus_To_seedmanagement_CanaryStatus(in *CanaryStatus, out *seedmanagement.CanaryStatus, s conversion.Scope) error {
+	out.Replica = in.Replica
+	out.Revision = in.Revision
+	out.Phase = seedmanagement.CanaryPhase(in.Phase)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.BakeStartTime = (*metav1.Time)(unsafe.Pointer(in.BakeStartTime))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_CanaryStatus_To_seedmanagement_CanaryStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryStatus_To_seedmanagement_CanaryStatus(in *CanaryStatus, out *seedmanagement.CanaryStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryStatus_To_seedmanagement_CanaryStatus(in, out, s)
+}
+
+func autoConvert_seedmanagement_CanaryStatus_To_v1alpha1_CanaryStatus(in *seedmanagement.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	out.Replica = in.Replica
+	out.Revision = in.Revision
+	out.Phase = CanaryPhase(in.Phase)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.BakeStartTime = (*metav1.Time)(unsafe.Pointer(in.BakeStartTime))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_seedmanagement_CanaryStatus_To_v1alpha1_CanaryStatus is an autogenerated conversion function.
+func Convert_seedmanagement_CanaryStatus_To_v1alpha1_CanaryStatus(in *seedmanagement.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	return autoConvert_seedmanagement_CanaryStatus_To_v1alpha1_CanaryStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryUpdateStrategy_To_seedmanagement_CanaryUpdateStrategy(in *CanaryUpdateStrategy, out *seedmanagement.CanaryUpdateStrategy, s conversion.Scope) error {
+	out.BakeTime = (*metav1.Duration)(unsafe.Pointer(in.BakeTime))
+	out.MaxUnhealthyShoots = (*int32)(unsafe.Pointer(in.MaxUnhealthyShoots))
+	out.FailurePolicy = (*seedmanagement.CanaryFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_v1alpha1_CanaryUpdateStrategy_To_seedmanagement_CanaryUpdateStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryUpdateStrategy_To_seedmanagement_CanaryUpdateStrategy(in *CanaryUpdateStrategy, out *seedmanagement.CanaryUpdateStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryUpdateStrategy_To_seedmanagement_CanaryUpdateStrategy(in, out, s)
+}
+
+func autoConvert_seedmanagement_CanaryUpdateStrategy_To_v1alpha1_CanaryUpdateStrategy(in *seedmanagement.CanaryUpdateStrategy, out *CanaryUpdateStrategy, s conversion.Scope) error {
+	out.BakeTime = (*metav1.Duration)(unsafe.Pointer(in.BakeTime))
+	out.MaxUnhealthyShoots = (*int32)(unsafe.Pointer(in.MaxUnhealthyShoots))
+	out.FailurePolicy = (*CanaryFailurePolicy)(unsafe.Pointer(in.FailurePolicy))
+	return nil
+}
+
+// Convert_seedmanagement_CanaryUpdateStrategy_To_v1alpha1_CanaryUpdateStrategy is an autogenerated conversion function.
+func Convert_seedmanagement_CanaryUpdateStrategy_To_v1alpha1_CanaryUpdateStrategy(in *seedmanagement.CanaryUpdateStrategy, out *CanaryUpdateStrategy, s conversion.Scope) error {
+	return autoConvert_seedmanagement_CanaryUpdateStrategy_To_v1alpha1_CanaryUpdateStrategy(in, out, s)
+}
+
 func autoConvert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in *Gardenlet, out *seedmanagement.Gardenlet, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_GardenletSpec_To_seedmanagement_GardenletSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -741,6 +816,7 @@ func autoConvert_v1alpha1_ManagedSeedSetStatus_To_seedmanagement_ManagedSeedSetS
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.PendingReplica = (*seedmanagement.PendingReplica)(unsafe.Pointer(in.PendingReplica))
+	out.Canary = (*seedmanagement.CanaryStatus)(unsafe.Pointer(in.Canary))
 	return nil
 }
 
@@ -761,6 +837,7 @@ func autoConvert_seedmanagement_ManagedSeedSetStatus_To_v1alpha1_ManagedSeedSetS
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.PendingReplica = (*PendingReplica)(unsafe.Pointer(in.PendingReplica))
+	out.Canary = (*CanaryStatus)(unsafe.Pointer(in.Canary))
 	return nil
 }
 
@@ -912,6 +989,7 @@ func Convert_seedmanagement_Shoot_To_v1alpha1_Shoot(in *seedmanagement.Shoot, ou
 func autoConvert_v1alpha1_UpdateStrategy_To_seedmanagement_UpdateStrategy(in *UpdateStrategy, out *seedmanagement.UpdateStrategy, s conversion.Scope) error {
 	out.Type = (*seedmanagement.UpdateStrategyType)(unsafe.Pointer(in.Type))
 	out.RollingUpdate = (*seedmanagement.RollingUpdateStrategy)(unsafe.Pointer(in.RollingUpdate))
+	out.Canary = (*seedmanagement.CanaryUpdateStrategy)(unsafe.Pointer(in.Canary))
 	return nil
 }
 
@@ -923,6 +1001,7 @@ func Convert_v1alpha1_UpdateStrategy_To_seedmanagement_UpdateStrategy(in *Update
 func autoConvert_seedmanagement_UpdateStrategy_To_v1alpha1_UpdateStrategy(in *seedmanagement.UpdateStrategy, out *UpdateStrategy, s conversion.Scope) error {
 	out.Type = (*UpdateStrategyType)(unsafe.Pointer(in.Type))
 	out.RollingUpdate = (*RollingUpdateStrategy)(unsafe.Pointer(in.RollingUpdate))
+	out.Canary = (*CanaryUpdateStrategy)(unsafe.Pointer(in.Canary))
 	return nil
 }
 
@@ -240,6 +240,9 @@ func SetObjectDefaults_ManagedSeedSet(in *ManagedSeedSet) {
 		if in.Spec.UpdateStrategy.RollingUpdate != nil {
 			SetDefaults_RollingUpdateStrategy(in.Spec.UpdateStrategy.RollingUpdate)
 		}
+		if in.Spec.UpdateStrategy.Canary != nil {
+			SetDefaults_CanaryUpdateStrategy(in.Spec.UpdateStrategy.Canary)
+		}
 	}
 }
 
@@ -9,6 +9,16 @@
 
 package v1alpha1
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CanaryStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.CanaryStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in CanaryUpdateStrategy) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.CanaryUpdateStrategy"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Gardenlet) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Gardenlet"
@@ -37,8 +37,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ClusterOpenIDConnectPreset{},
 		&ClusterOpenIDConnectPresetList{},
+		&ClusterShootPolicy{},
+		&ClusterShootPolicyList{},
 		&OpenIDConnectPreset{},
 		&OpenIDConnectPresetList{},
+		&ShootPolicy{},
+		&ShootPolicyList{},
 	)
 
 	return nil
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicy contains CEL validation and mutation rules that are enforced
+// for Shoots cluster-wide.
+type ClusterShootPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of this ClusterShootPolicy.
+	Spec ClusterShootPolicySpec
+	// Status contains the most recently observed status of this ClusterShootPolicy.
+	Status ShootPolicyStatus
+}
+
+// ClusterShootPolicySpec contains the ShootPolicy specification and
+// project selector matching Shoots in Projects.
+type ClusterShootPolicySpec struct {
+	ShootPolicySpec
+
+	// ProjectSelector decides whether the policy is evaluated for a Shoot in a
+	// Project matching the label selector.
+	// Defaults to the empty LabelSelector, which matches everything.
+	ProjectSelector *metav1.LabelSelector
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicyList is a collection of ClusterShootPolicies.
+type ClusterShootPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of ClusterShootPolicies.
+	Items []ClusterShootPolicy
+}
+
+var _ Policy = &ClusterShootPolicy{}
+
+// GetPolicySpec returns a pointer to the policy specification.
+func (p *ClusterShootPolicy) GetPolicySpec() *ShootPolicySpec {
+	return &p.Spec.ShootPolicySpec
+}
+
+// GetPolicyStatus returns a pointer to the policy status.
+func (p *ClusterShootPolicy) GetPolicyStatus() *ShootPolicyStatus {
+	return &p.Status
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicy contains CEL validation and mutation rules that are enforced
+// for Shoots in a namespace.
+type ShootPolicy struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	// Spec is the specification of this ShootPolicy.
+	Spec ShootPolicySpec
+	// Status contains the most recently observed status of this ShootPolicy.
+	Status ShootPolicyStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicyList is a collection of ShootPolicies.
+type ShootPolicyList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+
+	// Items is the list of ShootPolicies.
+	Items []ShootPolicy
+}
+
+// ShootPolicySpec contains the Shoot selector, the enforcement action and the rules of a policy.
+type ShootPolicySpec struct {
+	// ShootSelector decides whether the policy is evaluated for a Shoot with matching labels.
+	// Defaults to the empty LabelSelector, which matches everything.
+	ShootSelector *metav1.LabelSelector
+	// EnforcementAction defines how violations of the validation rules are handled.
+	EnforcementAction *ShootPolicyEnforcementAction
+	// Validations is a list of CEL expressions which must all evaluate to true for a Shoot.
+	Validations []ShootPolicyValidation
+	// Mutations is a list of CEL expressions which are applied to a Shoot in the given order.
+	Mutations []ShootPolicyMutation
+}
+
+// ShootPolicyEnforcementAction is a string alias.
+type ShootPolicyEnforcementAction string
+
+const (
+	// ShootPolicyEnforcementActionDeny denies requests which violate the policy.
+	ShootPolicyEnforcementActionDeny ShootPolicyEnforcementAction = "Deny"
+	// ShootPolicyEnforcementActionWarn admits requests which violate the policy but returns a warning to the client.
+	ShootPolicyEnforcementActionWarn ShootPolicyEnforcementAction = "Warn"
+	// ShootPolicyEnforcementActionAudit admits requests which violate the policy and only records the violation in the
+	// audit log and in the status of the policy. Mutations are not applied.
+	ShootPolicyEnforcementActionAudit ShootPolicyEnforcementAction = "Audit"
+)
+
+// ShootPolicyValidation is a CEL expression that validates a Shoot.
+type ShootPolicyValidation struct {
+	// Expression is a CEL expression which must evaluate to a boolean. The Shoot is considered to be valid if the
+	// expression evaluates to true.
+	Expression string
+	// Message is the message returned to the client if the expression evaluates to false.
+	Message *string
+}
+
+// ShootPolicyMutation is a CEL expression that mutates a Shoot.
+type ShootPolicyMutation struct {
+	// Expression is a CEL expression which must evaluate to an object that is applied to the Shoot as a JSON merge
+	// patch (RFC 7386).
+	Expression string
+}
+
+// ShootPolicyStatus contains the most recently observed status of a policy.
+type ShootPolicyStatus struct {
+	// ObservedGeneration is the most recent generation observed for this policy.
+	ObservedGeneration int64
+	// LastEvaluationTime is the time when the existing Shoots were evaluated against this policy for the last time.
+	LastEvaluationTime *metav1.Time
+	// ViolationsCount is the number of existing Shoots violating this policy.
+	ViolationsCount int32
+	// Violations is a list of existing Shoots violating this policy. The list might be truncated, see ViolationsCount
+	// for the total number of violating Shoots.
+	Violations []ShootPolicyViolation
+}
+
+// ShootPolicyViolation describes an existing Shoot which violates a policy.
+type ShootPolicyViolation struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string
+	// Name is the name of the Shoot.
+	Name string
+	// Messages are the messages of the violated validations.
+	Messages []string
+}
+
+// Policy is an interface for ShootPolicies and ClusterShootPolicies.
+type Policy interface {
+	metav1.ObjectMetaAccessor
+	GetPolicySpec() *ShootPolicySpec
+	GetPolicyStatus() *ShootPolicyStatus
+}
+
+var _ Policy = &ShootPolicy{}
+
+// GetPolicySpec returns a pointer to the policy specification.
+func (p *ShootPolicy) GetPolicySpec() *ShootPolicySpec {
+	return &p.Spec
+}
+
+// GetPolicyStatus returns a pointer to the policy status.
+func (p *ShootPolicy) GetPolicyStatus() *ShootPolicyStatus {
+	return &p.Status
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// SetDefaults_ShootPolicySpec sets default values for ShootPolicySpec objects.
+func SetDefaults_ShootPolicySpec(obj *ShootPolicySpec) {
+	if obj.ShootSelector == nil {
+		obj.ShootSelector = &metav1.LabelSelector{}
+	}
+
+	if obj.EnforcementAction == nil {
+		obj.EnforcementAction = ptr.To(ShootPolicyEnforcementActionDeny)
+	}
+}
+
+// SetDefaults_ClusterShootPolicySpec sets default values for ClusterShootPolicySpec objects.
+func SetDefaults_ClusterShootPolicySpec(obj *ClusterShootPolicySpec) {
+	if obj.ProjectSelector == nil {
+		obj.ProjectSelector = &metav1.LabelSelector{}
+	}
+}
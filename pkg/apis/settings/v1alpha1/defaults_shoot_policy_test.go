@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+)
+
+var _ = Describe("ShootPolicy defaulting", func() {
+	It("should default ShootPolicy correctly", func() {
+		obj := &ShootPolicy{}
+		expected := &ShootPolicy{
+			Spec: ShootPolicySpec{
+				ShootSelector: &metav1.LabelSelector{},
+				// string literal is used to be sure that the test fails if the constant value is changed.
+				EnforcementAction: ptr.To(ShootPolicyEnforcementAction("Deny")),
+			},
+		}
+		SetObjectDefaults_ShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+
+	It("should not default ShootPolicy if it is already set", func() {
+		obj := &ShootPolicy{
+			Spec: ShootPolicySpec{
+				ShootSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				EnforcementAction: ptr.To(ShootPolicyEnforcementActionWarn),
+			},
+		}
+		expected := obj.DeepCopy()
+		SetObjectDefaults_ShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+
+	It("should default ClusterShootPolicy correctly", func() {
+		obj := &ClusterShootPolicy{}
+		expected := &ClusterShootPolicy{
+			Spec: ClusterShootPolicySpec{
+				ShootPolicySpec: ShootPolicySpec{
+					ShootSelector:     &metav1.LabelSelector{},
+					EnforcementAction: ptr.To(ShootPolicyEnforcementAction("Deny")),
+				},
+				ProjectSelector: &metav1.LabelSelector{},
+			},
+		}
+		SetObjectDefaults_ClusterShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+
+	It("should not default ClusterShootPolicy if it is already set", func() {
+		obj := &ClusterShootPolicy{
+			Spec: ClusterShootPolicySpec{
+				ShootPolicySpec: ShootPolicySpec{
+					ShootSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					EnforcementAction: ptr.To(ShootPolicyEnforcementActionAudit),
+				},
+				ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			},
+		}
+		expected := obj.DeepCopy()
+		SetObjectDefaults_ClusterShootPolicy(obj)
+
+		Expect(obj).To(Equal(expected))
+	})
+})
@@ -15,6 +15,12 @@ func (*ClusterOpenIDConnectPresetList) ProtoMessage() {}
 
 func (*ClusterOpenIDConnectPresetSpec) ProtoMessage() {}
 
+func (*ClusterShootPolicy) ProtoMessage() {}
+
+func (*ClusterShootPolicyList) ProtoMessage() {}
+
+func (*ClusterShootPolicySpec) ProtoMessage() {}
+
 func (*KubeAPIServerOpenIDConnect) ProtoMessage() {}
 
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
@@ -24,3 +30,17 @@ func (*OpenIDConnectPreset) ProtoMessage() {}
 func (*OpenIDConnectPresetList) ProtoMessage() {}
 
 func (*OpenIDConnectPresetSpec) ProtoMessage() {}
+
+func (*ShootPolicy) ProtoMessage() {}
+
+func (*ShootPolicyList) ProtoMessage() {}
+
+func (*ShootPolicyMutation) ProtoMessage() {}
+
+func (*ShootPolicySpec) ProtoMessage() {}
+
+func (*ShootPolicyStatus) ProtoMessage() {}
+
+func (*ShootPolicyValidation) ProtoMessage() {}
+
+func (*ShootPolicyViolation) ProtoMessage() {}
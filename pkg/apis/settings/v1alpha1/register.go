@@ -39,8 +39,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ClusterOpenIDConnectPreset{},
 		&ClusterOpenIDConnectPresetList{},
+		&ClusterShootPolicy{},
+		&ClusterShootPolicyList{},
 		&OpenIDConnectPreset{},
 		&OpenIDConnectPresetList{},
+		&ShootPolicy{},
+		&ShootPolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
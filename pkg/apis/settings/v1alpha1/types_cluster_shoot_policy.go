@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicy contains CEL validation and mutation rules that are enforced
+// for Shoots cluster-wide.
+type ClusterShootPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of this ClusterShootPolicy.
+	Spec ClusterShootPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed status of this ClusterShootPolicy.
+	// +optional
+	Status ShootPolicyStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ClusterShootPolicySpec contains the ShootPolicy specification and
+// project selector matching Shoots in Projects.
+type ClusterShootPolicySpec struct {
+	ShootPolicySpec `json:",inline" protobuf:"bytes,1,opt,name=shootPolicySpec"`
+
+	// ProjectSelector decides whether the policy is evaluated for a Shoot in a
+	// Project matching the label selector.
+	// Defaults to the empty LabelSelector, which matches everything.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty" protobuf:"bytes,2,opt,name=projectSelector"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPolicyList is a collection of ClusterShootPolicies.
+type ClusterShootPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is the list of ClusterShootPolicies.
+	Items []ClusterShootPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
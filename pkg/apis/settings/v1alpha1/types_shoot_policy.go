@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicy contains CEL validation and mutation rules that are enforced
+// for Shoots in a namespace.
+type ShootPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of this ShootPolicy.
+	Spec ShootPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed status of this ShootPolicy.
+	// +optional
+	Status ShootPolicyStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicyList is a collection of ShootPolicies.
+type ShootPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Items is the list of ShootPolicies.
+	Items []ShootPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ShootPolicySpec contains the Shoot selector, the enforcement action and the rules of a policy.
+type ShootPolicySpec struct {
+	// ShootSelector decides whether the policy is evaluated for a Shoot with matching labels.
+	// Use the selector only if the policy is opt-in, because end users may skip the admission
+	// by setting the labels.
+	// Defaults to the empty LabelSelector, which matches everything.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty" protobuf:"bytes,1,opt,name=shootSelector"`
+	// EnforcementAction defines how violations of the validation rules are handled.
+	// Possible values are `Deny`, `Warn` and `Audit`.
+	// Defaults to `Deny`.
+	// +optional
+	EnforcementAction *ShootPolicyEnforcementAction `json:"enforcementAction,omitempty" protobuf:"bytes,2,opt,name=enforcementAction,casttype=ShootPolicyEnforcementAction"`
+	// Validations is a list of CEL expressions which must all evaluate to true for a Shoot.
+	// The expressions have access to the Shoot in its `core.gardener.cloud/v1beta1` representation via the `object`
+	// variable, and to the previous version of the Shoot via the `oldObject` variable (`null` on creation).
+	// +optional
+	Validations []ShootPolicyValidation `json:"validations,omitempty" protobuf:"bytes,3,rep,name=validations"`
+	// Mutations is a list of CEL expressions which are applied to a Shoot in the given order.
+	// The expressions have access to the same variables as the validations. Mutations are applied
+	// before the validations are evaluated.
+	// +optional
+	Mutations []ShootPolicyMutation `json:"mutations,omitempty" protobuf:"bytes,4,rep,name=mutations"`
+}
+
+// ShootPolicyEnforcementAction is a string alias.
+type ShootPolicyEnforcementAction string
+
+const (
+	// ShootPolicyEnforcementActionDeny denies requests which violate the policy.
+	ShootPolicyEnforcementActionDeny ShootPolicyEnforcementAction = "Deny"
+	// ShootPolicyEnforcementActionWarn admits requests which violate the policy but returns a warning to the client.
+	ShootPolicyEnforcementActionWarn ShootPolicyEnforcementAction = "Warn"
+	// ShootPolicyEnforcementActionAudit admits requests which violate the policy and only records the violation in the
+	// audit log and in the status of the policy. Mutations are not applied.
+	ShootPolicyEnforcementActionAudit ShootPolicyEnforcementAction = "Audit"
+)
+
+// ShootPolicyValidation is a CEL expression that validates a Shoot.
+type ShootPolicyValidation struct {
+	// Expression is a CEL expression which must evaluate to a boolean. The Shoot is considered to be valid if the
+	// expression evaluates to true.
+	// Example: `object.spec.purpose != 'production' || object.spec.?controlPlane.?highAvailability.hasValue()`
+	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+	// Message is the message returned to the client if the expression evaluates to false.
+	// Defaults to a message containing the failed expression.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+}
+
+// ShootPolicyMutation is a CEL expression that mutates a Shoot.
+type ShootPolicyMutation struct {
+	// Expression is a CEL expression which must evaluate to an object that is applied to the Shoot as a JSON merge
+	// patch (RFC 7386). An empty object leaves the Shoot unchanged.
+	// Example: `has(object.spec.purpose) ? {} : {'spec': {'purpose': 'evaluation'}}`
+	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+}
+
+// ShootPolicyStatus contains the most recently observed status of a policy.
+type ShootPolicyStatus struct {
+	// ObservedGeneration is the most recent generation observed for this policy.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+	// LastEvaluationTime is the time when the existing Shoots were evaluated against this policy for the last time.
+	// +optional
+	LastEvaluationTime *metav1.Time `json:"lastEvaluationTime,omitempty" protobuf:"bytes,2,opt,name=lastEvaluationTime"`
+	// ViolationsCount is the number of existing Shoots violating this policy.
+	// +optional
+	ViolationsCount int32 `json:"violationsCount,omitempty" protobuf:"varint,3,opt,name=violationsCount"`
+	// Violations is a list of existing Shoots violating this policy. The list might be truncated, see ViolationsCount
+	// for the total number of violating Shoots.
+	// +optional
+	Violations []ShootPolicyViolation `json:"violations,omitempty" protobuf:"bytes,4,rep,name=violations"`
+}
+
+// ShootPolicyViolation describes an existing Shoot which violates a policy.
+type ShootPolicyViolation struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Messages are the messages of the violated validations.
+	Messages []string `json:"messages" protobuf:"bytes,3,rep,name=messages"`
+}
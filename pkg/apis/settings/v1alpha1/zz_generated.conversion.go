@@ -55,6 +55,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicy)(nil), (*settings.ClusterShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(a.(*ClusterShootPolicy), b.(*settings.ClusterShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicy)(nil), (*ClusterShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(a.(*settings.ClusterShootPolicy), b.(*ClusterShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicyList)(nil), (*settings.ClusterShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(a.(*ClusterShootPolicyList), b.(*settings.ClusterShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicyList)(nil), (*ClusterShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(a.(*settings.ClusterShootPolicyList), b.(*ClusterShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPolicySpec)(nil), (*settings.ClusterShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(a.(*ClusterShootPolicySpec), b.(*settings.ClusterShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPolicySpec)(nil), (*ClusterShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(a.(*settings.ClusterShootPolicySpec), b.(*ClusterShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerOpenIDConnect)(nil), (*settings.KubeAPIServerOpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(a.(*KubeAPIServerOpenIDConnect), b.(*settings.KubeAPIServerOpenIDConnect), scope)
 	}); err != nil {
@@ -105,6 +135,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicy)(nil), (*settings.ShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(a.(*ShootPolicy), b.(*settings.ShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicy)(nil), (*ShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(a.(*settings.ShootPolicy), b.(*ShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyList)(nil), (*settings.ShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(a.(*ShootPolicyList), b.(*settings.ShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyList)(nil), (*ShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(a.(*settings.ShootPolicyList), b.(*ShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyMutation)(nil), (*settings.ShootPolicyMutation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyMutation_To_settings_ShootPolicyMutation(a.(*ShootPolicyMutation), b.(*settings.ShootPolicyMutation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyMutation)(nil), (*ShootPolicyMutation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyMutation_To_v1alpha1_ShootPolicyMutation(a.(*settings.ShootPolicyMutation), b.(*ShootPolicyMutation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicySpec)(nil), (*settings.ShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(a.(*ShootPolicySpec), b.(*settings.ShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicySpec)(nil), (*ShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(a.(*settings.ShootPolicySpec), b.(*ShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyStatus)(nil), (*settings.ShootPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyStatus_To_settings_ShootPolicyStatus(a.(*ShootPolicyStatus), b.(*settings.ShootPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyStatus)(nil), (*ShootPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyStatus_To_v1alpha1_ShootPolicyStatus(a.(*settings.ShootPolicyStatus), b.(*ShootPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyValidation)(nil), (*settings.ShootPolicyValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyValidation_To_settings_ShootPolicyValidation(a.(*ShootPolicyValidation), b.(*settings.ShootPolicyValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyValidation)(nil), (*ShootPolicyValidation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyValidation_To_v1alpha1_ShootPolicyValidation(a.(*settings.ShootPolicyValidation), b.(*ShootPolicyValidation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyViolation)(nil), (*settings.ShootPolicyViolation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyViolation_To_settings_ShootPolicyViolation(a.(*ShootPolicyViolation), b.(*settings.ShootPolicyViolation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyViolation)(nil), (*ShootPolicyViolation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyViolation_To_v1alpha1_ShootPolicyViolation(a.(*settings.ShootPolicyViolation), b.(*ShootPolicyViolation), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -182,6 +282,86 @@ func Convert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDCo
 	return autoConvert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDConnectPresetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in *ClusterShootPolicy, out *settings.ClusterShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ShootPolicyStatus_To_settings_ShootPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in *ClusterShootPolicy, out *settings.ClusterShootPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicy_To_settings_ClusterShootPolicy(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in *settings.ClusterShootPolicy, out *ClusterShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_settings_ShootPolicyStatus_To_v1alpha1_ShootPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in *settings.ClusterShootPolicy, out *ClusterShootPolicy, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicy_To_v1alpha1_ClusterShootPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in *ClusterShootPolicyList, out *settings.ClusterShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ClusterShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in *ClusterShootPolicyList, out *settings.ClusterShootPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicyList_To_settings_ClusterShootPolicyList(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in *settings.ClusterShootPolicyList, out *ClusterShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in *settings.ClusterShootPolicyList, out *ClusterShootPolicyList, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicyList_To_v1alpha1_ClusterShootPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in *ClusterShootPolicySpec, out *settings.ClusterShootPolicySpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(&in.ShootPolicySpec, &out.ShootPolicySpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in *ClusterShootPolicySpec, out *settings.ClusterShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPolicySpec_To_settings_ClusterShootPolicySpec(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in *settings.ClusterShootPolicySpec, out *ClusterShootPolicySpec, s conversion.Scope) error {
+	if err := Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(&in.ShootPolicySpec, &out.ShootPolicySpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec is an autogenerated conversion function.
+func Convert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in *settings.ClusterShootPolicySpec, out *ClusterShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPolicySpec_To_v1alpha1_ClusterShootPolicySpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(in *KubeAPIServerOpenIDConnect, out *settings.KubeAPIServerOpenIDConnect, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	out.ClientID = in.ClientID
@@ -317,3 +497,175 @@ func autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPrese
 func Convert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in *settings.OpenIDConnectPresetSpec, out *OpenIDConnectPresetSpec, s conversion.Scope) error {
 	return autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in *ShootPolicy, out *settings.ShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ShootPolicyStatus_To_settings_ShootPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in *ShootPolicy, out *settings.ShootPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in *settings.ShootPolicy, out *ShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_settings_ShootPolicyStatus_To_v1alpha1_ShootPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy is an autogenerated conversion function.
+func Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in *settings.ShootPolicy, out *ShootPolicy, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in *ShootPolicyList, out *settings.ShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in *ShootPolicyList, out *settings.ShootPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in *settings.ShootPolicyList, out *ShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList is an autogenerated conversion function.
+func Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in *settings.ShootPolicyList, out *ShootPolicyList, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyMutation_To_settings_ShootPolicyMutation(in *ShootPolicyMutation, out *settings.ShootPolicyMutation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyMutation_To_settings_ShootPolicyMutation is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyMutation_To_settings_ShootPolicyMutation(in *ShootPolicyMutation, out *settings.ShootPolicyMutation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyMutation_To_settings_ShootPolicyMutation(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyMutation_To_v1alpha1_ShootPolicyMutation(in *settings.ShootPolicyMutation, out *ShootPolicyMutation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	return nil
+}
+
+// Convert_settings_ShootPolicyMutation_To_v1alpha1_ShootPolicyMutation is an autogenerated conversion function.
+func Convert_settings_ShootPolicyMutation_To_v1alpha1_ShootPolicyMutation(in *settings.ShootPolicyMutation, out *ShootPolicyMutation, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyMutation_To_v1alpha1_ShootPolicyMutation(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in *ShootPolicySpec, out *settings.ShootPolicySpec, s conversion.Scope) error {
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.EnforcementAction = (*settings.ShootPolicyEnforcementAction)(unsafe.Pointer(in.EnforcementAction))
+	out.Validations = *(*[]settings.ShootPolicyValidation)(unsafe.Pointer(&in.Validations))
+	out.Mutations = *(*[]settings.ShootPolicyMutation)(unsafe.Pointer(&in.Mutations))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in *ShootPolicySpec, out *settings.ShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in *settings.ShootPolicySpec, out *ShootPolicySpec, s conversion.Scope) error {
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.EnforcementAction = (*ShootPolicyEnforcementAction)(unsafe.Pointer(in.EnforcementAction))
+	out.Validations = *(*[]ShootPolicyValidation)(unsafe.Pointer(&in.Validations))
+	out.Mutations = *(*[]ShootPolicyMutation)(unsafe.Pointer(&in.Mutations))
+	return nil
+}
+
+// Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec is an autogenerated conversion function.
+func Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in *settings.ShootPolicySpec, out *ShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyStatus_To_settings_ShootPolicyStatus(in *ShootPolicyStatus, out *settings.ShootPolicyStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastEvaluationTime = (*v1.Time)(unsafe.Pointer(in.LastEvaluationTime))
+	out.ViolationsCount = in.ViolationsCount
+	out.Violations = *(*[]settings.ShootPolicyViolation)(unsafe.Pointer(&in.Violations))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyStatus_To_settings_ShootPolicyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyStatus_To_settings_ShootPolicyStatus(in *ShootPolicyStatus, out *settings.ShootPolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyStatus_To_settings_ShootPolicyStatus(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyStatus_To_v1alpha1_ShootPolicyStatus(in *settings.ShootPolicyStatus, out *ShootPolicyStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastEvaluationTime = (*v1.Time)(unsafe.Pointer(in.LastEvaluationTime))
+	out.ViolationsCount = in.ViolationsCount
+	out.Violations = *(*[]ShootPolicyViolation)(unsafe.Pointer(&in.Violations))
+	return nil
+}
+
+// Convert_settings_ShootPolicyStatus_To_v1alpha1_ShootPolicyStatus is an autogenerated conversion function.
+func Convert_settings_ShootPolicyStatus_To_v1alpha1_ShootPolicyStatus(in *settings.ShootPolicyStatus, out *ShootPolicyStatus, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyStatus_To_v1alpha1_ShootPolicyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyValidation_To_settings_ShootPolicyValidation(in *ShootPolicyValidation, out *settings.ShootPolicyValidation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyValidation_To_settings_ShootPolicyValidation is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyValidation_To_settings_ShootPolicyValidation(in *ShootPolicyValidation, out *settings.ShootPolicyValidation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyValidation_To_settings_ShootPolicyValidation(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyValidation_To_v1alpha1_ShootPolicyValidation(in *settings.ShootPolicyValidation, out *ShootPolicyValidation, s conversion.Scope) error {
+	out.Expression = in.Expression
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_settings_ShootPolicyValidation_To_v1alpha1_ShootPolicyValidation is an autogenerated conversion function.
+func Convert_settings_ShootPolicyValidation_To_v1alpha1_ShootPolicyValidation(in *settings.ShootPolicyValidation, out *ShootPolicyValidation, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyValidation_To_v1alpha1_ShootPolicyValidation(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyViolation_To_settings_ShootPolicyViolation(in *ShootPolicyViolation, out *settings.ShootPolicyViolation, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Messages = *(*[]string)(unsafe.Pointer(&in.Messages))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyViolation_To_settings_ShootPolicyViolation is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyViolation_To_settings_ShootPolicyViolation(in *ShootPolicyViolation, out *settings.ShootPolicyViolation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyViolation_To_settings_ShootPolicyViolation(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyViolation_To_v1alpha1_ShootPolicyViolation(in *settings.ShootPolicyViolation, out *ShootPolicyViolation, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Messages = *(*[]string)(unsafe.Pointer(&in.Messages))
+	return nil
+}
+
+// Convert_settings_ShootPolicyViolation_To_v1alpha1_ShootPolicyViolation is an autogenerated conversion function.
+func Convert_settings_ShootPolicyViolation_To_v1alpha1_ShootPolicyViolation(in *settings.ShootPolicyViolation, out *ShootPolicyViolation, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyViolation_To_v1alpha1_ShootPolicyViolation(in, out, s)
+}
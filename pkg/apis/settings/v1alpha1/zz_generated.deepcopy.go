@@ -96,6 +96,89 @@ func (in *ClusterOpenIDConnectPresetSpec) DeepCopy() *ClusterOpenIDConnectPreset
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterShootPolicy) DeepCopyInto(out *ClusterShootPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterShootPolicy.
+func (in *ClusterShootPolicy) DeepCopy() *ClusterShootPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterShootPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterShootPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterShootPolicyList) DeepCopyInto(out *ClusterShootPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterShootPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterShootPolicyList.
+func (in *ClusterShootPolicyList) DeepCopy() *ClusterShootPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterShootPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterShootPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterShootPolicySpec) DeepCopyInto(out *ClusterShootPolicySpec) {
+	*out = *in
+	in.ShootPolicySpec.DeepCopyInto(&out.ShootPolicySpec)
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterShootPolicySpec.
+func (in *ClusterShootPolicySpec) DeepCopy() *ClusterShootPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterShootPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerOpenIDConnect) DeepCopyInto(out *KubeAPIServerOpenIDConnect) {
 	*out = *in
@@ -263,3 +346,187 @@ func (in *OpenIDConnectPresetSpec) DeepCopy() *OpenIDConnectPresetSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicy) DeepCopyInto(out *ShootPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicy.
+func (in *ShootPolicy) DeepCopy() *ShootPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicyList) DeepCopyInto(out *ShootPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicyList.
+func (in *ShootPolicyList) DeepCopy() *ShootPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicyMutation) DeepCopyInto(out *ShootPolicyMutation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicyMutation.
+func (in *ShootPolicyMutation) DeepCopy() *ShootPolicyMutation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicyMutation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicySpec) DeepCopyInto(out *ShootPolicySpec) {
+	*out = *in
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnforcementAction != nil {
+		in, out := &in.EnforcementAction, &out.EnforcementAction
+		*out = new(ShootPolicyEnforcementAction)
+		**out = **in
+	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ShootPolicyValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mutations != nil {
+		in, out := &in.Mutations, &out.Mutations
+		*out = make([]ShootPolicyMutation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicySpec.
+func (in *ShootPolicySpec) DeepCopy() *ShootPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicyStatus) DeepCopyInto(out *ShootPolicyStatus) {
+	*out = *in
+	if in.LastEvaluationTime != nil {
+		in, out := &in.LastEvaluationTime, &out.LastEvaluationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Violations != nil {
+		in, out := &in.Violations, &out.Violations
+		*out = make([]ShootPolicyViolation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicyStatus.
+func (in *ShootPolicyStatus) DeepCopy() *ShootPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicyValidation) DeepCopyInto(out *ShootPolicyValidation) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicyValidation.
+func (in *ShootPolicyValidation) DeepCopy() *ShootPolicyValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicyValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPolicyViolation) DeepCopyInto(out *ShootPolicyViolation) {
+	*out = *in
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPolicyViolation.
+func (in *ShootPolicyViolation) DeepCopy() *ShootPolicyViolation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPolicyViolation)
+	in.DeepCopyInto(out)
+	return out
+}
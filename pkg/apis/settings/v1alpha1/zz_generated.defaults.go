@@ -21,8 +21,12 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ClusterOpenIDConnectPresetList{}, func(obj interface{}) {
 		SetObjectDefaults_ClusterOpenIDConnectPresetList(obj.(*ClusterOpenIDConnectPresetList))
 	})
+	scheme.AddTypeDefaultingFunc(&ClusterShootPolicy{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPolicy(obj.(*ClusterShootPolicy)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootPolicyList{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPolicyList(obj.(*ClusterShootPolicyList)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPreset{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPreset(obj.(*OpenIDConnectPreset)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPresetList{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPresetList(obj.(*OpenIDConnectPresetList)) })
+	scheme.AddTypeDefaultingFunc(&ShootPolicy{}, func(obj interface{}) { SetObjectDefaults_ShootPolicy(obj.(*ShootPolicy)) })
+	scheme.AddTypeDefaultingFunc(&ShootPolicyList{}, func(obj interface{}) { SetObjectDefaults_ShootPolicyList(obj.(*ShootPolicyList)) })
 	return nil
 }
 
@@ -39,6 +43,18 @@ func SetObjectDefaults_ClusterOpenIDConnectPresetList(in *ClusterOpenIDConnectPr
 	}
 }
 
+func SetObjectDefaults_ClusterShootPolicy(in *ClusterShootPolicy) {
+	SetDefaults_ClusterShootPolicySpec(&in.Spec)
+	SetDefaults_ShootPolicySpec(&in.Spec.ShootPolicySpec)
+}
+
+func SetObjectDefaults_ClusterShootPolicyList(in *ClusterShootPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterShootPolicy(a)
+	}
+}
+
 func SetObjectDefaults_OpenIDConnectPreset(in *OpenIDConnectPreset) {
 	SetDefaults_OpenIDConnectPresetSpec(&in.Spec)
 	SetDefaults_KubeAPIServerOpenIDConnect(&in.Spec.Server)
@@ -50,3 +66,14 @@ func SetObjectDefaults_OpenIDConnectPresetList(in *OpenIDConnectPresetList) {
 		SetObjectDefaults_OpenIDConnectPreset(a)
 	}
 }
+
+func SetObjectDefaults_ShootPolicy(in *ShootPolicy) {
+	SetDefaults_ShootPolicySpec(&in.Spec)
+}
+
+func SetObjectDefaults_ShootPolicyList(in *ShootPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootPolicy(a)
+	}
+}
@@ -24,6 +24,21 @@ func (in ClusterOpenIDConnectPresetSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterOpenIDConnectPresetSpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ClusterShootPolicy) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootPolicy"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ClusterShootPolicyList) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootPolicyList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ClusterShootPolicySpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ClusterShootPolicySpec"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in KubeAPIServerOpenIDConnect) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.KubeAPIServerOpenIDConnect"
@@ -48,3 +63,38 @@ func (in OpenIDConnectPresetList) OpenAPIModelName() string {
 func (in OpenIDConnectPresetSpec) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.OpenIDConnectPresetSpec"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPolicy) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicy"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPolicyList) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicyList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPolicyMutation) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicyMutation"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPolicySpec) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicySpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPolicyStatus) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicyStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPolicyValidation) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicyValidation"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ShootPolicyViolation) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.settings.v1alpha1.ShootPolicyViolation"
+}
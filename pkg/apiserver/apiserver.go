@@ -108,6 +108,7 @@ func (c completedConfig) New() (*GardenerServer, error) {
 			KubeInformerFactory:           c.kubeInformerFactory,
 			CoreInformerFactory:           c.coreInformerFactory,
 			SubjectAccessReviewer:         c.subjectAccessReviewer,
+			LoopbackClientConfig:          c.GenericConfig.LoopbackClientConfig,
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		seedManagementAPIGroupInfo = (seedmanagementrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		settingsAPIGroupInfo       = (settingsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
@@ -50,9 +50,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.Addon{}.OpenAPIModelName():                                        schema_pkg_apis_core_v1beta1_Addon(ref),
 		v1beta1.Addons{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Addons(ref),
 		v1beta1.AdmissionPlugin{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
+		v1beta1.AlertMatcher{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_AlertMatcher(ref),
+		v1beta1.AlertReceiver{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_AlertReceiver(ref),
+		v1beta1.AlertSilence{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_AlertSilence(ref),
 		v1beta1.Alerting{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_Alerting(ref),
+		v1beta1.AuditBackends{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_AuditBackends(ref),
 		v1beta1.AuditConfig{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_AuditConfig(ref),
+		v1beta1.AuditLogBackend{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_AuditLogBackend(ref),
 		v1beta1.AuditPolicy{}.OpenAPIModelName():                                  schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
+		v1beta1.AuditWebhookBackend{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_AuditWebhookBackend(ref),
 		v1beta1.AuthorizerKubeconfigReference{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref),
 		v1beta1.AvailabilityZone{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
 		v1beta1.Backup{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Backup(ref),
@@ -83,6 +89,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ContainerRuntime{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
 		v1beta1.ControlPlane{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_ControlPlane(ref),
 		v1beta1.ControlPlaneAutoscaling{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ControlPlaneAutoscaling(ref),
+		v1beta1.ControlPlaneSLO{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_ControlPlaneSLO(ref),
+		v1beta1.ControlPlaneSLOReport{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_ControlPlaneSLOReport(ref),
+		v1beta1.ControlPlaneServiceLevel{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_ControlPlaneServiceLevel(ref),
 		v1beta1.ControllerDeployment{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
 		v1beta1.ControllerDeploymentList{}.OpenAPIModelName():                     schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref),
 		v1beta1.ControllerInstallation{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
@@ -155,6 +164,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.LifecycleStage{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_LifecycleStage(ref),
 		v1beta1.Limits{}.OpenAPIModelName():                                       schema_pkg_apis_core_v1beta1_Limits(ref),
 		v1beta1.LoadBalancerServicesProxyProtocol{}.OpenAPIModelName():            schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref),
+		v1beta1.Logging{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Logging(ref),
+		v1beta1.LoggingOutput{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_LoggingOutput(ref),
 		v1beta1.Machine{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_Machine(ref),
 		v1beta1.MachineControllerManagerSettings{}.OpenAPIModelName():             schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref),
 		v1beta1.MachineImage{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_MachineImage(ref),
@@ -172,6 +183,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref),
 		v1beta1.MemorySwapConfiguration{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		v1beta1.Monitoring{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_Monitoring(ref),
+		v1beta1.MonitoringRules{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_MonitoringRules(ref),
 		v1beta1.NamedResourceReference{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_NamedResourceReference(ref),
 		v1beta1.NamespacedCloudProfile{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref),
 		v1beta1.NamespacedCloudProfileList{}.OpenAPIModelName():                   schema_pkg_apis_core_v1beta1_NamespacedCloudProfileList(ref),
@@ -208,6 +220,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.SeedDNS{}.OpenAPIModelName():                                      schema_pkg_apis_core_v1beta1_SeedDNS(ref),
 		v1beta1.SeedDNSProvider{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
 		v1beta1.SeedDNSProviderConfig{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref),
+		v1beta1.SeedDrainStatus{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref),
 		v1beta1.SeedList{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1beta1_SeedList(ref),
 		v1beta1.SeedNetworks{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
 		v1beta1.SeedProvider{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_SeedProvider(ref),
@@ -241,6 +254,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.ShootList{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_ShootList(ref),
 		v1beta1.ShootMachineImage{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
 		v1beta1.ShootNetworks{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
+		v1beta1.ShootPlan{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_ShootPlan(ref),
+		v1beta1.ShootPlannedChange{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_ShootPlannedChange(ref),
 		v1beta1.ShootSSHKeypairRotation{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
 		v1beta1.ShootSpec{}.OpenAPIModelName():                                    schema_pkg_apis_core_v1beta1_ShootSpec(ref),
 		v1beta1.ShootState{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_ShootState(ref),
@@ -261,11 +276,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.WorkerKubernetes{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
 		v1beta1.WorkerSystemComponents{}.OpenAPIModelName():                       schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		v1beta1.WorkersSettings{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
+		operationsv1alpha1.AlertSilenceRequest{}.OpenAPIModelName():               schema_pkg_apis_operations_v1alpha1_AlertSilenceRequest(ref),
+		operationsv1alpha1.AlertSilenceRequestSpec{}.OpenAPIModelName():           schema_pkg_apis_operations_v1alpha1_AlertSilenceRequestSpec(ref),
+		operationsv1alpha1.AlertSilenceRequestStatus{}.OpenAPIModelName():         schema_pkg_apis_operations_v1alpha1_AlertSilenceRequestStatus(ref),
 		operationsv1alpha1.Bastion{}.OpenAPIModelName():                           schema_pkg_apis_operations_v1alpha1_Bastion(ref),
 		operationsv1alpha1.BastionIngressPolicy{}.OpenAPIModelName():              schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
 		operationsv1alpha1.BastionList{}.OpenAPIModelName():                       schema_pkg_apis_operations_v1alpha1_BastionList(ref),
+		operationsv1alpha1.BastionSession{}.OpenAPIModelName():                    schema_pkg_apis_operations_v1alpha1_BastionSession(ref),
+		operationsv1alpha1.BastionSessionRecording{}.OpenAPIModelName():           schema_pkg_apis_operations_v1alpha1_BastionSessionRecording(ref),
 		operationsv1alpha1.BastionSpec{}.OpenAPIModelName():                       schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		operationsv1alpha1.BastionStatus{}.OpenAPIModelName():                     schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
+		operationsv1alpha1.CloneRequest{}.OpenAPIModelName():                      schema_pkg_apis_operations_v1alpha1_CloneRequest(ref),
+		operationsv1alpha1.CloneRequestNetworking{}.OpenAPIModelName():            schema_pkg_apis_operations_v1alpha1_CloneRequestNetworking(ref),
+		operationsv1alpha1.CloneRequestResource{}.OpenAPIModelName():              schema_pkg_apis_operations_v1alpha1_CloneRequestResource(ref),
+		operationsv1alpha1.CloneRequestSpec{}.OpenAPIModelName():                  schema_pkg_apis_operations_v1alpha1_CloneRequestSpec(ref),
+		operationsv1alpha1.CloneRequestStatus{}.OpenAPIModelName():                schema_pkg_apis_operations_v1alpha1_CloneRequestStatus(ref),
+		operationsv1alpha1.DiagnosticsRequest{}.OpenAPIModelName():                schema_pkg_apis_operations_v1alpha1_DiagnosticsRequest(ref),
+		operationsv1alpha1.DiagnosticsRequestSpec{}.OpenAPIModelName():            schema_pkg_apis_operations_v1alpha1_DiagnosticsRequestSpec(ref),
+		operationsv1alpha1.DiagnosticsRequestStatus{}.OpenAPIModelName():          schema_pkg_apis_operations_v1alpha1_DiagnosticsRequestStatus(ref),
 		securityv1alpha1.ContextObject{}.OpenAPIModelName():                       schema_pkg_apis_security_v1alpha1_ContextObject(ref),
 		securityv1alpha1.CredentialsBinding{}.OpenAPIModelName():                  schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref),
 		securityv1alpha1.CredentialsBindingList{}.OpenAPIModelName():              schema_pkg_apis_security_v1alpha1_CredentialsBindingList(ref),
@@ -278,6 +306,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		securityv1alpha1.WorkloadIdentityList{}.OpenAPIModelName():                schema_pkg_apis_security_v1alpha1_WorkloadIdentityList(ref),
 		securityv1alpha1.WorkloadIdentitySpec{}.OpenAPIModelName():                schema_pkg_apis_security_v1alpha1_WorkloadIdentitySpec(ref),
 		securityv1alpha1.WorkloadIdentityStatus{}.OpenAPIModelName():              schema_pkg_apis_security_v1alpha1_WorkloadIdentityStatus(ref),
+		seedmanagementv1alpha1.CanaryStatus{}.OpenAPIModelName():                  schema_pkg_apis_seedmanagement_v1alpha1_CanaryStatus(ref),
+		seedmanagementv1alpha1.CanaryUpdateStrategy{}.OpenAPIModelName():          schema_pkg_apis_seedmanagement_v1alpha1_CanaryUpdateStrategy(ref),
 		seedmanagementv1alpha1.Gardenlet{}.OpenAPIModelName():                     schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref),
 		seedmanagementv1alpha1.GardenletConfig{}.OpenAPIModelName():               schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref),
 		seedmanagementv1alpha1.GardenletDeployment{}.OpenAPIModelName():           schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref),
@@ -303,11 +333,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		settingsv1alpha1.ClusterOpenIDConnectPreset{}.OpenAPIModelName():          schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref),
 		settingsv1alpha1.ClusterOpenIDConnectPresetList{}.OpenAPIModelName():      schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref),
 		settingsv1alpha1.ClusterOpenIDConnectPresetSpec{}.OpenAPIModelName():      schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref),
+		settingsv1alpha1.ClusterShootPolicy{}.OpenAPIModelName():                  schema_pkg_apis_settings_v1alpha1_ClusterShootPolicy(ref),
+		settingsv1alpha1.ClusterShootPolicyList{}.OpenAPIModelName():              schema_pkg_apis_settings_v1alpha1_ClusterShootPolicyList(ref),
+		settingsv1alpha1.ClusterShootPolicySpec{}.OpenAPIModelName():              schema_pkg_apis_settings_v1alpha1_ClusterShootPolicySpec(ref),
 		settingsv1alpha1.KubeAPIServerOpenIDConnect{}.OpenAPIModelName():          schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref),
 		settingsv1alpha1.OpenIDConnectClientAuthentication{}.OpenAPIModelName():   schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref),
 		settingsv1alpha1.OpenIDConnectPreset{}.OpenAPIModelName():                 schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref),
 		settingsv1alpha1.OpenIDConnectPresetList{}.OpenAPIModelName():             schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref),
 		settingsv1alpha1.OpenIDConnectPresetSpec{}.OpenAPIModelName():             schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref),
+		settingsv1alpha1.ShootPolicy{}.OpenAPIModelName():                         schema_pkg_apis_settings_v1alpha1_ShootPolicy(ref),
+		settingsv1alpha1.ShootPolicyList{}.OpenAPIModelName():                     schema_pkg_apis_settings_v1alpha1_ShootPolicyList(ref),
+		settingsv1alpha1.ShootPolicyMutation{}.OpenAPIModelName():                 schema_pkg_apis_settings_v1alpha1_ShootPolicyMutation(ref),
+		settingsv1alpha1.ShootPolicySpec{}.OpenAPIModelName():                     schema_pkg_apis_settings_v1alpha1_ShootPolicySpec(ref),
+		settingsv1alpha1.ShootPolicyStatus{}.OpenAPIModelName():                   schema_pkg_apis_settings_v1alpha1_ShootPolicyStatus(ref),
+		settingsv1alpha1.ShootPolicyValidation{}.OpenAPIModelName():               schema_pkg_apis_settings_v1alpha1_ShootPolicyValidation(ref),
+		settingsv1alpha1.ShootPolicyViolation{}.OpenAPIModelName():                schema_pkg_apis_settings_v1alpha1_ShootPolicyViolation(ref),
 		autoscalingv1.ContainerResourceMetricSource{}.OpenAPIModelName():          schema_k8sio_api_autoscaling_v1_ContainerResourceMetricSource(ref),
 		autoscalingv1.ContainerResourceMetricStatus{}.OpenAPIModelName():          schema_k8sio_api_autoscaling_v1_ContainerResourceMetricStatus(ref),
 		autoscalingv1.CrossVersionObjectReference{}.OpenAPIModelName():            schema_k8sio_api_autoscaling_v1_CrossVersionObjectReference(ref),
@@ -1294,6 +1334,175 @@ func schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AlertMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertMatcher is a matcher for an alert label.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the alert label.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the alert label.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex specifies whether the value is a regular expression.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AlertReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertReceiver contains information about a receiver to which alerts are sent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the receiver.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the receiver. Supported types are 'webhook', 'slack', 'pagerduty', 'opsgenie', and 'msteams'.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the credentials of the receiver. Depending on the type, the Secret must contain the key 'url' (webhook, slack, msteams), 'routingKey' (pagerduty), or 'apiKey' (opsgenie).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"severities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severities is a list of alert severities ('info', 'warning', 'critical', 'blocker') which are sent to the receiver. If empty, alerts of all severities are sent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"visibilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Visibilities is a list of alert visibilities ('all', 'owner') which are sent to the receiver. If empty, alerts of all visibilities are sent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "type", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AlertSilence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertSilence contains information about a silence which mutes notifications for matching alerts. Silences are not part of the Shoot specification. They are managed via the `shoots/alertsilence` subresource, stored in the `<shoot-name>.alert-silences` InternalSecret in the project namespace, and pushed to the Alertmanager of the Shoot by gardenlet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the silence.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Matchers is a list of label matchers which all have to match an alert to be silenced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.AlertMatcher{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"startsAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartsAt is the time when the silence starts. If not set, the silence starts immediately.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"endsAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndsAt is the time when the silence ends.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"createdBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedBy is the name of the user who created the silence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment is a comment describing the silence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "matchers", "endsAt"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.AlertMatcher{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Alerting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1316,9 +1525,52 @@ func schema_pkg_apis_core_v1beta1_Alerting(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Receivers is a list of receivers (e.g., webhooks or incident management tools) to which alerts are sent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.AlertReceiver{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.AlertReceiver{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditBackends(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditBackends contains configuration for the backends the audit events are sent to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"log": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log contains configuration for the log backend.",
+							Ref:         ref(v1beta1.AuditLogBackend{}.OpenAPIModelName()),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook contains configuration for the webhook backend.",
+							Ref:         ref(v1beta1.AuditWebhookBackend{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			v1beta1.AuditLogBackend{}.OpenAPIModelName(), v1beta1.AuditWebhookBackend{}.OpenAPIModelName()},
 	}
 }
 
@@ -1343,6 +1595,28 @@ func schema_pkg_apis_core_v1beta1_AuditConfig(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AuditLogBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogBackend contains configuration for the log backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the audit events are written to the log of the kube-apiserver and are shipped into the logging stack of the shoot control plane.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1356,6 +1630,13 @@ func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) comm
 							Ref:         ref(corev1.ObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"preset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preset is the name of a predefined audit policy. It is mutually exclusive with ConfigMapRef. Possible values are `Minimal`, `CIS` and `PCI`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1364,6 +1645,56 @@ func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AuditWebhookBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditWebhookBackend contains configuration for the webhook backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the kubeconfig of the webhook in the `kubeconfig` data key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batchMaxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchMaxSize is the maximum number of audit events in a batch.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchMaxWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchMaxWait is the amount of time to wait before sending a batch which has not reached the maximum size.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"batchBufferSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchBufferSize is the number of audit events buffered before they are batched and sent. Events are dropped if the buffer is full.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"initialBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialBackoff is the amount of time to wait before retrying a failed request. Subsequent retries back off exponentially.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2836,46 +3167,181 @@ func schema_pkg_apis_core_v1beta1_ControlPlaneAutoscaling(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ControlPlaneSLO(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ControllerDeployment contains information about how this controller is deployed.",
+				Description: "ControlPlaneSLO contains the service levels achieved by the Shoot's control plane.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"window": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Window is the duration of the rolling window over which the service levels are computed.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
 						},
 					},
-					"apiVersion": {
+					"availability": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Availability is the service level of the API server availability, i.e., the ratio of successful probes of the API server's health endpoint.",
+							Ref:         ref(v1beta1.ControlPlaneServiceLevel{}.OpenAPIModelName()),
 						},
 					},
-					"metadata": {
+					"latency": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+							Description: "Latency is the service level of the API server latency, i.e., the ratio of non-long-running requests which were served within one second.",
+							Ref:         ref(v1beta1.ControlPlaneServiceLevel{}.OpenAPIModelName()),
 						},
 					},
-					"type": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the deployment type.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastUpdateTime is the last time the service levels changed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
 						},
 					},
-					"providerConfig": {
+					"reports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig contains type-specific configuration. It contains assets that deploy the controller.",
-							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
+							Description: "Reports contains the service levels achieved in past calendar months, the most recent month first.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ControlPlaneSLOReport{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"window", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ControlPlaneSLOReport{}.OpenAPIModelName(), v1beta1.ControlPlaneServiceLevel{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControlPlaneSLOReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneSLOReport contains the service levels achieved by the Shoot's control plane in a calendar month.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"month": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Month is the reported calendar month (UTC) in the format YYYY-MM.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Availability is the service level of the API server availability in the reported month.",
+							Ref:         ref(v1beta1.ControlPlaneServiceLevel{}.OpenAPIModelName()),
+						},
+					},
+					"latency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latency is the service level of the API server latency in the reported month.",
+							Ref:         ref(v1beta1.ControlPlaneServiceLevel{}.OpenAPIModelName()),
+						},
+					},
+					"coverage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Coverage is the ratio of the recorded samples of the service level indicators to the expected number of samples in the reported month (since the creation of the Shoot) in percent. A coverage below 100 means that the service levels are based on incomplete data, e.g. because the Shoot was hibernated or the data was no longer retained.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"month"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ControlPlaneServiceLevel{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControlPlaneServiceLevel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneServiceLevel contains a service level objective together with the achieved service level.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"objective": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Objective is the targeted service level in percent, e.g. \"99.9\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"actual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actual is the achieved service level in percent.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorBudgetRemaining": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorBudgetRemaining is the remaining error budget in percent. It becomes negative when the objective is missed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"objective", "actual", "errorBudgetRemaining"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerDeployment contains information about how this controller is deployed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the deployment type.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig contains type-specific configuration. It contains assets that deploy the controller.",
+							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
 						},
 					},
 					"injectGardenKubeconfig": {
@@ -5989,6 +6455,101 @@ func schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref common.R
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_Logging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Logging contains information about the logging configuration for the shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"outputs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outputs is a list of customer-owned endpoints to which logs of the shoot's control plane are forwarded.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.LoggingOutput{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"auditBackends": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuditBackends contains configuration for the backends the audit events of the shoot's kube-apiserver are sent to. Multiple backends can be used simultaneously. An audit policy must be configured in `.spec.kubernetes.kubeAPIServer.auditConfig.auditPolicy` when backends are configured.",
+							Ref:         ref(v1beta1.AuditBackends{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.AuditBackends{}.OpenAPIModelName(), v1beta1.LoggingOutput{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_LoggingOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoggingOutput contains information about an endpoint to which logs of the shoot's control plane are forwarded.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the output.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the output. Supported types are 'otlp', 'loki', 'http', and 'syslog'.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the URL of the endpoint. The scheme must be 'http' or 'https' for 'otlp', 'loki', and 'http' outputs, and one of 'tcp', 'tls', or 'udp' for 'syslog' outputs.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources is a list of log sources ('audit', 'control-plane') which are forwarded to the output.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of a resource in `.spec.resources` which refers to a Secret containing the credentials of the endpoint. The Secret may contain the keys 'username' and 'password' (otlp, loki, http), and 'bearerToken' and 'tenantID' (loki).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "type", "url", "sources"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6671,11 +7232,39 @@ func schema_pkg_apis_core_v1beta1_Monitoring(ref common.ReferenceCallback) commo
 							Ref:         ref(v1beta1.Alerting{}.OpenAPIModelName()),
 						},
 					},
+					"rules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules contains information about additional user-defined recording and alerting rules which are evaluated by the shoot's control plane Prometheus.",
+							Ref:         ref(v1beta1.MonitoringRules{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Alerting{}.OpenAPIModelName()},
+			v1beta1.Alerting{}.OpenAPIModelName(), v1beta1.MonitoringRules{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_MonitoringRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MonitoringRules contains information about additional user-defined Prometheus rules.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName is the name of the ConfigMap in the project namespace which contains the Prometheus rule groups. The rule groups must be stored in the data key `rules.yaml` using the Prometheus rule file format.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"configMapName"},
+			},
+		},
 	}
 }
 
@@ -8535,53 +9124,133 @@ func schema_pkg_apis_core_v1beta1_SeedDNSProviderConfig(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedDrainStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedList is a collection of Seeds.",
+				Description: "SeedDrainStatus contains information about the drain of a Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Phase is the phase of the drain.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "StartTime is the time when the drain was started.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
 						},
 					},
-					"metadata": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+							Description: "CompletionTime is the time when all Shoots have been migrated off the Seed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
 						},
 					},
-					"items": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Seeds.",
+							Description: "LastUpdateTime is the time when the progress of the drain was last updated.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"remainingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemainingShoots is the number of Shoots which have not been migrated off the Seed yet, including the ones which are currently being migrated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migratingShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratingShoots is the list of Shoots (in the format `<namespace>/<name>`) which are currently being migrated off the Seed.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(v1beta1.Seed{}.OpenAPIModelName()),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
+					"unschedulableShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnschedulableShoots is the list of Shoots (in the format `<namespace>/<name>`) which could not be rescheduled to another Seed within the reschedule timeout. They are not rescheduled again while the drain is running.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"phase", "startTime", "lastUpdateTime", "remainingShoots"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedList is a collection of Seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Seeds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.Seed{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
 			v1beta1.Seed{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
 	}
 }
@@ -9480,11 +10149,17 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref(v1beta1.LastOperation{}.OpenAPIModelName()),
 						},
 					},
+					"drain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Drain contains information about the drain of the Seed, i.e., about the migration of all its Shoots to other Seeds. It is set when the Seed is annotated with `gardener.cloud/operation=drain`.",
+							Ref:         ref(v1beta1.SeedDrainStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.SeedDrainStatus{}.OpenAPIModelName(), resource.Quantity{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -10030,6 +10705,118 @@ func schema_pkg_apis_core_v1beta1_ShootNetworks(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPlan contains the changes an extension controller would perform to reach the desired state of an extension resource of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the extension resource, i.e., `Infrastructure` or `Worker`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the extension resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootGeneration is the generation of the Shoot the plan was computed for. Plans are approved by setting the `shoot.gardener.cloud/approved-plan-generation` annotation to this generation.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the timestamp when the plan was computed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes is the list of changes which would be performed. It is empty if the extension resource is already in its desired state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootPlannedChange{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is the description of the error which prevented computing the plan.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name", "shootGeneration", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.ShootPlannedChange{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootPlannedChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPlannedChange is a single change of a resource which would be performed by an extension controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the affected resource, e.g. `aws_vpc` or `MachineDeployment`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the affected resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action which would be performed on the resource, i.e., one of `Create`, `Update`, `Replace`, `Delete` or `Rollout`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is an optional human-readable description of the change.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name", "action"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10257,12 +11044,18 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logging contains information about custom logging configurations for the shoot.",
+							Ref:         ref(v1beta1.Logging{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.AccessRestrictionWithOptions{}.OpenAPIModelName(), v1beta1.Addons{}.OpenAPIModelName(), v1beta1.CloudProfileReference{}.OpenAPIModelName(), v1beta1.ControlPlane{}.OpenAPIModelName(), v1beta1.DNS{}.OpenAPIModelName(), v1beta1.Extension{}.OpenAPIModelName(), v1beta1.Hibernation{}.OpenAPIModelName(), v1beta1.Kubernetes{}.OpenAPIModelName(), v1beta1.Maintenance{}.OpenAPIModelName(), v1beta1.Monitoring{}.OpenAPIModelName(), v1beta1.NamedResourceReference{}.OpenAPIModelName(), v1beta1.Networking{}.OpenAPIModelName(), v1beta1.Provider{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.SystemComponents{}.OpenAPIModelName(), v1beta1.Toleration{}.OpenAPIModelName()},
+			v1beta1.AccessRestrictionWithOptions{}.OpenAPIModelName(), v1beta1.Addons{}.OpenAPIModelName(), v1beta1.CloudProfileReference{}.OpenAPIModelName(), v1beta1.ControlPlane{}.OpenAPIModelName(), v1beta1.DNS{}.OpenAPIModelName(), v1beta1.Extension{}.OpenAPIModelName(), v1beta1.Hibernation{}.OpenAPIModelName(), v1beta1.Kubernetes{}.OpenAPIModelName(), v1beta1.Logging{}.OpenAPIModelName(), v1beta1.Maintenance{}.OpenAPIModelName(), v1beta1.Monitoring{}.OpenAPIModelName(), v1beta1.NamedResourceReference{}.OpenAPIModelName(), v1beta1.Networking{}.OpenAPIModelName(), v1beta1.Provider{}.OpenAPIModelName(), v1beta1.SeedSelector{}.OpenAPIModelName(), v1beta1.SystemComponents{}.OpenAPIModelName(), v1beta1.Toleration{}.OpenAPIModelName()},
 	}
 }
 
@@ -10610,12 +11403,32 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref(v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName()),
 						},
 					},
+					"controlPlaneSLO": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneSLO contains the service levels achieved by the Shoot's control plane.",
+							Ref:         ref(v1beta1.ControlPlaneSLO{}.OpenAPIModelName()),
+						},
+					},
+					"plans": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plans contains the changes the extension controllers would perform on the infrastructure and the machines of the Shoot. Plans are computed if the `shoot.gardener.cloud/require-plan-approval` annotation is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.ShootPlan{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), v1beta1.ControlPlaneSLO{}.OpenAPIModelName(), v1beta1.Gardener{}.OpenAPIModelName(), v1beta1.InPlaceUpdatesStatus{}.OpenAPIModelName(), v1beta1.LastError{}.OpenAPIModelName(), v1beta1.LastMaintenance{}.OpenAPIModelName(), v1beta1.LastOperation{}.OpenAPIModelName(), v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName(), v1beta1.NetworkingStatus{}.OpenAPIModelName(), v1beta1.ShootAdvertisedAddress{}.OpenAPIModelName(), v1beta1.ShootCredentials{}.OpenAPIModelName(), v1beta1.ShootPlan{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -11386,11 +12199,11 @@ func schema_pkg_apis_core_v1beta1_WorkersSettings(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_AlertSilenceRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Bastion holds details about an SSH bastion for a shoot cluster.",
+				Description: "AlertSilenceRequest can be used to silence alerts of a Shoot cluster. The silence is stored on behalf of the requesting user in the `<shoot-name>.alert-silences` InternalSecret in the project namespace (expired silences are removed), and gardenlet pushes it to the Alertmanager of the Shoot right away.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11416,55 +12229,110 @@ func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) c
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the Bastion.",
+							Description: "Spec is the specification of the AlertSilenceRequest.",
 							Default:     map[string]interface{}{},
-							Ref:         ref(operationsv1alpha1.BastionSpec{}.OpenAPIModelName()),
+							Ref:         ref(operationsv1alpha1.AlertSilenceRequestSpec{}.OpenAPIModelName()),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Bastion.",
+							Description: "Status is the status of the AlertSilenceRequest.",
 							Default:     map[string]interface{}{},
-							Ref:         ref(operationsv1alpha1.BastionStatus{}.OpenAPIModelName()),
+							Ref:         ref(operationsv1alpha1.AlertSilenceRequestStatus{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"metadata", "spec"},
+				Required: []string{"spec", "status"},
 			},
 		},
 		Dependencies: []string{
-			operationsv1alpha1.BastionSpec{}.OpenAPIModelName(), operationsv1alpha1.BastionStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+			operationsv1alpha1.AlertSilenceRequestSpec{}.OpenAPIModelName(), operationsv1alpha1.AlertSilenceRequestStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_AlertSilenceRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionIngressPolicy represents an ingress policy for SSH bastion hosts.",
+				Description: "AlertSilenceRequestSpec contains the specification of the silence.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ipBlock": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPBlock defines an IP block that is allowed to access the bastion.",
-							Default:     map[string]interface{}{},
-							Ref:         ref(networkingv1.IPBlock{}.OpenAPIModelName()),
+							Description: "Name is the name of the silence. An existing silence with the same name is replaced.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Matchers is a list of label matchers which all have to match an alert to be silenced. They must not be set if the duration is zero.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.AlertMatcher{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the duration for which matching alerts are silenced, starting now. The value must not exceed 7 days. A duration of zero removes the silence with the given name. Defaults to 1 hour.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment is a comment describing the silence.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"ipBlock"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			networkingv1.IPBlock{}.OpenAPIModelName()},
+			v1beta1.AlertMatcher{}.OpenAPIModelName(), metav1.Duration{}.OpenAPIModelName()},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_AlertSilenceRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionList is a list of Bastion objects.",
+				Description: "AlertSilenceRequestStatus is the status of the AlertSilenceRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startsAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartsAt is the time when the silence starts. It is not set if the silence was removed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"endsAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndsAt is the time when the silence ends. It is not set if the silence was removed.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Bastion holds details about an SSH bastion for a shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11483,15 +12351,89 @@ func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallbac
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
+							Description: "Standard object metadata.",
 							Default:     map[string]interface{}{},
-							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Bastion.",
-							Type:        []string{"array"},
+							Description: "Specification of the Bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.BastionSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.BastionStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.BastionSpec{}.OpenAPIModelName(), operationsv1alpha1.BastionStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionIngressPolicy represents an ingress policy for SSH bastion hosts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipBlock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPBlock defines an IP block that is allowed to access the bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(networkingv1.IPBlock{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"ipBlock"},
+			},
+		},
+		Dependencies: []string{
+			networkingv1.IPBlock{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionList is a list of Bastion objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Bastion.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -11511,6 +12453,91 @@ func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_operations_v1alpha1_BastionSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionSession contains the metadata of an SSH session recorded by a bastion host.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the unique identifier of the session.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the name of the user who created the Bastion and thereby owns the SSH key used for the session.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is the address from which the session has been established.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the address of the node to which the session has been forwarded. It is empty for interactive sessions on the bastion host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the session has been established.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTime is the time when the session has been closed. It is not set for sessions which are still active.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"transcript": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transcript is the key of the object in the backup bucket of the Shoot containing the terminal transcript of the session. It is only set for interactive sessions if transcripts are recorded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "source", "startTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionSessionRecording(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionSessionRecording configures the recording of SSH sessions through a bastion host.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"transcripts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transcripts indicates whether terminal transcripts of interactive sessions on the bastion host are recorded in addition to the session log.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11561,12 +12588,18 @@ func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"sessionRecording": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionRecording configures the recording of SSH sessions through the bastion host. If set, the bastion host only allows recorded sessions, and the session log (and optionally terminal transcripts) is written on the bastion host. Gardener does not ship these records anywhere, it is up to the provider extension to store them in the backup bucket of the referenced Shoot (not all provider extensions do). This field is immutable.",
+							Ref:         ref(operationsv1alpha1.BastionSessionRecording{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"shootRef", "sshPublicKey", "ingress"},
 			},
 		},
 		Dependencies: []string{
-			operationsv1alpha1.BastionIngressPolicy{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+			operationsv1alpha1.BastionIngressPolicy{}.OpenAPIModelName(), operationsv1alpha1.BastionSessionRecording{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
 	}
 }
 
@@ -11579,54 +12612,404 @@ func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallb
 				Properties: map[string]spec.Schema{
 					"ingress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ingress holds the public IP and/or hostname of the bastion instance.",
-							Ref:         ref(corev1.LoadBalancerIngress{}.OpenAPIModelName()),
+							Description: "Ingress holds the public IP and/or hostname of the bastion instance.",
+							Ref:         ref(corev1.LoadBalancerIngress{}.OpenAPIModelName()),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Bastion's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"lastHeartbeatTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHeartbeatTimestamp is the time when the bastion was last marked as not to be deleted. When this is set, the ExpirationTimestamp is advanced as well.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which a Bastion is supposed to be garbage collected.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the Bastion's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sessions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sessions is the list of SSH sessions that have been recorded by the bastion host.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(operationsv1alpha1.BastionSession{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"sshCertificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHCertificate is an SSH user certificate (in authorized_keys format) for the public key of the Bastion which is signed by the SSH certificate authority of the shoot. It is only valid for the \"gardener\" user until the ExpirationTimestamp of the Bastion and is renewed whenever the Bastion is kept alive.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.Condition{}.OpenAPIModelName(), operationsv1alpha1.BastionSession{}.OpenAPIModelName(), corev1.LoadBalancerIngress{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_CloneRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloneRequest can be used to create a new Shoot cluster as a copy of an existing Shoot cluster. The specification of the source Shoot is copied while fields which cannot be shared between clusters (e.g., the seed and the DNS domain) are reset or replaced by the values given in the request. The new Shoot is created on behalf of the requesting user.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the CloneRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.CloneRequestSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the CloneRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.CloneRequestStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.CloneRequestSpec{}.OpenAPIModelName(), operationsv1alpha1.CloneRequestStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_CloneRequestNetworking(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloneRequestNetworking contains the networks of the new Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network. It must not be set if the new Shoot is restored from a backup of the source Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_CloneRequestResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloneRequestResource contains a replacement for a resource referenced by the source Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the resource reference in the source Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the resource which shall be referenced by the new Shoot instead.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_CloneRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloneRequestSpec contains the name of the new Shoot and the fields of the source Shoot which shall be overwritten.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the new Shoot. It is created in the namespace of the source Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is the region of the new Shoot. If not set, the region of the source Shoot is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones are the zones used for all worker pools of the new Shoot. They must be set if the region is changed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSDomain is the external domain of the new Shoot. If not set, the new Shoot gets a default domain assigned.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networking": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networking contains the networks of the new Shoot. Networks which are not set are copied from the source Shoot.",
+							Ref:         ref(operationsv1alpha1.CloneRequestNetworking{}.OpenAPIModelName()),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains replacements for resources referenced by the source Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(operationsv1alpha1.CloneRequestResource{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"restoreFromBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestoreFromBackup specifies whether the etcd of the new Shoot shall be restored from the latest backup of the source Shoot. If set, the new Shoot is scheduled to the seed of the source Shoot.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.CloneRequestNetworking{}.OpenAPIModelName(), operationsv1alpha1.CloneRequestResource{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_CloneRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloneRequestStatus is the status of the CloneRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID is the UID of the new Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_DiagnosticsRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiagnosticsRequest can be used to request a diagnostics bundle for a Shoot cluster. The bundle is collected asynchronously by the gardenlet responsible for the Shoot, hence the request has to be repeated until the state reported in the status is 'Succeeded'.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the DiagnosticsRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.DiagnosticsRequestSpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the DiagnosticsRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(operationsv1alpha1.DiagnosticsRequestStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			operationsv1alpha1.DiagnosticsRequestSpec{}.OpenAPIModelName(), operationsv1alpha1.DiagnosticsRequestStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_DiagnosticsRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiagnosticsRequestSpec contains the time window for which diagnostics shall be collected.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sinceSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SinceSeconds is the length of the time window (ending at the time of the collection) for which events and logs are collected. The value must be between 1 minute and 24 hours. Defaults to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_DiagnosticsRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiagnosticsRequestStatus is the status of the DiagnosticsRequest containing the diagnostics bundle once it was collected.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the diagnostics bundle collection.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"bundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Bastion's current state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref(v1beta1.Condition{}.OpenAPIModelName()),
-									},
-								},
-							},
+							Description: "Bundle is a gzip-compressed tarball containing the collected (and redacted) diagnostics. It is only set if the state is 'Succeeded'.",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-					"lastHeartbeatTimestamp": {
+					"collectionTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastHeartbeatTimestamp is the time when the bastion was last marked as not to be deleted. When this is set, the ExpirationTimestamp is advanced as well.",
+							Description: "CollectionTimestamp is the time when the diagnostics bundle was collected.",
 							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
 						},
 					},
 					"expirationTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExpirationTimestamp is the time after which a Bastion is supposed to be garbage collected.",
+							Description: "ExpirationTimestamp is the time until the diagnostics bundle can be downloaded.",
 							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
 						},
 					},
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the Bastion's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
 				},
+				Required: []string{"state"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), corev1.LoadBalancerIngress{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+			metav1.Time{}.OpenAPIModelName()},
 	}
 }
 
@@ -12122,6 +13505,100 @@ func schema_pkg_apis_security_v1alpha1_WorkloadIdentityStatus(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_CanaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryStatus contains information about the canary of an update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replica is the name of the canary replica.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision the canary has been updated to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the canary.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime is the moment in time when the canary has been updated.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"bakeStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BakeStartTime is the moment in time when the canary has become ready and started baking.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message indicating details about the phase of the canary.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"replica", "revision", "phase", "lastUpdateTime"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_CanaryUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CanaryUpdateStrategy is used to communicate parameters for CanaryUpdateStrategyType.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bakeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BakeTime is the duration for which the canary must stay healthy after it has become ready before the update is rolled out to the remaining replicas. It is also the maximum duration for the canary to become ready after it has been updated. Defaults to 1h.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
+						},
+					},
+					"maxUnhealthyShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnhealthyShoots is the maximum number of Shoots scheduled on the canary's Seed which may become unhealthy while the canary is baking without failing the canary. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailurePolicy determines what happens if the canary fails. Defaults to Pause.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13056,12 +14533,18 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetStatus(ref common.Ref
 							Ref:         ref(seedmanagementv1alpha1.PendingReplica{}.OpenAPIModelName()),
 						},
 					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary, if not empty, indicates the progress of the canary of the last update when the update strategy is Canary.",
+							Ref:         ref(seedmanagementv1alpha1.CanaryStatus{}.OpenAPIModelName()),
+						},
+					},
 				},
 				Required: []string{"replicas"},
 			},
 		},
 		Dependencies: []string{
-			v1beta1.Condition{}.OpenAPIModelName(), seedmanagementv1alpha1.PendingReplica{}.OpenAPIModelName()},
+			v1beta1.Condition{}.OpenAPIModelName(), seedmanagementv1alpha1.CanaryStatus{}.OpenAPIModelName(), seedmanagementv1alpha1.PendingReplica{}.OpenAPIModelName()},
 	}
 }
 
@@ -13272,11 +14755,17 @@ func schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref common.Reference
 							Ref:         ref(seedmanagementv1alpha1.RollingUpdateStrategy{}.OpenAPIModelName()),
 						},
 					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary is used to communicate parameters when Type is CanaryUpdateStrategyType.",
+							Ref:         ref(seedmanagementv1alpha1.CanaryUpdateStrategy{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			seedmanagementv1alpha1.RollingUpdateStrategy{}.OpenAPIModelName()},
+			seedmanagementv1alpha1.CanaryUpdateStrategy{}.OpenAPIModelName(), seedmanagementv1alpha1.RollingUpdateStrategy{}.OpenAPIModelName()},
 	}
 }
 
@@ -13411,16 +14900,180 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref common
 					},
 					"projectSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Project decides whether to apply the configuration if the Shoot is in a specific Project matching the label selector. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Defaults to the empty LabelSelector, which matches everything.",
+							Description: "Project decides whether to apply the configuration if the Shoot is in a specific Project matching the label selector. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Defaults to the empty LabelSelector, which matches everything.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"server", "weight"},
+			},
+		},
+		Dependencies: []string{
+			settingsv1alpha1.KubeAPIServerOpenIDConnect{}.OpenAPIModelName(), settingsv1alpha1.OpenIDConnectClientAuthentication{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterShootPolicy contains CEL validation and mutation rules that are enforced for Shoots cluster-wide.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of this ClusterShootPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(settingsv1alpha1.ClusterShootPolicySpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the most recently observed status of this ClusterShootPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(settingsv1alpha1.ShootPolicyStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			settingsv1alpha1.ClusterShootPolicySpec{}.OpenAPIModelName(), settingsv1alpha1.ShootPolicyStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterShootPolicyList is a collection of ClusterShootPolicies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ClusterShootPolicies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(settingsv1alpha1.ClusterShootPolicy{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			settingsv1alpha1.ClusterShootPolicy{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterShootPolicySpec contains the ShootPolicy specification and project selector matching Shoots in Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shootSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootSelector decides whether the policy is evaluated for a Shoot with matching labels. Use the selector only if the policy is opt-in, because end users may skip the admission by setting the labels. Defaults to the empty LabelSelector, which matches everything.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"enforcementAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementAction defines how violations of the validation rules are handled. Possible values are `Deny`, `Warn` and `Audit`. Defaults to `Deny`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"validations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Validations is a list of CEL expressions which must all evaluate to true for a Shoot. The expressions have access to the Shoot in its `core.gardener.cloud/v1beta1` representation via the `object` variable, and to the previous version of the Shoot via the `oldObject` variable (`null` on creation).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(settingsv1alpha1.ShootPolicyValidation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"mutations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mutations is a list of CEL expressions which are applied to a Shoot in the given order. The expressions have access to the same variables as the validations. Mutations are applied before the validations are evaluated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(settingsv1alpha1.ShootPolicyMutation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"projectSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectSelector decides whether the policy is evaluated for a Shoot in a Project matching the label selector. Defaults to the empty LabelSelector, which matches everything.",
 							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
 						},
 					},
 				},
-				Required: []string{"server", "weight"},
 			},
 		},
 		Dependencies: []string{
-			settingsv1alpha1.KubeAPIServerOpenIDConnect{}.OpenAPIModelName(), settingsv1alpha1.OpenIDConnectClientAuthentication{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+			settingsv1alpha1.ShootPolicyMutation{}.OpenAPIModelName(), settingsv1alpha1.ShootPolicyValidation{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
 	}
 }
 
@@ -13694,6 +15347,309 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_settings_v1alpha1_ShootPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicy contains CEL validation and mutation rules that are enforced for Shoots in a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of this ShootPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(settingsv1alpha1.ShootPolicySpec{}.OpenAPIModelName()),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the most recently observed status of this ShootPolicy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(settingsv1alpha1.ShootPolicyStatus{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			settingsv1alpha1.ShootPolicySpec{}.OpenAPIModelName(), settingsv1alpha1.ShootPolicyStatus{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicyList is a collection of ShootPolicies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ShootPolicies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(settingsv1alpha1.ShootPolicy{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			settingsv1alpha1.ShootPolicy{}.OpenAPIModelName(), metav1.ListMeta{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyMutation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicyMutation is a CEL expression that mutates a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a CEL expression which must evaluate to an object that is applied to the Shoot as a JSON merge patch (RFC 7386). An empty object leaves the Shoot unchanged. Example: `has(object.spec.purpose) ? {} : {'spec': {'purpose': 'evaluation'}}`",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"expression"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicySpec contains the Shoot selector, the enforcement action and the rules of a policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shootSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootSelector decides whether the policy is evaluated for a Shoot with matching labels. Use the selector only if the policy is opt-in, because end users may skip the admission by setting the labels. Defaults to the empty LabelSelector, which matches everything.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"enforcementAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementAction defines how violations of the validation rules are handled. Possible values are `Deny`, `Warn` and `Audit`. Defaults to `Deny`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"validations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Validations is a list of CEL expressions which must all evaluate to true for a Shoot. The expressions have access to the Shoot in its `core.gardener.cloud/v1beta1` representation via the `object` variable, and to the previous version of the Shoot via the `oldObject` variable (`null` on creation).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(settingsv1alpha1.ShootPolicyValidation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"mutations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mutations is a list of CEL expressions which are applied to a Shoot in the given order. The expressions have access to the same variables as the validations. Mutations are applied before the validations are evaluated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(settingsv1alpha1.ShootPolicyMutation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			settingsv1alpha1.ShootPolicyMutation{}.OpenAPIModelName(), settingsv1alpha1.ShootPolicyValidation{}.OpenAPIModelName(), metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicyStatus contains the most recently observed status of a policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastEvaluationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastEvaluationTime is the time when the existing Shoots were evaluated against this policy for the last time.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"violationsCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ViolationsCount is the number of existing Shoots violating this policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"violations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Violations is a list of existing Shoots violating this policy. The list might be truncated, see ViolationsCount for the total number of violating Shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(settingsv1alpha1.ShootPolicyViolation{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			settingsv1alpha1.ShootPolicyViolation{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyValidation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicyValidation is a CEL expression that validates a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a CEL expression which must evaluate to a boolean. The Shoot is considered to be valid if the expression evaluates to true. Example: `object.spec.purpose != 'production' || object.spec.?controlPlane.?highAvailability.hasValue()`",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the message returned to the client if the expression evaluates to false. Defaults to a message containing the failed expression.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"expression"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyViolation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicyViolation describes an existing Shoot which violates a policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"messages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Messages are the messages of the violated validations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"namespace", "name", "messages"},
+			},
+		},
+	}
+}
+
 func schema_k8sio_api_autoscaling_v1_ContainerResourceMetricSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -31,6 +31,7 @@ import (
 	shootnodelocaldns "github.com/gardener/gardener/plugin/pkg/shoot/nodelocaldns"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/clusteropenidconnectpreset"
 	"github.com/gardener/gardener/plugin/pkg/shoot/oidc/openidconnectpreset"
+	shootpolicy "github.com/gardener/gardener/plugin/pkg/shoot/policy"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootresourcereservation "github.com/gardener/gardener/plugin/pkg/shoot/resourcereservation"
 	shoottolerationrestriction "github.com/gardener/gardener/plugin/pkg/shoot/tolerationrestriction"
@@ -54,6 +55,7 @@ func RegisterAllAdmissionPlugins(plugins *admission.Plugins) {
 	shootnodelocaldns.Register(plugins)
 	shootdnsrewriting.Register(plugins)
 	shootmutator.Register(plugins)
+	shootpolicy.Register(plugins)
 	shootvalidator.Register(plugins)
 	seedvalidator.Register(plugins)
 	seedmutator.Register(plugins)
@@ -13,6 +13,7 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	kubeinformers "k8s.io/client-go/informers"
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	restclient "k8s.io/client-go/rest"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -44,6 +45,7 @@ type StorageProvider struct {
 	KubeInformerFactory           kubeinformers.SharedInformerFactory
 	CoreInformerFactory           gardencoreinformers.SharedInformerFactory
 	SubjectAccessReviewer         clientauthorizationv1.SubjectAccessReviewInterface
+	LoopbackClientConfig          *restclient.Config
 }
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 core storage.
@@ -128,12 +130,16 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 		p.ViewerKubeconfigMaxExpiration,
 		p.CredentialsRotationInterval,
 		p.SubjectAccessReviewer,
+		p.LoopbackClientConfig,
 	)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
 	storage["shoots/binding"] = shootStorage.Binding
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
+	storage["shoots/diagnostics"] = shootStorage.Diagnostics
+	storage["shoots/alertsilence"] = shootStorage.AlertSilence
+	storage["shoots/clone"] = shootStorage.Clone
 
 	return storage
 }
@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/api"
+	operationsvalidation "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	gardencoreversioned "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// AlertSilenceREST implements a RESTStorage for an alert silence request.
+type AlertSilenceREST struct {
+	shootStorage      getter
+	alertSilenceStore AlertSilenceStore
+	clock             clock.PassiveClock
+}
+
+var (
+	_ = rest.NamedCreater(&AlertSilenceREST{})
+	_ = rest.GroupVersionKindProvider(&AlertSilenceREST{})
+)
+
+// NewAlertSilenceREST returns a new AlertSilenceREST.
+func NewAlertSilenceREST(shootStorage getter, alertSilenceStore AlertSilenceStore, clock clock.PassiveClock) *AlertSilenceREST {
+	return &AlertSilenceREST{
+		shootStorage:      shootStorage,
+		alertSilenceStore: alertSilenceStore,
+		clock:             clock,
+	}
+}
+
+// New returns an instance of the object.
+func (r *AlertSilenceREST) New() runtime.Object {
+	return &operationsv1alpha1.AlertSilenceRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *AlertSilenceREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create stores the requested silence on behalf of the requesting user in the alert silences InternalSecret of the
+// shoot. An existing silence with the same name is replaced, and it is removed if the requested duration is zero.
+// Expired silences are removed as well. The shoot specification is not touched, i.e., the silence neither bumps the
+// generation of the shoot nor triggers a reconciliation. Instead, gardenlet pushes it to the Alertmanager of the shoot
+// right away.
+func (r *AlertSilenceREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	alertSilenceRequest := &operations.AlertSilenceRequest{}
+	if err := api.Scheme.Convert(obj, alertSilenceRequest, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", obj, alertSilenceRequest, err)
+	}
+
+	if errs := operationsvalidation.ValidateAlertSilenceRequest(alertSilenceRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(operations.Kind("AlertSilenceRequest"), "", errs)
+	}
+
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no user info in context")
+	}
+
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	var (
+		now     = metav1.NewTime(r.clock.Now().UTC().Truncate(time.Second))
+		silence *gardencorev1beta1.AlertSilence
+	)
+
+	if alertSilenceRequest.Spec.Duration.Duration > 0 {
+		silence = &gardencorev1beta1.AlertSilence{
+			Name:      alertSilenceRequest.Spec.Name,
+			StartsAt:  ptr.To(now),
+			EndsAt:    metav1.NewTime(now.Add(alertSilenceRequest.Spec.Duration.Duration)),
+			CreatedBy: ptr.To(userInfo.GetName()),
+			Comment:   alertSilenceRequest.Spec.Comment,
+		}
+		for _, matcher := range alertSilenceRequest.Spec.Matchers {
+			silence.Matchers = append(silence.Matchers, gardencorev1beta1.AlertMatcher{Name: matcher.Name, Value: matcher.Value, Regex: matcher.Regex})
+		}
+	}
+
+	var dryRun []string
+	if options != nil {
+		dryRun = options.DryRun
+	}
+
+	if err := r.alertSilenceStore.Update(ctx, shoot, func(oldSilences []gardencorev1beta1.AlertSilence) []gardencorev1beta1.AlertSilence {
+		var silences []gardencorev1beta1.AlertSilence
+		for _, s := range oldSilences {
+			if s.Name == alertSilenceRequest.Spec.Name || !s.EndsAt.After(now.Time) {
+				continue
+			}
+			silences = append(silences, s)
+		}
+		if silence != nil {
+			silences = append(silences, *silence)
+		}
+		return silences
+	}, dryRun); err != nil {
+		return nil, err
+	}
+
+	if silence != nil {
+		alertSilenceRequest.Status = operations.AlertSilenceRequestStatus{
+			StartsAt: silence.StartsAt,
+			EndsAt:   &silence.EndsAt,
+		}
+	}
+
+	if err := api.Scheme.Convert(alertSilenceRequest, obj, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", alertSilenceRequest, obj, err)
+	}
+	return obj, nil
+}
+
+// GroupVersionKind returns the GVK for the alert silence request type.
+func (r *AlertSilenceREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return operationsv1alpha1.SchemeGroupVersion.WithKind("AlertSilenceRequest")
+}
+
+// AlertSilenceStore stores the alert silences of Shoots.
+type AlertSilenceStore interface {
+	// Update passes the stored silences of the given Shoot to the given function and stores the returned silences.
+	Update(ctx context.Context, shoot *core.Shoot, mutate func([]gardencorev1beta1.AlertSilence) []gardencorev1beta1.AlertSilence, dryRun []string) error
+}
+
+// NewAlertSilenceStore returns an AlertSilenceStore which uses the given (loopback) client config to store the silences
+// in the alert silences InternalSecret of the Shoot. Afterwards, it annotates the Shoot with the resource version of the
+// InternalSecret so that gardenlet pushes the silences to the Alertmanager of the Shoot. Users do not have access to
+// InternalSecrets, hence both requests are not sent on behalf of the user. Authorization is checked for the
+// alertsilence subresource instead.
+func NewAlertSilenceStore(config *restclient.Config) AlertSilenceStore {
+	return &alertSilenceStore{config: config}
+}
+
+type alertSilenceStore struct {
+	config *restclient.Config
+}
+
+func (s *alertSilenceStore) Update(ctx context.Context, shoot *core.Shoot, mutate func([]gardencorev1beta1.AlertSilence) []gardencorev1beta1.AlertSilence, dryRun []string) error {
+	clientSet, err := gardencoreversioned.NewForConfig(s.config)
+	if err != nil {
+		return fmt.Errorf("failed creating client: %w", err)
+	}
+
+	var (
+		name     = gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixAlertSilences)
+		revision string
+	)
+
+	if err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		internalSecret, err := clientSet.CoreV1beta1().InternalSecrets(shoot.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			internalSecret = &gardencorev1beta1.InternalSecret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       shoot.Namespace,
+					Labels:          map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleAlertSilences},
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot"))},
+				},
+				Type: corev1.SecretTypeOpaque,
+			}
+		}
+
+		var silences []gardencorev1beta1.AlertSilence
+		if data := internalSecret.Data[v1beta1constants.DataKeyAlertSilences]; len(data) > 0 {
+			if err := json.Unmarshal(data, &silences); err != nil {
+				return fmt.Errorf("failed decoding alert silences: %w", err)
+			}
+		}
+
+		data, err := json.Marshal(mutate(silences))
+		if err != nil {
+			return fmt.Errorf("failed encoding alert silences: %w", err)
+		}
+		internalSecret.Data = map[string][]byte{v1beta1constants.DataKeyAlertSilences: data}
+
+		if internalSecret.ResourceVersion == "" {
+			internalSecret, err = clientSet.CoreV1beta1().InternalSecrets(shoot.Namespace).Create(ctx, internalSecret, metav1.CreateOptions{DryRun: dryRun})
+		} else {
+			internalSecret, err = clientSet.CoreV1beta1().InternalSecrets(shoot.Namespace).Update(ctx, internalSecret, metav1.UpdateOptions{DryRun: dryRun})
+		}
+		if err != nil {
+			return err
+		}
+
+		revision = internalSecret.ResourceVersion
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed storing alert silences: %w", err)
+	}
+
+	patch, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": map[string]string{v1beta1constants.AnnotationShootAlertSilencesRevision: revision}}})
+	if err != nil {
+		return fmt.Errorf("failed marshalling patch: %w", err)
+	}
+
+	_, err = clientSet.CoreV1beta1().Shoots(shoot.Namespace).Patch(ctx, shoot.Name, types.MergePatchType, patch, metav1.PatchOptions{DryRun: dryRun})
+	return err
+}
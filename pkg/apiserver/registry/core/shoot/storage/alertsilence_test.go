@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("AlertSilence", func() {
+	var (
+		ctx       context.Context
+		fakeClock *testclock.FakePassiveClock
+		now       metav1.Time
+
+		shoot             *gardencore.Shoot
+		shootStorage      *fakeGetter
+		alertSilenceStore *fakeAlertSilenceStore
+
+		alertSilenceREST *AlertSilenceREST
+		obj              *operationsv1alpha1.AlertSilenceRequest
+	)
+
+	BeforeEach(func() {
+		ctx = genericapirequest.WithUser(context.Background(), &user.DefaultInfo{Name: "alice"})
+		now = metav1.NewTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		fakeClock = testclock.NewFakePassiveClock(now.Time)
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+		}
+		shootStorage = &fakeGetter{obj: shoot}
+		alertSilenceStore = &fakeAlertSilenceStore{}
+
+		alertSilenceREST = NewAlertSilenceREST(shootStorage, alertSilenceStore, fakeClock)
+		obj = &operationsv1alpha1.AlertSilenceRequest{
+			Spec: operationsv1alpha1.AlertSilenceRequestSpec{
+				Name:     "maintenance",
+				Matchers: []gardencorev1beta1.AlertMatcher{{Name: "alertname", Value: "KubeletTooManyPods"}},
+				Duration: &metav1.Duration{Duration: 2 * time.Hour},
+				Comment:  ptr.To("node maintenance"),
+			},
+		}
+	})
+
+	It("should return an error if the request is invalid", func() {
+		obj.Spec.Matchers = nil
+
+		_, err := alertSilenceREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("must provide at least one matcher")))
+	})
+
+	It("should return an error if the create validation fails", func() {
+		_, err := alertSilenceREST.Create(ctx, shoot.Name, obj, func(context.Context, runtime.Object) error { return errors.New("fake") }, nil)
+		Expect(err).To(MatchError("fake"))
+	})
+
+	It("should return an error if there is no user info in the context", func() {
+		_, err := alertSilenceREST.Create(context.Background(), shoot.Name, obj, nil, nil)
+		Expect(err).To(MatchError("no user info in context"))
+	})
+
+	It("should return an error if the shoot does not exist", func() {
+		shootStorage.err = apierrors.NewNotFound(gardencore.Resource("shoots"), shoot.Name)
+
+		_, err := alertSilenceREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(alertSilenceStore.updated).To(BeFalse())
+	})
+
+	It("should return an error if the silences cannot be stored", func() {
+		alertSilenceStore.err = errors.New("fake")
+
+		_, err := alertSilenceREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).To(MatchError("fake"))
+	})
+
+	It("should store the silence without touching the shoot", func() {
+		result, err := alertSilenceREST.Create(ctx, shoot.Name, obj, nil, &metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		Expect(err).NotTo(HaveOccurred())
+
+		request := result.(*operationsv1alpha1.AlertSilenceRequest)
+		Expect(request.Status.StartsAt).To(PointTo(Equal(now)))
+		Expect(request.Status.EndsAt).To(PointTo(Equal(metav1.NewTime(now.Add(2 * time.Hour)))))
+
+		Expect(alertSilenceStore.shoot).To(Equal(shoot))
+		Expect(alertSilenceStore.dryRun).To(ConsistOf(metav1.DryRunAll))
+		Expect(alertSilenceStore.silences).To(ConsistOf(gardencorev1beta1.AlertSilence{
+			Name:      "maintenance",
+			Matchers:  []gardencorev1beta1.AlertMatcher{{Name: "alertname", Value: "KubeletTooManyPods"}},
+			StartsAt:  &now,
+			EndsAt:    metav1.NewTime(now.Add(2 * time.Hour)),
+			CreatedBy: ptr.To("alice"),
+			Comment:   ptr.To("node maintenance"),
+		}))
+		Expect(shoot.Spec.Monitoring).To(BeNil())
+	})
+
+	It("should replace a silence with the same name and remove expired silences", func() {
+		alertSilenceStore.silences = []gardencorev1beta1.AlertSilence{
+			{Name: "maintenance", Matchers: []gardencorev1beta1.AlertMatcher{{Name: "service", Value: "nodes"}}, EndsAt: metav1.NewTime(now.Add(time.Hour))},
+			{Name: "expired", Matchers: []gardencorev1beta1.AlertMatcher{{Name: "service", Value: "nodes"}}, EndsAt: now},
+			{Name: "active", Matchers: []gardencorev1beta1.AlertMatcher{{Name: "service", Value: "vpn"}}, EndsAt: metav1.NewTime(now.Add(time.Minute))},
+		}
+
+		_, err := alertSilenceREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(alertSilenceStore.silences).To(HaveLen(2))
+		Expect(alertSilenceStore.silences[0].Name).To(Equal("active"))
+		Expect(alertSilenceStore.silences[1].Name).To(Equal("maintenance"))
+		Expect(alertSilenceStore.silences[1].Matchers).To(ConsistOf(gardencorev1beta1.AlertMatcher{Name: "alertname", Value: "KubeletTooManyPods"}))
+	})
+
+	It("should remove the silence if the duration is zero", func() {
+		alertSilenceStore.silences = []gardencorev1beta1.AlertSilence{
+			{Name: "maintenance", Matchers: []gardencorev1beta1.AlertMatcher{{Name: "service", Value: "nodes"}}, EndsAt: metav1.NewTime(now.Add(time.Hour))},
+		}
+		obj.Spec.Matchers = nil
+		obj.Spec.Duration = &metav1.Duration{}
+
+		result, err := alertSilenceREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		request := result.(*operationsv1alpha1.AlertSilenceRequest)
+		Expect(request.Status.StartsAt).To(BeNil())
+		Expect(request.Status.EndsAt).To(BeNil())
+		Expect(alertSilenceStore.updated).To(BeTrue())
+		Expect(alertSilenceStore.silences).To(BeEmpty())
+	})
+})
+
+type fakeAlertSilenceStore struct {
+	err error
+
+	updated  bool
+	shoot    *gardencore.Shoot
+	silences []gardencorev1beta1.AlertSilence
+	dryRun   []string
+}
+
+func (f *fakeAlertSilenceStore) Update(_ context.Context, shoot *gardencore.Shoot, mutate func([]gardencorev1beta1.AlertSilence) []gardencorev1beta1.AlertSilence, dryRun []string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.updated = true
+	f.shoot = shoot
+	f.silences = mutate(f.silences)
+	f.dryRun = dryRun
+	return nil
+}
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/api"
+	operationsvalidation "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+// CloneREST implements a RESTStorage for a clone request.
+type CloneREST struct {
+	shootStorage getter
+	shootCreator ShootCreator
+}
+
+var (
+	_ = rest.NamedCreater(&CloneREST{})
+	_ = rest.GroupVersionKindProvider(&CloneREST{})
+)
+
+// ShootCreator creates Shoots on behalf of a user.
+type ShootCreator interface {
+	// Create creates the given Shoot on behalf of the given user.
+	Create(ctx context.Context, userInfo user.Info, shoot *gardencorev1beta1.Shoot, options metav1.CreateOptions) (*gardencorev1beta1.Shoot, error)
+}
+
+// NewCloneREST returns a new CloneREST.
+func NewCloneREST(shootStorage getter, shootCreator ShootCreator) *CloneREST {
+	return &CloneREST{
+		shootStorage: shootStorage,
+		shootCreator: shootCreator,
+	}
+}
+
+// New returns an instance of the object.
+func (r *CloneREST) New() runtime.Object {
+	return &operationsv1alpha1.CloneRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *CloneREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create creates a new Shoot as a copy of the given Shoot. The new Shoot is created on behalf of the requesting user,
+// i.e., the same authorization and admission checks apply as if the user had created the Shoot directly.
+func (r *CloneREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	cloneRequest := &operations.CloneRequest{}
+	if err := api.Scheme.Convert(obj, cloneRequest, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", obj, cloneRequest, err)
+	}
+
+	if errs := operationsvalidation.ValidateCloneRequest(cloneRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(operations.Kind("CloneRequest"), "", errs)
+	}
+
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no user info in context")
+	}
+
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	clone, errs := cloneShoot(shoot, cloneRequest.Spec)
+	if len(errs) != 0 {
+		return nil, apierrors.NewInvalid(operations.Kind("CloneRequest"), shoot.Name, errs)
+	}
+
+	cloneV1beta1 := &gardencorev1beta1.Shoot{}
+	if err := api.Scheme.Convert(clone, cloneV1beta1, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", clone, cloneV1beta1, err)
+	}
+
+	createOptions := metav1.CreateOptions{}
+	if options != nil {
+		createOptions.DryRun = options.DryRun
+	}
+
+	if source, ok := cloneV1beta1.Annotations[v1beta1constants.AnnotationShootCloneSource]; ok {
+		userInfo = withExtra(userInfo, v1beta1constants.UserExtraShootCloneSource, source)
+	}
+
+	createdShoot, err := r.shootCreator.Create(ctx, userInfo, cloneV1beta1, createOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	cloneRequest.Status.UID = createdShoot.UID
+
+	if err := api.Scheme.Convert(cloneRequest, obj, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", cloneRequest, obj, err)
+	}
+	return obj, nil
+}
+
+// GroupVersionKind returns the GVK for the clone request type.
+func (r *CloneREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return operationsv1alpha1.SchemeGroupVersion.WithKind("CloneRequest")
+}
+
+func cloneShoot(shoot *core.Shoot, spec operations.CloneRequestSpec) (*core.Shoot, field.ErrorList) {
+	var (
+		allErrs  = field.ErrorList{}
+		specPath = field.NewPath("spec")
+	)
+
+	clone := &core.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        spec.Name,
+			Namespace:   shoot.Namespace,
+			Labels:      copyUserMetadata(shoot.Labels),
+			Annotations: copyUserMetadata(shoot.Annotations),
+		},
+		Spec: *shoot.Spec.DeepCopy(),
+	}
+
+	// The new shoot is scheduled independently of the source shoot and gets its own default domain unless a domain is
+	// requested explicitly.
+	clone.Spec.SeedName = nil
+	if clone.Spec.DNS != nil {
+		clone.Spec.DNS.Domain = spec.DNSDomain
+	} else if spec.DNSDomain != nil {
+		clone.Spec.DNS = &core.DNS{Domain: spec.DNSDomain}
+	}
+
+	if spec.Networking != nil {
+		if clone.Spec.Networking == nil {
+			clone.Spec.Networking = &core.Networking{}
+		}
+		if spec.Networking.Nodes != nil {
+			clone.Spec.Networking.Nodes = spec.Networking.Nodes
+		}
+		if spec.Networking.Pods != nil {
+			clone.Spec.Networking.Pods = spec.Networking.Pods
+		}
+		if spec.Networking.Services != nil {
+			clone.Spec.Networking.Services = spec.Networking.Services
+		}
+	}
+
+	for i, replacement := range spec.Resources {
+		found := false
+		for j, resource := range clone.Spec.Resources {
+			if resource.Name == replacement.Name {
+				clone.Spec.Resources[j].ResourceRef.Name = replacement.ResourceName
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			allErrs = append(allErrs, field.NotFound(specPath.Child("resources").Index(i).Child("name"), replacement.Name))
+		}
+	}
+
+	if spec.Region != nil && *spec.Region != clone.Spec.Region {
+		clone.Spec.Region = *spec.Region
+		if len(clone.Spec.Provider.Workers) > 0 && len(spec.Zones) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("zones"), "zones must be provided when the region is changed"))
+		}
+	}
+
+	if len(spec.Zones) > 0 {
+		for i := range clone.Spec.Provider.Workers {
+			clone.Spec.Provider.Workers[i].Zones = append([]string(nil), spec.Zones...)
+		}
+	}
+
+	if spec.RestoreFromBackup {
+		if shoot.Spec.SeedName == nil || shoot.Status.SeedName == nil || *shoot.Spec.SeedName != *shoot.Status.SeedName {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("restoreFromBackup"), spec.RestoreFromBackup, "restoring from a backup is only possible for shoots which are scheduled to a seed and not being migrated"))
+		} else {
+			// The backups are copied within the backup bucket of the source shoot's seed, hence the new shoot has to be
+			// scheduled to the same seed.
+			clone.Spec.SeedName = shoot.Spec.SeedName
+			metav1.SetMetaDataAnnotation(&clone.ObjectMeta, v1beta1constants.AnnotationShootCloneSource, shoot.Name)
+		}
+	}
+
+	return clone, allErrs
+}
+
+// withExtra returns a copy of the given user info with the given extra. The admission plugins use it to verify that the
+// Shoot is created via the 'shoots/clone' subresource.
+func withExtra(userInfo user.Info, key, value string) user.Info {
+	extra := make(map[string][]string, len(userInfo.GetExtra())+1)
+	maps.Copy(extra, userInfo.GetExtra())
+	extra[key] = []string{value}
+
+	return &user.DefaultInfo{
+		Name:   userInfo.GetName(),
+		UID:    userInfo.GetUID(),
+		Groups: userInfo.GetGroups(),
+		Extra:  extra,
+	}
+}
+
+// copyUserMetadata returns a copy of the given labels or annotations without the keys managed by Gardener.
+func copyUserMetadata(metadata map[string]string) map[string]string {
+	var out map[string]string
+
+	for key, value := range metadata {
+		if strings.Contains(key, "gardener.cloud/") || key == corev1.LastAppliedConfigAnnotation {
+			continue
+		}
+
+		if out == nil {
+			out = make(map[string]string)
+		}
+		out[key] = value
+	}
+
+	return out
+}
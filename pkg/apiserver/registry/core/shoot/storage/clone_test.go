@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		ctx      context.Context
+		userInfo *user.DefaultInfo
+
+		shoot        *gardencore.Shoot
+		shootStorage *fakeGetter
+		shootCreator *fakeShootCreator
+
+		cloneREST *CloneREST
+		obj       *operationsv1alpha1.CloneRequest
+	)
+
+	BeforeEach(func() {
+		userInfo = &user.DefaultInfo{Name: "alice", Groups: []string{"foo"}}
+		ctx = genericapirequest.WithUser(context.Background(), userInfo)
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "garden-bar",
+				Labels:    map[string]string{"team": "a", "shoot.gardener.cloud/status": "healthy"},
+				Annotations: map[string]string{
+					"owner":                     "alice",
+					"gardener.cloud/created-by": "bob",
+					"kubectl.kubernetes.io/last-applied-configuration": "{}",
+				},
+				UID: "source-uid",
+			},
+			Spec: gardencore.ShootSpec{
+				Region:   "eu-west-1",
+				SeedName: ptr.To("seed"),
+				DNS:      &gardencore.DNS{Domain: ptr.To("foo.bar.example.com")},
+				Networking: &gardencore.Networking{
+					Type:     ptr.To("calico"),
+					Nodes:    ptr.To("10.250.0.0/16"),
+					Pods:     ptr.To("100.96.0.0/11"),
+					Services: ptr.To("100.64.0.0/13"),
+				},
+				Provider: gardencore.Provider{
+					Type:    "aws",
+					Workers: []gardencore.Worker{{Name: "worker", Zones: []string{"eu-west-1a"}}},
+				},
+				Resources: []gardencore.NamedResourceReference{
+					{Name: "foo-config", ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "ConfigMap", Name: "foo-config", APIVersion: "v1"}},
+					{Name: "shared", ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "shared", APIVersion: "v1"}},
+				},
+			},
+			Status: gardencore.ShootStatus{SeedName: ptr.To("seed")},
+		}
+
+		shootStorage = &fakeGetter{obj: shoot}
+		shootCreator = &fakeShootCreator{}
+
+		cloneREST = NewCloneREST(shootStorage, shootCreator)
+		obj = &operationsv1alpha1.CloneRequest{
+			Spec: operationsv1alpha1.CloneRequestSpec{Name: "clone"},
+		}
+	})
+
+	It("should return an error if the request is invalid", func() {
+		obj.Spec.Name = ""
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(shootCreator.createdShoot).To(BeNil())
+	})
+
+	It("should return an error if the create validation fails", func() {
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, func(context.Context, runtime.Object) error { return errors.New("fake") }, nil)
+		Expect(err).To(MatchError("fake"))
+	})
+
+	It("should return an error if the source shoot cannot be read", func() {
+		shootStorage.err = apierrors.NewNotFound(gardencore.Resource("shoots"), shoot.Name)
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should create a copy of the shoot on behalf of the user", func() {
+		result, err := cloneREST.Create(ctx, shoot.Name, obj, nil, &metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.(*operationsv1alpha1.CloneRequest).Status.UID).To(BeEquivalentTo("clone-uid"))
+
+		Expect(shootCreator.userInfo).To(Equal(userInfo))
+		Expect(shootCreator.options).To(Equal(metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}))
+
+		clone := shootCreator.createdShoot
+		Expect(clone.Name).To(Equal("clone"))
+		Expect(clone.Namespace).To(Equal("garden-bar"))
+		Expect(clone.Labels).To(Equal(map[string]string{"team": "a"}))
+		Expect(clone.Annotations).To(Equal(map[string]string{"owner": "alice"}))
+		Expect(clone.Spec.SeedName).To(BeNil())
+		Expect(clone.Spec.DNS.Domain).To(BeNil())
+		Expect(clone.Spec.Region).To(Equal("eu-west-1"))
+		Expect(clone.Spec.Networking.Nodes).To(Equal(ptr.To("10.250.0.0/16")))
+		Expect(clone.Spec.Networking.Pods).To(Equal(ptr.To("100.96.0.0/11")))
+		Expect(clone.Spec.Networking.Services).To(Equal(ptr.To("100.64.0.0/13")))
+		Expect(clone.Spec.Provider.Workers[0].Zones).To(ConsistOf("eu-west-1a"))
+		Expect(clone.Spec.Resources).To(HaveLen(2))
+	})
+
+	It("should overwrite the requested fields", func() {
+		obj.Spec.Region = ptr.To("eu-central-1")
+		obj.Spec.Zones = []string{"eu-central-1a", "eu-central-1b"}
+		obj.Spec.DNSDomain = ptr.To("clone.bar.example.com")
+		obj.Spec.Networking = &operationsv1alpha1.CloneRequestNetworking{
+			Nodes:    ptr.To("10.251.0.0/16"),
+			Services: ptr.To("100.72.0.0/13"),
+		}
+		obj.Spec.Resources = []operationsv1alpha1.CloneRequestResource{{Name: "foo-config", ResourceName: "clone-config"}}
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		clone := shootCreator.createdShoot
+		Expect(clone.Spec.Region).To(Equal("eu-central-1"))
+		Expect(clone.Spec.Provider.Workers[0].Zones).To(ConsistOf("eu-central-1a", "eu-central-1b"))
+		Expect(clone.Spec.DNS.Domain).To(Equal(ptr.To("clone.bar.example.com")))
+		Expect(clone.Spec.Networking.Nodes).To(Equal(ptr.To("10.251.0.0/16")))
+		Expect(clone.Spec.Networking.Pods).To(Equal(ptr.To("100.96.0.0/11")))
+		Expect(clone.Spec.Networking.Services).To(Equal(ptr.To("100.72.0.0/13")))
+		Expect(clone.Spec.Resources[0].ResourceRef.Name).To(Equal("clone-config"))
+		Expect(clone.Spec.Resources[1].ResourceRef.Name).To(Equal("shared"))
+
+		Expect(shoot.Spec.Provider.Workers[0].Zones).To(ConsistOf("eu-west-1a"), "source shoot must not be modified")
+	})
+
+	It("should return an error if the region is changed without zones", func() {
+		obj.Spec.Region = ptr.To("eu-central-1")
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("zones must be provided when the region is changed")))
+	})
+
+	It("should return an error if a replaced resource is not referenced by the source shoot", func() {
+		obj.Spec.Resources = []operationsv1alpha1.CloneRequestResource{{Name: "unknown", ResourceName: "clone-config"}}
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring(`spec.resources[0].name: Not found: "unknown"`)))
+	})
+
+	It("should schedule the clone to the source seed and annotate it when restoring from a backup", func() {
+		obj.Spec.RestoreFromBackup = true
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		clone := shootCreator.createdShoot
+		Expect(clone.Spec.SeedName).To(Equal(ptr.To("seed")))
+		Expect(clone.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/clone-source", "foo"))
+		Expect(shootCreator.userInfo).To(Equal(&user.DefaultInfo{
+			Name:   "alice",
+			Groups: []string{"foo"},
+			Extra:  map[string][]string{"shoot.gardener.cloud/clone-source": {"foo"}},
+		}))
+	})
+
+	It("should return an error when restoring from a backup of a shoot which is being migrated", func() {
+		obj.Spec.RestoreFromBackup = true
+		shoot.Status.SeedName = ptr.To("old-seed")
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("restoring from a backup is only possible for shoots which are scheduled to a seed and not being migrated")))
+	})
+
+	It("should return the error of the shoot creation", func() {
+		shootCreator.err = apierrors.NewForbidden(gardencore.Resource("shoots"), "clone", errors.New("fake"))
+
+		_, err := cloneREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+	})
+})
+
+type fakeShootCreator struct {
+	userInfo     user.Info
+	createdShoot *gardencorev1beta1.Shoot
+	options      metav1.CreateOptions
+	err          error
+}
+
+func (f *fakeShootCreator) Create(_ context.Context, userInfo user.Info, shoot *gardencorev1beta1.Shoot, options metav1.CreateOptions) (*gardencorev1beta1.Shoot, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.userInfo = userInfo
+	f.createdShoot = shoot
+	f.options = options
+
+	created := shoot.DeepCopy()
+	created.UID = "clone-uid"
+	return created, nil
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener/pkg/api"
+	operationsvalidation "github.com/gardener/gardener/pkg/api/operations/validation"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// DiagnosticsREST implements a RESTStorage for a diagnostics request.
+type DiagnosticsREST struct {
+	shootStorage         getter
+	shootAnnotator       ShootAnnotator
+	internalSecretLister gardencorev1beta1listers.InternalSecretLister
+	clock                clock.PassiveClock
+}
+
+var (
+	_ = rest.NamedCreater(&DiagnosticsREST{})
+	_ = rest.GroupVersionKindProvider(&DiagnosticsREST{})
+)
+
+// NewDiagnosticsREST returns a new DiagnosticsREST.
+func NewDiagnosticsREST(
+	shootStorage getter,
+	shootAnnotator ShootAnnotator,
+	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
+	clock clock.PassiveClock,
+) *DiagnosticsREST {
+	return &DiagnosticsREST{
+		shootStorage:         shootStorage,
+		shootAnnotator:       shootAnnotator,
+		internalSecretLister: internalSecretLister,
+		clock:                clock,
+	}
+}
+
+// New returns an instance of the object.
+func (r *DiagnosticsREST) New() runtime.Object {
+	return &operationsv1alpha1.DiagnosticsRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *DiagnosticsREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns a diagnostics request containing the diagnostics bundle of the shoot if it has already been collected
+// by gardenlet for the requested time window and has not yet expired. Otherwise, it instructs gardenlet to collect the
+// bundle by annotating the shoot and returns a pending diagnostics request. The shoot is annotated on behalf of the
+// requesting user, i.e., the same authorization and admission checks apply as if the user had annotated it directly.
+func (r *DiagnosticsREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	diagnosticsRequest := &operations.DiagnosticsRequest{}
+	if err := api.Scheme.Convert(obj, diagnosticsRequest, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", obj, diagnosticsRequest, err)
+	}
+
+	if errs := operationsvalidation.ValidateDiagnosticsRequest(diagnosticsRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(operations.Kind("DiagnosticsRequest"), "", errs)
+	}
+
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no user info in context")
+	}
+
+	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	if shoot.Spec.SeedName == nil {
+		fieldErr := field.Invalid(field.NewPath("spec", "seedName"), shoot.Spec.SeedName, "diagnostics can only be collected for shoots scheduled to a seed")
+		return nil, apierrors.NewInvalid(operations.Kind("DiagnosticsRequest"), shoot.Name, field.ErrorList{fieldErr})
+	}
+
+	sinceSeconds := strconv.FormatInt(diagnosticsRequest.Spec.SinceSeconds, 10)
+
+	if _, requested := shoot.Annotations[v1beta1constants.AnnotationShootDiagnosticsRequested]; !requested {
+		bundle, err := r.internalSecretLister.InternalSecrets(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixDiagnostics))
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, apierrors.NewInternalError(fmt.Errorf("could not get diagnostics bundle: %w", err))
+		}
+
+		if err == nil && bundle.Annotations[v1beta1constants.AnnotationDiagnosticsSinceSeconds] == sinceSeconds {
+			collectionTimestamp, expirationTimestamp, ok := r.bundleTimestamps(bundle)
+			if ok && r.clock.Now().Before(expirationTimestamp.Time) {
+				diagnosticsRequest.Status = operations.DiagnosticsRequestStatus{
+					State:               operations.DiagnosticsRequestStateSucceeded,
+					Bundle:              bundle.Data[v1beta1constants.DataKeyDiagnosticsBundle],
+					CollectionTimestamp: collectionTimestamp,
+					ExpirationTimestamp: expirationTimestamp,
+				}
+
+				return r.convertResult(diagnosticsRequest, obj)
+			}
+		}
+	}
+
+	if shoot.Annotations[v1beta1constants.AnnotationShootDiagnosticsRequested] != sinceSeconds {
+		patchOptions := metav1.PatchOptions{}
+		if options != nil {
+			patchOptions.DryRun = options.DryRun
+		}
+
+		if _, err := r.shootAnnotator.Annotate(ctx, userInfo, shoot.Namespace, shoot.Name, v1beta1constants.AnnotationShootDiagnosticsRequested, sinceSeconds, patchOptions); err != nil {
+			return nil, err
+		}
+	}
+
+	diagnosticsRequest.Status = operations.DiagnosticsRequestStatus{State: operations.DiagnosticsRequestStatePending}
+	return r.convertResult(diagnosticsRequest, obj)
+}
+
+func (r *DiagnosticsREST) bundleTimestamps(bundle *gardencorev1beta1.InternalSecret) (*metav1.Time, *metav1.Time, bool) {
+	collectionTimestamp, err := time.Parse(time.RFC3339, bundle.Annotations[v1beta1constants.AnnotationDiagnosticsCollectionTimestamp])
+	if err != nil {
+		return nil, nil, false
+	}
+
+	expirationTimestamp, err := time.Parse(time.RFC3339, bundle.Annotations[v1beta1constants.AnnotationDiagnosticsExpirationTimestamp])
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return &metav1.Time{Time: collectionTimestamp}, &metav1.Time{Time: expirationTimestamp}, true
+}
+
+func (r *DiagnosticsREST) convertResult(diagnosticsRequest *operations.DiagnosticsRequest, obj runtime.Object) (runtime.Object, error) {
+	if err := api.Scheme.Convert(diagnosticsRequest, obj, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", diagnosticsRequest, obj, err)
+	}
+	return obj, nil
+}
+
+// GroupVersionKind returns the GVK for the diagnostics request type.
+func (r *DiagnosticsREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return operationsv1alpha1.SchemeGroupVersion.WithKind("DiagnosticsRequest")
+}
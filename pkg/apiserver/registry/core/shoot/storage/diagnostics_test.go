@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("Diagnostics", func() {
+	var (
+		ctx       context.Context
+		fakeClock *testclock.FakePassiveClock
+
+		shoot                *gardencore.Shoot
+		bundle               *gardencorev1beta1.InternalSecret
+		userInfo             user.Info
+		shootStorage         *fakeGetter
+		shootAnnotator       *fakeShootAnnotator
+		internalSecretLister *fakeInternalSecretLister
+
+		diagnosticsREST *DiagnosticsREST
+		obj             *operationsv1alpha1.DiagnosticsRequest
+	)
+
+	BeforeEach(func() {
+		userInfo = &user.DefaultInfo{Name: "alice", Groups: []string{"foo"}}
+		ctx = genericapirequest.WithUser(context.Background(), userInfo)
+		fakeClock = testclock.NewFakePassiveClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar"},
+			Spec:       gardencore.ShootSpec{SeedName: ptr.To("seed")},
+		}
+		bundle = &gardencorev1beta1.InternalSecret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo.diagnostics",
+				Namespace: "garden-bar",
+				Annotations: map[string]string{
+					"diagnostics.gardener.cloud/since-seconds":        "3600",
+					"diagnostics.gardener.cloud/collection-timestamp": "2026-01-01T11:55:00Z",
+					"diagnostics.gardener.cloud/expiration-timestamp": "2026-01-01T12:10:00Z",
+				},
+			},
+			Data: map[string][]byte{"bundle.tar.gz": []byte("bundle")},
+		}
+
+		shootStorage = &fakeGetter{obj: shoot}
+		shootAnnotator = &fakeShootAnnotator{}
+		internalSecretLister = &fakeInternalSecretLister{obj: bundle}
+
+		diagnosticsREST = NewDiagnosticsREST(shootStorage, shootAnnotator, internalSecretLister, fakeClock)
+		obj = &operationsv1alpha1.DiagnosticsRequest{
+			Spec: operationsv1alpha1.DiagnosticsRequestSpec{SinceSeconds: ptr.To(int64(3600))},
+		}
+	})
+
+	It("should return an error if the request is invalid", func() {
+		obj.Spec.SinceSeconds = ptr.To(int64(10))
+
+		_, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("may not specify a duration less than 1 minute")))
+	})
+
+	It("should return an error if the create validation fails", func() {
+		_, err := diagnosticsREST.Create(ctx, shoot.Name, obj, func(context.Context, runtime.Object) error { return errors.New("fake") }, nil)
+		Expect(err).To(MatchError("fake"))
+	})
+
+	It("should return an error if the shoot cannot be read", func() {
+		shootStorage.err = errors.New("fake")
+
+		_, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).To(MatchError("fake"))
+	})
+
+	It("should return an error if the shoot is not scheduled", func() {
+		shoot.Spec.SeedName = nil
+
+		_, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("diagnostics can only be collected for shoots scheduled to a seed")))
+	})
+
+	It("should return the collected bundle if it is not expired", func() {
+		result, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		request := result.(*operationsv1alpha1.DiagnosticsRequest)
+		Expect(request.Status.State).To(Equal(operationsv1alpha1.DiagnosticsRequestStateSucceeded))
+		Expect(request.Status.Bundle).To(Equal([]byte("bundle")))
+		Expect(request.Status.CollectionTimestamp.Time).To(Equal(time.Date(2026, 1, 1, 11, 55, 0, 0, time.UTC)))
+		Expect(request.Status.ExpirationTimestamp.Time).To(Equal(time.Date(2026, 1, 1, 12, 10, 0, 0, time.UTC)))
+		Expect(shootAnnotator.annotations).To(BeNil())
+	})
+
+	DescribeTable("should request the collection of a new bundle",
+		func(mutate func()) {
+			mutate()
+
+			result, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			request := result.(*operationsv1alpha1.DiagnosticsRequest)
+			Expect(request.Status.State).To(Equal(operationsv1alpha1.DiagnosticsRequestStatePending))
+			Expect(request.Status.Bundle).To(BeEmpty())
+			Expect(shootAnnotator.userInfo).To(Equal(userInfo))
+			Expect(shootAnnotator.namespace).To(Equal(shoot.Namespace))
+			Expect(shootAnnotator.name).To(Equal(shoot.Name))
+			Expect(shootAnnotator.annotations).To(Equal(map[string]string{v1beta1constants.AnnotationShootDiagnosticsRequested: "3600"}))
+			Expect(shoot.Annotations).To(BeEmpty())
+		},
+
+		Entry("no bundle exists", func() {
+			internalSecretLister.err = apierrors.NewNotFound(gardencorev1beta1.Resource("internalsecrets"), bundle.Name)
+		}),
+		Entry("bundle is expired", func() { fakeClock.SetTime(time.Date(2026, 1, 1, 12, 10, 0, 0, time.UTC)) }),
+		Entry("bundle was collected for another time window", func() { bundle.Annotations["diagnostics.gardener.cloud/since-seconds"] = "600" }),
+		Entry("bundle has invalid timestamps", func() { bundle.Annotations["diagnostics.gardener.cloud/expiration-timestamp"] = "foo" }),
+	)
+
+	It("should not update the shoot if the collection has already been requested", func() {
+		shoot.Annotations = map[string]string{v1beta1constants.AnnotationShootDiagnosticsRequested: "3600"}
+
+		result, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.(*operationsv1alpha1.DiagnosticsRequest).Status.State).To(Equal(operationsv1alpha1.DiagnosticsRequestStatePending))
+		Expect(shootAnnotator.annotations).To(BeNil())
+	})
+
+	It("should pass the dry-run option when annotating the shoot", func() {
+		internalSecretLister.err = apierrors.NewNotFound(gardencorev1beta1.Resource("internalsecrets"), bundle.Name)
+
+		_, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, &metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shootAnnotator.options.DryRun).To(ConsistOf(metav1.DryRunAll))
+	})
+
+	It("should return an error if the shoot cannot be annotated", func() {
+		internalSecretLister.err = apierrors.NewNotFound(gardencorev1beta1.Resource("internalsecrets"), bundle.Name)
+		shootAnnotator.err = apierrors.NewForbidden(gardencorev1beta1.Resource("shoots"), shoot.Name, errors.New("admission denied"))
+
+		_, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+	})
+
+	It("should return an error if there is no user info in the context", func() {
+		_, err := diagnosticsREST.Create(context.Background(), shoot.Name, obj, nil, nil)
+		Expect(err).To(MatchError("no user info in context"))
+	})
+
+	It("should return an error if the bundle cannot be read", func() {
+		internalSecretLister.err = errors.New("fake")
+
+		_, err := diagnosticsREST.Create(ctx, shoot.Name, obj, nil, nil)
+		Expect(apierrors.IsInternalError(err)).To(BeTrue())
+	})
+})
+
+type fakeShootAnnotator struct {
+	userInfo    user.Info
+	namespace   string
+	name        string
+	annotations map[string]string
+	options     metav1.PatchOptions
+	err         error
+}
+
+func (f *fakeShootAnnotator) Annotate(_ context.Context, userInfo user.Info, namespace, name, key, value string, options metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.userInfo = userInfo
+	f.namespace = namespace
+	f.name = name
+	f.options = options
+	if f.annotations == nil {
+		f.annotations = map[string]string{}
+	}
+	f.annotations[key] = value
+
+	return &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Annotations: f.annotations}}, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/user"
+	restclient "k8s.io/client-go/rest"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreversioned "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+)
+
+// ShootAnnotator annotates Shoots on behalf of a user.
+type ShootAnnotator interface {
+	// Annotate sets the given annotation on the Shoot with the given namespace and name on behalf of the given user.
+	Annotate(ctx context.Context, userInfo user.Info, namespace, name, key, value string, options metav1.PatchOptions) (*gardencorev1beta1.Shoot, error)
+}
+
+// NewImpersonatingShootCreator returns a ShootCreator which uses the given (loopback) client config to create Shoots
+// while impersonating the user.
+func NewImpersonatingShootCreator(config *restclient.Config) ShootCreator {
+	return &impersonatingShootClient{config: config}
+}
+
+// NewImpersonatingShootAnnotator returns a ShootAnnotator which uses the given (loopback) client config to annotate
+// Shoots while impersonating the user. This way, the same authorization and admission checks apply as if the user had
+// annotated the Shoot directly.
+func NewImpersonatingShootAnnotator(config *restclient.Config) ShootAnnotator {
+	return &impersonatingShootClient{config: config}
+}
+
+type impersonatingShootClient struct {
+	config *restclient.Config
+}
+
+func (c *impersonatingShootClient) Create(ctx context.Context, userInfo user.Info, shoot *gardencorev1beta1.Shoot, options metav1.CreateOptions) (*gardencorev1beta1.Shoot, error) {
+	clientSet, err := c.clientSetFor(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientSet.CoreV1beta1().Shoots(shoot.Namespace).Create(ctx, shoot, options)
+}
+
+func (c *impersonatingShootClient) Annotate(ctx context.Context, userInfo user.Info, namespace, name, key, value string, options metav1.PatchOptions) (*gardencorev1beta1.Shoot, error) {
+	clientSet, err := c.clientSetFor(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": map[string]string{key: value}}})
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling patch: %w", err)
+	}
+
+	return clientSet.CoreV1beta1().Shoots(namespace).Patch(ctx, name, types.MergePatchType, patch, options)
+}
+
+func (c *impersonatingShootClient) clientSetFor(userInfo user.Info) (gardencoreversioned.Interface, error) {
+	config := restclient.CopyConfig(c.config)
+	config.Impersonate = restclient.ImpersonationConfig{
+		UserName: userInfo.GetName(),
+		UID:      userInfo.GetUID(),
+		Groups:   userInfo.GetGroups(),
+		Extra:    userInfo.GetExtra(),
+	}
+
+	clientSet, err := gardencoreversioned.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client for impersonating user %q: %w", userInfo.GetName(), err)
+	}
+
+	return clientSet, nil
+}
@@ -16,6 +16,8 @@ import (
 	"k8s.io/apiserver/pkg/storage"
 	clientauthorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apiserver/registry/core/shoot"
@@ -33,6 +35,9 @@ type ShootStorage struct {
 	Status           *StatusREST
 	AdminKubeconfig  *KubeconfigREST
 	ViewerKubeconfig *KubeconfigREST
+	Diagnostics      *DiagnosticsREST
+	AlertSilence     *AlertSilenceREST
+	Clone            *CloneREST
 	Binding          *BindingREST
 }
 
@@ -46,6 +51,7 @@ func NewStorage(
 	viewerKubeconfigMaxExpiration time.Duration,
 	credentialsRotationInterval time.Duration,
 	subjectAccessReviewer clientauthorizationv1.SubjectAccessReviewInterface,
+	loopbackClientConfig *restclient.Config,
 ) ShootStorage {
 	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval)
 
@@ -55,6 +61,9 @@ func NewStorage(
 		Binding:          bindingREST,
 		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, subjectAccessReviewer),
 		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration, subjectAccessReviewer),
+		Diagnostics:      NewDiagnosticsREST(shootRest, NewImpersonatingShootAnnotator(loopbackClientConfig), internalSecretLister, clock.RealClock{}),
+		AlertSilence:     NewAlertSilenceREST(shootRest, NewAlertSilenceStore(loopbackClientConfig), clock.RealClock{}),
+		Clone:            NewCloneREST(shootRest, NewImpersonatingShootCreator(loopbackClientConfig)),
 	}
 }
 
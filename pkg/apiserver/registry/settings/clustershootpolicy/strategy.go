@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clustershootpolicy
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/api/settings/validation"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+type clusterShootPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ClusterShootPolicies.
+var Strategy = clusterShootPolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (clusterShootPolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (clusterShootPolicyStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	policy := obj.(*settings.ClusterShootPolicy)
+
+	policy.Generation = 1
+	policy.Status = settings.ShootPolicyStatus{}
+}
+
+func (clusterShootPolicyStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newPolicy := obj.(*settings.ClusterShootPolicy)
+	oldPolicy := old.(*settings.ClusterShootPolicy)
+	newPolicy.Status = oldPolicy.Status
+
+	if mustIncreaseGeneration(oldPolicy, newPolicy) {
+		newPolicy.Generation = oldPolicy.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldPolicy, newPolicy *settings.ClusterShootPolicy) bool {
+	// The ClusterShootPolicy specification changes.
+	if !apiequality.Semantic.DeepEqual(oldPolicy.Spec, newPolicy.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldPolicy.DeletionTimestamp == nil && newPolicy.DeletionTimestamp != nil {
+		return true
+	}
+
+	if kubernetesutils.HasMetaDataAnnotation(&newPolicy.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile) {
+		delete(newPolicy.Annotations, v1beta1constants.GardenerOperation)
+		return true
+	}
+
+	return false
+}
+
+func (clusterShootPolicyStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	policy := obj.(*settings.ClusterShootPolicy)
+	return validation.ValidateClusterShootPolicy(policy)
+}
+
+func (clusterShootPolicyStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (clusterShootPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (clusterShootPolicyStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	newPolicy := newObj.(*settings.ClusterShootPolicy)
+	oldPolicy := oldObj.(*settings.ClusterShootPolicy)
+	return validation.ValidateClusterShootPolicyUpdate(newPolicy, oldPolicy)
+}
+
+func (clusterShootPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (clusterShootPolicyStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (clusterShootPolicyStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type clusterShootPolicyStatusStrategy struct {
+	clusterShootPolicyStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ClusterShootPolicies.
+var StatusStrategy = clusterShootPolicyStatusStrategy{Strategy}
+
+func (clusterShootPolicyStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newPolicy := obj.(*settings.ClusterShootPolicy)
+	oldPolicy := old.(*settings.ClusterShootPolicy)
+	newPolicy.Spec = oldPolicy.Spec
+}
+
+func (clusterShootPolicyStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateClusterShootPolicyStatusUpdate(obj.(*settings.ClusterShootPolicy), old.(*settings.ClusterShootPolicy))
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/gardener/gardener/pkg/apis/settings"
 	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
 	clusteropenidconnectpresetstore "github.com/gardener/gardener/pkg/apiserver/registry/settings/clusteropenidconnectpreset/storage"
+	clustershootpolicystore "github.com/gardener/gardener/pkg/apiserver/registry/settings/clustershootpolicy/storage"
 	openidconnectpresetstore "github.com/gardener/gardener/pkg/apiserver/registry/settings/openidconnectpreset/storage"
+	shootpolicystore "github.com/gardener/gardener/pkg/apiserver/registry/settings/shootpolicy/storage"
 )
 
 // StorageProvider is an empty struct.
@@ -41,5 +43,13 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["openidconnectpresets"] = oidcPresetStorage.OpenIDConnectPreset
 	storage["clusteropenidconnectpresets"] = clusterOIDCStorage.ClusterOpenIDConnectPreset
 
+	shootPolicyStorage := shootpolicystore.NewStorage(restOptionsGetter)
+	storage["shootpolicies"] = shootPolicyStorage.ShootPolicy
+	storage["shootpolicies/status"] = shootPolicyStorage.Status
+
+	clusterShootPolicyStorage := clustershootpolicystore.NewStorage(restOptionsGetter)
+	storage["clustershootpolicies"] = clusterShootPolicyStorage.ClusterShootPolicy
+	storage["clustershootpolicies/status"] = clusterShootPolicyStorage.Status
+
 	return storage
 }
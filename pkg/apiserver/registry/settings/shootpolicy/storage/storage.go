@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/apiserver/registry/settings/shootpolicy"
+)
+
+// REST implements a RESTStorage for ShootPolicies against etcd.
+type REST struct {
+	*genericregistry.Store
+}
+
+// Storage implements the storage for ShootPolicies and their status subresource.
+type Storage struct {
+	ShootPolicy *REST
+	Status      *StatusREST
+}
+
+// NewStorage creates a new ShootPolicy object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) Storage {
+	shootpolicyRest, shootpolicyStatusRest := NewREST(optsGetter)
+
+	return Storage{
+		ShootPolicy: shootpolicyRest,
+		Status:      shootpolicyStatusRest,
+	}
+}
+
+// NewREST returns a RESTStorage object that will work against ShootPolicies.
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
+	store := &genericregistry.Store{
+		NewFunc:     func() runtime.Object { return &settings.ShootPolicy{} },
+		NewListFunc: func() runtime.Object { return &settings.ShootPolicyList{} },
+
+		DefaultQualifiedResource:  settings.Resource("shootpolicies"),
+		SingularQualifiedResource: settings.Resource("shootpolicy"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: shootpolicy.Strategy,
+		UpdateStrategy: shootpolicy.Strategy,
+		DeleteStrategy: shootpolicy.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = shootpolicy.StatusStrategy
+	return &REST{store}, &StatusREST{store: &statusStore}
+}
+
+// Implement CategoriesProvider
+var _ rest.CategoriesProvider = &REST{}
+
+// Categories implements the CategoriesProvider interface. Returns a list of categories a resource is part of.
+func (r *REST) Categories() []string {
+	return []string{"all"}
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"sp"}
+}
+
+// StatusREST implements the REST endpoint for changing the status of a ShootPolicy.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal ShootPolicy object.
+func (r *StatusREST) New() runtime.Object {
+	return &settings.ShootPolicy{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
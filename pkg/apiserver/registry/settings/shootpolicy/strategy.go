@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpolicy
+
+import (
+	"context"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/api/settings/validation"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+type shootPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ShootPolicies.
+var Strategy = shootPolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootPolicyStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (shootPolicyStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	policy := obj.(*settings.ShootPolicy)
+
+	policy.Generation = 1
+	policy.Status = settings.ShootPolicyStatus{}
+}
+
+func (shootPolicyStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newPolicy := obj.(*settings.ShootPolicy)
+	oldPolicy := old.(*settings.ShootPolicy)
+	newPolicy.Status = oldPolicy.Status
+
+	if mustIncreaseGeneration(oldPolicy, newPolicy) {
+		newPolicy.Generation = oldPolicy.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldPolicy, newPolicy *settings.ShootPolicy) bool {
+	// The ShootPolicy specification changes.
+	if !apiequality.Semantic.DeepEqual(oldPolicy.Spec, newPolicy.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldPolicy.DeletionTimestamp == nil && newPolicy.DeletionTimestamp != nil {
+		return true
+	}
+
+	if kubernetesutils.HasMetaDataAnnotation(&newPolicy.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile) {
+		delete(newPolicy.Annotations, v1beta1constants.GardenerOperation)
+		return true
+	}
+
+	return false
+}
+
+func (shootPolicyStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	policy := obj.(*settings.ShootPolicy)
+	return validation.ValidateShootPolicy(policy)
+}
+
+func (shootPolicyStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (shootPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootPolicyStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	newPolicy := newObj.(*settings.ShootPolicy)
+	oldPolicy := oldObj.(*settings.ShootPolicy)
+	return validation.ValidateShootPolicyUpdate(newPolicy, oldPolicy)
+}
+
+func (shootPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (shootPolicyStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (shootPolicyStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type shootPolicyStatusStrategy struct {
+	shootPolicyStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ShootPolicies.
+var StatusStrategy = shootPolicyStatusStrategy{Strategy}
+
+func (shootPolicyStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newPolicy := obj.(*settings.ShootPolicy)
+	oldPolicy := old.(*settings.ShootPolicy)
+	newPolicy.Spec = oldPolicy.Spec
+}
+
+func (shootPolicyStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateShootPolicyStatusUpdate(obj.(*settings.ShootPolicy), old.(*settings.ShootPolicy))
+}
@@ -20,10 +20,18 @@ func (c *FakeSettingsV1alpha1) ClusterOpenIDConnectPresets() v1alpha1.ClusterOpe
 	return newFakeClusterOpenIDConnectPresets(c)
 }
 
+func (c *FakeSettingsV1alpha1) ClusterShootPolicies() v1alpha1.ClusterShootPolicyInterface {
+	return newFakeClusterShootPolicies(c)
+}
+
 func (c *FakeSettingsV1alpha1) OpenIDConnectPresets(namespace string) v1alpha1.OpenIDConnectPresetInterface {
 	return newFakeOpenIDConnectPresets(c, namespace)
 }
 
+func (c *FakeSettingsV1alpha1) ShootPolicies(namespace string) v1alpha1.ShootPolicyInterface {
+	return newFakeShootPolicies(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSettingsV1alpha1) RESTClient() rest.Interface {
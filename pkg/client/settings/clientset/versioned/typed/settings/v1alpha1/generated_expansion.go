@@ -8,4 +8,8 @@ package v1alpha1
 
 type ClusterOpenIDConnectPresetExpansion interface{}
 
+type ClusterShootPolicyExpansion interface{}
+
 type OpenIDConnectPresetExpansion interface{}
+
+type ShootPolicyExpansion interface{}
@@ -43,8 +43,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=settings.gardener.cloud, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("clusteropenidconnectpresets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().ClusterOpenIDConnectPresets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("clustershootpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().ClusterShootPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("openidconnectpresets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().OpenIDConnectPresets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("shootpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().ShootPolicies().Informer()}, nil
 
 	}
 
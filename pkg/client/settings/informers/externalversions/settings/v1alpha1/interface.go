@@ -14,8 +14,12 @@ import (
 type Interface interface {
 	// ClusterOpenIDConnectPresets returns a ClusterOpenIDConnectPresetInformer.
 	ClusterOpenIDConnectPresets() ClusterOpenIDConnectPresetInformer
+	// ClusterShootPolicies returns a ClusterShootPolicyInformer.
+	ClusterShootPolicies() ClusterShootPolicyInformer
 	// OpenIDConnectPresets returns a OpenIDConnectPresetInformer.
 	OpenIDConnectPresets() OpenIDConnectPresetInformer
+	// ShootPolicies returns a ShootPolicyInformer.
+	ShootPolicies() ShootPolicyInformer
 }
 
 type version struct {
@@ -34,7 +38,17 @@ func (v *version) ClusterOpenIDConnectPresets() ClusterOpenIDConnectPresetInform
 	return &clusterOpenIDConnectPresetInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterShootPolicies returns a ClusterShootPolicyInformer.
+func (v *version) ClusterShootPolicies() ClusterShootPolicyInformer {
+	return &clusterShootPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // OpenIDConnectPresets returns a OpenIDConnectPresetInformer.
 func (v *version) OpenIDConnectPresets() OpenIDConnectPresetInformer {
 	return &openIDConnectPresetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ShootPolicies returns a ShootPolicyInformer.
+func (v *version) ShootPolicies() ShootPolicyInformer {
+	return &shootPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
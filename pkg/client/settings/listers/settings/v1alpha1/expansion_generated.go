@@ -10,6 +10,10 @@ package v1alpha1
 // ClusterOpenIDConnectPresetLister.
 type ClusterOpenIDConnectPresetListerExpansion interface{}
 
+// ClusterShootPolicyListerExpansion allows custom methods to be added to
+// ClusterShootPolicyLister.
+type ClusterShootPolicyListerExpansion interface{}
+
 // OpenIDConnectPresetListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetLister.
 type OpenIDConnectPresetListerExpansion interface{}
@@ -17,3 +21,11 @@ type OpenIDConnectPresetListerExpansion interface{}
 // OpenIDConnectPresetNamespaceListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetNamespaceLister.
 type OpenIDConnectPresetNamespaceListerExpansion interface{}
+
+// ShootPolicyListerExpansion allows custom methods to be added to
+// ShootPolicyLister.
+type ShootPolicyListerExpansion interface{}
+
+// ShootPolicyNamespaceListerExpansion allows custom methods to be added to
+// ShootPolicyNamespaceLister.
+type ShootPolicyNamespaceListerExpansion interface{}
@@ -113,7 +113,19 @@ func InjectAuditSettings(deployment *appsv1.Deployment, configMapAuditPolicy *co
 		},
 	})
 
-	if auditConfig == nil || auditConfig.Webhook == nil {
+	if auditConfig == nil {
+		return
+	}
+
+	if auditConfig.Log != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-log-path=-", "--audit-log-format=json")
+
+		if v := auditConfig.Log.Version; v != nil {
+			deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-log-version="+*v)
+		}
+	}
+
+	if auditConfig.Webhook == nil {
 		return
 	}
 
@@ -138,6 +150,18 @@ func InjectAuditSettings(deployment *appsv1.Deployment, configMapAuditPolicy *co
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--audit-webhook-batch-max-size=%d", *v))
 	}
 
+	if v := auditConfig.Webhook.BatchMaxWait; v != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-batch-max-wait="+v.String())
+	}
+
+	if v := auditConfig.Webhook.BatchBufferSize; v != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--audit-webhook-batch-buffer-size=%d", *v))
+	}
+
+	if v := auditConfig.Webhook.InitialBackoff; v != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-initial-backoff="+v.String())
+	}
+
 	if v := auditConfig.Webhook.Version; v != nil {
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-version="+*v)
 	}
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var (
+	// nonResourceURLsHealth are the health and version endpoints which are frequently called by probes and clients.
+	nonResourceURLsHealth = []string{"/healthz*", "/livez*", "/readyz*", "/version", "/version/"}
+
+	// resourcesSensitive are resources whose request or response bodies contain credentials. Only the metadata of
+	// requests to these resources is recorded by all presets.
+	resourcesSensitive = []auditv1.GroupResources{
+		{Group: "", Resources: []string{"secrets", "configmaps", "serviceaccounts/token"}},
+		{Group: "authentication.k8s.io", Resources: []string{"tokenreviews"}},
+		{Group: "certificates.k8s.io", Resources: []string{"certificatesigningrequests"}},
+		{Group: "core.gardener.cloud", Resources: []string{"shoots/adminkubeconfig", "shoots/viewerkubeconfig", "internalsecrets"}},
+		{Group: "security.gardener.cloud", Resources: []string{"workloadidentities/token"}},
+	}
+
+	// resourcesIdentityAndAccess are resources which control identities and permissions.
+	resourcesIdentityAndAccess = []auditv1.GroupResources{
+		{Group: "rbac.authorization.k8s.io"},
+		{Group: "", Resources: []string{"serviceaccounts"}},
+		{Group: "admissionregistration.k8s.io"},
+	}
+
+	verbsRead     = []string{"get", "list", "watch"}
+	verbsMutating = []string{"create", "update", "patch", "delete", "deletecollection"}
+)
+
+// AuditPolicyForPreset returns the audit policy document in YAML format for the given preset.
+func AuditPolicyForPreset(preset gardencorev1beta1.AuditPolicyPreset) (string, error) {
+	var rules []auditv1.PolicyRule
+
+	switch preset {
+	case gardencorev1beta1.AuditPolicyPresetMinimal:
+		rules = minimalAuditPolicyRules()
+	case gardencorev1beta1.AuditPolicyPresetCIS:
+		rules = cisAuditPolicyRules()
+	case gardencorev1beta1.AuditPolicyPresetPCI:
+		rules = pciAuditPolicyRules()
+	default:
+		return "", fmt.Errorf("unsupported audit policy preset %q", preset)
+	}
+
+	data, err := runtime.Encode(auditCodec, &auditv1.Policy{
+		OmitStages: []auditv1.Stage{auditv1.StageRequestReceived},
+		Rules:      rules,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// noiseAuditPolicyRules returns rules which drop high-volume requests of system components which are not relevant
+// for auditing.
+func noiseAuditPolicyRules() []auditv1.PolicyRule {
+	return []auditv1.PolicyRule{
+		{
+			Level:           auditv1.LevelNone,
+			NonResourceURLs: nonResourceURLsHealth,
+		},
+		{
+			Level: auditv1.LevelNone,
+			Users: []string{"system:kube-proxy"},
+			Verbs: []string{"watch"},
+			Resources: []auditv1.GroupResources{
+				{Group: "", Resources: []string{"endpoints", "services", "services/status"}},
+				{Group: "discovery.k8s.io", Resources: []string{"endpointslices"}},
+			},
+		},
+		{
+			Level:      auditv1.LevelNone,
+			UserGroups: []string{"system:nodes"},
+			Verbs:      []string{"get"},
+			Resources:  []auditv1.GroupResources{{Group: "", Resources: []string{"nodes", "nodes/status"}}},
+		},
+		{
+			Level:     auditv1.LevelNone,
+			Verbs:     []string{"get", "update"},
+			Resources: []auditv1.GroupResources{{Group: "coordination.k8s.io", Resources: []string{"leases"}}},
+		},
+		{
+			Level:     auditv1.LevelNone,
+			Resources: []auditv1.GroupResources{{Group: "", Resources: []string{"events"}}, {Group: "events.k8s.io", Resources: []string{"events"}}},
+		},
+	}
+}
+
+func minimalAuditPolicyRules() []auditv1.PolicyRule {
+	return append(noiseAuditPolicyRules(),
+		auditv1.PolicyRule{
+			Level:     auditv1.LevelMetadata,
+			Resources: resourcesSensitive,
+		},
+		auditv1.PolicyRule{
+			Level: auditv1.LevelMetadata,
+			Verbs: verbsMutating,
+		},
+		auditv1.PolicyRule{
+			Level: auditv1.LevelNone,
+		},
+	)
+}
+
+func cisAuditPolicyRules() []auditv1.PolicyRule {
+	return append(noiseAuditPolicyRules(),
+		auditv1.PolicyRule{
+			Level:     auditv1.LevelMetadata,
+			Resources: resourcesSensitive,
+		},
+		auditv1.PolicyRule{
+			Level: auditv1.LevelMetadata,
+			Resources: []auditv1.GroupResources{
+				{Group: "", Resources: []string{"pods/exec", "pods/attach", "pods/portforward", "pods/proxy", "services/proxy", "nodes/proxy"}},
+			},
+		},
+		auditv1.PolicyRule{
+			Level: auditv1.LevelRequest,
+			Verbs: verbsRead,
+		},
+		auditv1.PolicyRule{
+			Level: auditv1.LevelRequestResponse,
+			Verbs: verbsMutating,
+		},
+		auditv1.PolicyRule{
+			Level: auditv1.LevelMetadata,
+		},
+	)
+}
+
+func pciAuditPolicyRules() []auditv1.PolicyRule {
+	return []auditv1.PolicyRule{
+		{
+			Level:           auditv1.LevelNone,
+			NonResourceURLs: nonResourceURLsHealth,
+		},
+		{
+			Level:     auditv1.LevelMetadata,
+			Resources: resourcesSensitive,
+		},
+		{
+			Level:     auditv1.LevelRequestResponse,
+			Verbs:     verbsMutating,
+			Resources: resourcesIdentityAndAccess,
+		},
+		{
+			Level: auditv1.LevelRequest,
+			Verbs: verbsMutating,
+		},
+		{
+			Level: auditv1.LevelMetadata,
+		},
+	}
+}
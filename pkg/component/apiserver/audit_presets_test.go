@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver_test
+
+import (
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	auditvalidation "k8s.io/apiserver/pkg/apis/audit/validation"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/component/apiserver"
+)
+
+var _ = Describe("AuditPresets", func() {
+	Describe("#AuditPolicyForPreset", func() {
+		decode := func(policy string) *auditv1.Policy {
+			out := &auditv1.Policy{}
+			Expect(yaml.Unmarshal([]byte(policy), out)).To(Succeed())
+			Expect(out.APIVersion).To(Equal("audit.k8s.io/v1"))
+			Expect(out.Kind).To(Equal("Policy"))
+			return out
+		}
+
+		levelFor := func(policy *auditv1.Policy, verb, group, resource string) auditv1.Level {
+			for _, rule := range policy.Rules {
+				if len(rule.Users) > 0 || len(rule.UserGroups) > 0 || len(rule.NonResourceURLs) > 0 {
+					continue
+				}
+				if len(rule.Verbs) > 0 && !slices.Contains(rule.Verbs, verb) {
+					continue
+				}
+				if len(rule.Resources) > 0 && !matchesResource(rule.Resources, group, resource) {
+					continue
+				}
+				return rule.Level
+			}
+			return auditv1.LevelNone
+		}
+
+		DescribeTable("should return a valid policy",
+			func(preset gardencorev1beta1.AuditPolicyPreset) {
+				policy, err := AuditPolicyForPreset(preset)
+				Expect(err).NotTo(HaveOccurred())
+
+				out := decode(policy)
+				Expect(out.OmitStages).To(ConsistOf(auditv1.StageRequestReceived))
+
+				internal := &auditinternal.Policy{}
+				Expect(auditv1.Convert_v1_Policy_To_audit_Policy(out, internal, nil)).To(Succeed())
+				Expect(auditvalidation.ValidatePolicy(internal)).To(BeEmpty())
+
+				By("never recording the bodies of secrets")
+				for _, verb := range []string{"get", "list", "create", "update", "patch", "delete"} {
+					Expect(levelFor(out, verb, "", "secrets")).To(BeElementOf(auditv1.LevelNone, auditv1.LevelMetadata))
+				}
+
+				By("never recording the bodies of requests for credentials")
+				Expect(levelFor(out, "create", "", "serviceaccounts/token")).To(Equal(auditv1.LevelMetadata))
+				Expect(levelFor(out, "create", "core.gardener.cloud", "shoots/adminkubeconfig")).To(Equal(auditv1.LevelMetadata))
+
+				By("recording mutating requests")
+				Expect(levelFor(out, "create", "apps", "deployments")).NotTo(Equal(auditv1.LevelNone))
+			},
+
+			Entry("Minimal", gardencorev1beta1.AuditPolicyPresetMinimal),
+			Entry("CIS", gardencorev1beta1.AuditPolicyPresetCIS),
+			Entry("PCI", gardencorev1beta1.AuditPolicyPresetPCI),
+		)
+
+		It("should not record read requests for the minimal preset", func() {
+			policy, err := AuditPolicyForPreset(gardencorev1beta1.AuditPolicyPresetMinimal)
+			Expect(err).NotTo(HaveOccurred())
+
+			out := decode(policy)
+			Expect(levelFor(out, "get", "apps", "deployments")).To(Equal(auditv1.LevelNone))
+			Expect(levelFor(out, "get", "", "secrets")).To(Equal(auditv1.LevelMetadata))
+			Expect(levelFor(out, "create", "apps", "deployments")).To(Equal(auditv1.LevelMetadata))
+		})
+
+		It("should record request and response bodies of mutating requests for the CIS preset", func() {
+			policy, err := AuditPolicyForPreset(gardencorev1beta1.AuditPolicyPresetCIS)
+			Expect(err).NotTo(HaveOccurred())
+
+			out := decode(policy)
+			Expect(levelFor(out, "get", "apps", "deployments")).To(Equal(auditv1.LevelRequest))
+			Expect(levelFor(out, "create", "apps", "deployments")).To(Equal(auditv1.LevelRequestResponse))
+		})
+
+		It("should record all requests and the bodies of changes to permissions for the PCI preset", func() {
+			policy, err := AuditPolicyForPreset(gardencorev1beta1.AuditPolicyPresetPCI)
+			Expect(err).NotTo(HaveOccurred())
+
+			out := decode(policy)
+			Expect(levelFor(out, "get", "apps", "deployments")).To(Equal(auditv1.LevelMetadata))
+			Expect(levelFor(out, "create", "apps", "deployments")).To(Equal(auditv1.LevelRequest))
+			Expect(levelFor(out, "create", "rbac.authorization.k8s.io", "clusterrolebindings")).To(Equal(auditv1.LevelRequestResponse))
+		})
+
+		It("should return an error for an unsupported preset", func() {
+			_, err := AuditPolicyForPreset("foo")
+			Expect(err).To(MatchError(`unsupported audit policy preset "foo"`))
+		})
+	})
+})
+
+func matchesResource(groupResources []auditv1.GroupResources, group, resource string) bool {
+	for _, gr := range groupResources {
+		if gr.Group == group && (len(gr.Resources) == 0 || slices.Contains(gr.Resources, resource)) {
+			return true
+		}
+	}
+	return false
+}
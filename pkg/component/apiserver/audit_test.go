@@ -6,6 +6,7 @@ package apiserver_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -209,6 +210,56 @@ rules:
 			}))
 		})
 
+		It("should inject the correct settings w/ log backend", func() {
+			deployment := &appsv1.Deployment{}
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{})
+
+			configMapAuditPolicy := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "audit-policy"}}
+
+			InjectAuditSettings(deployment, configMapAuditPolicy, nil, &AuditConfig{Log: &AuditLog{
+				Version: ptr.To("bar"),
+			}})
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(Equal([]string{
+				"--audit-policy-file=/etc/kubernetes/audit/audit-policy.yaml",
+				"--audit-log-path=-",
+				"--audit-log-format=json",
+				"--audit-log-version=bar",
+			}))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		})
+
+		It("should inject the correct settings w/ log backend and webhook batching and retries", func() {
+			deployment := &appsv1.Deployment{}
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{})
+
+			configMapAuditPolicy := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "audit-policy"}}
+			secretWebhookKubeconfig := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "audit-webhook"}}
+
+			InjectAuditSettings(deployment, configMapAuditPolicy, secretWebhookKubeconfig, &AuditConfig{
+				Log: &AuditLog{},
+				Webhook: &AuditWebhook{
+					Kubeconfig:      []byte("foo"),
+					BatchMaxSize:    ptr.To[int32](2),
+					BatchMaxWait:    ptr.To(5 * time.Second),
+					BatchBufferSize: ptr.To[int32](1000),
+					InitialBackoff:  ptr.To(10 * time.Second),
+				},
+			})
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).To(Equal([]string{
+				"--audit-policy-file=/etc/kubernetes/audit/audit-policy.yaml",
+				"--audit-log-path=-",
+				"--audit-log-format=json",
+				"--audit-webhook-config-file=/etc/kubernetes/webhook/audit/kubeconfig.yaml",
+				"--audit-webhook-batch-max-size=2",
+				"--audit-webhook-batch-max-wait=5s",
+				"--audit-webhook-batch-buffer-size=1000",
+				"--audit-webhook-initial-backoff=10s",
+			}))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(HaveLen(2))
+		})
+
 		It("should inject the correct settings w/ webhook", func() {
 			deployment := &appsv1.Deployment{}
 			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{})
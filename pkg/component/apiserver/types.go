@@ -5,6 +5,8 @@
 package apiserver
 
 import (
+	"time"
+
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 
@@ -65,6 +67,15 @@ type AuditConfig struct {
 	Policy *string
 	// Webhook contains configuration for the audit webhook.
 	Webhook *AuditWebhook
+	// Log contains configuration for the audit log backend.
+	Log *AuditLog
+}
+
+// AuditLog contains configuration for the audit log backend which writes the audit events to the standard output of
+// the API server container.
+type AuditLog struct {
+	// Version is the API group and version used for serializing audit events written to the log.
+	Version *string
 }
 
 // AuditWebhook contains configuration for the audit webhook.
@@ -73,6 +84,12 @@ type AuditWebhook struct {
 	Kubeconfig []byte
 	// BatchMaxSize is the maximum size of a batch.
 	BatchMaxSize *int32
+	// BatchMaxWait is the amount of time to wait before force writing a batch that hadn't reached the max size.
+	BatchMaxWait *time.Duration
+	// BatchBufferSize is the size of the buffer to store events before batching and writing.
+	BatchBufferSize *int32
+	// InitialBackoff is the amount of time to wait before retrying the first failed request.
+	InitialBackoff *time.Duration
 	// Version is the API group and version used for serializing audit events written to webhook.
 	Version *string
 }
@@ -24,6 +24,7 @@ import (
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	sshutils "github.com/gardener/gardener/pkg/utils/ssh"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 // Bastion is a component for managing a Bastion (extensions.gardener.cloud) object. It is used for accessing the
@@ -104,6 +105,7 @@ func (b *Bastion) Deploy(ctx context.Context) error {
 	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, b.client, b.bastion, func() error {
 		metav1.SetMetaDataAnnotation(&b.bastion.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
 		metav1.SetMetaDataAnnotation(&b.bastion.ObjectMeta, v1beta1constants.GardenerTimestamp, b.Clock.Now().UTC().Format(time.RFC3339Nano))
+		tracing.InjectIntoAnnotations(ctx, &b.bastion.ObjectMeta)
 
 		b.bastion.Spec = extensionsv1alpha1.BastionSpec{
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 	"github.com/gardener/gardener/pkg/utils/workloadidentity"
 )
 
@@ -150,6 +151,7 @@ func (d *dnsRecord) deploy(ctx context.Context, operation string) (extensionsv1a
 			d.isTimestampInvalidOrAfterLastUpdateTime() {
 			metav1.SetMetaDataAnnotation(&d.dnsRecord.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 			metav1.SetMetaDataAnnotation(&d.dnsRecord.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+			tracing.InjectIntoAnnotations(ctx, &d.dnsRecord.ObjectMeta)
 		}
 
 		if d.values.IPStack != "" {
@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 )
 
 var (
@@ -220,6 +221,7 @@ func (e *extension) deploy(ctx context.Context, ext *extensionsv1alpha1.Extensio
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, e.client, ext, func() error {
 		metav1.SetMetaDataAnnotation(&ext.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 		metav1.SetMetaDataAnnotation(&ext.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		tracing.InjectIntoAnnotations(ctx, &ext.ObjectMeta)
 		ext.Spec.Type = extType
 		ext.Spec.ProviderConfig = providerConfig
 		return nil
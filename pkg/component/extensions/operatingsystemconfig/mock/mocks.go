@@ -160,6 +160,18 @@ func (mr *MockInterfaceMockRecorder) SetCredentialsRotationStatus(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentialsRotationStatus", reflect.TypeOf((*MockInterface)(nil).SetCredentialsRotationStatus), arg0)
 }
 
+// SetSSHCAPublicKeys mocks base method.
+func (m *MockInterface) SetSSHCAPublicKeys(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSSHCAPublicKeys", arg0)
+}
+
+// SetSSHCAPublicKeys indicates an expected call of SetSSHCAPublicKeys.
+func (mr *MockInterfaceMockRecorder) SetSSHCAPublicKeys(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSSHCAPublicKeys", reflect.TypeOf((*MockInterface)(nil).SetSSHCAPublicKeys), arg0)
+}
+
 // SetSSHPublicKeys mocks base method.
 func (m *MockInterface) SetSSHPublicKeys(arg0 []string) {
 	m.ctrl.T.Helper()
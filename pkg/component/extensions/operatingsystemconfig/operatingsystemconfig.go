@@ -46,6 +46,7 @@ import (
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/tracing"
 	"github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -91,6 +92,8 @@ type Interface interface {
 	SetCredentialsRotationStatus(*gardencorev1beta1.ShootCredentialsRotation)
 	// SetSSHPublicKeys sets the SSHPublicKeys value.
 	SetSSHPublicKeys([]string)
+	// SetSSHCAPublicKeys sets the SSHCAPublicKeys value.
+	SetSSHCAPublicKeys([]string)
 	// WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 	// containing both the init and the original operating system config data.
 	WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs
@@ -141,6 +144,8 @@ type OriginalValues struct {
 	MachineTypes []gardencorev1beta1.MachineType
 	// SSHPublicKeys is a list of public SSH keys.
 	SSHPublicKeys []string
+	// SSHCAPublicKeys is a list of public keys of SSH certificate authorities whose user certificates are trusted.
+	SSHCAPublicKeys []string
 	// SSHAccessEnabled states whether sshd.service service in systemd should be enabled and running for the worker nodes.
 	SSHAccessEnabled bool
 	// ValitailEnabled states whether Valitail shall be enabled.
@@ -688,6 +693,11 @@ func (o *operatingSystemConfig) SetSSHPublicKeys(keys []string) {
 	o.values.SSHPublicKeys = keys
 }
 
+// SetSSHCAPublicKeys sets the SSHCAPublicKeys value.
+func (o *operatingSystemConfig) SetSSHCAPublicKeys(keys []string) {
+	o.values.SSHCAPublicKeys = keys
+}
+
 // WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 // containing both the init script and the original config.
 func (o *operatingSystemConfig) WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs {
@@ -790,6 +800,7 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		kubeProxyEnabled:                        o.values.KubeProxyEnabled,
 		kubernetesVersion:                       kubernetesVersion,
 		sshPublicKeys:                           o.values.SSHPublicKeys,
+		sshCAPublicKeys:                         o.values.SSHCAPublicKeys,
 		sshAccessEnabled:                        o.values.SSHAccessEnabled,
 		valiIngressHostName:                     o.values.ValiIngressHostName,
 		valitailEnabled:                         o.values.ValitailEnabled,
@@ -862,6 +873,7 @@ type deployer struct {
 	kubeProxyEnabled                            bool
 	kubernetesVersion                           *semver.Version
 	sshPublicKeys                               []string
+	sshCAPublicKeys                             []string
 	sshAccessEnabled                            bool
 	valiIngressHostName                         string
 	valitailEnabled                             bool
@@ -907,6 +919,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		KubeProxyEnabled:                        d.kubeProxyEnabled,
 		KubernetesVersion:                       d.kubernetesVersion,
 		SSHPublicKeys:                           d.sshPublicKeys,
+		SSHCAPublicKeys:                         d.sshCAPublicKeys,
 		SSHAccessEnabled:                        d.sshAccessEnabled,
 		ValitailEnabled:                         d.valitailEnabled,
 		ValiIngress:                             d.valiIngressHostName,
@@ -958,6 +971,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, d.client, d.osc, func() error {
 		metav1.SetMetaDataAnnotation(&d.osc.ObjectMeta, v1beta1constants.GardenerOperation, operation)
 		metav1.SetMetaDataAnnotation(&d.osc.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+		tracing.InjectIntoAnnotations(ctx, &d.osc.ObjectMeta)
 		metav1.SetMetaDataLabel(&d.osc.ObjectMeta, v1beta1constants.LabelWorkerPool, d.worker.Name)
 		metav1.SetMetaDataLabel(&d.osc.ObjectMeta, v1beta1constants.LabelExtensionProviderMutatedByControlplaneWebhook, "true")
 
@@ -16,6 +16,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	"github.com/gardener/gardener/pkg/utils"
+	sshutils "github.com/gardener/gardener/pkg/utils/ssh"
 )
 
 var (
@@ -45,8 +46,29 @@ const (
 
 	// pathAuthorizedSSHKeys is the new file that can contain multiple SSH public keys.
 	pathAuthorizedSSHKeys = "/var/lib/gardener-user-authorized-keys"
+
+	// pathTrustedUserCAKeys is the file that contains the public keys of the SSH certificate authorities whose user
+	// certificates are trusted by sshd.
+	pathTrustedUserCAKeys = "/var/lib/gardener-user-trusted-user-ca-keys"
+
+	// pathAuthorizedPrincipalsCommand is the command that returns the principals of user certificates which may log in
+	// as the given user. sshd requires it to be owned by root and not writable by others.
+	pathAuthorizedPrincipalsCommand = "/var/lib/gardener-user/authorized-principals"
 )
 
+// authorizedPrincipalsCommand allows the principal of the given user certificate to log in as the gardener user if it
+// is a per-user principal. It is taken from the first word of the key ID of the certificate, sshd verifies that the
+// certificate is valid for it.
+const authorizedPrincipalsCommand = `#!/bin/sh
+
+if [ "$1" = "gardener" ]; then
+  principal="${2%% *}"
+  case "$principal" in
+    ` + sshutils.PrincipalPrefix + `*) echo "$principal" ;;
+  esac
+fi
+`
+
 type component struct{}
 
 // New returns a new Gardener user component.
@@ -61,19 +83,29 @@ func (component) Name() string {
 func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
 	var script bytes.Buffer
 	if err := tpl.Execute(&script, map[string]any{
-		"pathPublicSSHKey":      pathPublicSSHKey,
-		"pathAuthorizedSSHKeys": pathAuthorizedSSHKeys,
+		"pathPublicSSHKey":                pathPublicSSHKey,
+		"pathAuthorizedSSHKeys":           pathAuthorizedSSHKeys,
+		"pathTrustedUserCAKeys":           pathTrustedUserCAKeys,
+		"pathAuthorizedPrincipalsCommand": pathAuthorizedPrincipalsCommand,
 	}); err != nil {
 		return nil, nil, err
 	}
 
 	authorizedKeys := strings.Join(ctx.SSHPublicKeys, "\n")
 
-	return []extensionsv1alpha1.Unit{
-			{
-				Name:   "gardener-user.service",
-				Enable: ptr.To(true),
-				Content: ptr.To(`[Unit]
+	pathUnitContent := `[Path]
+PathChanged=` + pathAuthorizedSSHKeys + `
+`
+	if len(ctx.SSHCAPublicKeys) > 0 {
+		pathUnitContent += `PathChanged=` + pathTrustedUserCAKeys + `
+`
+	}
+
+	units := []extensionsv1alpha1.Unit{
+		{
+			Name:   "gardener-user.service",
+			Enable: ptr.To(true),
+			Content: ptr.To(`[Unit]
 Description=Configure gardener user
 After=sshd.service
 [Service]
@@ -81,38 +113,60 @@ Restart=on-failure
 EnvironmentFile=/etc/environment
 ExecStart=` + pathScript + `
 `),
-			},
-			{
-				Name:   "gardener-user.path",
-				Enable: ptr.To(true),
-				Content: ptr.To(`[Path]
-PathChanged=` + pathAuthorizedSSHKeys + `
-[Install]
+		},
+		{
+			Name:   "gardener-user.path",
+			Enable: ptr.To(true),
+			Content: ptr.To(pathUnitContent + `[Install]
 WantedBy=multi-user.target
 `),
-			},
 		},
-		[]extensionsv1alpha1.File{
-			{
-				Path:        pathAuthorizedSSHKeys,
-				Permissions: ptr.To[uint32](0644),
-				Content: extensionsv1alpha1.FileContent{
-					Inline: &extensionsv1alpha1.FileContentInline{
-						Encoding: "b64",
-						Data:     utils.EncodeBase64([]byte(authorizedKeys)),
-					},
+	}
+
+	files := []extensionsv1alpha1.File{
+		{
+			Path:        pathAuthorizedSSHKeys,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(authorizedKeys)),
 				},
 			},
-			{
-				Path:        pathScript,
-				Permissions: ptr.To[uint32](0755),
-				Content: extensionsv1alpha1.FileContent{
-					Inline: &extensionsv1alpha1.FileContentInline{
-						Encoding: "b64",
-						Data:     utils.EncodeBase64(script.Bytes()),
-					},
+		},
+		{
+			Path:        pathScript,
+			Permissions: ptr.To[uint32](0755),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64(script.Bytes()),
 				},
 			},
 		},
-		nil
+	}
+
+	if len(ctx.SSHCAPublicKeys) > 0 {
+		files = append(files, extensionsv1alpha1.File{
+			Path:        pathTrustedUserCAKeys,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(strings.Join(ctx.SSHCAPublicKeys, "\n"))),
+				},
+			},
+		}, extensionsv1alpha1.File{
+			Path:        pathAuthorizedPrincipalsCommand,
+			Permissions: ptr.To[uint32](0755),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(authorizedPrincipalsCommand)),
+				},
+			},
+		})
+	}
+
+	return units, files, nil
 }
@@ -85,6 +85,47 @@ WantedBy=multi-user.target
 				},
 			))
 		})
+
+		It("should return the expected units and files when SSH certificate authorities are trusted", func() {
+			sshCAPublicKeys := []string{"ca-public-key", "old-ca-public-key"}
+			ctx.SSHCAPublicKeys = sshCAPublicKeys
+
+			units, files, err := component.Config(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+				Name:   "gardener-user.path",
+				Enable: ptr.To(true),
+				Content: ptr.To(`[Path]
+PathChanged=/var/lib/gardener-user-authorized-keys
+PathChanged=/var/lib/gardener-user-trusted-user-ca-keys
+[Install]
+WantedBy=multi-user.target
+`),
+			}))
+			Expect(files).To(ContainElements(
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user-trusted-user-ca-keys",
+					Permissions: ptr.To[uint32](0644),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte("ca-public-key\nold-ca-public-key")),
+						},
+					},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-user/authorized-principals",
+					Permissions: ptr.To[uint32](0755),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte(authorizedPrincipalsCommand)),
+						},
+					},
+				},
+			))
+		})
 	})
 })
 
@@ -93,6 +134,12 @@ const script = `#!/bin/bash -eu
 DIR_SSH="/home/gardener/.ssh"
 PATH_AUTHORIZED_KEYS="$DIR_SSH/authorized_keys"
 PATH_SUDOERS="/etc/sudoers.d/99-gardener-user"
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
+SSHD_CONFIG_LINES=(
+  "TrustedUserCAKeys /var/lib/gardener-user-trusted-user-ca-keys"
+  "AuthorizedPrincipalsCommand /var/lib/gardener-user/authorized-principals %u %i"
+  "AuthorizedPrincipalsCommandUser nobody"
+)
 USERNAME="gardener"
 
 # create user if missing
@@ -112,4 +159,34 @@ fi
 if [ ! -f "$PATH_SUDOERS" ]; then
   echo "$USERNAME ALL=(ALL) NOPASSWD:ALL" > $PATH_SUDOERS
 fi
+
+# trust user certificates signed by the SSH certificate authority of the shoot, they are issued for per-user principals
+# which may log in as the gardener user
+SSHD_CONFIG_CHANGED=false
+for line in "${SSHD_CONFIG_LINES[@]}"; do
+  if [ -s "/var/lib/gardener-user-trusted-user-ca-keys" ]; then
+    if ! grep -qxF "$line" $PATH_SSHD_CONFIG; then
+      # the options must be placed before any 'Match' block
+      sed -i "1i $line" $PATH_SSHD_CONFIG
+      SSHD_CONFIG_CHANGED=true
+    fi
+  elif grep -qxF "$line" $PATH_SSHD_CONFIG; then
+    sed -i "\|^$line\$|d" $PATH_SSHD_CONFIG
+    SSHD_CONFIG_CHANGED=true
+  fi
+done
+
+if [ "$SSHD_CONFIG_CHANGED" = true ]; then
+  systemctl reload sshd || systemctl reload ssh || true
+fi
+`
+
+const authorizedPrincipalsCommand = `#!/bin/sh
+
+if [ "$1" = "gardener" ]; then
+  principal="${2%% *}"
+  case "$principal" in
+    gardener-user:*) echo "$principal" ;;
+  esac
+fi
 `
@@ -3,6 +3,12 @@
 DIR_SSH="/home/gardener/.ssh"
 PATH_AUTHORIZED_KEYS="$DIR_SSH/authorized_keys"
 PATH_SUDOERS="/etc/sudoers.d/99-gardener-user"
+PATH_SSHD_CONFIG="/etc/ssh/sshd_config"
+SSHD_CONFIG_LINES=(
+  "TrustedUserCAKeys {{ .pathTrustedUserCAKeys }}"
+  "AuthorizedPrincipalsCommand {{ .pathAuthorizedPrincipalsCommand }} %u %i"
+  "AuthorizedPrincipalsCommandUser nobody"
+)
 USERNAME="gardener"
 
 # create user if missing
@@ -22,3 +28,23 @@ fi
 if [ ! -f "$PATH_SUDOERS" ]; then
   echo "$USERNAME ALL=(ALL) NOPASSWD:ALL" > $PATH_SUDOERS
 fi
+
+# trust user certificates signed by the SSH certificate authority of the shoot, they are issued for per-user principals
+# which may log in as the gardener user
+SSHD_CONFIG_CHANGED=false
+for line in "${SSHD_CONFIG_LINES[@]}"; do
+  if [ -s "{{ .pathTrustedUserCAKeys }}" ]; then
+    if ! grep -qxF "$line" $PATH_SSHD_CONFIG; then
+      # the options must be placed before any 'Match' block
+      sed -i "1i $line" $PATH_SSHD_CONFIG
+      SSHD_CONFIG_CHANGED=true
+    fi
+  elif grep -qxF "$line" $PATH_SSHD_CONFIG; then
+    sed -i "\|^$line\$|d" $PATH_SSHD_CONFIG
+    SSHD_CONFIG_CHANGED=true
+  fi
+done
+
+if [ "$SSHD_CONFIG_CHANGED" = true ]; then
+  systemctl reload sshd || systemctl reload ssh || true
+fi
@@ -460,7 +460,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{settingsv1alpha1.GroupName},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
 				{
@@ -481,6 +481,9 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/diagnostics",
+						"shoots/clone",
+						"shoots/alertsilence",
 					},
 					Verbs: []string{"create"},
 				},
@@ -576,7 +579,7 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 				},
 				{
 					APIGroups: []string{settingsv1alpha1.GroupName},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
 				{
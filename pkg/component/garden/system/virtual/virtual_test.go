@@ -479,7 +479,7 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"settings.gardener.cloud"},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies"},
 					Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 				},
 				{
@@ -500,6 +500,9 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/diagnostics",
+						"shoots/clone",
+						"shoots/alertsilence",
 					},
 					Verbs: []string{"create"},
 				},
@@ -593,7 +596,7 @@ var _ = Describe("Virtual", func() {
 				},
 				{
 					APIGroups: []string{"settings.gardener.cloud"},
-					Resources: []string{"openidconnectpresets"},
+					Resources: []string{"openidconnectpresets", "shootpolicies"},
 					Verbs:     []string{"get", "list", "watch"},
 				},
 				{
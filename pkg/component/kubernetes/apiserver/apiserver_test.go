@@ -538,7 +538,7 @@ var _ = Describe("KubeAPIServer", func() {
 							MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
 								SourceLabels: []monitoringv1.LabelName{"__name__"},
 								Action:       "keep",
-								Regex:        `^(authentication_attempts|authenticated_user_requests|apiserver_admission_controller_admission_duration_seconds_.+|apiserver_admission_webhook_admission_duration_seconds_.+|apiserver_admission_step_admission_duration_seconds_.+|apiserver_admission_webhook_request_total|apiserver_admission_webhook_rejection_count|apiserver_audit_event_total|apiserver_audit_error_total|apiserver_audit_requests_rejected_total|apiserver_cache_list_.+|apiserver_crd_conversion_webhook_duration_seconds_.+|apiserver_current_inflight_requests|apiserver_current_inqueue_requests|apiserver_flowcontrol_rejected_requests_total|apiserver_flowcontrol_dispatched_requests_total|apiserver_flowcontrol_current_inqueue_requests|apiserver_flowcontrol_current_executing_requests|apiserver_flowcontrol_current_executing_seats|apiserver_flowcontrol_request_wait_duration_seconds|apiserver_flowcontrol_nominal_limit_seats|apiserver_flowcontrol_request_concurrency_in_use|apiserver_flowcontrol_priority_level_request_utilization|apiserver_flowcontrol_priority_level_seat_utilization|apiserver_init_events_total|apiserver_latency|apiserver_latency_seconds|apiserver_longrunning_requests|apiserver_request_duration_seconds_.+|apiserver_request_duration_seconds_bucket|apiserver_request_duration_seconds_count|apiserver_request_terminations_total|apiserver_response_sizes_.+|apiserver_storage_list_.+|apiserver_storage_objects|apiserver_storage_transformation_duration_seconds_.+|apiserver_storage_transformation_operations_total|apiserver_storage_size_bytes|apiserver_registered_watchers|apiserver_request_count|apiserver_request_total|apiserver_validating_admission_policy_check_total|apiserver_watch_duration|apiserver_watch_events_sizes_.+|apiserver_watch_events_total|etcd_request_duration_seconds_.+|go_.+|process_max_fds|process_open_fds|watch_cache_capacity_increase_total|watch_cache_capacity_decrease_total|watch_cache_capacity)$`,
+								Regex:        `^(authentication_attempts|authenticated_user_requests|apiserver_admission_controller_admission_duration_seconds_.+|apiserver_admission_webhook_admission_duration_seconds_.+|apiserver_admission_step_admission_duration_seconds_.+|apiserver_admission_webhook_request_total|apiserver_admission_webhook_rejection_count|apiserver_audit_event_total|apiserver_audit_error_total|apiserver_audit_level_total|apiserver_audit_requests_rejected_total|apiserver_cache_list_.+|apiserver_crd_conversion_webhook_duration_seconds_.+|apiserver_current_inflight_requests|apiserver_current_inqueue_requests|apiserver_flowcontrol_rejected_requests_total|apiserver_flowcontrol_dispatched_requests_total|apiserver_flowcontrol_current_inqueue_requests|apiserver_flowcontrol_current_executing_requests|apiserver_flowcontrol_current_executing_seats|apiserver_flowcontrol_request_wait_duration_seconds|apiserver_flowcontrol_nominal_limit_seats|apiserver_flowcontrol_request_concurrency_in_use|apiserver_flowcontrol_priority_level_request_utilization|apiserver_flowcontrol_priority_level_seat_utilization|apiserver_init_events_total|apiserver_latency|apiserver_latency_seconds|apiserver_longrunning_requests|apiserver_request_duration_seconds_.+|apiserver_request_duration_seconds_bucket|apiserver_request_duration_seconds_count|apiserver_request_terminations_total|apiserver_response_sizes_.+|apiserver_storage_list_.+|apiserver_storage_objects|apiserver_storage_transformation_duration_seconds_.+|apiserver_storage_transformation_operations_total|apiserver_storage_size_bytes|apiserver_registered_watchers|apiserver_request_count|apiserver_request_total|apiserver_validating_admission_policy_check_total|apiserver_watch_duration|apiserver_watch_events_sizes_.+|apiserver_watch_events_total|etcd_request_duration_seconds_.+|go_.+|process_max_fds|process_open_fds|watch_cache_capacity_increase_total|watch_cache_capacity_decrease_total|watch_cache_capacity)$`,
 							}},
 						}},
 					},
@@ -733,6 +733,10 @@ var _ = Describe("KubeAPIServer", func() {
 									Record: "shoot:apiserver_audit_error_total:sum",
 									Expr:   intstr.FromString(`sum(rate(apiserver_audit_error_total{plugin!="log",job="kube-apiserver"}[5m]))`),
 								},
+								{
+									Record: "shoot:apiserver_audit_level_total:sum",
+									Expr:   intstr.FromString(`sum by (level) (rate(apiserver_audit_level_total{job="kube-apiserver"}[5m]))`),
+								},
 
 								// API latency
 								{
@@ -159,6 +159,10 @@ func (k *kubeAPIServer) reconcilePrometheusRule(ctx context.Context, prometheusR
 						Record: "shoot:apiserver_audit_error_total:sum",
 						Expr:   intstr.FromString(`sum(rate(apiserver_audit_error_total{plugin!="log",job="kube-apiserver"}[5m]))`),
 					},
+					{
+						Record: "shoot:apiserver_audit_level_total:sum",
+						Expr:   intstr.FromString(`sum by (level) (rate(apiserver_audit_level_total{job="kube-apiserver"}[5m]))`),
+					},
 
 					// API latency
 					{
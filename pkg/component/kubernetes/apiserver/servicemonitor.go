@@ -63,6 +63,7 @@ func (k *kubeAPIServer) reconcileServiceMonitor(ctx context.Context, serviceMoni
 					"apiserver_admission_webhook_rejection_count",
 					"apiserver_audit_event_total",
 					"apiserver_audit_error_total",
+					"apiserver_audit_level_total",
 					"apiserver_audit_requests_rejected_total",
 					"apiserver_cache_list_.+",
 					"apiserver_crd_conversion_webhook_duration_seconds_.+",
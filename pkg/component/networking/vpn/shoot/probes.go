@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
+)
+
+const (
+	// ProbeSegmentNode is the segment of the connectivity probes testing the path from the kube-apiserver through the
+	// VPN to the kubelets in the node network of the shoot.
+	ProbeSegmentNode = "node"
+	// ProbeSegmentPod is the segment of the connectivity probes testing the path from the kube-apiserver through the
+	// VPN to a pod in the pod network of the shoot.
+	ProbeSegmentPod = "pod"
+	// ProbeSegmentService is the segment of the connectivity probes testing the path from the kube-apiserver through
+	// the VPN to a service of the shoot.
+	ProbeSegmentService = "service"
+	// ProbeSegmentTunnel is the segment of the connectivity probes testing the path from the kube-apiserver through
+	// the VPN to each vpn-shoot replica terminating the tunnels of a highly available VPN.
+	ProbeSegmentTunnel = "tunnel"
+
+	// RecordProbeLoss is the name of the recording rule for the ratio of failed connectivity probes during the last
+	// five minutes per segment and tunnel.
+	RecordProbeLoss = "shoot:vpn_probe_loss:ratio"
+	// RecordProbeLatency is the name of the recording rule for the average duration of the connectivity probes during
+	// the last five minutes per segment and tunnel.
+	RecordProbeLatency = "shoot:vpn_probe_latency_seconds:avg"
+
+	probeTargetBlackboxExporter = "blackbox-exporter"
+	probeTargetPathHealth       = "/-/healthy"
+	probeTargetPort             = 9115
+
+	tunnelControllerContainerName = "tunnel-controller"
+	tunnelControllerPort          = 8080
+	tunnelControllerPathReady     = "/readyz"
+)
+
+// ProbeSegments are the segments tested by the connectivity probes.
+var ProbeSegments = []string{ProbeSegmentNode, ProbeSegmentPod, ProbeSegmentService, ProbeSegmentTunnel}
+
+// TunnelNames returns the values of the `tunnel` label of the connectivity probes of the tunnel segment. Each tunnel is
+// identified by the vpn-shoot replica terminating it in the shoot. Without a highly available VPN, there is only a
+// single tunnel which is covered by the probes of the other segments, hence no names are returned.
+func TunnelNames(highAvailabilityEnabled bool, numberOfShootClients int) []string {
+	if !highAvailabilityEnabled {
+		return nil
+	}
+
+	names := make([]string, 0, numberOfShootClients)
+	for i := 0; i < numberOfShootClients; i++ {
+		names = append(names, fmt.Sprintf("%s-%d", deploymentName, i))
+	}
+	return names
+}
+
+func probeJobName(segment string) string {
+	return "tunnel-probe-" + segment
+}
+
+func (v *vpnShoot) emptyProbeScrapeConfig(segment string) *monitoringv1alpha1.ScrapeConfig {
+	return &monitoringv1alpha1.ScrapeConfig{ObjectMeta: monitoringutils.ConfigObjectMeta(probeJobName(segment), v.namespace, shoot.Label)}
+}
+
+// probeScrapeConfigSpecs returns the scrape config specs for the connectivity probes of all segments. The probes are
+// executed by the blackbox-exporter in the control plane namespace and are routed via the kube-apiserver proxy
+// subresources, i.e., they take the same path through the VPN as `kubectl logs/exec/port-forward`. The tunnel segment
+// is only probed for highly available VPNs.
+func (v *vpnShoot) probeScrapeConfigSpecs() map[string]monitoringv1alpha1.ScrapeConfigSpec {
+	kubeAPIServerURL := "https://" + v1beta1constants.DeploymentNameKubeAPIServer + ":" + strconv.Itoa(kubeapiserverconstants.Port)
+
+	specs := map[string]monitoringv1alpha1.ScrapeConfigSpec{
+		ProbeSegmentNode: probeScrapeConfigSpec(ProbeSegmentNode, monitoringv1alpha1.KubernetesRolePod, []monitoringv1.RelabelConfig{
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name", "__meta_kubernetes_pod_container_name", "__meta_kubernetes_pod_node_name"},
+				Action:       "keep",
+				Regex:        `vpn-shoot-(\d+|.+-.+);vpn-shoot-init;.+`,
+			},
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_node_name"},
+				TargetLabel:  "__param_target",
+				Replacement:  ptr.To(kubeAPIServerURL + "/api/v1/nodes/${1}/proxy/healthz"),
+				Action:       "replace",
+			},
+		}),
+		ProbeSegmentPod: probeScrapeConfigSpec(ProbeSegmentPod, monitoringv1alpha1.KubernetesRolePod, []monitoringv1.RelabelConfig{
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_app", "__meta_kubernetes_pod_container_port_number", "__meta_kubernetes_pod_ready"},
+				Action:       "keep",
+				Regex:        probeTargetBlackboxExporter + ";" + strconv.Itoa(probeTargetPort) + ";true",
+			},
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name"},
+				TargetLabel:  "__param_target",
+				Replacement:  ptr.To(kubeAPIServerURL + "/api/v1/namespaces/kube-system/pods/${1}:" + strconv.Itoa(probeTargetPort) + "/proxy" + probeTargetPathHealth),
+				Action:       "replace",
+			},
+		}),
+		// The service is discovered via its endpoints and only probed while it has ready endpoints. Otherwise, the
+		// kube-apiserver responds with `503 Service Unavailable` which does not indicate a problem of the VPN.
+		// Targets of multiple ready endpoints have the same labels and are hence deduplicated by Prometheus.
+		ProbeSegmentService: probeScrapeConfigSpec(ProbeSegmentService, monitoringv1alpha1.KubernetesRoleEndpoint, []monitoringv1.RelabelConfig{
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_service_name", "__meta_kubernetes_endpoint_port_number", "__meta_kubernetes_endpoint_ready"},
+				Action:       "keep",
+				Regex:        probeTargetBlackboxExporter + ";" + strconv.Itoa(probeTargetPort) + ";true",
+			},
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_service_name"},
+				TargetLabel:  "__param_target",
+				Replacement:  ptr.To(kubeAPIServerURL + "/api/v1/namespaces/kube-system/services/${1}:probe/proxy" + probeTargetPathHealth),
+				Action:       "replace",
+			},
+		}),
+	}
+
+	if v.values.HighAvailabilityEnabled {
+		// Each vpn-shoot replica is probed explicitly via the readiness endpoint of its tunnel-controller. The probe only
+		// succeeds if the request is routed through the VPN to this replica and the replica reports its tunnels as ready.
+		specs[ProbeSegmentTunnel] = probeScrapeConfigSpec(ProbeSegmentTunnel, monitoringv1alpha1.KubernetesRolePod, []monitoringv1.RelabelConfig{
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_app", "__meta_kubernetes_pod_container_name"},
+				Action:       "keep",
+				Regex:        labelValue + ";" + tunnelControllerContainerName,
+			},
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name"},
+				TargetLabel:  "tunnel",
+				Action:       "replace",
+			},
+			{
+				SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name"},
+				TargetLabel:  "__param_target",
+				Replacement:  ptr.To(kubeAPIServerURL + "/api/v1/namespaces/kube-system/pods/${1}:" + strconv.Itoa(tunnelControllerPort) + "/proxy" + tunnelControllerPathReady),
+				Action:       "replace",
+			},
+		})
+	}
+
+	return specs
+}
+
+func probeScrapeConfigSpec(segment string, role monitoringv1alpha1.KubernetesRole, targetRelabelConfigs []monitoringv1.RelabelConfig) monitoringv1alpha1.ScrapeConfigSpec {
+	relabelConfigs := append([]monitoringv1.RelabelConfig{{
+		TargetLabel: "type",
+		Replacement: ptr.To("seed"),
+	}}, targetRelabelConfigs...)
+
+	return monitoringv1alpha1.ScrapeConfigSpec{
+		HonorLabels: ptr.To(false),
+		MetricsPath: ptr.To("/probe"),
+		Params:      map[string][]string{"module": {"http_apiserver"}},
+		KubernetesSDConfigs: []monitoringv1alpha1.KubernetesSDConfig{{
+			Role:       role,
+			APIServer:  ptr.To("https://" + v1beta1constants.DeploymentNameKubeAPIServer),
+			Namespaces: &monitoringv1alpha1.NamespaceDiscovery{Names: []string{metav1.NamespaceSystem}},
+			Authorization: &monitoringv1.SafeAuthorization{Credentials: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: shoot.AccessSecretName},
+				Key:                  resourcesv1alpha1.DataKeyToken,
+			}},
+			// This is needed because we do not fetch the correct cluster CA bundle right now
+			TLSConfig: &monitoringv1.SafeTLSConfig{InsecureSkipVerify: ptr.To(true)},
+		}},
+		RelabelConfigs: append(relabelConfigs,
+			monitoringv1.RelabelConfig{
+				SourceLabels: []monitoringv1.LabelName{"__param_target"},
+				TargetLabel:  "instance",
+				Action:       "replace",
+			},
+			monitoringv1.RelabelConfig{
+				TargetLabel: "__address__",
+				Replacement: ptr.To("blackbox-exporter:9115"),
+				Action:      "replace",
+			},
+			monitoringv1.RelabelConfig{
+				TargetLabel: "segment",
+				Replacement: ptr.To(segment),
+			},
+			monitoringv1.RelabelConfig{
+				Action:      "replace",
+				Replacement: ptr.To(probeJobName(segment)),
+				TargetLabel: "job",
+			},
+		),
+		MetricRelabelConfigs: monitoringutils.StandardMetricRelabelConfig(
+			"probe_duration_seconds",
+			"probe_http_status_code",
+			"probe_success",
+		),
+	}
+}
+
+func probeRecordingRules() []monitoringv1.Rule {
+	jobNames := make([]string, 0, len(ProbeSegments))
+	for _, segment := range ProbeSegments {
+		jobNames = append(jobNames, probeJobName(segment))
+	}
+	jobSelector := `job=~"` + strings.Join(jobNames, "|") + `"`
+
+	return []monitoringv1.Rule{
+		{
+			Record: RecordProbeLoss,
+			Expr:   intstr.FromString(`1 - avg by (segment, tunnel) (avg_over_time(probe_success{` + jobSelector + `}[5m]))`),
+		},
+		{
+			Record: RecordProbeLatency,
+			Expr:   intstr.FromString(`avg by (segment, tunnel) (avg_over_time(probe_duration_seconds{` + jobSelector + `}[5m]))`),
+		},
+	}
+}
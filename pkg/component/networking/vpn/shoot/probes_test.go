@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/component/networking/vpn/shoot"
+)
+
+var _ = Describe("Probes", func() {
+	DescribeTable("#TunnelNames",
+		func(highAvailabilityEnabled bool, numberOfShootClients int, expected []string) {
+			Expect(TunnelNames(highAvailabilityEnabled, numberOfShootClients)).To(Equal(expected))
+		},
+
+		Entry("non-HA VPN", false, 0, nil),
+		Entry("HA VPN", true, 2, []string{"vpn-shoot-0", "vpn-shoot-1"}),
+	)
+})
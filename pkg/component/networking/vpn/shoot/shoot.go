@@ -213,13 +213,34 @@ func (v *vpnShoot) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	probeScrapeConfigSpecs := v.probeScrapeConfigSpecs()
+	for _, segment := range ProbeSegments {
+		probeScrapeConfig := v.emptyProbeScrapeConfig(segment)
+
+		spec, ok := probeScrapeConfigSpecs[segment]
+		if !ok {
+			if err := kubernetesutils.DeleteObject(ctx, v.client, probeScrapeConfig); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, v.client, probeScrapeConfig, func() error {
+			metav1.SetMetaDataLabel(&probeScrapeConfig.ObjectMeta, "prometheus", shoot.Label)
+			probeScrapeConfig.Spec = spec
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
 	prometheusRule := v.emptyPrometheusRule()
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, v.client, prometheusRule, func() error {
 		metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", shoot.Label)
 		prometheusRule.Spec = monitoringv1.PrometheusRuleSpec{
 			Groups: []monitoringv1.RuleGroup{{
 				Name: "vpn.rules",
-				Rules: []monitoringv1.Rule{
+				Rules: append([]monitoringv1.Rule{
 					{
 						Alert: "VPNShootNoPods",
 						Expr:  intstr.FromString(`kube_deployment_status_replicas_available{deployment="` + deploymentName + `"} == 0`),
@@ -265,7 +286,7 @@ func (v *vpnShoot) Deploy(ctx context.Context) error {
 							"summary":     "API Server Proxy not usable",
 						},
 					},
-				},
+				}, probeRecordingRules()...),
 			}},
 		}
 		return nil
@@ -329,6 +350,10 @@ func (v *vpnShoot) Deploy(ctx context.Context) error {
 func (v *vpnShoot) Destroy(ctx context.Context) error {
 	if err := kubernetesutils.DeleteObjects(ctx, v.client,
 		v.emptyScrapeConfig(),
+		v.emptyProbeScrapeConfig(ProbeSegmentNode),
+		v.emptyProbeScrapeConfig(ProbeSegmentPod),
+		v.emptyProbeScrapeConfig(ProbeSegmentService),
+		v.emptyProbeScrapeConfig(ProbeSegmentTunnel),
 		v.emptyPrometheusRule(),
 	); err != nil {
 		return err
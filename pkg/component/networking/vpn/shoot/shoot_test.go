@@ -204,10 +204,140 @@ var _ = Describe("VPNShoot", func() {
 								"summary":     "API Server Proxy not usable",
 							},
 						},
+						{
+							Record: "shoot:vpn_probe_loss:ratio",
+							Expr:   intstr.FromString(`1 - avg by (segment, tunnel) (avg_over_time(probe_success{job=~"tunnel-probe-node|tunnel-probe-pod|tunnel-probe-service|tunnel-probe-tunnel"}[5m]))`),
+						},
+						{
+							Record: "shoot:vpn_probe_latency_seconds:avg",
+							Expr:   intstr.FromString(`avg by (segment, tunnel) (avg_over_time(probe_duration_seconds{job=~"tunnel-probe-node|tunnel-probe-pod|tunnel-probe-service|tunnel-probe-tunnel"}[5m]))`),
+						},
 					},
 				}},
 			},
 		}
+
+		probeScrapeConfig = func(segment string, role monitoringv1alpha1.KubernetesRole, targetRelabelConfigs ...monitoringv1.RelabelConfig) *monitoringv1alpha1.ScrapeConfig {
+			return &monitoringv1alpha1.ScrapeConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "shoot-tunnel-probe-" + segment,
+					Namespace:       namespace,
+					Labels:          map[string]string{"prometheus": "shoot"},
+					ResourceVersion: "1",
+				},
+				Spec: monitoringv1alpha1.ScrapeConfigSpec{
+					HonorLabels: ptr.To(false),
+					MetricsPath: ptr.To("/probe"),
+					Params:      map[string][]string{"module": {"http_apiserver"}},
+					KubernetesSDConfigs: []monitoringv1alpha1.KubernetesSDConfig{{
+						Role:       role,
+						APIServer:  ptr.To("https://kube-apiserver"),
+						Namespaces: &monitoringv1alpha1.NamespaceDiscovery{Names: []string{"kube-system"}},
+						Authorization: &monitoringv1.SafeAuthorization{Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "shoot-access-prometheus-shoot"},
+							Key:                  "token",
+						}},
+						TLSConfig: &monitoringv1.SafeTLSConfig{InsecureSkipVerify: ptr.To(true)},
+					}},
+					RelabelConfigs: append(append([]monitoringv1.RelabelConfig{{
+						TargetLabel: "type",
+						Replacement: ptr.To("seed"),
+					}}, targetRelabelConfigs...),
+						monitoringv1.RelabelConfig{
+							SourceLabels: []monitoringv1.LabelName{"__param_target"},
+							TargetLabel:  "instance",
+							Action:       "replace",
+						},
+						monitoringv1.RelabelConfig{
+							TargetLabel: "__address__",
+							Replacement: ptr.To("blackbox-exporter:9115"),
+							Action:      "replace",
+						},
+						monitoringv1.RelabelConfig{
+							TargetLabel: "segment",
+							Replacement: ptr.To(segment),
+						},
+						monitoringv1.RelabelConfig{
+							Action:      "replace",
+							Replacement: ptr.To("tunnel-probe-" + segment),
+							TargetLabel: "job",
+						},
+					),
+					MetricRelabelConfigs: []monitoringv1.RelabelConfig{{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Action:       "keep",
+						Regex:        `^(probe_duration_seconds|probe_http_status_code|probe_success)$`,
+					}},
+				},
+			}
+		}
+
+		probeScrapeConfigs = func(highAvailabilityEnabled bool) []*monitoringv1alpha1.ScrapeConfig {
+			scrapeConfigs := []*monitoringv1alpha1.ScrapeConfig{
+				probeScrapeConfig("node", "Pod",
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name", "__meta_kubernetes_pod_container_name", "__meta_kubernetes_pod_node_name"},
+						Action:       "keep",
+						Regex:        `vpn-shoot-(\d+|.+-.+);vpn-shoot-init;.+`,
+					},
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_node_name"},
+						TargetLabel:  "__param_target",
+						Replacement:  ptr.To("https://kube-apiserver:443/api/v1/nodes/${1}/proxy/healthz"),
+						Action:       "replace",
+					},
+				),
+				probeScrapeConfig("pod", "Pod",
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_app", "__meta_kubernetes_pod_container_port_number", "__meta_kubernetes_pod_ready"},
+						Action:       "keep",
+						Regex:        "blackbox-exporter;9115;true",
+					},
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name"},
+						TargetLabel:  "__param_target",
+						Replacement:  ptr.To("https://kube-apiserver:443/api/v1/namespaces/kube-system/pods/${1}:9115/proxy/-/healthy"),
+						Action:       "replace",
+					},
+				),
+				probeScrapeConfig("service", "Endpoints",
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_service_name", "__meta_kubernetes_endpoint_port_number", "__meta_kubernetes_endpoint_ready"},
+						Action:       "keep",
+						Regex:        "blackbox-exporter;9115;true",
+					},
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_service_name"},
+						TargetLabel:  "__param_target",
+						Replacement:  ptr.To("https://kube-apiserver:443/api/v1/namespaces/kube-system/services/${1}:probe/proxy/-/healthy"),
+						Action:       "replace",
+					},
+				),
+			}
+
+			if highAvailabilityEnabled {
+				scrapeConfigs = append(scrapeConfigs, probeScrapeConfig("tunnel", "Pod",
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_label_app", "__meta_kubernetes_pod_container_name"},
+						Action:       "keep",
+						Regex:        "vpn-shoot;tunnel-controller",
+					},
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name"},
+						TargetLabel:  "tunnel",
+						Action:       "replace",
+					},
+					monitoringv1.RelabelConfig{
+						SourceLabels: []monitoringv1.LabelName{"__meta_kubernetes_pod_name"},
+						TargetLabel:  "__param_target",
+						Replacement:  ptr.To("https://kube-apiserver:443/api/v1/namespaces/kube-system/pods/${1}:8080/proxy/readyz"),
+						Action:       "replace",
+					},
+				))
+			}
+
+			return scrapeConfigs
+		}
 	)
 
 	BeforeEach(func() {
@@ -875,6 +1005,15 @@ var _ = Describe("VPNShoot", func() {
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(scrapeConfig), actualScrapeConfig)).To(Succeed())
 			Expect(actualScrapeConfig).To(DeepEqual(scrapeConfig))
 
+			for _, expectedProbeScrapeConfig := range probeScrapeConfigs(values.HighAvailabilityEnabled) {
+				actualProbeScrapeConfig := &monitoringv1alpha1.ScrapeConfig{}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(expectedProbeScrapeConfig), actualProbeScrapeConfig)).To(Succeed())
+				Expect(actualProbeScrapeConfig).To(DeepEqual(expectedProbeScrapeConfig))
+			}
+			if !values.HighAvailabilityEnabled {
+				Expect(c.Get(ctx, client.ObjectKey{Name: "shoot-tunnel-probe-tunnel", Namespace: namespace}, &monitoringv1alpha1.ScrapeConfig{})).To(BeNotFoundError())
+			}
+
 			actualPrometheusRule := &monitoringv1.PrometheusRule{}
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(prometheusRule), actualPrometheusRule)).To(Succeed())
 			Expect(actualPrometheusRule).To(DeepEqual(prometheusRule))
@@ -1054,10 +1193,18 @@ var _ = Describe("VPNShoot", func() {
 			Expect(c.Create(ctx, managedResourceSecret)).To(Succeed())
 			Expect(c.Create(ctx, scrapeConfig)).To(Succeed())
 			Expect(c.Create(ctx, prometheusRule)).To(Succeed())
+			probeScrapeConfigs := probeScrapeConfigs(true)
+			for _, probeScrapeConfig := range probeScrapeConfigs {
+				probeScrapeConfig.ResourceVersion = ""
+				Expect(c.Create(ctx, probeScrapeConfig)).To(Succeed())
+			}
 
 			Expect(vpnShoot.Destroy(ctx)).To(Succeed())
 
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(scrapeConfig), scrapeConfig)).To(BeNotFoundError())
+			for _, probeScrapeConfig := range probeScrapeConfigs {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(probeScrapeConfig), probeScrapeConfig)).To(BeNotFoundError())
+			}
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(prometheusRule), prometheusRule)).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(BeNotFoundError())
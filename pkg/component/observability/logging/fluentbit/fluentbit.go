@@ -46,6 +46,8 @@ var (
 	modify_severity_lua string
 	//go:embed lua/add_tag_to_record.lua
 	add_tag_to_record_lua string
+	//go:embed lua/encode_log_message.lua
+	encode_log_message_lua string
 	//go:embed lua/add_time_and_systemd_attr.lua
 	add_time_and_systemd_attr_lua string
 )
@@ -94,6 +96,7 @@ func (f *fluentBit) Deploy(ctx context.Context) error {
 				"modify_severity.lua":           modify_severity_lua,
 				"add_tag_to_record.lua":         add_tag_to_record_lua,
 				"add_time_and_systemd_attr.lua": add_time_and_systemd_attr_lua,
+				"encode_log_message.lua":        encode_log_message_lua,
 			},
 		}
 		serviceMonitor = &monitoringv1.ServiceMonitor{
@@ -339,6 +342,9 @@ func getLabels() map[string]string {
 		v1beta1constants.GardenRole:                           v1beta1constants.GardenRoleLogging,
 		v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
 		v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+		// Logs of shoot control planes can be forwarded to endpoints outside of the seed, see `.spec.logging.outputs` of
+		// Shoots.
+		v1beta1constants.LabelNetworkPolicyToPublicNetworks: v1beta1constants.LabelNetworkPolicyAllowed,
 	}
 	return utils.MergeStringMaps(labels, getTargetNetworkPolicyLabels())
 }
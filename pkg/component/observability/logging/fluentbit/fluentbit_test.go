@@ -68,6 +68,7 @@ var _ = Describe("Fluent Bit", func() {
 					"gardener.cloud/role":              "logging",
 					"networking.gardener.cloud/to-dns": "allowed",
 					"networking.gardener.cloud/to-runtime-apiserver":                                               "allowed",
+					"networking.gardener.cloud/to-public-networks":                                                 "allowed",
 					"networking.resources.gardener.cloud/to-all-shoots-opentelemetry-collector-collector-tcp-4317": "allowed",
 					"networking.resources.gardener.cloud/to-opentelemetry-collector-collector-tcp-4317":            "allowed",
 				}},
@@ -104,6 +105,7 @@ var _ = Describe("Fluent Bit", func() {
 					"gardener.cloud/role":              "logging",
 					"networking.gardener.cloud/to-dns": "allowed",
 					"networking.gardener.cloud/to-runtime-apiserver":                                               "allowed",
+					"networking.gardener.cloud/to-public-networks":                                                 "allowed",
 					"networking.resources.gardener.cloud/to-all-shoots-opentelemetry-collector-collector-tcp-4317": "allowed",
 					"networking.resources.gardener.cloud/to-opentelemetry-collector-collector-tcp-4317":            "allowed",
 				}},
@@ -282,7 +284,7 @@ var _ = Describe("Fluent Bit", func() {
 			Expect(customResourcesManagedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 
 			test.ExpectKindWithNameAndNamespace(manifests, "ConfigMap", "fluent-bit-lua-config", namespace)
-			test.ExpectKindWithNameAndNamespace(manifests, "FluentBit", "fluent-bit-c6dcd", namespace)
+			test.ExpectKindWithNameAndNamespace(manifests, "FluentBit", "fluent-bit-f4919", namespace)
 			test.ExpectKindWithNameAndNamespace(manifests, "ClusterFluentBitConfig", "fluent-bit-config", "")
 			test.ExpectKindWithNameAndNamespace(manifests, "ClusterInput", "tail-kubernetes", "")
 			test.ExpectKindWithNameAndNamespace(manifests, "ClusterFilter", "01-systemd", "")
@@ -0,0 +1,52 @@
+-- SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- encode_log_message adds the `log` key of the record as string to the `message` key. Structured log lines, which
+-- are decoded by the containerd parser, are encoded as JSON again for outputs which only support string messages.
+local escapes = { ['"'] = '\\"', ['\\'] = '\\\\', ['\b'] = '\\b', ['\f'] = '\\f', ['\n'] = '\\n', ['\r'] = '\\r', ['\t'] = '\\t' }
+
+local function escape(value)
+  local escaped = value:gsub('[%c"\\]', function(c)
+    return escapes[c] or string.format('\\u%04x', c:byte())
+  end)
+  return '"' .. escaped .. '"'
+end
+
+local function encode(value)
+  local t = type(value)
+  if t == "string" then
+    return escape(value)
+  elseif t == "number" or t == "boolean" then
+    return tostring(value)
+  elseif t ~= "table" then
+    return "null"
+  end
+
+  local parts = {}
+  if #value > 0 then
+    for _, v in ipairs(value) do
+      table.insert(parts, encode(v))
+    end
+    return "[" .. table.concat(parts, ",") .. "]"
+  end
+
+  for k, v in pairs(value) do
+    table.insert(parts, escape(tostring(k)) .. ":" .. encode(v))
+  end
+  return "{" .. table.concat(parts, ",") .. "}"
+end
+
+function encode_log_message(tag, timestamp, record)
+  local log = record["log"]
+  if log == nil then
+    return 0, timestamp, record
+  end
+
+  if type(log) == "table" then
+    record["message"] = encode(log)
+  else
+    record["message"] = tostring(log)
+  end
+  return 2, timestamp, record
+end
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -28,6 +29,8 @@ type Values struct {
 	Filters []*fluentbitv1alpha2.ClusterFilter
 	Parsers []*fluentbitv1alpha2.ClusterParser
 	Outputs []*fluentbitv1alpha2.ClusterOutput
+	// Secrets are referenced by the outputs. They must be located in the namespace of fluent-bit.
+	Secrets []*corev1.Secret
 }
 
 type customResources struct {
@@ -71,6 +74,10 @@ func (c *customResources) Deploy(ctx context.Context) error {
 		resources = append(resources, clusterOutput)
 	}
 
+	for _, secret := range c.values.Secrets {
+		resources = append(resources, secret)
+	}
+
 	serializedResources, err := registry.AddAllAndSerialize(resources...)
 	if err != nil {
 		return err
@@ -121,6 +121,15 @@ var _ = Describe("Custom Resources", func() {
 					},
 				},
 			},
+			Secrets: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "output-credentials",
+						Namespace: "garden",
+					},
+					Data: map[string][]byte{"username": []byte("user")},
+				},
+			},
 		}
 
 		c         client.Client
@@ -190,6 +199,7 @@ var _ = Describe("Custom Resources", func() {
 			test.ExpectKindWithNameAndNamespace(manifests, "ClusterFilter", "gardener-extension", "")
 			test.ExpectKindWithNameAndNamespace(manifests, "ClusterParser", "extensions-parser", "")
 			test.ExpectKindWithNameAndNamespace(manifests, "ClusterOutput", "journald2", "")
+			test.ExpectKindWithNameAndNamespace(manifests, "Secret", "output-credentials", "garden")
 		})
 	})
 
@@ -0,0 +1,362 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentcustomresources
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins"
+	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins/filter"
+	fluentbitv1alpha2output "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins/output"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+const (
+	// LogForwardingSuffix is the suffix of the managed resource containing the custom resources for forwarding the logs
+	// of a shoot control plane.
+	LogForwardingSuffix = "-log-forwarding"
+
+	// DataKeyUsername is the key in the credentials of a log forwarding output containing the basic auth username.
+	DataKeyUsername = "username"
+	// DataKeyPassword is the key in the credentials of a log forwarding output containing the basic auth password.
+	DataKeyPassword = "password"
+	// DataKeyBearerToken is the key in the credentials of a log forwarding output containing the bearer token.
+	DataKeyBearerToken = "bearerToken"
+	// DataKeyTenantID is the key in the credentials of a log forwarding output containing the tenant ID.
+	DataKeyTenantID = "tenantID"
+
+	// logForwardingShootTotalLimitSizeMB is the maximum size in MB of the records of a shoot control plane which are
+	// buffered on the disk of each seed node because they could not be delivered yet. The fluent-bit is shared by all
+	// shoot control planes on the node, hence the limit is split among the outputs of the shoot so that unreachable
+	// endpoints of one shoot cannot fill the disk.
+	logForwardingShootTotalLimitSizeMB = 20
+	logForwardingMessageKey            = "message"
+)
+
+// LogForwardingOutput contains information about an endpoint to which logs of a shoot control plane are forwarded.
+type LogForwardingOutput struct {
+	// Name is the name of the output.
+	Name string
+	// Type is the type of the output.
+	Type gardencorev1beta1.LoggingOutputType
+	// URL is the URL of the endpoint.
+	URL string
+	// Sources is a list of log sources which are forwarded to the output.
+	Sources []gardencorev1beta1.LoggingSource
+	// Credentials contains the credentials of the endpoint.
+	Credentials map[string][]byte
+}
+
+// GetLogForwardingResources returns the ClusterFilters, ClusterOutputs and Secrets for forwarding the logs of the shoot
+// control plane in the given namespace to the given outputs. The Secrets are located in the given fluent-bit namespace
+// because the fluent-operator resolves the secret references of outputs in its own namespace. If keepAuditLogs is
+// false, audit events are only sent to the outputs and not to the logging stack of the seed.
+func GetLogForwardingResources(
+	controlPlaneNamespace string,
+	fluentBitNamespace string,
+	outputs []LogForwardingOutput,
+	keepAuditLogs bool,
+	labels map[string]string,
+) (
+	[]*fluentbitv1alpha2.ClusterFilter,
+	[]*fluentbitv1alpha2.ClusterOutput,
+	[]*corev1.Secret,
+	error,
+) {
+	var (
+		filters        []*fluentbitv1alpha2.ClusterFilter
+		clusterOutputs []*fluentbitv1alpha2.ClusterOutput
+		secrets        []*corev1.Secret
+		syslogSources  []gardencorev1beta1.LoggingSource
+		totalLimitSize = logForwardingTotalLimitSize(len(outputs))
+	)
+
+	for _, output := range outputs {
+		if output.Type == gardencorev1beta1.LoggingOutputTypeSyslog {
+			syslogSources = append(syslogSources, output.Sources...)
+		}
+
+		var secret *corev1.Secret
+		if len(output.Credentials) > 0 && output.Type != gardencorev1beta1.LoggingOutputTypeSyslog {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      logForwardingName(controlPlaneNamespace, output.Name),
+					Namespace: fluentBitNamespace,
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: output.Credentials,
+			}
+			secrets = append(secrets, secret)
+		}
+
+		spec, err := logForwardingOutputSpec(output, secret, totalLimitSize)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed computing log forwarding output %q: %w", output.Name, err)
+		}
+		spec.MatchRegex = logForwardingMatchRegex(controlPlaneNamespace, output.Sources)
+		if output.Type == gardencorev1beta1.LoggingOutputTypeSyslog {
+			spec.MatchRegex = syslogMatchRegex(controlPlaneNamespace, output.Sources)
+		}
+
+		clusterOutputs = append(clusterOutputs, &fluentbitv1alpha2.ClusterOutput{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   logForwardingName(controlPlaneNamespace, output.Name),
+				Labels: labels,
+			},
+			Spec: spec,
+		})
+	}
+
+	if slices.ContainsFunc(outputs, func(output LogForwardingOutput) bool {
+		return slices.Contains(output.Sources, gardencorev1beta1.LoggingSourceAudit)
+	}) {
+		filters = append(filters, &fluentbitv1alpha2.ClusterFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				// This filter must run before the parser filter of the kube-apiserver because the operator orders them by
+				// name.
+				Name:   "00-" + logForwardingName(controlPlaneNamespace, "audit"),
+				Labels: labels,
+			},
+			Spec: fluentbitv1alpha2.FilterSpec{
+				Match: fmt.Sprintf("kubernetes.*%[1]s-*_%[2]s_%[1]s-*", v1beta1constants.DeploymentNameKubeAPIServer, controlPlaneNamespace),
+				FilterItems: []fluentbitv1alpha2.FilterItem{{
+					// The audit events are written by the log backend of the kube-apiserver and are decoded by the
+					// containerd parser. They are re-emitted with a dedicated tag so that they are sent to the outputs
+					// requesting audit logs. The original records are only kept for the logging stack of the seed if
+					// requested.
+					RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+						Rules:              []string{`$log['apiVersion'] ^audit\.k8s\.io/ ` + auditTag(controlPlaneNamespace) + ` ` + strconv.FormatBool(keepAuditLogs)},
+						EmitterName:        logForwardingName(controlPlaneNamespace, "audit"),
+						EmitterStorageType: "filesystem",
+					},
+				}},
+			},
+		})
+	}
+
+	if len(syslogSources) > 0 {
+		// Syslog outputs only support string messages, hence the log lines are encoded by a Lua filter. The filter must
+		// not modify the records which are sent to the logging stack of the seed or to other outputs, so it only
+		// processes copies of the records which are re-emitted with dedicated tags.
+		for _, source := range []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane, gardencorev1beta1.LoggingSourceAudit} {
+			if !slices.Contains(syslogSources, source) {
+				continue
+			}
+
+			// Records of containers carry their tag in the `tag` key (see `02-add-tag-to-record` filter), audit events
+			// are identified by their API version.
+			rule := `$tag ^kubernetes\. ` + syslogTag(controlPlaneNamespace, source) + ` true`
+			if source == gardencorev1beta1.LoggingSourceAudit {
+				rule = `$log['apiVersion'] ^audit\.k8s\.io/ ` + syslogTag(controlPlaneNamespace, source) + ` true`
+			}
+
+			filters = append(filters, &fluentbitv1alpha2.ClusterFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   logForwardingName(controlPlaneNamespace, "syslog-"+string(source)),
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.FilterSpec{
+					MatchRegex: logForwardingMatchRegex(controlPlaneNamespace, []gardencorev1beta1.LoggingSource{source}),
+					FilterItems: []fluentbitv1alpha2.FilterItem{{
+						RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+							Rules:              []string{rule},
+							EmitterName:        logForwardingName(controlPlaneNamespace, "syslog-"+string(source)),
+							EmitterStorageType: "filesystem",
+						},
+					}},
+				},
+			})
+		}
+
+		filters = append(filters, &fluentbitv1alpha2.ClusterFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   logForwardingName(controlPlaneNamespace, "syslog"),
+				Labels: labels,
+			},
+			Spec: fluentbitv1alpha2.FilterSpec{
+				MatchRegex: syslogMatchRegex(controlPlaneNamespace, syslogSources),
+				FilterItems: []fluentbitv1alpha2.FilterItem{{
+					Lua: &fluentbitv1alpha2filter.Lua{
+						Script: corev1.ConfigMapKeySelector{
+							Key:                  "encode_log_message.lua",
+							LocalObjectReference: corev1.LocalObjectReference{Name: v1beta1constants.ConfigMapNameFluentBitLua},
+						},
+						Call: "encode_log_message",
+					},
+				}},
+			},
+		})
+	}
+
+	return filters, clusterOutputs, secrets, nil
+}
+
+// logForwardingTotalLimitSize returns the maximum size of the buffered records of each of the given number of outputs of
+// a shoot control plane.
+func logForwardingTotalLimitSize(outputs int) string {
+	return strconv.Itoa(max(1, logForwardingShootTotalLimitSizeMB/max(1, outputs))) + "M"
+}
+
+func logForwardingOutputSpec(output LogForwardingOutput, secret *corev1.Secret, totalLimitSize string) (fluentbitv1alpha2.OutputSpec, error) {
+	u, err := url.Parse(output.URL)
+	if err != nil {
+		return fluentbitv1alpha2.OutputSpec{}, err
+	}
+
+	port, err := logForwardingPort(u)
+	if err != nil {
+		return fluentbitv1alpha2.OutputSpec{}, err
+	}
+
+	var tls *plugins.TLS
+	if u.Scheme == "https" || u.Scheme == "tls" {
+		tls = &plugins.TLS{Verify: ptr.To(true)}
+	}
+
+	secretRef := func(key string) *plugins.Secret {
+		if secret == nil || len(secret.Data[key]) == 0 {
+			return nil
+		}
+		return &plugins.Secret{ValueFrom: plugins.ValueSource{SecretKeyRef: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+			Key:                  key,
+		}}}
+	}
+
+	switch output.Type {
+	case gardencorev1beta1.LoggingOutputTypeOTLP:
+		return fluentbitv1alpha2.OutputSpec{OpenTelemetry: &fluentbitv1alpha2output.OpenTelemetry{
+			Host:           u.Hostname(),
+			Port:           port,
+			LogsUri:        pathOrDefault(u, "/v1/logs"),
+			HTTPUser:       secretRef(DataKeyUsername),
+			HTTPPasswd:     secretRef(DataKeyPassword),
+			TLS:            tls,
+			TotalLimitSize: totalLimitSize,
+		}}, nil
+
+	case gardencorev1beta1.LoggingOutputTypeLoki:
+		return fluentbitv1alpha2.OutputSpec{Loki: &fluentbitv1alpha2output.Loki{
+			Host:           u.Hostname(),
+			Port:           port,
+			Uri:            pathOrDefault(u, "/loki/api/v1/push"),
+			HTTPUser:       secretRef(DataKeyUsername),
+			HTTPPasswd:     secretRef(DataKeyPassword),
+			BearerToken:    secretRef(DataKeyBearerToken),
+			TenantID:       secretRef(DataKeyTenantID),
+			LineFormat:     "json",
+			TLS:            tls,
+			TotalLimitSize: totalLimitSize,
+		}}, nil
+
+	case gardencorev1beta1.LoggingOutputTypeHTTP:
+		return fluentbitv1alpha2.OutputSpec{HTTP: &fluentbitv1alpha2output.HTTP{
+			Host:           u.Hostname(),
+			Port:           port,
+			Uri:            pathOrDefault(u, "/"),
+			Format:         "json",
+			JsonDateKey:    "timestamp",
+			JsonDateFormat: "iso8601",
+			HTTPUser:       secretRef(DataKeyUsername),
+			HTTPPasswd:     secretRef(DataKeyPassword),
+			TLS:            tls,
+			TotalLimitSize: totalLimitSize,
+		}}, nil
+
+	case gardencorev1beta1.LoggingOutputTypeSyslog:
+		return fluentbitv1alpha2.OutputSpec{Syslog: &fluentbitv1alpha2output.Syslog{
+			Host:             u.Hostname(),
+			Port:             port,
+			Mode:             u.Scheme,
+			SyslogFormat:     "rfc5424",
+			SyslogMessageKey: logForwardingMessageKey,
+			TLS:              tls,
+			TotalLimitSize:   totalLimitSize,
+		}}, nil
+	}
+
+	return fluentbitv1alpha2.OutputSpec{}, fmt.Errorf("unsupported type %q", output.Type)
+}
+
+func logForwardingPort(u *url.URL) (*int32, error) {
+	if p := u.Port(); p != "" {
+		port, err := strconv.ParseInt(p, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q: %w", p, err)
+		}
+		return ptr.To(int32(port)), nil
+	}
+
+	switch u.Scheme {
+	case "https":
+		return ptr.To[int32](443), nil
+	case "http":
+		return ptr.To[int32](80), nil
+	case "tls":
+		return ptr.To[int32](6514), nil
+	default:
+		return ptr.To[int32](514), nil
+	}
+}
+
+func pathOrDefault(u *url.URL, defaultPath string) string {
+	if u.Path == "" || u.Path == "/" {
+		return defaultPath
+	}
+	return u.Path
+}
+
+// logForwardingMatchRegex returns a regular expression matching the tags of the records of the given sources.
+// Records of containers are tagged with `kubernetes.var.log.containers.<pod>_<namespace>_<container>-<id>.log` by the
+// tail input, records of audit events are tagged by the rewrite tag filter.
+func logForwardingMatchRegex(controlPlaneNamespace string, sources []gardencorev1beta1.LoggingSource) string {
+	var patterns []string
+
+	if slices.Contains(sources, gardencorev1beta1.LoggingSourceControlPlane) {
+		patterns = append(patterns, `kubernetes\.[^_]+_`+regexp.QuoteMeta(controlPlaneNamespace)+`_.+`)
+	}
+	if slices.Contains(sources, gardencorev1beta1.LoggingSourceAudit) {
+		patterns = append(patterns, regexp.QuoteMeta(auditTag(controlPlaneNamespace)))
+	}
+
+	return "^(" + strings.Join(patterns, "|") + ")$"
+}
+
+// syslogMatchRegex returns a regular expression matching the tags of the copies of the records of the given sources
+// which are re-emitted for syslog outputs.
+func syslogMatchRegex(controlPlaneNamespace string, sources []gardencorev1beta1.LoggingSource) string {
+	var patterns []string
+
+	for _, source := range sources {
+		if pattern := regexp.QuoteMeta(syslogTag(controlPlaneNamespace, source)); !slices.Contains(patterns, pattern) {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return "^(" + strings.Join(patterns, "|") + ")$"
+}
+
+func syslogTag(controlPlaneNamespace string, source gardencorev1beta1.LoggingSource) string {
+	return "syslog." + string(source) + "." + controlPlaneNamespace
+}
+
+func auditTag(controlPlaneNamespace string) string {
+	return "audit." + controlPlaneNamespace
+}
+
+func logForwardingName(controlPlaneNamespace, name string) string {
+	return controlPlaneNamespace + "--" + name
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fluentcustomresources_test
+
+import (
+	fluentbitv1alpha2 "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2"
+	"github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins"
+	fluentbitv1alpha2filter "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins/filter"
+	fluentbitv1alpha2output "github.com/fluent/fluent-operator/v3/apis/fluentbit/v1alpha2/plugins/output"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/component/observability/logging/fluentcustomresources"
+)
+
+var _ = Describe("LogForwarding", func() {
+	Describe("#GetLogForwardingResources", func() {
+		const (
+			controlPlaneNamespace = "shoot--foo--bar"
+			fluentBitNamespace    = "garden"
+		)
+
+		var (
+			labels = map[string]string{"some-key": "some-value"}
+
+			secretRef = func(key string) *plugins.Secret {
+				return &plugins.Secret{ValueFrom: plugins.ValueSource{SecretKeyRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "shoot--foo--bar--siem"},
+					Key:                  key,
+				}}}
+			}
+		)
+
+		It("should return no resources if there are no outputs", func() {
+			filters, outputs, secrets, err := GetLogForwardingResources(controlPlaneNamespace, fluentBitNamespace, nil, false, labels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+			Expect(outputs).To(BeEmpty())
+			Expect(secrets).To(BeEmpty())
+		})
+
+		It("should return the resources for forwarding control plane logs", func() {
+			filters, outputs, secrets, err := GetLogForwardingResources(controlPlaneNamespace, fluentBitNamespace, []LogForwardingOutput{{
+				Name:    "loki",
+				Type:    gardencorev1beta1.LoggingOutputTypeLoki,
+				URL:     "http://loki.example.com",
+				Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane},
+			}}, false, labels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+			Expect(secrets).To(BeEmpty())
+			Expect(outputs).To(ConsistOf(&fluentbitv1alpha2.ClusterOutput{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "shoot--foo--bar--loki",
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.OutputSpec{
+					MatchRegex: `^(kubernetes\.[^_]+_shoot--foo--bar_.+)$`,
+					Loki: &fluentbitv1alpha2output.Loki{
+						Host:           "loki.example.com",
+						Port:           ptr.To[int32](80),
+						Uri:            "/loki/api/v1/push",
+						LineFormat:     "json",
+						TotalLimitSize: "20M",
+					},
+				},
+			}))
+		})
+
+		It("should return the resources for forwarding audit logs with credentials", func() {
+			filters, outputs, secrets, err := GetLogForwardingResources(controlPlaneNamespace, fluentBitNamespace, []LogForwardingOutput{{
+				Name:        "siem",
+				Type:        gardencorev1beta1.LoggingOutputTypeHTTP,
+				URL:         "https://siem.example.com:8443/ingest",
+				Sources:     []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceAudit, gardencorev1beta1.LoggingSourceControlPlane},
+				Credentials: map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+			}}, false, labels)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filters).To(ConsistOf(&fluentbitv1alpha2.ClusterFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "00-shoot--foo--bar--audit",
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.FilterSpec{
+					Match: "kubernetes.*kube-apiserver-*_shoot--foo--bar_kube-apiserver-*",
+					FilterItems: []fluentbitv1alpha2.FilterItem{{
+						RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+							Rules:              []string{`$log['apiVersion'] ^audit\.k8s\.io/ audit.shoot--foo--bar false`},
+							EmitterName:        "shoot--foo--bar--audit",
+							EmitterStorageType: "filesystem",
+						},
+					}},
+				},
+			}))
+
+			Expect(secrets).To(ConsistOf(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot--foo--bar--siem",
+					Namespace: fluentBitNamespace,
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+			}))
+
+			Expect(outputs).To(ConsistOf(&fluentbitv1alpha2.ClusterOutput{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "shoot--foo--bar--siem",
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.OutputSpec{
+					MatchRegex: `^(kubernetes\.[^_]+_shoot--foo--bar_.+|audit\.shoot--foo--bar)$`,
+					HTTP: &fluentbitv1alpha2output.HTTP{
+						Host:           "siem.example.com",
+						Port:           ptr.To[int32](8443),
+						Uri:            "/ingest",
+						Format:         "json",
+						JsonDateKey:    "timestamp",
+						JsonDateFormat: "iso8601",
+						HTTPUser:       secretRef("username"),
+						HTTPPasswd:     secretRef("password"),
+						TLS:            &plugins.TLS{Verify: ptr.To(true)},
+						TotalLimitSize: "20M",
+					},
+				},
+			}))
+		})
+
+		It("should keep the audit logs for the logging stack if requested", func() {
+			filters, _, _, err := GetLogForwardingResources(controlPlaneNamespace, fluentBitNamespace, []LogForwardingOutput{{
+				Name:    "loki",
+				Type:    gardencorev1beta1.LoggingOutputTypeLoki,
+				URL:     "http://loki.example.com",
+				Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceAudit},
+			}}, true, labels)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filters).To(HaveLen(1))
+			Expect(filters[0].Spec.FilterItems[0].RewriteTag.Rules).To(ConsistOf(`$log['apiVersion'] ^audit\.k8s\.io/ audit.shoot--foo--bar true`))
+		})
+
+		It("should return the resources for forwarding to OTLP and syslog endpoints", func() {
+			filters, outputs, secrets, err := GetLogForwardingResources(controlPlaneNamespace, fluentBitNamespace, []LogForwardingOutput{
+				{
+					Name:        "otlp",
+					Type:        gardencorev1beta1.LoggingOutputTypeOTLP,
+					URL:         "https://otlp.example.com",
+					Sources:     []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane},
+					Credentials: map[string][]byte{"username": []byte("user")},
+				},
+				{
+					Name:    "syslog",
+					Type:    gardencorev1beta1.LoggingOutputTypeSyslog,
+					URL:     "tls://syslog.example.com",
+					Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceAudit},
+				},
+			}, false, labels)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filters).To(HaveLen(3))
+			Expect(filters[1]).To(Equal(&fluentbitv1alpha2.ClusterFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "shoot--foo--bar--syslog-audit",
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.FilterSpec{
+					MatchRegex: `^(audit\.shoot--foo--bar)$`,
+					FilterItems: []fluentbitv1alpha2.FilterItem{{
+						RewriteTag: &fluentbitv1alpha2filter.RewriteTag{
+							Rules:              []string{`$log['apiVersion'] ^audit\.k8s\.io/ syslog.audit.shoot--foo--bar true`},
+							EmitterName:        "shoot--foo--bar--syslog-audit",
+							EmitterStorageType: "filesystem",
+						},
+					}},
+				},
+			}))
+			Expect(filters[2]).To(Equal(&fluentbitv1alpha2.ClusterFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "shoot--foo--bar--syslog",
+					Labels: labels,
+				},
+				Spec: fluentbitv1alpha2.FilterSpec{
+					MatchRegex: `^(syslog\.audit\.shoot--foo--bar)$`,
+					FilterItems: []fluentbitv1alpha2.FilterItem{{
+						Lua: &fluentbitv1alpha2filter.Lua{
+							Script: corev1.ConfigMapKeySelector{
+								Key:                  "encode_log_message.lua",
+								LocalObjectReference: corev1.LocalObjectReference{Name: "fluent-bit-lua-config"},
+							},
+							Call: "encode_log_message",
+						},
+					}},
+				},
+			}))
+
+			Expect(secrets).To(HaveLen(1))
+			Expect(outputs).To(HaveLen(2))
+			Expect(outputs[0].Spec.OpenTelemetry).To(Equal(&fluentbitv1alpha2output.OpenTelemetry{
+				Host:    "otlp.example.com",
+				Port:    ptr.To[int32](443),
+				LogsUri: "/v1/logs",
+				HTTPUser: &plugins.Secret{ValueFrom: plugins.ValueSource{SecretKeyRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "shoot--foo--bar--otlp"},
+					Key:                  "username",
+				}}},
+				TLS:            &plugins.TLS{Verify: ptr.To(true)},
+				TotalLimitSize: "10M",
+			}))
+			Expect(outputs[0].Spec.MatchRegex).To(Equal(`^(kubernetes\.[^_]+_shoot--foo--bar_.+)$`))
+			Expect(outputs[1].Spec.MatchRegex).To(Equal(`^(syslog\.audit\.shoot--foo--bar)$`))
+			Expect(outputs[1].Spec.Syslog).To(Equal(&fluentbitv1alpha2output.Syslog{
+				Host:             "syslog.example.com",
+				Port:             ptr.To[int32](6514),
+				Mode:             "tls",
+				SyslogFormat:     "rfc5424",
+				SyslogMessageKey: "message",
+				TLS:              &plugins.TLS{Verify: ptr.To(true)},
+				TotalLimitSize:   "10M",
+			}))
+		})
+
+		It("should only encode copies of the control plane logs for syslog outputs", func() {
+			filters, outputs, _, err := GetLogForwardingResources(controlPlaneNamespace, fluentBitNamespace, []LogForwardingOutput{{
+				Name:    "syslog",
+				Type:    gardencorev1beta1.LoggingOutputTypeSyslog,
+				URL:     "udp://syslog.example.com",
+				Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane},
+			}}, false, labels)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filters).To(HaveLen(2))
+			Expect(filters[0].Name).To(Equal("shoot--foo--bar--syslog-control-plane"))
+			Expect(filters[0].Spec.MatchRegex).To(Equal(`^(kubernetes\.[^_]+_shoot--foo--bar_.+)$`))
+			Expect(filters[0].Spec.FilterItems[0].RewriteTag.Rules).To(ConsistOf(`$tag ^kubernetes\. syslog.control-plane.shoot--foo--bar true`))
+			Expect(filters[1].Name).To(Equal("shoot--foo--bar--syslog"))
+			Expect(filters[1].Spec.MatchRegex).To(Equal(`^(syslog\.control-plane\.shoot--foo--bar)$`))
+			Expect(outputs).To(HaveLen(1))
+			Expect(outputs[0].Spec.MatchRegex).To(Equal(`^(syslog\.control-plane\.shoot--foo--bar)$`))
+		})
+
+		It("should return an error for an invalid port", func() {
+			_, _, _, err := GetLogForwardingResources(controlPlaneNamespace, fluentBitNamespace, []LogForwardingOutput{{
+				Name:    "otlp",
+				Type:    gardencorev1beta1.LoggingOutputTypeOTLP,
+				URL:     "https://otlp.example.com:99999999999",
+				Sources: []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane},
+			}}, false, labels)
+			Expect(err).To(MatchError(ContainSubstring(`failed computing log forwarding output "otlp"`)))
+		})
+	})
+})
@@ -556,7 +556,11 @@ var _ = Describe("Alertmanager", func() {
 			BeforeEach(func() {
 				values.ClusterType = component.ClusterTypeShoot
 
-				service.Annotations = map[string]string{"networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports": `[{"protocol":"TCP","port":9093}]`}
+				service.Annotations = map[string]string{
+					"networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports": `[{"protocol":"TCP","port":9093}]`,
+					"networking.resources.gardener.cloud/pod-label-selector-namespace-alias":    "all-shoots",
+					"networking.resources.gardener.cloud/namespace-selectors":                   `[{"matchLabels":{"kubernetes.io/metadata.name":"garden"}}]`,
+				}
 				alertManager.Labels["gardener.cloud/role"] = "monitoring"
 				alertManager.Spec.PodMetadata.Labels["gardener.cloud/role"] = "monitoring"
 				config.Spec.Route.Routes[0].Raw = []byte(`{"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"}],"receiver":"email-kubernetes-ops"}`)
@@ -571,6 +575,65 @@ var _ = Describe("Alertmanager", func() {
 					smtpSecret,
 				))
 			})
+
+			When("additional receivers are configured", func() {
+				BeforeEach(func() {
+					values.Receivers = []Receiver{
+						{Name: "oncall", Type: gardencorev1beta1.AlertReceiverTypePagerDuty, SecretName: "ref-pagerduty", Severities: []string{"critical", "blocker"}},
+						{Name: "chat", Type: gardencorev1beta1.AlertReceiverTypeSlack, SecretName: "ref-slack", Visibilities: []string{"owner"}},
+						{Name: "hook", Type: gardencorev1beta1.AlertReceiverTypeWebhook, SecretName: "ref-webhook"},
+						{Name: "genie", Type: gardencorev1beta1.AlertReceiverTypeOpsgenie, SecretName: "ref-opsgenie"},
+						{Name: "teams", Type: gardencorev1beta1.AlertReceiverTypeMSTeams, SecretName: "ref-teams"},
+					}
+					secretKeyRef := func(name, key string) *corev1.SecretKeySelector {
+						return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}
+					}
+
+					config.Spec.Route.Routes = []apiextensionsv1.JSON{
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"}],"receiver":"email-kubernetes-ops"}`)},
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"},{"matchType":"=~","name":"severity","value":"critical|blocker"}],"receiver":"receiver-oncall"}`)},
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"owner"}],"receiver":"receiver-chat"}`)},
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"}],"receiver":"receiver-hook"}`)},
+						{Raw: []byte(`{"continue":true,"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"}],"receiver":"receiver-genie"}`)},
+						{Raw: []byte(`{"matchers":[{"matchType":"=~","name":"visibility","value":"all|owner"}],"receiver":"receiver-teams"}`)},
+					}
+					config.Spec.Receivers = append(config.Spec.Receivers,
+						monitoringv1alpha1.Receiver{Name: "receiver-oncall", PagerDutyConfigs: []monitoringv1alpha1.PagerDutyConfig{{SendResolved: ptr.To(true), RoutingKey: secretKeyRef("ref-pagerduty", "routingKey")}}},
+						monitoringv1alpha1.Receiver{Name: "receiver-chat", SlackConfigs: []monitoringv1alpha1.SlackConfig{{SendResolved: ptr.To(true), APIURL: secretKeyRef("ref-slack", "url")}}},
+						monitoringv1alpha1.Receiver{Name: "receiver-hook", WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{SendResolved: ptr.To(true), URLSecret: secretKeyRef("ref-webhook", "url")}}},
+						monitoringv1alpha1.Receiver{Name: "receiver-genie", OpsGenieConfigs: []monitoringv1alpha1.OpsGenieConfig{{SendResolved: ptr.To(true), APIKey: secretKeyRef("ref-opsgenie", "apiKey")}}},
+						monitoringv1alpha1.Receiver{Name: "receiver-teams", MSTeamsV2Configs: []monitoringv1alpha1.MSTeamsV2Config{{SendResolved: ptr.To(true), WebhookURL: secretKeyRef("ref-teams", "url")}}},
+					)
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(managedResource).To(consistOf(
+						service,
+						alertManager,
+						vpa,
+						config,
+						smtpSecret,
+					))
+				})
+
+				When("no alerting smtp secret is configured", func() {
+					BeforeEach(func() {
+						values.AlertingSMTPSecret = nil
+					})
+
+					It("should successfully deploy all resources", func() {
+						config.Spec.Route.Routes = config.Spec.Route.Routes[1:]
+						config.Spec.Receivers = append(config.Spec.Receivers[:1:1], config.Spec.Receivers[2:]...)
+
+						Expect(managedResource).To(consistOf(
+							service,
+							alertManager,
+							vpa,
+							config,
+						))
+					})
+				})
+			})
 		})
 	})
 
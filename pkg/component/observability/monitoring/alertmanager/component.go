@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
@@ -22,7 +23,8 @@ import (
 )
 
 const (
-	port = 9093
+	// Port is the port of the alertmanager service.
+	Port = 9093
 	// PortNameMetrics is the name of the metrics port.
 	PortNameMetrics = "metrics"
 )
@@ -59,10 +61,28 @@ type Values struct {
 	// EmailReceivers is a list of email addresses to which alerts should be sent. If this list is empty, the alerts
 	// will be sent to the email address in `.data.to` in the alerting SMTP secret.
 	EmailReceivers []string
+	// Receivers is a list of additional receivers to which alerts should be sent.
+	Receivers []Receiver
 	// Ingress contains configuration for exposing this AlertManager instance via an Ingress resource.
 	Ingress *IngressValues
 }
 
+// Receiver contains configuration for an additional receiver to which alerts should be sent.
+type Receiver struct {
+	// Name is the name of the receiver.
+	Name string
+	// Type is the type of the receiver.
+	Type gardencorev1beta1.AlertReceiverType
+	// SecretName is the name of the secret containing the credentials of the receiver.
+	SecretName string
+	// Severities is a list of alert severities which are sent to the receiver. If empty, alerts of all severities are
+	// sent.
+	Severities []string
+	// Visibilities is a list of alert visibilities which are sent to the receiver. If empty, alerts of all visibilities
+	// relevant for the cluster type are sent.
+	Visibilities []string
+}
+
 // IngressValues contains configuration for exposing this AlertManager instance via an Ingress resource.
 type IngressValues struct {
 	// AuthSecretName is the name of the auth secret.
@@ -5,32 +5,75 @@
 package alertmanager
 
 import (
+	"encoding/json"
+	"strings"
+
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/component"
 )
 
-const dataKeyAuthPassword = "auth_password"
+const (
+	dataKeyAuthPassword = "auth_password"
+	receiverNamePrefix  = "receiver-"
+
+	// DataKeyURL is the key in the secret of a webhook, Slack, or MS Teams receiver containing the URL.
+	DataKeyURL = "url"
+	// DataKeyRoutingKey is the key in the secret of a PagerDuty receiver containing the routing key.
+	DataKeyRoutingKey = "routingKey"
+	// DataKeyAPIKey is the key in the secret of an Opsgenie receiver containing the API key.
+	DataKeyAPIKey = "apiKey"
+)
 
 func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
-	if !a.hasSMTPSecret() {
+	if !a.hasConfig() {
 		return nil
 	}
 
 	var (
 		emailReceiverName = "email-kubernetes-ops"
 		visibility        = "operator"
+		routes            []apiextensionsv1.JSON
+		receivers         = []monitoringv1alpha1.Receiver{{Name: "dev-null"}}
 	)
 
 	if a.values.ClusterType == component.ClusterTypeShoot {
 		visibility = "owner"
 	}
 
+	if a.hasSMTPSecret() {
+		// email only for critical and blocker
+		routes = append(routes, a.route(emailReceiverName, []monitoringv1alpha1.Matcher{
+			{Name: "visibility", MatchType: monitoringv1alpha1.MatchRegexp, Value: "all|" + visibility},
+		}, len(a.values.Receivers) > 0))
+		receivers = append(receivers, monitoringv1alpha1.Receiver{
+			Name:         emailReceiverName,
+			EmailConfigs: a.emailConfigs(),
+		})
+	}
+
+	for i, receiver := range a.values.Receivers {
+		visibilities := receiver.Visibilities
+		if len(visibilities) == 0 {
+			visibilities = []string{"all", visibility}
+		}
+
+		matchers := []monitoringv1alpha1.Matcher{{Name: "visibility", MatchType: monitoringv1alpha1.MatchRegexp, Value: strings.Join(visibilities, "|")}}
+		if len(receiver.Severities) > 0 {
+			matchers = append(matchers, monitoringv1alpha1.Matcher{Name: "severity", MatchType: monitoringv1alpha1.MatchRegexp, Value: strings.Join(receiver.Severities, "|")})
+		}
+
+		routes = append(routes, a.route(receiverNamePrefix+receiver.Name, matchers, i < len(a.values.Receivers)-1))
+		receivers = append(receivers, receiverConfig(receiver))
+	}
+
 	return &monitoringv1alpha1.AlertmanagerConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      a.name(),
@@ -53,12 +96,7 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 				RepeatInterval: ptr.To(monitoringv1.NonEmptyDuration("72h")),
 				// Send alerts by default to nowhere
 				Receiver: "dev-null",
-				// email only for critical and blocker
-				Routes: []apiextensionsv1.JSON{{Raw: []byte(`
-				  {"matchers": [{"name": "visibility",
-				                 "matchType": "=~",
-				                 "value": "all|` + visibility + `"}],
-				   "receiver": "` + emailReceiverName + `"}`)}},
+				Routes:   routes,
 			},
 			InhibitRules: []monitoringv1alpha1.InhibitRule{
 				// Apply inhibition if the alert name is the same.
@@ -99,17 +137,50 @@ func (a *alertManager) config() *monitoringv1alpha1.AlertmanagerConfig {
 					Equal:       []string{"cluster"},
 				},
 			},
-			Receivers: []monitoringv1alpha1.Receiver{
-				{Name: "dev-null"},
-				{
-					Name:         emailReceiverName,
-					EmailConfigs: a.emailConfigs(),
-				},
-			},
+			Receivers: receivers,
 		},
 	}
 }
 
+func (a *alertManager) hasConfig() bool {
+	return a.hasSMTPSecret() || len(a.values.Receivers) > 0
+}
+
+// route returns a route for the given receiver.
+func (a *alertManager) route(receiverName string, matchers []monitoringv1alpha1.Matcher, continueMatching bool) apiextensionsv1.JSON {
+	raw, err := json.Marshal(monitoringv1alpha1.Route{
+		Receiver: receiverName,
+		Matchers: matchers,
+		Continue: continueMatching,
+	})
+	utilruntime.Must(err)
+	return apiextensionsv1.JSON{Raw: raw}
+}
+
+func receiverConfig(receiver Receiver) monitoringv1alpha1.Receiver {
+	var (
+		config      = monitoringv1alpha1.Receiver{Name: receiverNamePrefix + receiver.Name}
+		secretKeyOf = func(key string) *corev1.SecretKeySelector {
+			return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: receiver.SecretName}, Key: key}
+		}
+	)
+
+	switch receiver.Type {
+	case gardencorev1beta1.AlertReceiverTypeWebhook:
+		config.WebhookConfigs = []monitoringv1alpha1.WebhookConfig{{SendResolved: ptr.To(true), URLSecret: secretKeyOf(DataKeyURL)}}
+	case gardencorev1beta1.AlertReceiverTypeSlack:
+		config.SlackConfigs = []monitoringv1alpha1.SlackConfig{{SendResolved: ptr.To(true), APIURL: secretKeyOf(DataKeyURL)}}
+	case gardencorev1beta1.AlertReceiverTypePagerDuty:
+		config.PagerDutyConfigs = []monitoringv1alpha1.PagerDutyConfig{{SendResolved: ptr.To(true), RoutingKey: secretKeyOf(DataKeyRoutingKey)}}
+	case gardencorev1beta1.AlertReceiverTypeOpsgenie:
+		config.OpsGenieConfigs = []monitoringv1alpha1.OpsGenieConfig{{SendResolved: ptr.To(true), APIKey: secretKeyOf(DataKeyAPIKey)}}
+	case gardencorev1beta1.AlertReceiverTypeMSTeams:
+		config.MSTeamsV2Configs = []monitoringv1alpha1.MSTeamsV2Config{{SendResolved: ptr.To(true), WebhookURL: secretKeyOf(DataKeyURL)}}
+	}
+
+	return config
+}
+
 func (a *alertManager) smtpSecret() *corev1.Secret {
 	if !a.hasSMTPSecret() {
 		return nil
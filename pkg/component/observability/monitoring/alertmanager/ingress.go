@@ -66,7 +66,7 @@ func (a *alertManager) ingress(ctx context.Context) (*networkingv1.Ingress, erro
 							Backend: networkingv1.IngressBackend{
 								Service: &networkingv1.IngressServiceBackend{
 									Name: a.name(),
-									Port: networkingv1.ServiceBackendPort{Number: port},
+									Port: networkingv1.ServiceBackendPort{Number: Port},
 								},
 							},
 							Path:     "/",
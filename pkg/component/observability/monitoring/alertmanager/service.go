@@ -13,6 +13,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -29,13 +30,13 @@ func (a *alertManager) service() *corev1.Service {
 			Selector: a.getLabels(),
 			Ports: []corev1.ServicePort{{
 				Name: PortNameMetrics,
-				Port: port,
+				Port: Port,
 			}},
 		},
 	}
 
 	networkPolicyPort := networkingv1.NetworkPolicyPort{
-		Port:     ptr.To(intstr.FromInt32(port)),
+		Port:     ptr.To(intstr.FromInt32(Port)),
 		Protocol: ptr.To(corev1.ProtocolTCP),
 	}
 
@@ -49,6 +50,11 @@ func (a *alertManager) service() *corev1.Service {
 
 	case component.ClusterTypeShoot:
 		utilruntime.Must(gardenerutils.InjectNetworkPolicyAnnotationsForScrapeTargets(service, networkPolicyPort))
+		// gardenlet pushes the alert silences of the shoot to the alertmanager.
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, resourcesv1alpha1.NetworkingPodLabelSelectorNamespaceAlias, v1beta1constants.LabelNetworkPolicyShootNamespaceAlias)
+		utilruntime.Must(gardenerutils.InjectNetworkPolicyNamespaceSelectors(service, metav1.LabelSelector{MatchLabels: map[string]string{
+			corev1.LabelMetadataName: v1beta1constants.GardenNamespace,
+		}}))
 	}
 
 	return service
@@ -89,6 +89,7 @@ func CentralPrometheusRules(isWorkerless, wantsAlertmanager bool) []*monitoringv
 	out := []*monitoringv1.PrometheusRule{
 		prometheus.DeepCopy(),
 		vpa.DeepCopy(),
+		sloPrometheusRule(),
 	}
 
 	if isWorkerless {
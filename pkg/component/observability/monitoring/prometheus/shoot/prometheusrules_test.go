@@ -28,10 +28,10 @@ var _ = ginkgo.Describe("PrometheusRules", func() {
 				Expect(CentralPrometheusRules(isWorkerless, wantsAlertmanager)).To(HaveExactElements(matchers...))
 			},
 
-			ginkgo.Entry("workerless, w/o alertmanager", true, false, []string{"prometheus", "verticalpodautoscaler", "healthcheck", "kube-pods", "networking"}),
-			ginkgo.Entry("workerless, w/ alertmanager", true, true, []string{"prometheus", "verticalpodautoscaler", "healthcheck", "kube-pods", "networking", "alertmanager"}),
-			ginkgo.Entry("w/ workers, w/o alertmanager", false, false, []string{"prometheus", "verticalpodautoscaler", "healthcheck", "kube-kubelet", "kube-pods", "networking"}),
-			ginkgo.Entry("w/ workers, w/ alertmanager", false, true, []string{"prometheus", "verticalpodautoscaler", "healthcheck", "kube-kubelet", "kube-pods", "networking", "alertmanager"}),
+			ginkgo.Entry("workerless, w/o alertmanager", true, false, []string{"prometheus", "verticalpodautoscaler", "slo", "healthcheck", "kube-pods", "networking"}),
+			ginkgo.Entry("workerless, w/ alertmanager", true, true, []string{"prometheus", "verticalpodautoscaler", "slo", "healthcheck", "kube-pods", "networking", "alertmanager"}),
+			ginkgo.Entry("w/ workers, w/o alertmanager", false, false, []string{"prometheus", "verticalpodautoscaler", "slo", "healthcheck", "kube-kubelet", "kube-pods", "networking"}),
+			ginkgo.Entry("w/ workers, w/ alertmanager", false, true, []string{"prometheus", "verticalpodautoscaler", "slo", "healthcheck", "kube-kubelet", "kube-pods", "networking", "alertmanager"}),
 		)
 
 		ginkgo.It("should run the rules tests", func() {
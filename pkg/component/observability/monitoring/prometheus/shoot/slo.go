@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// SLOWindow is the rolling window over which the service levels of the control plane are computed.
+	SLOWindow = 30 * 24 * time.Hour
+	// SLOAvailabilityObjective is the objective for the ratio of successful probes of the API server's health endpoint.
+	SLOAvailabilityObjective = 0.999
+	// SLOLatencyObjective is the objective for the ratio of non-long-running API requests served within one second.
+	SLOLatencyObjective = 0.99
+
+	// RecordAvailabilitySLI is the name of the recording rule for the availability indicator over five minutes.
+	RecordAvailabilitySLI = "shoot:apiserver_availability_sli:ratio_rate5m"
+	// RecordAvailabilitySLIWindow is the name of the recording rule for the availability indicator over the SLO window.
+	RecordAvailabilitySLIWindow = "shoot:apiserver_availability_sli:ratio_rate30d"
+	// RecordLatencySLI is the name of the recording rule for the latency indicator over five minutes.
+	RecordLatencySLI = "shoot:apiserver_latency_sli:ratio_rate5m"
+	// RecordLatencySLIWindow is the name of the recording rule for the latency indicator over the SLO window.
+	RecordLatencySLIWindow = "shoot:apiserver_latency_sli:ratio_rate30d"
+
+	sloWindowPromQL = "30d"
+	// Long-running requests are excluded from the latency indicator, see KubeApiServerLatency alert.
+	sloLatencyRequestSelector = `subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH"`
+)
+
+func sloPrometheusRule() *monitoringv1.PrometheusRule {
+	var (
+		availabilityObjective = strconv.FormatFloat(SLOAvailabilityObjective, 'f', -1, 64)
+		latencyObjective      = strconv.FormatFloat(SLOLatencyObjective, 'f', -1, 64)
+	)
+
+	return &monitoringv1.PrometheusRule{
+		TypeMeta:   metav1.TypeMeta{APIVersion: monitoringv1.SchemeGroupVersion.String(), Kind: monitoringv1.PrometheusRuleKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "slo"},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: "slo.rules",
+					Rules: []monitoringv1.Rule{
+						{
+							Record: RecordAvailabilitySLI,
+							Expr:   intstr.FromString(`avg(avg_over_time(probe_success{job="blackbox-apiserver"}[5m]))`),
+						},
+						{
+							Record: RecordLatencySLI,
+							Expr: intstr.FromString(fmt.Sprintf(`sum(rate(apiserver_request_duration_seconds_bucket{le="1.0",%[1]s}[5m])) / sum(rate(apiserver_request_duration_seconds_count{%[1]s}[5m]))`,
+								sloLatencyRequestSelector)),
+						},
+						{
+							Record: "shoot:apiserver_availability_slo:objective",
+							Expr:   intstr.FromString(fmt.Sprintf(`vector(%s)`, availabilityObjective)),
+						},
+						{
+							Record: "shoot:apiserver_latency_slo:objective",
+							Expr:   intstr.FromString(fmt.Sprintf(`vector(%s)`, latencyObjective)),
+						},
+					},
+				},
+				{
+					// The window indicators are based on the recorded five minutes indicators, hence it is sufficient to
+					// evaluate them less frequently.
+					Name:     "slo-window.rules",
+					Interval: ptr.To(monitoringv1.Duration("5m")),
+					Rules: []monitoringv1.Rule{
+						{
+							Record: RecordAvailabilitySLIWindow,
+							Expr:   intstr.FromString(fmt.Sprintf(`avg_over_time(%s[%s])`, RecordAvailabilitySLI, sloWindowPromQL)),
+						},
+						{
+							Record: RecordLatencySLIWindow,
+							Expr:   intstr.FromString(fmt.Sprintf(`avg_over_time(%s[%s])`, RecordLatencySLI, sloWindowPromQL)),
+						},
+						{
+							Record: "shoot:apiserver_availability_slo:error_budget_remaining",
+							Expr:   intstr.FromString(fmt.Sprintf(`1 - (1 - %s) / (1 - %s)`, RecordAvailabilitySLIWindow, availabilityObjective)),
+						},
+						{
+							Record: "shoot:apiserver_latency_slo:error_budget_remaining",
+							Expr:   intstr.FromString(fmt.Sprintf(`1 - (1 - %s) / (1 - %s)`, RecordLatencySLIWindow, latencyObjective)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
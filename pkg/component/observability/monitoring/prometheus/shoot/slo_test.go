@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = ginkgo.Describe("SLO", func() {
+	ginkgo.Describe("#sloPrometheusRule", func() {
+		var rule *monitoringv1.PrometheusRule
+
+		ginkgo.BeforeEach(func() {
+			rule = sloPrometheusRule()
+		})
+
+		ginkgo.It("should record the service level indicators over five minutes", func() {
+			Expect(rule.Spec.Groups[0].Name).To(Equal("slo.rules"))
+			Expect(rule.Spec.Groups[0].Interval).To(BeNil())
+			Expect(rule.Spec.Groups[0].Rules).To(Equal([]monitoringv1.Rule{
+				{
+					Record: "shoot:apiserver_availability_sli:ratio_rate5m",
+					Expr:   intstr.FromString(`avg(avg_over_time(probe_success{job="blackbox-apiserver"}[5m]))`),
+				},
+				{
+					Record: "shoot:apiserver_latency_sli:ratio_rate5m",
+					Expr:   intstr.FromString(`sum(rate(apiserver_request_duration_seconds_bucket{le="1.0",subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH"}[5m])) / sum(rate(apiserver_request_duration_seconds_count{subresource!~"log|portforward|exec|proxy|attach",verb!~"CONNECT|LIST|WATCH"}[5m]))`),
+				},
+				{
+					Record: "shoot:apiserver_availability_slo:objective",
+					Expr:   intstr.FromString(`vector(0.999)`),
+				},
+				{
+					Record: "shoot:apiserver_latency_slo:objective",
+					Expr:   intstr.FromString(`vector(0.99)`),
+				},
+			}))
+		})
+
+		ginkgo.It("should record the service level indicators and error budgets over the SLO window", func() {
+			Expect(rule.Spec.Groups[1].Name).To(Equal("slo-window.rules"))
+			Expect(rule.Spec.Groups[1].Interval).To(PointTo(Equal(monitoringv1.Duration("5m"))))
+			Expect(rule.Spec.Groups[1].Rules).To(Equal([]monitoringv1.Rule{
+				{
+					Record: "shoot:apiserver_availability_sli:ratio_rate30d",
+					Expr:   intstr.FromString(`avg_over_time(shoot:apiserver_availability_sli:ratio_rate5m[30d])`),
+				},
+				{
+					Record: "shoot:apiserver_latency_sli:ratio_rate30d",
+					Expr:   intstr.FromString(`avg_over_time(shoot:apiserver_latency_sli:ratio_rate5m[30d])`),
+				},
+				{
+					Record: "shoot:apiserver_availability_slo:error_budget_remaining",
+					Expr:   intstr.FromString(`1 - (1 - shoot:apiserver_availability_sli:ratio_rate30d) / (1 - 0.999)`),
+				},
+				{
+					Record: "shoot:apiserver_latency_slo:error_budget_remaining",
+					Expr:   intstr.FromString(`1 - (1 - shoot:apiserver_latency_sli:ratio_rate30d) / (1 - 0.99)`),
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package userrules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DataKey is the data key in the ConfigMap referenced in the Shoot specification which contains the user-defined
+	// Prometheus rule groups.
+	DataKey = "rules.yaml"
+	// PrometheusRuleName is the name of the PrometheusRule resource containing the user-defined rules.
+	PrometheusRuleName = "user-rules"
+	// GroupNamePrefix is the prefix for the names of the user-defined rule groups.
+	GroupNamePrefix = "user-"
+	// RecordNamePrefix is the prefix the names of user-defined recording rules must start with. It makes sure that
+	// user-defined series never collide with the series recorded by Gardener, e.g., those prefixed with `shoot:`.
+	RecordNamePrefix = "user:"
+
+	// MaxGroups is the maximum number of user-defined rule groups.
+	MaxGroups = 10
+	// MaxRules is the maximum number of user-defined rules across all rule groups.
+	MaxRules = 50
+	// MinGroupInterval is the minimum evaluation interval of user-defined rule groups.
+	MinGroupInterval = time.Minute
+	// GroupLimit is the maximum number of alerts or series a single user-defined rule may produce. It is enforced by
+	// Prometheus for every rule group.
+	GroupLimit = 100
+)
+
+var (
+	metricNameRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRegex  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	alertSeverities = sets.New("info", "warning", "critical", "blocker")
+	// enforcedLabels are the labels which are always set by Gardener for user-defined alerting rules. They make
+	// sure that alerts are only routed to the shoot's alertmanager and never reach the operators.
+	enforcedLabels = map[string]string{
+		"type":       "shoot",
+		"visibility": "owner",
+	}
+)
+
+// Parse parses the given Prometheus rule file into a PrometheusRule specification.
+func Parse(raw string) (*monitoringv1.PrometheusRuleSpec, error) {
+	spec := &monitoringv1.PrometheusRuleSpec{}
+	if err := yaml.UnmarshalStrict([]byte(raw), spec); err != nil {
+		return nil, fmt.Errorf("failed to decode rule groups: %w", err)
+	}
+	return spec, nil
+}
+
+// Validate validates the given user-defined rule groups.
+func Validate(spec *monitoringv1.PrometheusRuleSpec) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		fldPath    = field.NewPath("groups")
+		groupNames = sets.New[string]()
+		numRules   int
+	)
+
+	if len(spec.Groups) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one rule group must be specified"))
+	}
+	if len(spec.Groups) > MaxGroups {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(spec.Groups), MaxGroups))
+	}
+
+	for i, group := range spec.Groups {
+		idxPath := fldPath.Index(i)
+
+		if len(group.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if groupNames.Has(group.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		groupNames.Insert(group.Name)
+
+		if group.Interval != nil {
+			if interval, err := model.ParseDuration(string(*group.Interval)); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("interval"), *group.Interval, err.Error()))
+			} else if time.Duration(interval) < MinGroupInterval {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("interval"), *group.Interval, fmt.Sprintf("must be at least %s", MinGroupInterval)))
+			}
+		}
+		if group.QueryOffset != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("query_offset"), "is not supported"))
+		}
+		if len(group.PartialResponseStrategy) > 0 {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("partial_response_strategy"), "is not supported"))
+		}
+		if group.Limit != nil && (*group.Limit <= 0 || *group.Limit > GroupLimit) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("limit"), *group.Limit, fmt.Sprintf("must be between 1 and %d", GroupLimit)))
+		}
+		allErrs = append(allErrs, validateRuleLabels(group.Labels, idxPath.Child("labels"))...)
+
+		if len(group.Rules) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("rules"), "at least one rule must be specified"))
+		}
+		numRules += len(group.Rules)
+
+		for j, rule := range group.Rules {
+			allErrs = append(allErrs, validateRule(rule, idxPath.Child("rules").Index(j))...)
+		}
+	}
+
+	if numRules > MaxRules {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("rules"), numRules, MaxRules))
+	}
+
+	return allErrs
+}
+
+func validateRule(rule monitoringv1.Rule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case len(rule.Record) > 0 && len(rule.Alert) > 0:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not specify both record and alert"))
+	case len(rule.Record) > 0:
+		if !metricNameRegex.MatchString(rule.Record) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("record"), rule.Record, "must be a valid metric name"))
+		} else if !strings.HasPrefix(rule.Record, RecordNamePrefix) || rule.Record == RecordNamePrefix {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("record"), rule.Record, fmt.Sprintf("must be prefixed with %q", RecordNamePrefix)))
+		}
+		if rule.For != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("for"), "is only supported for alerting rules"))
+		}
+		if rule.KeepFiringFor != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("keep_firing_for"), "is only supported for alerting rules"))
+		}
+		if len(rule.Annotations) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("annotations"), "is only supported for alerting rules"))
+		}
+	case len(rule.Alert) > 0:
+		if severity := rule.Labels["severity"]; !alertSeverities.Has(severity) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("labels", "severity"), severity, sets.List(alertSeverities)))
+		}
+		if rule.For != nil {
+			if _, err := model.ParseDuration(string(*rule.For)); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("for"), *rule.For, err.Error()))
+			}
+		}
+		if rule.KeepFiringFor != nil {
+			if _, err := model.ParseDuration(string(*rule.KeepFiringFor)); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("keep_firing_for"), *rule.KeepFiringFor, err.Error()))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify either record or alert"))
+	}
+
+	if rule.Expr == (intstr.IntOrString{}) || (rule.Expr.Type == intstr.String && len(strings.TrimSpace(rule.Expr.StrVal)) == 0) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expr"), "must provide an expression"))
+	}
+	allErrs = append(allErrs, validateRuleLabels(rule.Labels, fldPath.Child("labels"))...)
+
+	return allErrs
+}
+
+func validateRuleLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name := range labels {
+		if !labelNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), name, "must be a valid label name"))
+		}
+	}
+
+	return allErrs
+}
+
+// PrometheusRule returns the PrometheusRule resource for the given user-defined rule groups. The group names are
+// prefixed to avoid collisions with the rule groups shipped by Gardener, every group is limited and the routing
+// labels of alerting rules are enforced.
+func PrometheusRule(spec *monitoringv1.PrometheusRuleSpec) *monitoringv1.PrometheusRule {
+	prometheusRule := &monitoringv1.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.PrometheusRuleKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: PrometheusRuleName},
+		Spec:       *spec.DeepCopy(),
+	}
+
+	for i := range prometheusRule.Spec.Groups {
+		group := &prometheusRule.Spec.Groups[i]
+		group.Name = GroupNamePrefix + group.Name
+
+		if group.Limit == nil || *group.Limit > GroupLimit {
+			group.Limit = ptr.To(GroupLimit)
+		}
+		for k := range enforcedLabels {
+			delete(group.Labels, k)
+		}
+
+		for j := range group.Rules {
+			rule := &group.Rules[j]
+			if len(rule.Alert) == 0 {
+				continue
+			}
+
+			if rule.Labels == nil {
+				rule.Labels = make(map[string]string, len(enforcedLabels))
+			}
+			for k, v := range enforcedLabels {
+				rule.Labels[k] = v
+			}
+		}
+	}
+
+	return prometheusRule
+}
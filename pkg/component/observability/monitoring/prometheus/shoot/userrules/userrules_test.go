@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package userrules_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot/userrules"
+	"github.com/gardener/gardener/pkg/component/test"
+)
+
+var _ = Describe("UserRules", func() {
+	Describe("#Parse", func() {
+		It("should parse a valid rule file", func() {
+			spec, err := Parse(`groups:
+- name: apiserver
+  interval: 2m
+  rules:
+  - record: user:apiserver_request_latency:p99
+    expr: histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket[5m])) by (le, resource))
+`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Groups).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{
+				"Name":     Equal("apiserver"),
+				"Interval": PointTo(Equal(monitoringv1.Duration("2m"))),
+				"Rules":    HaveLen(1),
+			})))
+		})
+
+		It("should fail for unknown fields", func() {
+			_, err := Parse(`groups:
+- name: apiserver
+  foo: bar
+`)
+			Expect(err).To(MatchError(ContainSubstring("unknown field")))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should allow valid rule groups", func() {
+			Expect(Validate(&monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{{
+				Name:     "etcd",
+				Interval: ptr.To(monitoringv1.Duration("1m")),
+				Rules: []monitoringv1.Rule{
+					{Record: "user:etcd_db_total_size:max", Expr: intstr.FromString("max(etcd_mvcc_db_total_size_in_bytes)")},
+					{Alert: "EtcdDatabaseLarge", Expr: intstr.FromString("user:etcd_db_total_size:max > 4e9"), For: ptr.To(monitoringv1.Duration("15m")), Labels: map[string]string{"severity": "warning"}},
+				},
+			}}})).To(BeEmpty())
+		})
+
+		It("should forbid an empty rule file", func() {
+			Expect(Validate(&monitoringv1.PrometheusRuleSpec{})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("groups")})),
+			))
+		})
+
+		It("should forbid invalid rule groups", func() {
+			Expect(Validate(&monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+				{Name: "foo", Interval: ptr.To(monitoringv1.Duration("10s")), Limit: ptr.To(1000), PartialResponseStrategy: "warn", Rules: []monitoringv1.Rule{{Record: "user:foo", Expr: intstr.FromString("up")}}},
+				{Name: "foo", Labels: map[string]string{"in-valid": "bar"}},
+			}})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("groups[0].interval")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("groups[0].limit")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("groups[0].partial_response_strategy")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("groups[1].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("groups[1].labels[in-valid]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("groups[1].rules")})),
+			))
+		})
+
+		It("should forbid invalid rules", func() {
+			Expect(Validate(&monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{{
+				Name: "foo",
+				Rules: []monitoringv1.Rule{
+					{Expr: intstr.FromString("up")},
+					{Record: "user:foo", Alert: "Foo", Expr: intstr.FromString("up")},
+					{Record: "foo-bar", For: ptr.To(monitoringv1.Duration("5m")), Annotations: map[string]string{"foo": "bar"}},
+					{Alert: "Foo", Expr: intstr.FromString("up == 0"), For: ptr.To(monitoringv1.Duration("five")), Labels: map[string]string{"severity": "page"}},
+					{Record: "shoot:apiserver_request_total:sum", Expr: intstr.FromString("sum(rate(apiserver_request_total[5m])")},
+					{Record: "user:", Expr: intstr.FromString("up")},
+				},
+			}}})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("groups[0].rules[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("groups[0].rules[1]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("groups[0].rules[2].record")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("groups[0].rules[2].for")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("groups[0].rules[2].annotations")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("groups[0].rules[2].expr")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("groups[0].rules[3].labels.severity")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("groups[0].rules[3].for")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("groups[0].rules[4].record")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("groups[0].rules[5].record")})),
+			))
+		})
+
+		It("should forbid exceeding the limits", func() {
+			spec := &monitoringv1.PrometheusRuleSpec{}
+			for i := 0; i < MaxGroups+1; i++ {
+				group := monitoringv1.RuleGroup{Name: fmt.Sprintf("group-%d", i)}
+				for j := 0; j < 5; j++ {
+					group.Rules = append(group.Rules, monitoringv1.Rule{Record: "user:foo", Expr: intstr.FromString("up")})
+				}
+				spec.Groups = append(spec.Groups, group)
+			}
+
+			Expect(Validate(spec)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeTooMany), "Field": Equal("groups")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeTooMany), "Field": Equal("groups.rules")})),
+			))
+		})
+	})
+
+	Describe("#PrometheusRule", func() {
+		It("should return a PrometheusRule which is accepted by promtool", func() {
+			spec, err := Parse(`groups:
+- name: etcd
+  interval: 2m
+  rules:
+  - record: user:etcd_mvcc_db_total_size_in_bytes:max
+    expr: max(etcd_mvcc_db_total_size_in_bytes{job="kube-etcd3-main"})
+  - alert: EtcdDatabaseLarge
+    expr: user:etcd_mvcc_db_total_size_in_bytes:max > 6e9
+    for: 30m
+    labels:
+      severity: warning
+    annotations:
+      summary: The etcd database is getting large.
+`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(Validate(spec)).To(BeEmpty())
+
+			test.PrometheusRule(PrometheusRule(spec), "testdata/user-rules.prometheusrule.test.yaml")
+		})
+
+		It("should return the PrometheusRule with prefixed groups, limits and enforced labels", func() {
+			spec := &monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{
+				{
+					Name:   "apiserver",
+					Labels: map[string]string{"visibility": "operator", "team": "foo"},
+					Rules: []monitoringv1.Rule{
+						{Record: "apiserver:latency", Expr: intstr.FromString("up")},
+						{Alert: "ApiServerSlow", Expr: intstr.FromString("apiserver:latency > 1"), Labels: map[string]string{"severity": "critical", "visibility": "operator"}},
+					},
+				},
+				{Name: "etcd", Limit: ptr.To(10), Rules: []monitoringv1.Rule{{Alert: "EtcdLarge", Expr: intstr.FromString("up")}}},
+			}}
+
+			prometheusRule := PrometheusRule(spec)
+
+			Expect(prometheusRule.TypeMeta.Kind).To(Equal("PrometheusRule"))
+			Expect(prometheusRule.Name).To(Equal("user-rules"))
+			Expect(prometheusRule.Spec.Groups).To(HaveExactElements(
+				MatchFields(IgnoreExtras, Fields{
+					"Name":   Equal("user-apiserver"),
+					"Labels": Equal(map[string]string{"team": "foo"}),
+					"Limit":  PointTo(Equal(100)),
+					"Rules": HaveExactElements(
+						MatchFields(IgnoreExtras, Fields{"Record": Equal("apiserver:latency"), "Labels": BeNil()}),
+						MatchFields(IgnoreExtras, Fields{"Alert": Equal("ApiServerSlow"), "Labels": Equal(map[string]string{"severity": "critical", "type": "shoot", "visibility": "owner"})}),
+					),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Name":  Equal("user-etcd"),
+					"Limit": PointTo(Equal(10)),
+					"Rules": HaveExactElements(
+						MatchFields(IgnoreExtras, Fields{"Alert": Equal("EtcdLarge"), "Labels": Equal(map[string]string{"type": "shoot", "visibility": "owner"})}),
+					),
+				}),
+			))
+
+			Expect(spec.Groups[0].Name).To(Equal("apiserver"), "given specification must not be mutated")
+			Expect(spec.Groups[0].Rules[1].Labels).To(HaveKeyWithValue("visibility", "operator"), "given specification must not be mutated")
+		})
+	})
+})
@@ -34,20 +34,30 @@ func computeAPIServerAuditConfig(
 	objectMeta metav1.ObjectMeta,
 	config *gardencorev1beta1.AuditConfig,
 	webhookConfig *apiserver.AuditWebhook,
+	logConfig *apiserver.AuditLog,
 ) (
 	*apiserver.AuditConfig,
 	error,
 ) {
-	if config == nil || config.AuditPolicy == nil || config.AuditPolicy.ConfigMapRef == nil {
+	if config == nil || config.AuditPolicy == nil || (config.AuditPolicy.ConfigMapRef == nil && config.AuditPolicy.Preset == nil) {
 		return nil, nil
 	}
 
-	var (
-		out = &apiserver.AuditConfig{
-			Webhook: webhookConfig,
+	out := &apiserver.AuditConfig{
+		Webhook: webhookConfig,
+		Log:     logConfig,
+	}
+
+	if config.AuditPolicy.Preset != nil {
+		policy, err := apiserver.AuditPolicyForPreset(*config.AuditPolicy.Preset)
+		if err != nil {
+			return nil, err
 		}
-		key = client.ObjectKey{Namespace: objectMeta.Namespace, Name: config.AuditPolicy.ConfigMapRef.Name}
-	)
+		out.Policy = &policy
+		return out, nil
+	}
+
+	key := client.ObjectKey{Namespace: objectMeta.Namespace, Name: config.AuditPolicy.ConfigMapRef.Name}
 
 	configMap := &corev1.ConfigMap{}
 	if err := cl.Get(ctx, key, configMap); err != nil {
@@ -62,7 +62,7 @@ func NewGardenerAPIServer(
 	)
 
 	if apiServerConfig != nil {
-		auditConfig, err = computeAPIServerAuditConfig(ctx, runtimeClient, objectMeta, apiServerConfig.AuditConfig, auditWebhookConfig)
+		auditConfig, err = computeAPIServerAuditConfig(ctx, runtimeClient, objectMeta, apiServerConfig.AuditConfig, auditWebhookConfig, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -85,6 +85,7 @@ func NewKubeAPIServer(
 	runsAsStaticPod bool,
 	istioTLSTerminationEnabled bool,
 	auditWebhookConfig *apiserver.AuditWebhook,
+	auditLogConfig *apiserver.AuditLog,
 	authenticationWebhookConfig *kubeapiserver.AuthenticationWebhook,
 	authorizationWebhookConfigs []kubeapiserver.AuthorizationWebhook,
 	resourcesToStoreInETCDEvents []schema.GroupResource,
@@ -135,7 +136,7 @@ func NewKubeAPIServer(
 			}
 		}
 
-		auditConfig, err = computeAPIServerAuditConfig(ctx, resourceConfigClient, objectMeta, apiServerConfig.AuditConfig, auditWebhookConfig)
+		auditConfig, err = computeAPIServerAuditConfig(ctx, resourceConfigClient, objectMeta, apiServerConfig.AuditConfig, auditWebhookConfig, auditLogConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shootpolicy"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shootstate"
 )
 
@@ -123,6 +124,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *controllermanag
 		return fmt.Errorf("failed adding SecretBinding controller: %w", err)
 	}
 
+	if err := (&shootpolicy.Reconciler{
+		Config: *cfg.Controllers.ShootPolicy,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding ShootPolicy controller: %w", err)
+	}
+
 	if err := (&shootstate.Reconciler{
 		Config: *cfg.Controllers.ShootState,
 	}).AddToManager(mgr); err != nil {
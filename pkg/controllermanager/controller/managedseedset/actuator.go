@@ -101,6 +101,17 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	status.Replicas = int32(len(replicas))           // #nosec G115 -- `ra.replicaGetter.GetReplicas(ctx, managedSeedSet)` returns a line for every ManagedSeeds in the system. This number cannot exceed max int32.
 	status.ReadyReplicas = int32(len(readyReplicas)) // #nosec G115 -- `ra.replicaGetter.GetReplicas(ctx, managedSeedSet)` returns a line for every ManagedSeeds in the system. This number cannot exceed max int32.
 
+	// Reconcile the revisions of the set's ManagedSeed template and update currentReplicas and updatedReplicas in status
+	if managedSeedSet.DeletionTimestamp == nil {
+		if err := a.reconcileRevisions(ctx, managedSeedSet, status); err != nil {
+			return status, false, err
+		}
+		if err := a.adoptReplicas(ctx, log, status, replicas); err != nil {
+			return status, false, err
+		}
+	}
+	status.CurrentReplicas, status.UpdatedReplicas = countRevisions(replicas, status)
+
 	// Determine the actual and target replica counts
 	count := len(replicas)
 	targetCount := 0
@@ -111,6 +122,14 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	// Determine whether scaling out or in
 	scalingOut, scalingIn := count < targetCount, count > targetCount
 
+	// Reconcile the canary of the current update, if any
+	if managedSeedSet.DeletionTimestamp == nil && !scalingIn {
+		if err := a.reconcileCanary(ctx, log, managedSeedSet, status, replicas); err != nil {
+			return status, false, err
+		}
+		pendingReplica = getPendingReplica(replicas, status)
+	}
+
 	// Reconcile the pending replica, if any
 	if pendingReplica != nil {
 		if pending, err := a.reconcileReplica(ctx, log, managedSeedSet, status, pendingReplica, scalingIn); err != nil || pending {
@@ -161,6 +180,13 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		}
 	}
 
+	// Update replicas that have not been updated to the update revision yet
+	if managedSeedSet.DeletionTimestamp == nil {
+		if pending, err := a.updateReplicas(ctx, log, managedSeedSet, status, replicas); err != nil || pending {
+			return status, false, err
+		}
+	}
+
 	log.V(1).Info("Nothing to do")
 	status.PendingReplica = nil
 	return status, true, nil
@@ -182,6 +208,10 @@ const (
 	EventWaitingForManagedSeedRegistered = "WaitingForManagedSeedRegistered"
 	EventWaitingForManagedSeedDeleted    = "WaitingForManagedSeedDeleted"
 	EventWaitingForSeedReady             = "WaitingForSeedReady"
+	EventUpdatingManagedSeed             = "UpdatingManagedSeed"
+	EventCanaryBaking                    = "CanaryBaking"
+	EventCanarySucceeded                 = "CanarySucceeded"
+	EventCanaryFailed                    = "CanaryFailed"
 )
 
 func (a *actuator) reconcileReplica(
@@ -342,6 +372,85 @@ func (a *actuator) deleteReplica(
 	return nil
 }
 
+func (a *actuator) updateReplicas(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	replicas []Replica,
+) (bool, error) {
+	// Determine the replicas that have not been updated to the update revision yet, by descending ordinal
+	var outdatedReplicas []Replica
+	for _, r := range replicas {
+		if r.GetRevision() != status.UpdateRevision {
+			outdatedReplicas = append(outdatedReplicas, r)
+		}
+	}
+	if len(outdatedReplicas) == 0 {
+		// All replicas have been updated, the update revision becomes the current revision
+		status.CurrentRevision = status.UpdateRevision
+		status.CurrentReplicas = status.UpdatedReplicas
+		return false, nil
+	}
+	sort.Sort(sort.Reverse(ascendingOrdinal(outdatedReplicas)))
+
+	// Update one replica at a time, i.e., only continue once all replicas already updated to the update revision are ready
+	for _, r := range replicas {
+		if r.GetRevision() == status.UpdateRevision && !replicaIsReady(r) {
+			log.V(1).Info("Waiting for updated replica to be ready", "replica", r.GetObjectKey())
+			a.infoEventf(managedSeedSet, EventWaitingForSeedReady, gardencorev1beta1.EventActionReconcile, "Waiting for Seed %s to be ready", r.GetName())
+			updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.SeedNotReadyReason, nil)
+			return true, nil
+		}
+	}
+
+	updateStrategy := ptr.Deref(managedSeedSet.Spec.UpdateStrategy, seedmanagementv1alpha1.UpdateStrategy{})
+	switch ptr.Deref(updateStrategy.Type, seedmanagementv1alpha1.RollingUpdateStrategyType) {
+	case seedmanagementv1alpha1.RollingUpdateStrategyType:
+		// Only update replicas with an ordinal greater than or equal to the partition
+		var partition int32
+		if updateStrategy.RollingUpdate != nil {
+			partition = ptr.Deref(updateStrategy.RollingUpdate.Partition, 0)
+		}
+		if outdatedReplicas[0].GetOrdinal() < partition {
+			return false, nil
+		}
+
+	case seedmanagementv1alpha1.CanaryUpdateStrategyType:
+		// If the update revision differs from the current revision, update a single replica (the canary) first and
+		// only continue with the remaining replicas once the canary has succeeded
+		if status.CurrentRevision != status.UpdateRevision {
+			if status.Canary == nil || status.Canary.Revision != status.UpdateRevision {
+				return true, a.startCanary(ctx, log, managedSeedSet, status, outdatedReplicas[0])
+			}
+			if status.Canary.Phase != seedmanagementv1alpha1.CanaryPhaseSucceeded {
+				log.V(1).Info("Waiting for canary", "canary", status.Canary.Replica, "phase", status.Canary.Phase)
+				return true, nil
+			}
+		}
+	}
+
+	return true, a.updateReplica(ctx, log, managedSeedSet, status, outdatedReplicas[0], &managedSeedSet.Spec.Template, status.UpdateRevision)
+}
+
+func (a *actuator) updateReplica(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	r Replica,
+	template *seedmanagementv1alpha1.ManagedSeedTemplate,
+	revision string,
+) error {
+	log.Info("Updating ManagedSeed", "replica", r.GetObjectKey(), "revision", revision)
+	a.infoEventf(managedSeedSet, EventUpdatingManagedSeed, gardencorev1beta1.EventActionReconcile, "Updating ManagedSeed %s to revision %s", r.GetFullName(), revision)
+	if err := r.UpdateManagedSeed(ctx, a.gardenClient, template, revision); err != nil {
+		return err
+	}
+	updatePendingReplica(status, r.GetName(), seedmanagementv1alpha1.ManagedSeedPreparingReason, nil)
+	return nil
+}
+
 func (a *actuator) infoEventf(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, reason, action, fmt string, args ...any) {
 	a.recorder.Eventf(managedSeedSet, nil, corev1.EventTypeNormal, reason, action, fmt, args...)
 }
@@ -390,6 +499,19 @@ func getNextOrdinal(replicas []Replica, status *seedmanagementv1alpha1.ManagedSe
 	return status.NextReplicaNumber
 }
 
+func countRevisions(replicas []Replica, status *seedmanagementv1alpha1.ManagedSeedSetStatus) (int32, int32) {
+	var currentReplicas, updatedReplicas int32
+	for _, r := range replicas {
+		if revision := r.GetRevision(); revision != "" && revision == status.CurrentRevision {
+			currentReplicas++
+		}
+		if revision := r.GetRevision(); revision != "" && revision == status.UpdateRevision {
+			updatedReplicas++
+		}
+	}
+	return currentReplicas, updatedReplicas
+}
+
 func replicaIsReady(r Replica) bool {
 	return r.GetStatus() == StatusManagedSeedRegistered && r.IsSeedReady() && r.GetShootHealthStatus() == gardenerutils.ShootStatusHealthy
 }
@@ -6,12 +6,16 @@ package managedseedset_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -55,6 +59,9 @@ var _ = Describe("Actuator", func() {
 		before  = metav1.Now()
 		now     = metav1.Now()
 		cleanup func()
+
+		// The revision of the empty template of the test ManagedSeedSet, computing it cannot fail
+		revision, _ = ComputeRevision(&seedmanagementv1alpha1.ManagedSeedSet{ObjectMeta: metav1.ObjectMeta{Name: name}})
 	)
 
 	BeforeEach(func() {
@@ -112,6 +119,8 @@ var _ = Describe("Actuator", func() {
 				Status: seedmanagementv1alpha1.ManagedSeedSetStatus{
 					Replicas:          1,
 					NextReplicaNumber: nextReplicaNumber,
+					CurrentRevision:   revision,
+					UpdateRevision:    revision,
 					PendingReplica:    pendingReplica,
 				},
 			}
@@ -137,11 +146,15 @@ var _ = Describe("Actuator", func() {
 				Replicas:           replicas,
 				ReadyReplicas:      readyReplicas,
 				NextReplicaNumber:  nextReplicaNumber,
+				CurrentReplicas:    1,
+				UpdatedReplicas:    1,
+				CurrentRevision:    revision,
+				UpdateRevision:     revision,
 				PendingReplica:     pendingReplica,
 			}
 		}
 
-		expectReplica = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, seedReady bool, shootStatus gardenerutils.ShootStatus, deletable bool) {
+		expectReplicaWithRevision = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, seedReady bool, shootStatus gardenerutils.ShootStatus, deletable bool, revision string) {
 			r.EXPECT().GetName().Return(getReplicaName(ordinal)).AnyTimes()
 			r.EXPECT().GetFullName().Return(getReplicaFullName(ordinal)).AnyTimes()
 			r.EXPECT().GetObjectKey().Return(getReplicaObjectKey(ordinal)).AnyTimes()
@@ -150,6 +163,10 @@ var _ = Describe("Actuator", func() {
 			r.EXPECT().IsSeedReady().Return(seedReady).AnyTimes()
 			r.EXPECT().GetShootHealthStatus().Return(shootStatus).AnyTimes()
 			r.EXPECT().IsDeletable().Return(deletable).AnyTimes()
+			r.EXPECT().GetRevision().Return(revision).AnyTimes()
+		}
+		expectReplica = func(r *mockmanagedseedset.MockReplica, ordinal int32, status ReplicaStatus, seedReady bool, shootStatus gardenerutils.ShootStatus, deletable bool) {
+			expectReplicaWithRevision(r, ordinal, status, seedReady, shootStatus, deletable, revision)
 		}
 	)
 
@@ -529,6 +546,267 @@ var _ = Describe("Actuator", func() {
 			),
 		)
 	})
+
+	Context("updating", func() {
+		const oldRevision = name + "-old"
+
+		var (
+			r1 *mockmanagedseedset.MockReplica
+
+			updatingManagedSeedSet = func(currentRevision string, updateStrategy *seedmanagementv1alpha1.UpdateStrategy, canary *seedmanagementv1alpha1.CanaryStatus) *seedmanagementv1alpha1.ManagedSeedSet {
+				managedSeedSet := managedSeedSet(2, 2, "", "", nil)
+				managedSeedSet.Spec.UpdateStrategy = updateStrategy
+				managedSeedSet.Status.Replicas = 2
+				managedSeedSet.Status.CurrentRevision = currentRevision
+				managedSeedSet.Status.Canary = canary
+				return managedSeedSet
+			}
+			canaryUpdateStrategy = func(failurePolicy seedmanagementv1alpha1.CanaryFailurePolicy) *seedmanagementv1alpha1.UpdateStrategy {
+				return &seedmanagementv1alpha1.UpdateStrategy{
+					Type: ptr.To(seedmanagementv1alpha1.CanaryUpdateStrategyType),
+					Canary: &seedmanagementv1alpha1.CanaryUpdateStrategy{
+						BakeTime:           &metav1.Duration{Duration: time.Hour},
+						MaxUnhealthyShoots: ptr.To[int32](0),
+						FailurePolicy:      ptr.To(failurePolicy),
+					},
+				}
+			}
+			expectEvent = func(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, eventType, reason string) {
+				recorder.EXPECT().Eventf(managedSeedSet, nil, eventType, reason, gardencorev1beta1.EventActionReconcile, gomock.Any(), gomock.Any())
+			}
+		)
+
+		BeforeEach(func() {
+			r1 = mockmanagedseedset.NewMockReplica(ctrl)
+		})
+
+		It("should create a revision for a new template and adopt unlabeled replicas without updating them", func() {
+			managedSeedSet := managedSeedSet(1, 1, "", "", nil)
+			managedSeedSet.Status.CurrentRevision = ""
+			managedSeedSet.Status.UpdateRevision = ""
+			replicaRevision := ""
+			r0.EXPECT().GetRevision().DoAndReturn(func() string { return replicaRevision }).AnyTimes()
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, "")
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0}, nil)
+
+			gc.EXPECT().List(ctx, gomock.AssignableToTypeOf(&appsv1.ControllerRevisionList{}), gomock.Any()).Return(nil)
+			gc.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&appsv1.ControllerRevision{})).DoAndReturn(
+				func(_ context.Context, controllerRevision *appsv1.ControllerRevision, _ ...client.CreateOption) error {
+					Expect(controllerRevision.Name).To(Equal(revision))
+					Expect(controllerRevision.Revision).To(Equal(int64(1)))
+					Expect(metav1.IsControlledBy(controllerRevision, managedSeedSet)).To(BeTrue())
+					return nil
+				},
+			)
+			r0.EXPECT().AdoptManagedSeed(ctx, gc, revision).DoAndReturn(func(_ context.Context, _ client.Client, rev string) error {
+				replicaRevision = rev
+				return nil
+			})
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.CurrentRevision).To(Equal(revision))
+			Expect(s.UpdateRevision).To(Equal(revision))
+			Expect(s.PendingReplica).To(BeNil())
+		})
+
+		It("should not update further replicas while an updated replica is not ready", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, nil, nil)
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplicaWithRevision(r1, 1, StatusManagedSeedRegistered, false, gardenerutils.ShootStatusHealthy, true, revision)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventWaitingForSeedReady)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.PendingReplica).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Name":   Equal(getReplicaName(1)),
+				"Reason": Equal(seedmanagementv1alpha1.SeedNotReadyReason),
+			})))
+		})
+
+		It("should update the replica with the highest ordinal that is not below the partition", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, &seedmanagementv1alpha1.UpdateStrategy{
+				Type:          ptr.To(seedmanagementv1alpha1.RollingUpdateStrategyType),
+				RollingUpdate: &seedmanagementv1alpha1.RollingUpdateStrategy{Partition: ptr.To[int32](1)},
+			}, nil)
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplicaWithRevision(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			r1.EXPECT().UpdateManagedSeed(ctx, gc, &managedSeedSet.Spec.Template, revision).Return(nil)
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventUpdatingManagedSeed)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.CurrentReplicas).To(Equal(int32(2)))
+			Expect(s.UpdatedReplicas).To(BeZero())
+			Expect(s.PendingReplica.Name).To(Equal(getReplicaName(1)))
+		})
+
+		It("should not update replicas below the partition", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, &seedmanagementv1alpha1.UpdateStrategy{
+				Type:          ptr.To(seedmanagementv1alpha1.RollingUpdateStrategyType),
+				RollingUpdate: &seedmanagementv1alpha1.RollingUpdateStrategy{Partition: ptr.To[int32](1)},
+			}, nil)
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.CurrentRevision).To(Equal(oldRevision))
+			Expect(s.PendingReplica).To(BeNil())
+		})
+
+		It("should make the update revision the current revision once all replicas are updated", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, nil, nil)
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.CurrentRevision).To(Equal(revision))
+			Expect(s.CurrentReplicas).To(Equal(int32(2)))
+			Expect(s.UpdatedReplicas).To(Equal(int32(2)))
+		})
+
+		It("should start a canary if the update strategy is Canary", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, canaryUpdateStrategy(seedmanagementv1alpha1.CanaryFailurePolicyPause), nil)
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplicaWithRevision(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			r1.EXPECT().UpdateManagedSeed(ctx, gc, &managedSeedSet.Spec.Template, revision).Return(nil)
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventUpdatingManagedSeed)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Canary).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Replica":        Equal(getReplicaName(1)),
+				"Revision":       Equal(revision),
+				"Phase":          Equal(seedmanagementv1alpha1.CanaryPhaseProgressing),
+				"LastUpdateTime": Equal(now),
+			})))
+		})
+
+		It("should start baking once the canary is ready and not update further replicas", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, canaryUpdateStrategy(seedmanagementv1alpha1.CanaryFailurePolicyPause), &seedmanagementv1alpha1.CanaryStatus{
+				Replica:        getReplicaName(1),
+				Revision:       revision,
+				Phase:          seedmanagementv1alpha1.CanaryPhaseProgressing,
+				LastUpdateTime: before,
+			})
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventCanaryBaking)
+
+			s, removeFinalizer, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removeFinalizer).To(BeFalse())
+			Expect(s.Canary.Phase).To(Equal(seedmanagementv1alpha1.CanaryPhaseBaking))
+			Expect(s.Canary.BakeStartTime).To(PointTo(Equal(now)))
+		})
+
+		It("should pause the update if the canary does not become ready within the bake time", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, canaryUpdateStrategy(seedmanagementv1alpha1.CanaryFailurePolicyPause), &seedmanagementv1alpha1.CanaryStatus{
+				Replica:        getReplicaName(1),
+				Revision:       revision,
+				Phase:          seedmanagementv1alpha1.CanaryPhaseProgressing,
+				LastUpdateTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+			})
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, false, gardenerutils.ShootStatusHealthy, true)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			expectEvent(managedSeedSet, corev1.EventTypeWarning, EventCanaryFailed)
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventWaitingForSeedReady)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Canary.Phase).To(Equal(seedmanagementv1alpha1.CanaryPhasePaused))
+			Expect(s.Canary.Message).To(ContainSubstring("did not become ready within 1h0m0s"))
+		})
+
+		It("should roll back the canary if shoots on its seed become unhealthy", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, canaryUpdateStrategy(seedmanagementv1alpha1.CanaryFailurePolicyRollback), &seedmanagementv1alpha1.CanaryStatus{
+				Replica:        getReplicaName(1),
+				Revision:       revision,
+				Phase:          seedmanagementv1alpha1.CanaryPhaseBaking,
+				LastUpdateTime: metav1.NewTime(now.Add(-20 * time.Minute)),
+				BakeStartTime:  ptr.To(metav1.NewTime(now.Add(-10 * time.Minute))),
+			})
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			gc.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.MatchingFields{"spec.seedName": getReplicaName(1)}).DoAndReturn(
+				func(_ context.Context, list *gardencorev1beta1.ShootList, _ ...client.ListOption) error {
+					list.Items = []gardencorev1beta1.Shoot{
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "healthy", Namespace: "garden-foo"},
+							Status: gardencorev1beta1.ShootStatus{Conditions: []gardencorev1beta1.Condition{
+								{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionFalse, LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+							}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{Name: "unhealthy", Namespace: "garden-foo"},
+							Status: gardencorev1beta1.ShootStatus{Conditions: []gardencorev1beta1.Condition{
+								{Type: gardencorev1beta1.ShootControlPlaneHealthy, Status: gardencorev1beta1.ConditionFalse, LastTransitionTime: metav1.NewTime(now.Add(-5 * time.Minute))},
+							}},
+						},
+					}
+					return nil
+				},
+			)
+			oldTemplate := seedmanagementv1alpha1.ManagedSeedTemplate{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}}}
+			gc.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: oldRevision}, gomock.AssignableToTypeOf(&appsv1.ControllerRevision{})).DoAndReturn(
+				func(_ context.Context, _ client.ObjectKey, controllerRevision *appsv1.ControllerRevision, _ ...client.GetOption) error {
+					data, err := json.Marshal(oldTemplate)
+					Expect(err).NotTo(HaveOccurred())
+					controllerRevision.Data.Raw = data
+					return nil
+				},
+			)
+			r1.EXPECT().UpdateManagedSeed(ctx, gc, &oldTemplate, oldRevision).Return(nil)
+			expectEvent(managedSeedSet, corev1.EventTypeWarning, EventCanaryFailed)
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventUpdatingManagedSeed)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Canary.Phase).To(Equal(seedmanagementv1alpha1.CanaryPhaseRolledBack))
+			Expect(s.Canary.Message).To(ContainSubstring("garden-foo/unhealthy"))
+			Expect(s.Canary.Message).NotTo(ContainSubstring("garden-foo/healthy"))
+			Expect(s.PendingReplica.Name).To(Equal(getReplicaName(1)))
+		})
+
+		It("should roll out the update to the remaining replicas once the canary has succeeded", func() {
+			managedSeedSet := updatingManagedSeedSet(oldRevision, canaryUpdateStrategy(seedmanagementv1alpha1.CanaryFailurePolicyPause), &seedmanagementv1alpha1.CanaryStatus{
+				Replica:        getReplicaName(1),
+				Revision:       revision,
+				Phase:          seedmanagementv1alpha1.CanaryPhaseBaking,
+				LastUpdateTime: metav1.NewTime(now.Add(-2 * time.Hour)),
+				BakeStartTime:  ptr.To(metav1.NewTime(now.Add(-time.Hour))),
+			})
+			expectReplicaWithRevision(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true, oldRevision)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+
+			gc.EXPECT().List(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.ShootList{}), client.MatchingFields{"spec.seedName": getReplicaName(1)}).Return(nil)
+			r0.EXPECT().UpdateManagedSeed(ctx, gc, &managedSeedSet.Spec.Template, revision).Return(nil)
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventCanarySucceeded)
+			expectEvent(managedSeedSet, corev1.EventTypeNormal, EventUpdatingManagedSeed)
+
+			s, _, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Canary.Phase).To(Equal(seedmanagementv1alpha1.CanaryPhaseSucceeded))
+			Expect(s.PendingReplica.Name).To(Equal(getReplicaName(0)))
+		})
+	})
 })
 
 func getReplicaName(ordinal int32) string {
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseedset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+)
+
+// canaryHealthCheckInterval is the interval in which the health of a progressing or baking canary is checked.
+const canaryHealthCheckInterval = time.Minute
+
+func (a *actuator) startCanary(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	r Replica,
+) error {
+	log.Info("Starting canary", "replica", r.GetObjectKey(), "revision", status.UpdateRevision)
+	if err := a.updateReplica(ctx, log, managedSeedSet, status, r, &managedSeedSet.Spec.Template, status.UpdateRevision); err != nil {
+		return err
+	}
+	status.Canary = &seedmanagementv1alpha1.CanaryStatus{
+		Replica:        r.GetName(),
+		Revision:       status.UpdateRevision,
+		Phase:          seedmanagementv1alpha1.CanaryPhaseProgressing,
+		LastUpdateTime: Now(),
+		Message:        fmt.Sprintf("Waiting for canary %s to become ready", r.GetName()),
+	}
+	return nil
+}
+
+func (a *actuator) reconcileCanary(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	replicas []Replica,
+) error {
+	canaryStrategy := getCanaryUpdateStrategy(managedSeedSet)
+	if canaryStrategy == nil || status.Canary == nil {
+		return nil
+	}
+
+	canary := status.Canary
+	r := getReplica(replicas, canary.Replica)
+	if r == nil {
+		// The canary replica is gone, a new canary will be chosen if the update has not been rolled out yet
+		if canary.Phase != seedmanagementv1alpha1.CanaryPhaseSucceeded {
+			log.Info("Canary replica not found, resetting canary", "canary", canary.Replica)
+			status.Canary = nil
+		}
+		return nil
+	}
+	log = log.WithValues("canary", r.GetObjectKey())
+
+	if canary.Revision != status.UpdateRevision {
+		// The template has been changed while the update was paused. The paused canary most likely doesn't become ready
+		// anymore and would block the rollout of the fix, hence it is updated right away
+		if canary.Phase == seedmanagementv1alpha1.CanaryPhasePaused && r.GetRevision() != status.UpdateRevision {
+			if status.CurrentRevision == status.UpdateRevision {
+				status.Canary = nil
+				return a.updateReplica(ctx, log, managedSeedSet, status, r, &managedSeedSet.Spec.Template, status.UpdateRevision)
+			}
+			return a.startCanary(ctx, log, managedSeedSet, status, r)
+		}
+		return nil
+	}
+
+	bakeTime := getBakeTime(canaryStrategy)
+
+	switch canary.Phase {
+	case seedmanagementv1alpha1.CanaryPhaseProgressing:
+		if r.GetRevision() == canary.Revision && replicaIsReady(r) {
+			log.Info("Canary is ready, starting to bake", "bakeTime", bakeTime)
+			a.infoEventf(managedSeedSet, EventCanaryBaking, gardencorev1beta1.EventActionReconcile, "Canary %s is ready, observing its health for %s", r.GetFullName(), bakeTime)
+			canary.Phase = seedmanagementv1alpha1.CanaryPhaseBaking
+			canary.BakeStartTime = ptr.To(Now())
+			canary.Message = fmt.Sprintf("Observing health of canary %s", r.GetName())
+			return nil
+		}
+
+		if Now().Sub(canary.LastUpdateTime.Time) > bakeTime {
+			return a.failCanary(ctx, log, managedSeedSet, status, r, canaryStrategy, fmt.Sprintf("Canary %s did not become ready within %s", r.GetName(), bakeTime))
+		}
+
+	case seedmanagementv1alpha1.CanaryPhaseBaking:
+		message, err := a.checkCanaryHealth(ctx, canary, r, canaryStrategy)
+		if err != nil {
+			return err
+		}
+		if message != "" {
+			return a.failCanary(ctx, log, managedSeedSet, status, r, canaryStrategy, message)
+		}
+
+		if canary.BakeStartTime == nil || Now().Sub(canary.BakeStartTime.Time) >= bakeTime {
+			log.Info("Canary succeeded")
+			a.infoEventf(managedSeedSet, EventCanarySucceeded, gardencorev1beta1.EventActionReconcile, "Canary %s stayed healthy for %s, rolling out revision %s", r.GetFullName(), bakeTime, canary.Revision)
+			canary.Phase = seedmanagementv1alpha1.CanaryPhaseSucceeded
+			canary.Message = fmt.Sprintf("Canary %s stayed healthy for %s", r.GetName(), bakeTime)
+		}
+	}
+
+	return nil
+}
+
+func (a *actuator) checkCanaryHealth(
+	ctx context.Context,
+	canary *seedmanagementv1alpha1.CanaryStatus,
+	r Replica,
+	canaryStrategy *seedmanagementv1alpha1.CanaryUpdateStrategy,
+) (string, error) {
+	if !r.IsSeedReady() {
+		return fmt.Sprintf("Seed %s of canary is not ready", r.GetName()), nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := a.gardenClient.List(ctx, shootList, client.MatchingFields{gardencore.ShootSeedName: r.GetName()}); err != nil {
+		return "", err
+	}
+
+	var unhealthyShoots []string
+	for _, shoot := range shootList.Items {
+		if shootDegradedSince(&shoot, canary.LastUpdateTime.Time) {
+			unhealthyShoots = append(unhealthyShoots, client.ObjectKeyFromObject(&shoot).String())
+		}
+	}
+
+	if maxUnhealthyShoots := int(ptr.Deref(canaryStrategy.MaxUnhealthyShoots, 0)); len(unhealthyShoots) > maxUnhealthyShoots {
+		return fmt.Sprintf("%d Shoot(s) scheduled on Seed %s of canary became unhealthy (max %d): %s", len(unhealthyShoots), r.GetName(), maxUnhealthyShoots, strings.Join(unhealthyShoots, ", ")), nil
+	}
+
+	return "", nil
+}
+
+func (a *actuator) failCanary(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	r Replica,
+	canaryStrategy *seedmanagementv1alpha1.CanaryUpdateStrategy,
+	message string,
+) error {
+	canary := status.Canary
+
+	if ptr.Deref(canaryStrategy.FailurePolicy, seedmanagementv1alpha1.CanaryFailurePolicyPause) == seedmanagementv1alpha1.CanaryFailurePolicyRollback {
+		log.Info("Canary failed, rolling back", "reason", message, "revision", status.CurrentRevision)
+		a.errorEventf(managedSeedSet, EventCanaryFailed, gardencorev1beta1.EventActionReconcile, "%s, rolling back canary %s to revision %s", message, r.GetFullName(), status.CurrentRevision)
+
+		template, err := a.getRevisionTemplate(ctx, managedSeedSet, status.CurrentRevision)
+		if err != nil {
+			return fmt.Errorf("could not get template of revision %s for rolling back canary: %w", status.CurrentRevision, err)
+		}
+		if err := a.updateReplica(ctx, log, managedSeedSet, status, r, template, status.CurrentRevision); err != nil {
+			return err
+		}
+
+		canary.Phase = seedmanagementv1alpha1.CanaryPhaseRolledBack
+		canary.Message = fmt.Sprintf("%s, rolled back to revision %s", message, status.CurrentRevision)
+		return nil
+	}
+
+	log.Info("Canary failed, pausing update", "reason", message)
+	a.errorEventf(managedSeedSet, EventCanaryFailed, gardencorev1beta1.EventActionReconcile, "%s, pausing update to revision %s", message, canary.Revision)
+	canary.Phase = seedmanagementv1alpha1.CanaryPhasePaused
+	canary.Message = fmt.Sprintf("%s, update paused", message)
+	return nil
+}
+
+// shootDegradedSince returns true if any condition of the given shoot changed to False or Unknown after the given time.
+func shootDegradedSince(shoot *gardencorev1beta1.Shoot, since time.Time) bool {
+	for _, condition := range shoot.Status.Conditions {
+		if (condition.Status == gardencorev1beta1.ConditionFalse || condition.Status == gardencorev1beta1.ConditionUnknown) &&
+			condition.LastTransitionTime.After(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// getCanaryUpdateStrategy returns the canary update strategy of the given set if its update strategy type is Canary,
+// nil otherwise.
+func getCanaryUpdateStrategy(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) *seedmanagementv1alpha1.CanaryUpdateStrategy {
+	updateStrategy := managedSeedSet.Spec.UpdateStrategy
+	if updateStrategy == nil || ptr.Deref(updateStrategy.Type, "") != seedmanagementv1alpha1.CanaryUpdateStrategyType {
+		return nil
+	}
+	return ptr.To(ptr.Deref(updateStrategy.Canary, seedmanagementv1alpha1.CanaryUpdateStrategy{}))
+}
+
+func getBakeTime(canaryStrategy *seedmanagementv1alpha1.CanaryUpdateStrategy) time.Duration {
+	if canaryStrategy.BakeTime == nil {
+		return time.Hour
+	}
+	return canaryStrategy.BakeTime.Duration
+}
+
+// getCanaryRequeueAfter returns the duration after which the given set should be reconciled again to check the health
+// of its canary. If there is no progressing or baking canary, the given sync period is returned.
+func getCanaryRequeueAfter(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, status *seedmanagementv1alpha1.ManagedSeedSetStatus, syncPeriod time.Duration) time.Duration {
+	canaryStrategy := getCanaryUpdateStrategy(managedSeedSet)
+	if canaryStrategy == nil || status == nil || status.Canary == nil {
+		return syncPeriod
+	}
+
+	var deadline time.Time
+	switch status.Canary.Phase {
+	case seedmanagementv1alpha1.CanaryPhaseProgressing:
+		deadline = status.Canary.LastUpdateTime.Add(getBakeTime(canaryStrategy))
+	case seedmanagementv1alpha1.CanaryPhaseBaking:
+		if status.Canary.BakeStartTime == nil {
+			return syncPeriod
+		}
+		deadline = status.Canary.BakeStartTime.Add(getBakeTime(canaryStrategy))
+	default:
+		return syncPeriod
+	}
+
+	requeueAfter := min(syncPeriod, canaryHealthCheckInterval, max(deadline.Sub(Now().Time), 0))
+	if requeueAfter <= 0 {
+		// Reconcile again shortly after the deadline has passed
+		return time.Second
+	}
+	return requeueAfter
+}
+
+func getReplica(replicas []Replica, name string) Replica {
+	for _, r := range replicas {
+		if r.GetName() == name {
+			return r
+		}
+	}
+	return nil
+}
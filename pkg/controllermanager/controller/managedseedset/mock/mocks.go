@@ -86,6 +86,20 @@ func (m *MockReplica) EXPECT() *MockReplicaMockRecorder {
 	return m.recorder
 }
 
+// AdoptManagedSeed mocks base method.
+func (m *MockReplica) AdoptManagedSeed(ctx context.Context, c client.Client, revision string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptManagedSeed", ctx, c, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdoptManagedSeed indicates an expected call of AdoptManagedSeed.
+func (mr *MockReplicaMockRecorder) AdoptManagedSeed(ctx, c, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptManagedSeed", reflect.TypeOf((*MockReplica)(nil).AdoptManagedSeed), ctx, c, revision)
+}
+
 // CreateManagedSeed mocks base method.
 func (m *MockReplica) CreateManagedSeed(ctx context.Context, c client.Client) error {
 	m.ctrl.T.Helper()
@@ -198,6 +212,20 @@ func (mr *MockReplicaMockRecorder) GetOrdinal() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdinal", reflect.TypeOf((*MockReplica)(nil).GetOrdinal))
 }
 
+// GetRevision mocks base method.
+func (m *MockReplica) GetRevision() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockReplicaMockRecorder) GetRevision() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockReplica)(nil).GetRevision))
+}
+
 // GetShootHealthStatus mocks base method.
 func (m *MockReplica) GetShootHealthStatus() gardener.ShootStatus {
 	m.ctrl.T.Helper()
@@ -268,6 +296,20 @@ func (mr *MockReplicaMockRecorder) RetryShoot(ctx, c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryShoot", reflect.TypeOf((*MockReplica)(nil).RetryShoot), ctx, c)
 }
 
+// UpdateManagedSeed mocks base method.
+func (m *MockReplica) UpdateManagedSeed(ctx context.Context, c client.Client, template *v1alpha1.ManagedSeedTemplate, revision string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManagedSeed", ctx, c, template, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateManagedSeed indicates an expected call of UpdateManagedSeed.
+func (mr *MockReplicaMockRecorder) UpdateManagedSeed(ctx, c, template, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManagedSeed", reflect.TypeOf((*MockReplica)(nil).UpdateManagedSeed), ctx, c, template, revision)
+}
+
 // MockReplicaFactory is a mock of ReplicaFactory interface.
 type MockReplicaFactory struct {
 	ctrl     *gomock.Controller
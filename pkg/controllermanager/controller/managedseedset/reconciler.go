@@ -71,8 +71,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, managedSeed
 	}
 	log.V(1).Info("Creation or update reconciled")
 
-	// Return success result
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	// Return success result, requeue earlier if the health of a canary needs to be checked
+	return reconcile.Result{RequeueAfter: getCanaryRequeueAfter(managedSeedSet, status, r.Config.SyncPeriod.Duration)}, nil
 }
 
 func (r *Reconciler) delete(ctx context.Context, log logr.Logger, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) (result reconcile.Result, err error) {
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -91,6 +93,9 @@ type Replica interface {
 	IsSeedReady() bool
 	// GetShootHealthStatus returns this replica's shoot health status (healthy, progressing, or unhealthy).
 	GetShootHealthStatus() gardenerutils.ShootStatus
+	// GetRevision returns the revision of the template this replica's managed seed has been created or updated from.
+	// If the replica's managed seed doesn't exist or has no revision, an empty string is returned.
+	GetRevision() string
 	// IsDeletable returns true if this replica can be deleted, false otherwise. A replica can be deleted if it has no
 	// scheduled shoots and is not protected by the "protect-from-deletion" annotation.
 	IsDeletable() bool
@@ -98,6 +103,11 @@ type Replica interface {
 	CreateShoot(ctx context.Context, c client.Client, ordinal int32) error
 	// CreateManagedSeed initializes this replica's managed seed, and then creates it using the given context and client.
 	CreateManagedSeed(ctx context.Context, c client.Client) error
+	// AdoptManagedSeed labels this replica's managed seed with the given revision without changing its spec using the
+	// given context and client.
+	AdoptManagedSeed(ctx context.Context, c client.Client, revision string) error
+	// UpdateManagedSeed updates this replica's managed seed to the given template and revision using the given context and client.
+	UpdateManagedSeed(ctx context.Context, c client.Client, template *seedmanagementv1alpha1.ManagedSeedTemplate, revision string) error
 	// DeleteShoot deletes this replica's shoot using the given context and client.
 	DeleteShoot(ctx context.Context, c client.Client) error
 	// DeleteManagedSeed deletes this replica's managed seed using the given context and client.
@@ -230,6 +240,15 @@ func (r *replica) GetShootHealthStatus() gardenerutils.ShootStatus {
 	return shootHealthStatus(r.shoot)
 }
 
+// GetRevision returns the revision of the template this replica's managed seed has been created or updated from.
+// If the replica's managed seed doesn't exist or has no revision, an empty string is returned.
+func (r *replica) GetRevision() string {
+	if r.managedSeed == nil {
+		return ""
+	}
+	return r.managedSeed.Labels[appsv1.ControllerRevisionHashLabelKey]
+}
+
 // IsDeletable returns true if this replica can be deleted, false otherwise. A replica can be deleted if it has no
 // scheduled shoots and is not protected by the "protect-from-deletion" annotation.
 func (r *replica) IsDeletable() bool {
@@ -259,6 +278,29 @@ func (r *replica) CreateManagedSeed(ctx context.Context, c client.Client) error
 	return nil
 }
 
+// AdoptManagedSeed labels this replica's managed seed with the given revision without changing its spec using the
+// given context and client.
+func (r *replica) AdoptManagedSeed(ctx context.Context, c client.Client, revision string) error {
+	if r.managedSeed == nil {
+		return nil
+	}
+	patch := client.MergeFrom(r.managedSeed.DeepCopy())
+	r.managedSeed.Labels = utils.MergeStringMaps(r.managedSeed.Labels, map[string]string{appsv1.ControllerRevisionHashLabelKey: revision})
+	return c.Patch(ctx, r.managedSeed, patch)
+}
+
+// UpdateManagedSeed updates this replica's managed seed to the given template and revision using the given context and client.
+func (r *replica) UpdateManagedSeed(ctx context.Context, c client.Client, template *seedmanagementv1alpha1.ManagedSeedTemplate, revision string) error {
+	if r.managedSeed == nil {
+		return nil
+	}
+	patch := client.MergeFrom(r.managedSeed.DeepCopy())
+	r.managedSeed.Labels = utils.MergeStringMaps(r.managedSeed.Labels, template.Labels, map[string]string{appsv1.ControllerRevisionHashLabelKey: revision})
+	r.managedSeed.Annotations = utils.MergeStringMaps(r.managedSeed.Annotations, template.Annotations)
+	r.managedSeed.Spec.Gardenlet = template.Spec.Gardenlet
+	return c.Patch(ctx, r.managedSeed, patch)
+}
+
 // DeleteShoot deletes this replica's shoot using the given context and client.
 func (r *replica) DeleteShoot(ctx context.Context, c client.Client) error {
 	if r.shoot != nil {
@@ -358,12 +400,17 @@ func newShoot(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordinal int
 func newManagedSeed(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, ordinal int32) (*seedmanagementv1alpha1.ManagedSeed, error) {
 	name := getName(managedSeedSet, ordinal)
 
+	revision, err := ComputeRevision(managedSeedSet)
+	if err != nil {
+		return nil, err
+	}
+
 	// Initialize managed seed
 	managedSeed := &seedmanagementv1alpha1.ManagedSeed{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
 			Namespace:   managedSeedSet.Namespace,
-			Labels:      managedSeedSet.Spec.Template.Labels,
+			Labels:      utils.MergeStringMaps(managedSeedSet.Spec.Template.Labels, map[string]string{appsv1.ControllerRevisionHashLabelKey: revision}),
 			Annotations: managedSeedSet.Spec.Template.Annotations,
 			OwnerReferences: []metav1.OwnerReference{
 				*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
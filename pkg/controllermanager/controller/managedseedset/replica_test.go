@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -299,6 +300,21 @@ var _ = Describe("Replica", func() {
 			shoot(nil, "", "", "", false), managedSeed(nil, false, false), true, false),
 	)
 
+	Describe("#GetRevision", func() {
+		It("should return an empty revision if the managed seed doesn't exist", func() {
+			replica := NewReplica(managedSeedSet, shoot(nil, "", "", "", false), nil, nil, false)
+			Expect(replica.GetRevision()).To(BeEmpty())
+		})
+
+		It("should return the revision of the managed seed", func() {
+			managedSeed := managedSeed(nil, true, false)
+			managedSeed.Labels = map[string]string{appsv1.ControllerRevisionHashLabelKey: "test-123"}
+
+			replica := NewReplica(managedSeedSet, shoot(nil, "", "", "", false), managedSeed, nil, false)
+			Expect(replica.GetRevision()).To(Equal("test-123"))
+		})
+	})
+
 	Describe("#CreateShoot", func() {
 		It("should create the shoot", func() {
 			c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&gardencorev1beta1.Shoot{})).DoAndReturn(
@@ -333,6 +349,8 @@ var _ = Describe("Replica", func() {
 	Describe("#CreateManagedSeed", func() {
 		It("should create the managed seed", func() {
 			shoot := shoot(nil, "", "", "", false)
+			revision, err := ComputeRevision(managedSeedSet)
+			Expect(err).NotTo(HaveOccurred())
 			c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&seedmanagementv1alpha1.ManagedSeed{})).DoAndReturn(
 				func(_ context.Context, ms *seedmanagementv1alpha1.ManagedSeed, _ ...client.CreateOption) error {
 					Expect(ms).To(Equal(&seedmanagementv1alpha1.ManagedSeed{
@@ -340,7 +358,8 @@ var _ = Describe("Replica", func() {
 							Name:      replicaName,
 							Namespace: namespace,
 							Labels: map[string]string{
-								"foo": "bar",
+								"foo":                                 "bar",
+								appsv1.ControllerRevisionHashLabelKey: revision,
 							},
 							OwnerReferences: []metav1.OwnerReference{
 								*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
@@ -372,8 +391,43 @@ var _ = Describe("Replica", func() {
 			)
 
 			replica := NewReplica(managedSeedSet, shoot, nil, nil, false)
-			err := replica.CreateManagedSeed(ctx, c)
-			Expect(err).ToNot(HaveOccurred())
+			Expect(replica.CreateManagedSeed(ctx, c)).To(Succeed())
+		})
+	})
+
+	Describe("#UpdateManagedSeed", func() {
+		It("should update the managed seed to the given template and revision", func() {
+			managedSeed := managedSeed(nil, true, true)
+			managedSeed.Labels = map[string]string{appsv1.ControllerRevisionHashLabelKey: "test-old"}
+			template := &seedmanagementv1alpha1.ManagedSeedTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"foo": "bar"},
+					Annotations: map[string]string{"baz": "qux"},
+				},
+				Spec: seedmanagementv1alpha1.ManagedSeedSpec{
+					Gardenlet: seedmanagementv1alpha1.GardenletConfig{
+						Config: runtime.RawExtension{Raw: []byte(`{"apiVersion":"gardenlet.config.gardener.cloud/v1alpha1","kind":"GardenletConfiguration"}`)},
+					},
+				},
+			}
+
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&seedmanagementv1alpha1.ManagedSeed{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, ms *seedmanagementv1alpha1.ManagedSeed, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(ms.Labels).To(Equal(map[string]string{
+						"foo":                                 "bar",
+						appsv1.ControllerRevisionHashLabelKey: "test-new",
+					}))
+					Expect(ms.Annotations).To(Equal(map[string]string{
+						seedmanagementv1alpha1constants.AnnotationProtectFromDeletion: "true",
+						"baz": "qux",
+					}))
+					Expect(ms.Spec.Gardenlet).To(Equal(template.Spec.Gardenlet))
+					return nil
+				},
+			)
+
+			replica := NewReplica(managedSeedSet, shoot(nil, "", "", "", false), managedSeed, nil, false)
+			Expect(replica.UpdateManagedSeed(ctx, c, template, "test-new")).To(Succeed())
 		})
 	})
 
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseedset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// ComputeRevision computes the name of the revision of the given set's ManagedSeed template.
+// The name consists of the set name and a hash of the template and the set's collision count.
+func ComputeRevision(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) (string, error) {
+	data, err := json.Marshal(managedSeedSet.Spec.Template)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal ManagedSeed template: %w", err)
+	}
+	if managedSeedSet.Status.CollisionCount != nil {
+		data = append(data, []byte(strconv.FormatInt(int64(*managedSeedSet.Status.CollisionCount), 10))...)
+	}
+	return fmt.Sprintf("%s-%s", managedSeedSet.Name, utils.ComputeSHA256Hex(data)[:10]), nil
+}
+
+// reconcileRevisions ensures that a ControllerRevision exists for the current ManagedSeed template of the given set,
+// and that the number of ControllerRevisions doesn't exceed the set's revision history limit.
+func (a *actuator) reconcileRevisions(
+	ctx context.Context,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+) error {
+	updateRevision, err := ComputeRevision(managedSeedSet)
+	if err != nil {
+		return err
+	}
+	if updateRevision == status.UpdateRevision {
+		return nil
+	}
+
+	revisions, err := a.getRevisions(ctx, managedSeedSet)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(managedSeedSet.Spec.Template)
+	if err != nil {
+		return fmt.Errorf("could not marshal ManagedSeed template: %w", err)
+	}
+
+	var revisionNumber int64 = 1
+	if len(revisions) > 0 {
+		revisionNumber = revisions[len(revisions)-1].Revision + 1
+	}
+
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      updateRevision,
+			Namespace: managedSeedSet.Namespace,
+			Labels:    utils.MergeStringMaps(managedSeedSet.Spec.Template.Labels),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(managedSeedSet, seedmanagementv1alpha1.SchemeGroupVersion.WithKind("ManagedSeedSet")),
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: revisionNumber,
+	}
+	if err := a.gardenClient.Create(ctx, revision); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+
+		// Check whether the existing revision has been created for the same template, otherwise increment the collision
+		// count so that a different name is computed upon the next reconciliation
+		existing := &appsv1.ControllerRevision{}
+		if err := a.gardenClient.Get(ctx, client.ObjectKeyFromObject(revision), existing); err != nil {
+			return err
+		}
+		if !metav1.IsControlledBy(existing, managedSeedSet) || !bytes.Equal(existing.Data.Raw, data) {
+			status.CollisionCount = ptr.To(ptr.Deref(status.CollisionCount, 0) + 1)
+			return fmt.Errorf("hash collision for ControllerRevision %s", client.ObjectKeyFromObject(revision))
+		}
+	} else {
+		revisions = append(revisions, *revision)
+	}
+
+	// Initialize the current revision if this is the first revision observed for this set
+	if status.CurrentRevision == "" {
+		status.CurrentRevision = updateRevision
+	}
+	status.UpdateRevision = updateRevision
+
+	return a.truncateRevisionHistory(ctx, managedSeedSet, status, revisions)
+}
+
+// adoptReplicas labels the managed seeds of the given replicas that have no revision yet, e.g. because they have been
+// created before revisions were tracked, with the current revision. Their spec is not changed, hence they are only
+// updated in place by a subsequent change of the ManagedSeed template.
+func (a *actuator) adoptReplicas(ctx context.Context, log logr.Logger, status *seedmanagementv1alpha1.ManagedSeedSetStatus, replicas []Replica) error {
+	for _, r := range replicas {
+		if replicaStatus := r.GetStatus(); replicaStatus != StatusManagedSeedPreparing && replicaStatus != StatusManagedSeedRegistered {
+			continue
+		}
+		if r.GetRevision() != "" {
+			continue
+		}
+
+		log.Info("Adopting ManagedSeed as current revision", "replica", r.GetObjectKey(), "revision", status.CurrentRevision)
+		if err := r.AdoptManagedSeed(ctx, a.gardenClient, status.CurrentRevision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getRevisions returns all ControllerRevisions of the given set, sorted by ascending revision number.
+func (a *actuator) getRevisions(ctx context.Context, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) ([]appsv1.ControllerRevision, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&managedSeedSet.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionList := &appsv1.ControllerRevisionList{}
+	if err := a.gardenClient.List(ctx, revisionList, client.InNamespace(managedSeedSet.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	var revisions []appsv1.ControllerRevision
+	for _, revision := range revisionList.Items {
+		if metav1.IsControlledBy(&revision, managedSeedSet) {
+			revisions = append(revisions, revision)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].Revision < revisions[j].Revision })
+
+	return revisions, nil
+}
+
+// truncateRevisionHistory deletes the oldest ControllerRevisions of the given set that exceed its revision history
+// limit. The current and update revisions are never deleted.
+func (a *actuator) truncateRevisionHistory(
+	ctx context.Context,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	status *seedmanagementv1alpha1.ManagedSeedSetStatus,
+	revisions []appsv1.ControllerRevision,
+) error {
+	excess := len(revisions) - int(ptr.Deref(managedSeedSet.Spec.RevisionHistoryLimit, 10))
+	for i := 0; i < len(revisions) && excess > 0; i++ {
+		if name := revisions[i].Name; name == status.CurrentRevision || name == status.UpdateRevision {
+			continue
+		}
+		if err := client.IgnoreNotFound(a.gardenClient.Delete(ctx, &revisions[i])); err != nil {
+			return err
+		}
+		excess--
+	}
+	return nil
+}
+
+// getRevisionTemplate returns the ManagedSeed template stored in the given ControllerRevision of the given set.
+func (a *actuator) getRevisionTemplate(ctx context.Context, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, name string) (*seedmanagementv1alpha1.ManagedSeedTemplate, error) {
+	revision := &appsv1.ControllerRevision{}
+	if err := a.gardenClient.Get(ctx, client.ObjectKey{Namespace: managedSeedSet.Namespace, Name: name}, revision); err != nil {
+		return nil, err
+	}
+
+	template := &seedmanagementv1alpha1.ManagedSeedTemplate{}
+	if err := json.Unmarshal(revision.Data.Raw, template); err != nil {
+		return nil, fmt.Errorf("could not unmarshal ManagedSeed template of ControllerRevision %s: %w", client.ObjectKeyFromObject(revision), err)
+	}
+	return template, nil
+}
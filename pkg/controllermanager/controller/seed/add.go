@@ -12,6 +12,7 @@ import (
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupbucketscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/extensionscheck"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/secrets"
@@ -31,6 +32,12 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding extensions check reconciler: %w", err)
 	}
 
+	if err := (&drain.Reconciler{
+		Config: *cfg.Controllers.SeedDrain,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding drain reconciler: %w", err)
+	}
+
 	if err := (&secrets.Reconciler{}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding secrets reconciler: %w", err)
 	}
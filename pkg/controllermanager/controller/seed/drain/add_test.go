@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#SeedPredicate", func() {
+		var (
+			p    predicate.Predicate
+			seed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).SeedPredicate()
+			seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		})
+
+		It("should return false if the seed is not being drained", func() {
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeFalse())
+		})
+
+		It("should return true if the seed is annotated with the drain operation", func() {
+			seed.Annotations = map[string]string{"gardener.cloud/operation": "drain"}
+
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return true if the seed is annotated with the cancel-drain operation", func() {
+			seed.Annotations = map[string]string{"gardener.cloud/operation": "cancel-drain"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return true if the seed is being drained", func() {
+			seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{Phase: gardencorev1beta1.SeedDrainPhaseDraining}
+
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeTrue())
+		})
+
+		It("should return false if the seed is drained", func() {
+			seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{Phase: gardencorev1beta1.SeedDrainPhaseDrained}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Seeds which are being drained. It requests the rescheduling of their Shoots to other Seeds
+// in batches and reports the progress in the Seed status.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Seeds which are being drained.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	switch seed.Annotations[v1beta1constants.GardenerOperation] {
+	case v1beta1constants.SeedOperationDrain:
+		if err := r.startDrain(ctx, log, seed); err != nil {
+			return reconcile.Result{}, err
+		}
+	case v1beta1constants.SeedOperationCancelDrain:
+		return reconcile.Result{}, r.cancelDrain(ctx, log, seed)
+	}
+
+	if seed.Status.Drain == nil || seed.Status.Drain.Phase != gardencorev1beta1.SeedDrainPhaseDraining {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.drain(ctx, log, seed); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if seed.Status.Drain.Phase == gardencorev1beta1.SeedDrainPhaseDrained {
+		log.Info("Seed is drained, all shoots have been migrated to other seeds")
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) startDrain(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed) error {
+	if seed.Status.Drain == nil || seed.Status.Drain.Phase != gardencorev1beta1.SeedDrainPhaseDraining {
+		log.Info("Starting to drain seed")
+
+		now := metav1.NewTime(r.Clock.Now().UTC())
+		patch := client.MergeFrom(seed.DeepCopy())
+		seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{
+			Phase:          gardencorev1beta1.SeedDrainPhaseDraining,
+			StartTime:      now,
+			LastUpdateTime: now,
+		}
+		if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+			return fmt.Errorf("failed setting drain status: %w", err)
+		}
+	}
+
+	return r.removeOperationAnnotation(ctx, seed)
+}
+
+func (r *Reconciler) cancelDrain(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed) error {
+	if seed.Status.Drain != nil {
+		log.Info("Cancelling drain of seed")
+
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seed.Name}); err != nil {
+			return fmt.Errorf("failed listing shoots scheduled to seed: %w", err)
+		}
+
+		for _, shoot := range shootList.Items {
+			if !metav1.HasAnnotation(shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingReschedule) {
+				continue
+			}
+
+			if err := r.removeRescheduleAnnotations(ctx, &shoot); err != nil {
+				return err
+			}
+		}
+
+		patch := client.MergeFrom(seed.DeepCopy())
+		seed.Status.Drain = nil
+		if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+			return fmt.Errorf("failed removing drain status: %w", err)
+		}
+	}
+
+	return r.removeOperationAnnotation(ctx, seed)
+}
+
+func (r *Reconciler) drain(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootSeedName: seed.Name}); err != nil {
+		return fmt.Errorf("failed listing shoots scheduled to seed: %w", err)
+	}
+
+	migratingShootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, migratingShootList, client.MatchingFields{core.ShootStatusSeedName: seed.Name}); err != nil {
+		return fmt.Errorf("failed listing shoots running on seed: %w", err)
+	}
+
+	var (
+		now                 = r.Clock.Now().UTC()
+		migratingShoots     []string
+		unschedulableShoots = sets.New[string]()
+		candidates          []*gardencorev1beta1.Shoot
+	)
+
+	// Shoots which were found to be unschedulable are not rescheduled again while the drain is running, i.e., they are
+	// only kept as long as they are still scheduled to this seed.
+	previouslyUnschedulableShoots := sets.New(seed.Status.Drain.UnschedulableShoots...)
+
+	for _, shoot := range migratingShootList.Items {
+		if ptr.Deref(shoot.Spec.SeedName, "") != seed.Name {
+			migratingShoots = append(migratingShoots, client.ObjectKeyFromObject(&shoot).String())
+		}
+	}
+
+	for _, shoot := range shootList.Items {
+		key := client.ObjectKeyFromObject(&shoot).String()
+
+		switch {
+		case shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true":
+			requestTime, err := time.Parse(time.RFC3339, shoot.Annotations[v1beta1constants.AnnotationSchedulingRescheduleRequestTime])
+			if err != nil {
+				// The reschedule annotation was not set by this controller, hence the reschedule timeout starts now.
+				patch := client.MergeFrom(shoot.DeepCopy())
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingRescheduleRequestTime, now.Format(time.RFC3339))
+				if err := r.Client.Patch(ctx, &shoot, patch); err != nil {
+					return fmt.Errorf("failed setting reschedule request time of shoot %s: %w", key, err)
+				}
+				requestTime = now
+			}
+
+			if now.Sub(requestTime) < r.Config.RescheduleTimeout.Duration {
+				migratingShoots = append(migratingShoots, key)
+				continue
+			}
+
+			log.Info("Shoot could not be rescheduled within the reschedule timeout, skipping it", "shoot", key, "rescheduleTimeout", r.Config.RescheduleTimeout.Duration)
+			if err := r.removeRescheduleAnnotations(ctx, &shoot); err != nil {
+				return err
+			}
+			unschedulableShoots.Insert(key)
+		case previouslyUnschedulableShoots.Has(key):
+			unschedulableShoots.Insert(key)
+		case shoot.DeletionTimestamp == nil &&
+			ptr.Deref(shoot.Status.SeedName, seed.Name) == seed.Name &&
+			gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(&shoot, r.Clock):
+			candidates = append(candidates, shoot.DeepCopy())
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b *gardencorev1beta1.Shoot) int {
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+
+	for _, shoot := range candidates {
+		if int32(len(migratingShoots)) >= ptr.Deref(r.Config.MaxParallelMigrations, 1) { // #nosec G115 -- Number of shoots does not exceed int32.
+			break
+		}
+
+		log.Info("Requesting rescheduling of shoot", "shoot", client.ObjectKeyFromObject(shoot))
+		patch := client.MergeFrom(shoot.DeepCopy())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingReschedule, "true")
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationSchedulingRescheduleRequestTime, now.Format(time.RFC3339))
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return fmt.Errorf("failed requesting rescheduling of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+		migratingShoots = append(migratingShoots, client.ObjectKeyFromObject(shoot).String())
+	}
+
+	slices.Sort(migratingShoots)
+
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Status.Drain.RemainingShoots = int32(len(shootList.Items)) // #nosec G115 -- Number of shoots does not exceed int32.
+	seed.Status.Drain.MigratingShoots = migratingShoots
+	seed.Status.Drain.UnschedulableShoots = sets.List(unschedulableShoots)
+	seed.Status.Drain.LastUpdateTime = metav1.NewTime(now)
+	if len(shootList.Items) == 0 && len(migratingShoots) == 0 {
+		seed.Status.Drain.Phase = gardencorev1beta1.SeedDrainPhaseDrained
+		seed.Status.Drain.CompletionTime = &seed.Status.Drain.LastUpdateTime
+	}
+
+	if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+		return fmt.Errorf("failed updating drain status: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) removeRescheduleAnnotations(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingReschedule)
+	delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingRescheduleRequestTime)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed removing reschedule annotation from shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+	return nil
+}
+
+func (r *Reconciler) removeOperationAnnotation(ctx context.Context, seed *gardencorev1beta1.Seed) error {
+	patch := client.MergeFrom(seed.DeepCopy())
+	delete(seed.Annotations, v1beta1constants.GardenerOperation)
+	if err := r.Client.Patch(ctx, seed, patch); err != nil {
+		return fmt.Errorf("failed removing operation annotation: %w", err)
+	}
+	return nil
+}
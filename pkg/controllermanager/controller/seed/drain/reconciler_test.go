@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package drain_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/drain"
+)
+
+var _ = Describe("Reconciler", func() {
+	const syncPeriod = time.Minute
+
+	var (
+		ctx = context.TODO()
+
+		c          client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		seed *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "seed",
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: c,
+			Clock:  fakeClock,
+			Config: controllermanagerconfigv1alpha1.SeedDrainControllerConfiguration{
+				SyncPeriod:            &metav1.Duration{Duration: syncPeriod},
+				MaxParallelMigrations: ptr.To[int32](2),
+				RescheduleTimeout:     &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		}
+	})
+
+	newShoot := func(name, seedName string, inMaintenanceWindow bool) *gardencorev1beta1.Shoot {
+		begin, end := "110000+0000", "140000+0000"
+		if !inMaintenanceWindow {
+			begin, end = "000000+0000", "010000+0000"
+		}
+
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "garden-project",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName: ptr.To(seedName),
+				Maintenance: &gardencorev1beta1.Maintenance{
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: begin, End: end},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName: ptr.To(seedName),
+			},
+		}
+	}
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the seed is not being drained", func() {
+		Expect(c.Create(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Drain).To(BeNil())
+	})
+
+	Context("drain operation", func() {
+		BeforeEach(func() {
+			seed.Annotations = map[string]string{"gardener.cloud/operation": "drain"}
+			Expect(c.Create(ctx, seed)).To(Succeed())
+		})
+
+		It("should start draining and complete immediately if the seed has no shoots", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			Expect(seed.Status.Drain.Phase).To(Equal(gardencorev1beta1.SeedDrainPhaseDrained))
+			Expect(seed.Status.Drain.StartTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(seed.Status.Drain.CompletionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(seed.Status.Drain.RemainingShoots).To(BeZero())
+			Expect(seed.Status.Drain.MigratingShoots).To(BeEmpty())
+		})
+
+		It("should request rescheduling of shoots in maintenance window while respecting the max parallel migrations", func() {
+			shoot1 := newShoot("shoot1", seed.Name, true)
+			shoot2 := newShoot("shoot2", seed.Name, false)
+			shoot3 := newShoot("shoot3", seed.Name, true)
+			shoot4 := newShoot("shoot4", seed.Name, true)
+			shoot5 := newShoot("shoot5", "other-seed", true)
+
+			for _, shoot := range []*gardencorev1beta1.Shoot{shoot1, shoot2, shoot3, shoot4, shoot5} {
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			for _, shoot := range []*gardencorev1beta1.Shoot{shoot1, shoot2, shoot3, shoot4, shoot5} {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			}
+			Expect(shoot1.Annotations).To(HaveKeyWithValue("scheduling.gardener.cloud/reschedule", "true"))
+			Expect(shoot1.Annotations).To(HaveKeyWithValue("scheduling.gardener.cloud/reschedule-request-time", "2026-01-01T12:00:00Z"))
+			Expect(shoot2.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule"))
+			Expect(shoot3.Annotations).To(HaveKeyWithValue("scheduling.gardener.cloud/reschedule", "true"))
+			Expect(shoot4.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule"))
+			Expect(shoot5.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule"))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Status.Drain.Phase).To(Equal(gardencorev1beta1.SeedDrainPhaseDraining))
+			Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(4)))
+			Expect(seed.Status.Drain.MigratingShoots).To(ConsistOf("garden-project/shoot1", "garden-project/shoot3"))
+		})
+	})
+
+	Context("seed is being drained", func() {
+		BeforeEach(func() {
+			Expect(c.Create(ctx, seed)).To(Succeed())
+			seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{
+				Phase:     gardencorev1beta1.SeedDrainPhaseDraining,
+				StartTime: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+			}
+			Expect(c.Status().Update(ctx, seed)).To(Succeed())
+		})
+
+		It("should count shoots which are still migrating away from the seed", func() {
+			shoot := newShoot("shoot1", "other-seed", true)
+			shoot.Status.SeedName = ptr.To(seed.Name)
+			Expect(c.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Status.Drain.Phase).To(Equal(gardencorev1beta1.SeedDrainPhaseDraining))
+			Expect(seed.Status.Drain.RemainingShoots).To(BeZero())
+			Expect(seed.Status.Drain.MigratingShoots).To(ConsistOf("garden-project/shoot1"))
+		})
+
+		It("should not request rescheduling of more shoots if the max parallel migrations are in flight", func() {
+			shoot1 := newShoot("shoot1", "other-seed", true)
+			shoot1.Status.SeedName = ptr.To(seed.Name)
+			shoot2 := newShoot("shoot2", seed.Name, true)
+			shoot2.Annotations = map[string]string{"scheduling.gardener.cloud/reschedule": "true"}
+			shoot3 := newShoot("shoot3", seed.Name, true)
+
+			for _, shoot := range []*gardencorev1beta1.Shoot{shoot1, shoot2, shoot3} {
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+			Expect(shoot2.Annotations).To(HaveKeyWithValue("scheduling.gardener.cloud/reschedule-request-time", "2026-01-01T12:00:00Z"))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot3), shoot3)).To(Succeed())
+			Expect(shoot3.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule"))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(2)))
+			Expect(seed.Status.Drain.MigratingShoots).To(ConsistOf("garden-project/shoot1", "garden-project/shoot2"))
+		})
+
+		It("should skip shoots which could not be rescheduled within the reschedule timeout", func() {
+			shoot1 := newShoot("shoot1", seed.Name, true)
+			shoot1.Annotations = map[string]string{
+				"scheduling.gardener.cloud/reschedule":              "true",
+				"scheduling.gardener.cloud/reschedule-request-time": "2026-01-01T11:30:00Z",
+			}
+			shoot2 := newShoot("shoot2", seed.Name, true)
+			shoot2.Annotations = map[string]string{
+				"scheduling.gardener.cloud/reschedule":              "true",
+				"scheduling.gardener.cloud/reschedule-request-time": "2026-01-01T11:31:00Z",
+			}
+			shoot3 := newShoot("shoot3", seed.Name, true)
+
+			for _, shoot := range []*gardencorev1beta1.Shoot{shoot1, shoot2, shoot3} {
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+			}
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			for _, shoot := range []*gardencorev1beta1.Shoot{shoot1, shoot2, shoot3} {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			}
+			Expect(shoot1.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule"))
+			Expect(shoot1.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule-request-time"))
+			Expect(shoot2.Annotations).To(HaveKeyWithValue("scheduling.gardener.cloud/reschedule", "true"))
+			Expect(shoot3.Annotations).To(HaveKeyWithValue("scheduling.gardener.cloud/reschedule", "true"))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Status.Drain.Phase).To(Equal(gardencorev1beta1.SeedDrainPhaseDraining))
+			Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(3)))
+			Expect(seed.Status.Drain.MigratingShoots).To(ConsistOf("garden-project/shoot2", "garden-project/shoot3"))
+			Expect(seed.Status.Drain.UnschedulableShoots).To(ConsistOf("garden-project/shoot1"))
+		})
+
+		It("should not request rescheduling of unschedulable shoots again", func() {
+			shoot := newShoot("shoot1", seed.Name, true)
+			Expect(c.Create(ctx, shoot)).To(Succeed())
+
+			seed.Status.Drain.UnschedulableShoots = []string{"garden-project/shoot1", "garden-project/gone"}
+			Expect(c.Status().Update(ctx, seed)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule"))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Status.Drain.Phase).To(Equal(gardencorev1beta1.SeedDrainPhaseDraining))
+			Expect(seed.Status.Drain.RemainingShoots).To(Equal(int32(1)))
+			Expect(seed.Status.Drain.MigratingShoots).To(BeEmpty())
+			Expect(seed.Status.Drain.UnschedulableShoots).To(ConsistOf("garden-project/shoot1"))
+		})
+
+		It("should cancel the drain", func() {
+			shoot := newShoot("shoot1", seed.Name, true)
+			shoot.Annotations = map[string]string{"scheduling.gardener.cloud/reschedule": "true"}
+			Expect(c.Create(ctx, shoot)).To(Succeed())
+
+			metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "gardener.cloud/operation", "cancel-drain")
+			Expect(c.Update(ctx, seed)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey("scheduling.gardener.cloud/reschedule"))
+
+			Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+			Expect(seed.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			Expect(seed.Status.Drain).To(BeNil())
+		})
+	})
+})
@@ -146,6 +146,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		return err
 	}
 
+	// Kubernetes minor versions are only updated by the maintenance when the current version is expired, hence the update
+	// cannot be refused. However, users should be made aware if the Shoot still requests APIs removed in the new version.
+	upgradeReadinessWarning := computeUpgradeReadinessWarning(shoot, oldShootKubernetesVersion, shootKubernetesVersion)
+	if upgradeReadinessWarning != "" {
+		operations = append(operations, upgradeReadinessWarning)
+	}
+
 	// Set the .spec.kubernetes.kubeAPIServer.oidcConfig.clientAuthentication field to nil, when Shoot cluster is being forcefully updated to K8s >= 1.31.
 	// Gardener forbids setting the field for Shoots with K8s 1.31+. See https://github.com/gardener/gardener/pull/10253
 	{
@@ -470,6 +477,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
+	if upgradeReadinessWarning != "" {
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeWarning, gardencorev1beta1.ShootEventK8sVersionMaintenance, gardencorev1beta1.EventActionReconcile, "Control Plane: %s", upgradeReadinessWarning)
+	}
+
 	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
 	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
 
@@ -708,6 +719,26 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 	}, nil
 }
 
+// computeUpgradeReadinessWarning returns a warning if the Kubernetes minor version of the Shoot is updated although its
+// UpgradeReadiness constraint does not confirm that the Shoot is ready for the new version, i.e., it reports that removed
+// APIs are still used or the readiness could not be determined.
+func computeUpgradeReadinessWarning(shoot *gardencorev1beta1.Shoot, oldVersion, newVersion *semver.Version) string {
+	if newVersion.Major() == oldVersion.Major() && newVersion.Minor() <= oldVersion.Minor() {
+		return ""
+	}
+
+	constraint := v1beta1helper.GetCondition(shoot.Status.Constraints, gardencorev1beta1.ShootUpgradeReadiness)
+	if constraint == nil || constraint.Status == gardencorev1beta1.ConditionTrue {
+		return ""
+	}
+
+	if constraint.Status != gardencorev1beta1.ConditionFalse {
+		return fmt.Sprintf("Kubernetes version was updated to %q although it could not be verified that the Shoot is ready for this update. Reason: %s", newVersion.String(), constraint.Message)
+	}
+
+	return fmt.Sprintf("Kubernetes version was updated to %q although the Shoot is not ready for this update. Reason: %s", newVersion.String(), constraint.Message)
+}
+
 // computeCredentialsToRotationResults starts the credentials rotation if necessary and returns the reason why an update was done
 func computeCredentialsToRotationResults(log logr.Logger, shoot *gardencorev1beta1.Shoot, now metav1.Time) map[string]updateResult {
 	var (
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -1513,6 +1514,53 @@ var _ = Describe("Shoot Maintenance", func() {
 		})
 	})
 
+	Describe("#computeUpgradeReadinessWarning", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Status: gardencorev1beta1.ShootStatus{
+					Constraints: []gardencorev1beta1.Condition{{
+						Type:    gardencorev1beta1.ShootUpgradeReadiness,
+						Status:  gardencorev1beta1.ConditionFalse,
+						Message: "Some APIs requested in your cluster are removed in Kubernetes 1.32: flowschemas.flowcontrol.apiserver.k8s.io/v1beta3.",
+					}},
+				},
+			}
+		})
+
+		It("should return a warning if the minor version is updated and the Shoot is not ready for it", func() {
+			Expect(computeUpgradeReadinessWarning(shoot, semver.MustParse("1.31.9"), semver.MustParse("1.32.3"))).To(Equal(
+				`Kubernetes version was updated to "1.32.3" although the Shoot is not ready for this update. Reason: Some APIs requested in your cluster are removed in Kubernetes 1.32: flowschemas.flowcontrol.apiserver.k8s.io/v1beta3.`,
+			))
+		})
+
+		It("should not return a warning if only the patch version is updated", func() {
+			Expect(computeUpgradeReadinessWarning(shoot, semver.MustParse("1.31.8"), semver.MustParse("1.31.9"))).To(BeEmpty())
+		})
+
+		It("should not return a warning if the Shoot is ready for the update", func() {
+			shoot.Status.Constraints[0].Status = gardencorev1beta1.ConditionTrue
+
+			Expect(computeUpgradeReadinessWarning(shoot, semver.MustParse("1.31.9"), semver.MustParse("1.32.3"))).To(BeEmpty())
+		})
+
+		It("should return a warning if the readiness of the Shoot for the update is unknown", func() {
+			shoot.Status.Constraints[0].Status = gardencorev1beta1.ConditionUnknown
+			shoot.Status.Constraints[0].Message = "Could not query the requested deprecated APIs."
+
+			Expect(computeUpgradeReadinessWarning(shoot, semver.MustParse("1.31.9"), semver.MustParse("1.32.3"))).To(Equal(
+				`Kubernetes version was updated to "1.32.3" although it could not be verified that the Shoot is ready for this update. Reason: Could not query the requested deprecated APIs.`,
+			))
+		})
+
+		It("should not return a warning if the constraint is not present", func() {
+			shoot.Status.Constraints = nil
+
+			Expect(computeUpgradeReadinessWarning(shoot, semver.MustParse("1.31.9"), semver.MustParse("1.32.3"))).To(BeEmpty())
+		})
+	})
+
 	Describe("#computeCredentialsToRotationResults", func() {
 		var shoot *gardencorev1beta1.Shoot
 
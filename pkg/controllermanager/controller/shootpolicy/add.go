@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpolicy
+
+import (
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+const (
+	// ControllerName is the name of the controller for ShootPolicies.
+	ControllerName = "shootpolicy"
+	// ClusterControllerName is the name of the controller for ClusterShootPolicies.
+	ClusterControllerName = "clustershootpolicy"
+)
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	options := controller.Options{
+		MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+	}
+
+	if err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&settingsv1alpha1.ShootPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(options).
+		Complete(reconcile.Func(r.ReconcileShootPolicy)); err != nil {
+		return err
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ClusterControllerName).
+		For(&settingsv1alpha1.ClusterShootPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(options).
+		Complete(reconcile.Func(r.ReconcileClusterShootPolicy))
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpolicy
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/settings/shootpolicy"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+)
+
+// MaxViolations is the maximum number of violating Shoots listed in the status of a policy.
+const MaxViolations = 50
+
+// Reconciler periodically evaluates the existing Shoots against ShootPolicies and ClusterShootPolicies and reports
+// the violating Shoots in the status of the policies.
+type Reconciler struct {
+	Client client.Client
+	Config controllermanagerconfigv1alpha1.ShootPolicyControllerConfiguration
+	Clock  clock.Clock
+}
+
+// ReconcileShootPolicy evaluates the Shoots in the namespace of a ShootPolicy against it.
+func (r *Reconciler) ReconcileShootPolicy(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	policy := &settingsv1alpha1.ShootPolicy{}
+	if err := r.Client.Get(ctx, request.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(policy.Namespace)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing Shoots: %w", err)
+	}
+
+	var violations []settingsv1alpha1.ShootPolicyViolation
+	for _, shoot := range shootList.Items {
+		applicable, err := shootpolicy.ApplicablePolicies(&shoot, nil, []*settingsv1alpha1.ShootPolicy{policy}, nil)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		violation, err := evaluate(&shoot, applicable)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+
+	patch := client.MergeFrom(policy.DeepCopy())
+	r.updateStatus(&policy.Status, policy.Generation, violations)
+	if err := r.Client.Status().Patch(ctx, policy, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status of ShootPolicy: %w", err)
+	}
+
+	log.V(1).Info("Evaluated Shoots against ShootPolicy", "violations", len(violations))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// ReconcileClusterShootPolicy evaluates the Shoots in all Projects matching a ClusterShootPolicy against it.
+func (r *Reconciler) ReconcileClusterShootPolicy(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	policy := &settingsv1alpha1.ClusterShootPolicy{}
+	if err := r.Client.Get(ctx, request.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	projectList := &gardencorev1beta1.ProjectList{}
+	if err := r.Client.List(ctx, projectList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing Projects: %w", err)
+	}
+
+	namespaceToProject := make(map[string]*gardencorev1beta1.Project, len(projectList.Items))
+	for _, project := range projectList.Items {
+		if project.Spec.Namespace != nil {
+			namespaceToProject[*project.Spec.Namespace] = &project
+		}
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing Shoots: %w", err)
+	}
+
+	var violations []settingsv1alpha1.ShootPolicyViolation
+	for _, shoot := range shootList.Items {
+		applicable, err := shootpolicy.ApplicablePolicies(&shoot, namespaceToProject[shoot.Namespace], nil, []*settingsv1alpha1.ClusterShootPolicy{policy})
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		violation, err := evaluate(&shoot, applicable)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+
+	patch := client.MergeFrom(policy.DeepCopy())
+	r.updateStatus(&policy.Status, policy.Generation, violations)
+	if err := r.Client.Status().Patch(ctx, policy, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status of ClusterShootPolicy: %w", err)
+	}
+
+	log.V(1).Info("Evaluated Shoots against ClusterShootPolicy", "violations", len(violations))
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) updateStatus(status *settingsv1alpha1.ShootPolicyStatus, generation int64, violations []settingsv1alpha1.ShootPolicyViolation) {
+	status.ObservedGeneration = generation
+	status.LastEvaluationTime = &metav1.Time{Time: r.Clock.Now().UTC()}
+	status.ViolationsCount = int32(len(violations)) // #nosec G115 -- The number of Shoots fits into int32.
+	status.Violations = violations[:min(len(violations), MaxViolations)]
+}
+
+func evaluate(shoot *gardencorev1beta1.Shoot, applicable []shootpolicy.Applicable) (*settingsv1alpha1.ShootPolicyViolation, error) {
+	if len(applicable) == 0 || shoot.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	messages, err := applicable[0].Program.Validate(shoot, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed evaluating Shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	return &settingsv1alpha1.ShootPolicyViolation{
+		Namespace: shoot.Namespace,
+		Name:      shoot.Name,
+		Messages:  messages,
+	}, nil
+}
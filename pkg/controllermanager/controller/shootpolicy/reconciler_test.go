@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootpolicy_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shootpolicy"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClock  = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		spec settingsv1alpha1.ShootPolicySpec
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&settingsv1alpha1.ShootPolicy{}, &settingsv1alpha1.ClusterShootPolicy{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Clock:  fakeClock,
+			Config: controllermanagerconfigv1alpha1.ShootPolicyControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+			},
+		}
+
+		spec = settingsv1alpha1.ShootPolicySpec{
+			ShootSelector:     &metav1.LabelSelector{},
+			EnforcementAction: ptr.To(settingsv1alpha1.ShootPolicyEnforcementActionDeny),
+			Validations: []settingsv1alpha1.ShootPolicyValidation{{
+				Expression: "object.spec.?purpose.orValue('') == 'production'",
+				Message:    ptr.To("purpose must be production"),
+			}},
+		}
+	})
+
+	createShoot := func(namespace, name string, purpose gardencorev1beta1.ShootPurpose) {
+		ExpectWithOffset(1, fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       gardencorev1beta1.ShootSpec{Purpose: &purpose},
+		})).To(Succeed())
+	}
+
+	Describe("#ReconcileShootPolicy", func() {
+		var policy *settingsv1alpha1.ShootPolicy
+
+		BeforeEach(func() {
+			policy = &settingsv1alpha1.ShootPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "garden-foo", Generation: 2},
+				Spec:       spec,
+			}
+			Expect(fakeClient.Create(ctx, policy)).To(Succeed())
+		})
+
+		It("should do nothing if the policy is gone", func() {
+			Expect(fakeClient.Delete(ctx, policy)).To(Succeed())
+
+			result, err := reconciler.ReconcileShootPolicy(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{}))
+		})
+
+		It("should report the violating Shoots in the namespace of the policy", func() {
+			createShoot("garden-foo", "good", gardencorev1beta1.ShootPurposeProduction)
+			createShoot("garden-foo", "bad", gardencorev1beta1.ShootPurposeEvaluation)
+			createShoot("garden-bar", "other", gardencorev1beta1.ShootPurposeEvaluation)
+
+			result, err := reconciler.ReconcileShootPolicy(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			Expect(policy.Status.ObservedGeneration).To(Equal(int64(2)))
+			Expect(policy.Status.LastEvaluationTime.Time.Equal(fakeClock.Now())).To(BeTrue())
+			Expect(policy.Status.ViolationsCount).To(Equal(int32(1)))
+			Expect(policy.Status.Violations).To(ConsistOf(settingsv1alpha1.ShootPolicyViolation{
+				Namespace: "garden-foo",
+				Name:      "bad",
+				Messages:  []string{"purpose must be production"},
+			}))
+		})
+
+		It("should truncate the list of violations", func() {
+			for i := range MaxViolations + 5 {
+				createShoot("garden-foo", fmt.Sprintf("bad-%d", i), gardencorev1beta1.ShootPurposeEvaluation)
+			}
+
+			_, err := reconciler.ReconcileShootPolicy(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(policy)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			Expect(policy.Status.ViolationsCount).To(Equal(int32(MaxViolations + 5)))
+			Expect(policy.Status.Violations).To(HaveLen(MaxViolations))
+		})
+	})
+
+	Describe("#ReconcileClusterShootPolicy", func() {
+		var policy *settingsv1alpha1.ClusterShootPolicy
+
+		BeforeEach(func() {
+			policy = &settingsv1alpha1.ClusterShootPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy", Generation: 1},
+				Spec: settingsv1alpha1.ClusterShootPolicySpec{
+					ShootPolicySpec: spec,
+					ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+				},
+			}
+			Expect(fakeClient.Create(ctx, policy)).To(Succeed())
+
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"tier": "gold"}},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Labels: map[string]string{"tier": "silver"}},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-bar")},
+			})).To(Succeed())
+		})
+
+		It("should report the violating Shoots in the projects matching the policy", func() {
+			createShoot("garden-foo", "good", gardencorev1beta1.ShootPurposeProduction)
+			createShoot("garden-foo", "bad", gardencorev1beta1.ShootPurposeEvaluation)
+			createShoot("garden-bar", "other", gardencorev1beta1.ShootPurposeEvaluation)
+
+			result, err := reconciler.ReconcileClusterShootPolicy(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: policy.Name}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			Expect(policy.Status.ObservedGeneration).To(Equal(int64(1)))
+			Expect(policy.Status.ViolationsCount).To(Equal(int32(1)))
+			Expect(policy.Status.Violations).To(ConsistOf(settingsv1alpha1.ShootPolicyViolation{
+				Namespace: "garden-foo",
+				Name:      "bad",
+				Messages:  []string{"purpose must be production"},
+			}))
+		})
+	})
+})
@@ -11,6 +11,10 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -33,6 +37,27 @@ import (
 // TimeNow returns the current time. Exposed for testing.
 var TimeNow = time.Now
 
+// TracerName is the name of the tracer used for the spans of waiting for extension objects.
+const TracerName = "github.com/gardener/gardener/pkg/extensions"
+
+// startWaitSpan starts a span for waiting until the given object reached the given state.
+func startWaitSpan(ctx context.Context, obj client.Object, kind, state string) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, fmt.Sprintf("Wait for %s %s", kind, state), trace.WithAttributes(
+		attribute.String("kind", kind),
+		attribute.String("namespace", obj.GetNamespace()),
+		attribute.String("name", obj.GetName()),
+	))
+}
+
+// endWaitSpan records the given error in the span and ends it.
+func endWaitSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
 // WaitUntilExtensionObjectReady waits until the given extension object has become ready.
 // Passed objects are expected to be filled with the latest state the controller/component
 // applied/observed/retrieved, but at least namespace and name.
@@ -65,7 +90,10 @@ func WaitUntilObjectReadyWithHealthFunction(
 	severeThreshold time.Duration,
 	timeout time.Duration,
 	postReadyFunc func(context.Context) error,
-) error {
+) (err error) {
+	ctx, span := startWaitSpan(ctx, obj, kind, "ready")
+	defer func() { endWaitSpan(span, err) }()
+
 	var (
 		lastObservedError     error
 		retryCountUntilSevere int
@@ -210,7 +238,10 @@ func WaitUntilExtensionObjectDeleted(
 	kind string,
 	interval time.Duration,
 	timeout time.Duration,
-) error {
+) (err error) {
+	ctx, span := startWaitSpan(ctx, obj, kind, "deleted")
+	defer func() { endWaitSpan(span, err) }()
+
 	var (
 		lastObservedError error
 
@@ -427,7 +458,10 @@ func WaitUntilExtensionObjectMigrated(
 	kind string,
 	interval time.Duration,
 	timeout time.Duration,
-) error {
+) (err error) {
+	ctx, span := startWaitSpan(ctx, obj, kind, "migrated")
+	defer func() { endWaitSpan(span, err) }()
+
 	return retry.UntilTimeout(ctx, interval, timeout, func(ctx context.Context) (done bool, err error) {
 		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
 			if client.IgnoreNotFound(err) == nil {
@@ -112,6 +112,14 @@ const (
 	// owner: @rrhubenov
 	// alpha: v1.140.0
 	RemoveVali featuregate.Feature = "RemoveVali"
+
+	// SSHCertificateOnlyAccess disables the static SSH key pair of Shoots for accessing their worker nodes. The key pair
+	// is neither authorized on the worker nodes nor published in the project namespace anymore (existing copies are
+	// deleted), i.e., the worker nodes can only be accessed with user certificates signed by the SSH certificate
+	// authority of the Shoot (issued for Bastions).
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.140.0
+	SSHCertificateOnlyAccess featuregate.Feature = "SSHCertificateOnlyAccess"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -154,6 +162,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	PrometheusHealthChecks:         {Default: false, PreRelease: featuregate.Alpha},
 	VersionClassificationLifecycle: {Default: false, PreRelease: featuregate.Alpha},
 	RemoveVali:                     {Default: false, PreRelease: featuregate.Alpha},
+	SSHCertificateOnlyAccess:       {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -15,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -55,17 +57,68 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster, seedCluste
 			gardenCluster.GetCache(),
 			&operationsv1alpha1.Bastion{},
 			&handler.EnqueueRequestForObject{},
-			predicate.GenerationChangedPredicate{},
+			predicate.Or(
+				predicate.GenerationChangedPredicate{},
+				r.ExpirationTimestampChanged(),
+			),
 		)).
 		WatchesRawSource(source.Kind[client.Object](
 			seedCluster.GetCache(),
 			&extensionsv1alpha1.Bastion{},
 			handler.EnqueueRequestsFromMapFunc(r.MapExtensionsBastionToOperationsBastion(mgr.GetLogger().WithValues("controller", ControllerName))),
-			predicateutils.LastOperationChanged(predicateutils.GetExtensionLastOperation),
+			predicate.Or(
+				predicateutils.LastOperationChanged(predicateutils.GetExtensionLastOperation),
+				r.SessionsChanged(),
+			),
 		)).
 		Complete(r)
 }
 
+// SessionsChanged is a predicate which returns true if the sessions recorded by an extensions Bastion have changed.
+func (r *Reconciler) SessionsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			bastion, ok := e.ObjectNew.(*extensionsv1alpha1.Bastion)
+			if !ok {
+				return false
+			}
+
+			oldBastion, ok := e.ObjectOld.(*extensionsv1alpha1.Bastion)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(bastion.Status.Sessions, oldBastion.Status.Sessions)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// ExpirationTimestampChanged is a predicate which returns true if the expiration timestamp of an operations Bastion has
+// changed, i.e., when it has been kept alive. This is required for renewing its SSH certificate.
+func (r *Reconciler) ExpirationTimestampChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			bastion, ok := e.ObjectNew.(*operationsv1alpha1.Bastion)
+			if !ok {
+				return false
+			}
+
+			oldBastion, ok := e.ObjectOld.(*operationsv1alpha1.Bastion)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(bastion.Status.ExpirationTimestamp, oldBastion.Status.ExpirationTimestamp)
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
 // MapExtensionsBastionToOperationsBastion  is a handler.MapFunc for mapping extensions Bastion in the seed cluster to operations Bastion in the project namespace.
 func (r *Reconciler) MapExtensionsBastionToOperationsBastion(log logr.Logger) handler.MapFunc {
 	return func(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -6,6 +6,7 @@ package bastion_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -15,6 +16,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -106,4 +109,71 @@ var _ = Describe("Add", func() {
 			Expect(reconciler.MapExtensionsBastionToOperationsBastion(log)(ctx, extensionsBastion)).To(BeNil())
 		})
 	})
+
+	Describe("#SessionsChanged", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.SessionsChanged()
+
+			extensionsBastion = &extensionsv1alpha1.Bastion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      bastionName,
+					Namespace: shootTechnicalID,
+				},
+			}
+		})
+
+		It("should return false for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: extensionsBastion})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: extensionsBastion})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: extensionsBastion})).To(BeFalse())
+		})
+
+		It("should return false if the sessions did not change", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: extensionsBastion, ObjectNew: extensionsBastion.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should return true if the sessions changed", func() {
+			newBastion := extensionsBastion.DeepCopy()
+			newBastion.Status.Sessions = []extensionsv1alpha1.BastionSession{{ID: "1", Source: "1.2.3.4", StartTime: metav1.Now()}}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: extensionsBastion, ObjectNew: newBastion})).To(BeTrue())
+		})
+	})
+
+	Describe("#ExpirationTimestampChanged", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.ExpirationTimestampChanged()
+
+			operationsBastion = &operationsv1alpha1.Bastion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      bastionName,
+					Namespace: "garden-foo",
+				},
+				Status: operationsv1alpha1.BastionStatus{
+					ExpirationTimestamp: &metav1.Time{Time: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)},
+				},
+			}
+		})
+
+		It("should return false for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: operationsBastion})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: operationsBastion})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: operationsBastion})).To(BeFalse())
+		})
+
+		It("should return false if the expiration timestamp did not change", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: operationsBastion, ObjectNew: operationsBastion.DeepCopy()})).To(BeFalse())
+		})
+
+		It("should return true if the expiration timestamp changed", func() {
+			newBastion := operationsBastion.DeepCopy()
+			newBastion.Status.ExpirationTimestamp = &metav1.Time{Time: operationsBastion.Status.ExpirationTimestamp.Add(time.Hour)}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: operationsBastion, ObjectNew: newBastion})).To(BeTrue())
+		})
+	})
 })
@@ -5,18 +5,26 @@
 package bastion
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -30,13 +38,21 @@ import (
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	sshutils "github.com/gardener/gardener/pkg/utils/ssh"
 )
 
 // RequeueDurationWhenResourceDeletionStillPresent is the duration used for requeuing when owned resources are still in
 // the process of being deleted when deleting a Bastion.
 var RequeueDurationWhenResourceDeletionStillPresent = 5 * time.Second
 
+// sessionRecorderPath is the path of the root-owned script on the bastion host through which sshd runs all sessions of
+// the user if session recording is enabled. It appends to the session log and transcripts.
+const sessionRecorderPath = "/usr/local/sbin/gardener-bastion-record"
+
 // Reconciler reconciles Bastions and deploys them into the seed cluster.
 type Reconciler struct {
 	GardenClient client.Client
@@ -108,6 +124,14 @@ func (r *Reconciler) reconcileBastion(
 		}
 	}
 
+	sessionRecording, err := r.getSessionRecording(gardenCtx, bastion, shoot)
+	if err != nil {
+		if patchErr := patchReadyCondition(gardenCtx, r.GardenClient, r.Clock, bastion, gardencorev1beta1.ConditionFalse, "SessionRecordingUnavailable", err.Error()); patchErr != nil {
+			log.Error(patchErr, "Failed patching ready condition")
+		}
+		return err
+	}
+
 	var (
 		mustReconcileExtensionBastion = false
 		lastObservedError             error
@@ -115,8 +139,9 @@ func (r *Reconciler) reconcileBastion(
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
 				Type: *bastion.Spec.ProviderType,
 			},
-			UserData: createUserData(bastion),
-			Ingress:  extensionIngress,
+			UserData:         createUserData(bastion),
+			Ingress:          extensionIngress,
+			SessionRecording: sessionRecording,
 		}
 	)
 
@@ -179,7 +204,13 @@ func (r *Reconciler) reconcileBastion(
 		patch := client.MergeFrom(bastion.DeepCopy())
 		setReadyCondition(r.Clock, bastion, gardencorev1beta1.ConditionTrue, "SuccessfullyReconciled", "The bastion has been reconciled successfully.")
 		bastion.Status.Ingress = extensionBastion.Status.Ingress.DeepCopy()
+		bastion.Status.Sessions = sessionsFromExtension(bastion, extensionBastion)
 		bastion.Status.ObservedGeneration = &bastion.Generation
+		sshCertificate, err := r.issueSSHCertificate(seedCtx, bastion, shoot)
+		if err != nil {
+			return fmt.Errorf("failed issuing SSH certificate for Bastion: %w", err)
+		}
+		bastion.Status.SSHCertificate = sshCertificate
 		if err := r.GardenClient.Status().Patch(gardenCtx, bastion, patch); err != nil {
 			return fmt.Errorf("failed patching ready condition of Bastion: %w", err)
 		}
@@ -243,6 +274,141 @@ func (r *Reconciler) cleanupBastion(
 	}
 }
 
+// getSessionRecording returns the session recording configuration for the extension Bastion. Session logs and
+// transcripts are stored in the bucket of the Shoot's BackupEntry, hence recording sessions is only possible if the
+// Shoot has a backup.
+func (r *Reconciler) getSessionRecording(ctx context.Context, bastion *operationsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*extensionsv1alpha1.BastionSessionRecording, error) {
+	if bastion.Spec.SessionRecording == nil {
+		return nil, nil
+	}
+
+	backupEntryName, err := gardenerutils.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID, shoot.UID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine BackupEntry name for session recording: %w", err)
+	}
+
+	backupEntry := &gardencorev1beta1.BackupEntry{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: backupEntryName}, backupEntry); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("session recording requires a backup of the shoot, but BackupEntry %s does not exist", backupEntryName), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+		return nil, fmt.Errorf("failed getting BackupEntry %s for session recording: %w", backupEntryName, err)
+	}
+
+	return &extensionsv1alpha1.BastionSessionRecording{
+		BackupEntryName: backupEntry.Name,
+		Transcripts:     bastion.Spec.SessionRecording.Transcripts,
+	}, nil
+}
+
+// sessionsFromExtension returns the sessions recorded by the given extension Bastion. All sessions are attributed to
+// the user who created the Bastion, as only their public key is authorized on the bastion host.
+func sessionsFromExtension(bastion *operationsv1alpha1.Bastion, extensionBastion *extensionsv1alpha1.Bastion) []operationsv1alpha1.BastionSession {
+	if len(extensionBastion.Status.Sessions) == 0 {
+		return nil
+	}
+
+	sessions := make([]operationsv1alpha1.BastionSession, 0, len(extensionBastion.Status.Sessions))
+	for _, session := range extensionBastion.Status.Sessions {
+		sessions = append(sessions, operationsv1alpha1.BastionSession{
+			ID:         session.ID,
+			User:       bastion.Annotations[v1beta1constants.GardenCreatedBy],
+			Source:     session.Source,
+			Node:       session.Node,
+			StartTime:  session.StartTime,
+			EndTime:    session.EndTime.DeepCopy(),
+			Transcript: session.Transcript,
+		})
+	}
+	return sessions
+}
+
+// issueSSHCertificate returns an SSH user certificate for the public key of the given Bastion which is signed by the
+// current SSH certificate authority of the Shoot. The certificate is issued for a principal of the user who created the
+// Bastion (the nodes allow such principals to log in as the "gardener" user) and is only valid until the expiration of
+// the Bastion. It is only accepted for connections from the ingress CIDRs of the Bastion and from the node network of
+// the Shoot, since the connections to the nodes are established from the bastion host. The existing certificate is
+// returned if it was issued with the same parameters and is still signed by the current certificate authority. If the
+// Shoot has no SSH certificate authority (e.g., because SSH access to its nodes is disabled), no certificate is issued.
+func (r *Reconciler) issueSSHCertificate(ctx context.Context, bastion *operationsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) (*string, error) {
+	if bastion.Status.ExpirationTimestamp == nil {
+		return nil, nil
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := r.SeedClient.List(ctx, secretList, client.InNamespace(shoot.Status.TechnicalID), client.MatchingLabels{
+		secretsmanager.LabelKeyName:            v1beta1constants.SecretNameCASSH,
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: v1beta1constants.SecretManagerIdentityGardenlet,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing SSH certificate authority secrets: %w", err)
+	}
+
+	// The secrets manager keeps the old certificate authority during rotation, the newest one is the current one.
+	var (
+		caSecret       *corev1.Secret
+		caIssuedAtTime int64
+	)
+	for i, secret := range secretList.Items {
+		issuedAtTime, err := strconv.ParseInt(secret.Labels[secretsmanager.LabelKeyIssuedAtTime], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s label from secret %q: %w", secretsmanager.LabelKeyIssuedAtTime, secret.Name, err)
+		}
+		if caSecret == nil || issuedAtTime > caIssuedAtTime {
+			caSecret, caIssuedAtTime = &secretList.Items[i], issuedAtTime
+		}
+	}
+	if caSecret == nil {
+		return nil, nil
+	}
+
+	caPublicKey, _, _, _, err := ssh.ParseAuthorizedKey(caSecret.Data[secretsutils.DataKeySSHAuthorizedKeys])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public key of SSH certificate authority: %w", err)
+	}
+
+	var (
+		validBefore     = bastion.Status.ExpirationTimestamp.UTC()
+		principal       = sshutils.UserPrincipal(bastion.Annotations[v1beta1constants.GardenCreatedBy])
+		sourceAddresses []string
+	)
+
+	for _, ingress := range bastion.Spec.Ingress {
+		sourceAddresses = append(sourceAddresses, ingress.IPBlock.CIDR)
+	}
+	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Nodes != nil {
+		sourceAddresses = append(sourceAddresses, *shoot.Spec.Networking.Nodes)
+	}
+
+	if bastion.Status.SSHCertificate != nil {
+		if certificate, err := sshutils.ParseUserCertificate([]byte(*bastion.Status.SSHCertificate)); err == nil &&
+			bytes.Equal(certificate.SignatureKey.Marshal(), caPublicKey.Marshal()) &&
+			certificate.ValidBefore == uint64(validBefore.Unix()) && // #nosec G115 -- Unix timestamps of valid certificates are positive.
+			slices.Equal(certificate.ValidPrincipals, []string{principal}) &&
+			certificate.CriticalOptions["source-address"] == strings.Join(sourceAddresses, ",") {
+			return bastion.Status.SSHCertificate, nil
+		}
+	}
+
+	certificate, err := sshutils.SignUserCertificate(
+		caSecret.Data[secretsutils.DataKeyRSAPrivateKey],
+		[]byte(bastion.Spec.SSHPublicKey),
+		// The nodes take the principal from the first word of the key ID, see the gardener-user component of the
+		// OperatingSystemConfig.
+		fmt.Sprintf("%s (bastion %s)", principal, client.ObjectKeyFromObject(bastion)),
+		[]string{principal},
+		sourceAddresses,
+		// tolerate clock skew between gardenlet and the nodes
+		r.Clock.Now().UTC().Add(-5*time.Minute),
+		validBefore,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptr.To(string(certificate)), nil
+}
+
 func newBastionExtension(bastion *operationsv1alpha1.Bastion, shoot *gardencorev1beta1.Shoot) *extensionsv1alpha1.Bastion {
 	return &extensionsv1alpha1.Bastion{
 		ObjectMeta: metav1.ObjectMeta{
@@ -266,6 +432,10 @@ func patchReadyCondition(ctx context.Context, c client.StatusClient, clock clock
 }
 
 func createUserData(bastion *operationsv1alpha1.Bastion) []byte {
+	if bastion.Spec.SessionRecording != nil {
+		return createUserDataWithSessionRecording(bastion)
+	}
+
 	userData := fmt.Sprintf(`#!/bin/bash -eu
 
 id gardener || useradd gardener -mU
@@ -278,3 +448,108 @@ systemctl start sshd || systemctl start ssh
 
 	return []byte(userData)
 }
+
+// createUserDataWithSessionRecording creates user data which only allows recorded sessions on the bastion host:
+// Non-interactive sessions may only forward a connection to the SSH port of a node (e.g., via
+// `ssh -o ProxyCommand="ssh gardener@<bastion> %h %p" gardener@<node>`), interactive sessions get a login shell on the
+// bastion host which is optionally recorded in a terminal transcript. The user is not granted root privileges on the
+// bastion host. Instead, the ForceCommand of sshd runs every session through a root-owned recorder via sudo, which is
+// only allowed with a fixed argument and refuses to run unless it has been started by sshd. The recorder itself
+// determines the session ID, the source address, and the node, writes the session log and transcripts (owned by root,
+// only readable by root, and append-only), and only then drops privileges for the login shell. If the files cannot be
+// made append-only, the user data fails before SSH access is configured.
+// Note that the records are only written on the bastion host, it is up to the provider extension to ship them to the
+// bucket of the Shoot's BackupEntry.
+func createUserDataWithSessionRecording(bastion *operationsv1alpha1.Bastion) []byte {
+	userData := fmt.Sprintf(`#!/bin/bash -eu
+
+mkdir -p %[3]s %[4]s
+chown root:root %[3]s %[4]s
+chmod 0700 %[3]s %[4]s
+touch %[2]s
+chown root:root %[2]s
+chmod 0600 %[2]s
+chattr +a %[2]s
+
+cat >%[5]s <<'EOF'
+#!/bin/bash -u
+# Only sessions started by sshd (via the ForceCommand) are recorded, i.e., the recorder cannot be invoked from within a
+# session to forge entries in the session log.
+pid=$PPID
+while [[ "$(readlink "/proc/$pid/exe")" == */sudo ]]; do
+  pid="$(awk '/^PPid:/ {print $2}' "/proc/$pid/status")"
+done
+if [[ $# -ne 1 || "$1" != "session" || ! "$(readlink "/proc/$pid/exe")" =~ /sshd(-session)?$ ]]; then
+  echo "The session recorder can only be started by sshd" >&2
+  exit 1
+fi
+
+session_id="$(cat /proc/sys/kernel/random/uuid)"
+read -r source_address _ <<<"${SSH_CLIENT:--}"
+[[ "$source_address" =~ ^[A-Za-z0-9.:_-]+$ ]] || exit 1
+node="-"
+transcript="-"
+
+record() {
+  echo "$(date -u -Iseconds) $1 $session_id $source_address $node $transcript" >>%[2]s
+}
+
+if [[ -n "${SSH_ORIGINAL_COMMAND:-}" ]]; then
+  read -r node port rest <<<"$SSH_ORIGINAL_COMMAND"
+  if [[ ! "$node" =~ ^[A-Za-z0-9.:-]+$ || "${port:-22}" != "22" || -n "${rest:-}" ]]; then
+    echo "Only forwarding connections to the SSH port of a node is allowed: <node> [22]" >&2
+    exit 1
+  fi
+  record start || exit 1
+  trap 'record end' EXIT
+  trap 'exit 0' HUP TERM
+  exec 3<>"/dev/tcp/$node/22" || exit 1
+  cat <&3 &
+  cat >&3
+  exit 0
+fi
+
+if [[ "%[6]t" == "true" ]]; then
+  transcript="$session_id.log"
+  (umask 0077 && touch "%[4]s/$transcript") || exit 1
+  chattr +a "%[4]s/$transcript" || exit 1
+  record start || exit 1
+  trap 'record end' EXIT
+  trap 'exit 0' HUP TERM
+  script -q -f -a -c "runuser -l -s /bin/bash gardener" "%[4]s/$transcript"
+else
+  record start || exit 1
+  trap 'record end' EXIT
+  trap 'exit 0' HUP TERM
+  runuser -l -s /bin/bash gardener
+fi
+EOF
+chown root:root %[5]s
+chmod 0755 %[5]s
+cat >/etc/sudoers.d/99-gardener-bastion-record <<'EOF'
+Defaults!%[5]s env_keep += "SSH_CLIENT SSH_ORIGINAL_COMMAND"
+gardener ALL=(root) NOPASSWD: %[5]s session
+EOF
+chmod 0440 /etc/sudoers.d/99-gardener-bastion-record
+visudo -cf /etc/sudoers.d/99-gardener-bastion-record
+
+cat >>/etc/ssh/sshd_config <<'EOF'
+
+Match User gardener
+  AllowTcpForwarding no
+  AllowStreamLocalForwarding no
+  PermitTunnel no
+  X11Forwarding no
+  ForceCommand exec sudo -n %[5]s session
+EOF
+
+id gardener || useradd gardener -mU
+mkdir -p /home/gardener/.ssh
+echo "%[1]s" > /home/gardener/.ssh/authorized_keys
+chown gardener:gardener /home/gardener/.ssh/authorized_keys
+systemctl restart sshd || systemctl restart ssh
+`, bastion.Spec.SSHPublicKey, extensionsv1alpha1.BastionSessionLogPath, path.Dir(extensionsv1alpha1.BastionSessionLogPath),
+		extensionsv1alpha1.BastionTranscriptsDirectory, sessionRecorderPath, bastion.Spec.SessionRecording.Transcripts)
+
+	return []byte(userData)
+}
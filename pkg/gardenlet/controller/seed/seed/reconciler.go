@@ -98,8 +98,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 
 		log.Info("Setting cluster identity", "identity", seedClusterIdentity)
+		patch := client.MergeFrom(seed.DeepCopy())
 		seed.Status.ClusterIdentity = &seedClusterIdentity
-		if err := r.GardenClient.Status().Update(ctx, seed); err != nil {
+		if err := r.GardenClient.Status().Patch(ctx, seed, patch); err != nil {
 			return reconcile.Result{}, r.updateStatusOperationError(ctx, seed, err, operationType)
 		}
 	}
@@ -150,6 +151,7 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 	var (
 		now         = metav1.NewTime(r.Clock.Now().UTC())
 		description string
+		patch       = client.MergeFrom(seed.DeepCopy())
 	)
 
 	switch operationType {
@@ -196,7 +198,7 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 		seed.Status.Allocatable = allocatable
 	}
 
-	return r.GardenClient.Status().Update(ctx, seed)
+	return r.GardenClient.Status().Patch(ctx, seed, patch)
 }
 
 func (r *Reconciler) updateStatusOperationSuccess(ctx context.Context, seed *gardencorev1beta1.Seed, operationType gardencorev1beta1.LastOperationType) error {
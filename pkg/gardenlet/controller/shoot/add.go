@@ -20,7 +20,9 @@ import (
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/alertsilence"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/diagnostics"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/lease"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
@@ -81,6 +83,21 @@ func AddToManager(
 		return fmt.Errorf("failed adding status reconciler: %w", err)
 	}
 
+	if err := (&diagnostics.Reconciler{
+		SeedClientSet: seedClientSet,
+		Config:        *cfg.Controllers.ShootDiagnostics,
+		SeedName:      cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster); err != nil {
+		return fmt.Errorf("failed adding diagnostics reconciler: %w", err)
+	}
+
+	if err := (&alertsilence.Reconciler{
+		Config:   *cfg.Controllers.ShootAlertSilence,
+		SeedName: cfg.SeedConfig.Name,
+	}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+		return fmt.Errorf("failed adding alert silence reconciler: %w", err)
+	}
+
 	// If gardenlet is responsible for an unmanaged seed we want to add the state reconciler which performs periodic
 	// backups of shoot states (see GEP-0022).
 	if shootStateControllerEnabled {
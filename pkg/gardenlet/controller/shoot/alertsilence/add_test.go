@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alertsilence_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/alertsilence"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#ShootPredicate", func() {
+		var (
+			p        predicate.Predicate
+			shoot    *gardencorev1beta1.Shoot
+			oldShoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = ShootPredicate()
+			oldShoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot"}}
+			shoot = oldShoot.DeepCopy()
+		})
+
+		It("should return true for created shoots to push the silences after a restart", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for other events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for updates if the revision annotation did not change", func() {
+			oldShoot.Annotations = map[string]string{"shoot.gardener.cloud/alert-silences-revision": "1"}
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/alert-silences-revision": "1"}
+			shoot.Generation = 2
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return true for updates if the revision annotation changed", func() {
+			oldShoot.Annotations = map[string]string{"shoot.gardener.cloud/alert-silences-revision": "1"}
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/alert-silences-revision": "2"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alertsilence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SilenceStateExpired is the state of an expired silence.
+const SilenceStateExpired = "expired"
+
+// Silence is a silence as understood by the Alertmanager API.
+type Silence struct {
+	// ID is the ID of the silence. It is assigned by Alertmanager.
+	ID string `json:"id,omitempty"`
+	// Matchers is a list of label matchers which all have to match an alert to be silenced.
+	Matchers []Matcher `json:"matchers"`
+	// StartsAt is the time when the silence starts.
+	StartsAt time.Time `json:"startsAt"`
+	// EndsAt is the time when the silence ends.
+	EndsAt time.Time `json:"endsAt"`
+	// CreatedBy is the name of the user who created the silence.
+	CreatedBy string `json:"createdBy"`
+	// Comment is a comment describing the silence.
+	Comment string `json:"comment"`
+	// Status is the status of the silence. It is set by Alertmanager.
+	Status *SilenceStatus `json:"status,omitempty"`
+}
+
+// Matcher is a label matcher of a silence.
+type Matcher struct {
+	// Name is the name of the alert label.
+	Name string `json:"name"`
+	// Value is the value of the alert label.
+	Value string `json:"value"`
+	// IsRegex specifies whether the value is a regular expression.
+	IsRegex bool `json:"isRegex"`
+	// IsEqual specifies whether the label has to match (or not match) the value.
+	IsEqual bool `json:"isEqual"`
+}
+
+// SilenceStatus is the status of a silence.
+type SilenceStatus struct {
+	// State is the state of the silence, i.e., `active`, `pending`, or `expired`.
+	State string `json:"state"`
+}
+
+// AlertmanagerClient manages silences via the Alertmanager API.
+type AlertmanagerClient interface {
+	// ListSilences returns all silences.
+	ListSilences(ctx context.Context) ([]Silence, error)
+	// CreateSilence creates the given silence.
+	CreateSilence(ctx context.Context, silence Silence) error
+	// ExpireSilence expires the silence with the given ID.
+	ExpireSilence(ctx context.Context, id string) error
+}
+
+// NewAlertmanagerClient returns an AlertmanagerClient for the Alertmanager reachable at the given address.
+func NewAlertmanagerClient(address string) AlertmanagerClient {
+	return &alertmanagerClient{
+		address:    address,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type alertmanagerClient struct {
+	address    string
+	httpClient *http.Client
+}
+
+func (c *alertmanagerClient) ListSilences(ctx context.Context) ([]Silence, error) {
+	var silences []Silence
+	if err := c.do(ctx, http.MethodGet, "/api/v2/silences", nil, &silences); err != nil {
+		return nil, fmt.Errorf("failed listing silences: %w", err)
+	}
+	return silences, nil
+}
+
+func (c *alertmanagerClient) CreateSilence(ctx context.Context, silence Silence) error {
+	if err := c.do(ctx, http.MethodPost, "/api/v2/silences", silence, nil); err != nil {
+		return fmt.Errorf("failed creating silence: %w", err)
+	}
+	return nil
+}
+
+func (c *alertmanagerClient) ExpireSilence(ctx context.Context, id string) error {
+	if err := c.do(ctx, http.MethodDelete, "/api/v2/silence/"+url.PathEscape(id), nil, nil); err != nil {
+		return fmt.Errorf("failed expiring silence %q: %w", id, err)
+	}
+	return nil
+}
+
+func (c *alertmanagerClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		raw, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
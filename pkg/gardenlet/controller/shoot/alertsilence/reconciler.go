@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alertsilence
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// alertmanagerName is the name of the Alertmanager of a Shoot.
+	alertmanagerName = "shoot"
+	// managedCommentPrefix is the prefix of the comment of silences managed by this controller. It is followed by the
+	// name of the silence.
+	managedCommentPrefix = "[gardener:"
+)
+
+// Reconciler pushes the alert silences of Shoots, which are stored in an InternalSecret in the project namespace, to
+// the Alertmanager of the Shoot. Silences which are no longer stored are expired.
+type Reconciler struct {
+	GardenClient          client.Client
+	SeedClient            client.Client
+	Config                gardenletconfigv1alpha1.ShootAlertSilenceControllerConfiguration
+	Clock                 clock.Clock
+	NewAlertmanagerClient func(address string) AlertmanagerClient
+	SeedName              string
+}
+
+// Reconcile pushes the alert silences of the Shoot to its Alertmanager.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		log.Info("Shoot is being deleted or is no longer managed by this gardenlet, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	if shoot.Status.TechnicalID == "" || shoot.Status.IsHibernated {
+		log.V(1).Info("Shoot control plane is not running, skipping push of alert silences")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: alertmanagerName, Namespace: shoot.Status.TechnicalID}, &monitoringv1.Alertmanager{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed reading shoot Alertmanager: %w", err)
+		}
+		log.V(1).Info("Shoot does not have an Alertmanager, skipping push of alert silences")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	silences, err := r.storedSilences(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	address := fmt.Sprintf("http://alertmanager-%s.%s.svc.cluster.local:%d", alertmanagerName, shoot.Status.TechnicalID, alertmanager.Port)
+	if err := r.sync(ctx, log, r.NewAlertmanagerClient(address), silences); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed pushing alert silences to shoot Alertmanager: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) storedSilences(ctx context.Context, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.AlertSilence, error) {
+	internalSecret := &gardencorev1beta1.InternalSecret{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixAlertSilences), Namespace: shoot.Namespace}, internalSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading alert silences: %w", err)
+	}
+
+	var silences []gardencorev1beta1.AlertSilence
+	if data := internalSecret.Data[v1beta1constants.DataKeyAlertSilences]; len(data) > 0 {
+		if err := json.Unmarshal(data, &silences); err != nil {
+			return nil, fmt.Errorf("failed decoding alert silences: %w", err)
+		}
+	}
+
+	return silences, nil
+}
+
+// sync creates the given silences in Alertmanager unless an equivalent silence exists already, and expires all other
+// silences managed by this controller. Silences created by other means, e.g., via the Alertmanager UI, are not touched.
+func (r *Reconciler) sync(ctx context.Context, log logr.Logger, alertmanagerClient AlertmanagerClient, silences []gardencorev1beta1.AlertSilence) error {
+	existingSilences, err := alertmanagerClient.ListSilences(ctx)
+	if err != nil {
+		return err
+	}
+
+	managedSilences := map[string][]Silence{}
+	for _, silence := range existingSilences {
+		if silence.Status != nil && silence.Status.State == SilenceStateExpired {
+			continue
+		}
+		if name, ok := managedSilenceName(silence); ok {
+			managedSilences[name] = append(managedSilences[name], silence)
+		}
+	}
+
+	now := r.Clock.Now()
+	for _, s := range silences {
+		if !s.EndsAt.After(now) {
+			continue
+		}
+
+		var (
+			desired = toAlertmanagerSilence(s)
+			found   bool
+			stale   []Silence
+		)
+
+		for _, existing := range managedSilences[s.Name] {
+			if !found && equivalent(existing, desired) {
+				found = true
+				continue
+			}
+			stale = append(stale, existing)
+		}
+		managedSilences[s.Name] = stale
+
+		if !found {
+			log.Info("Creating silence", "name", s.Name, "endsAt", s.EndsAt)
+			if err := alertmanagerClient.CreateSilence(ctx, desired); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, stale := range managedSilences {
+		for _, silence := range stale {
+			log.Info("Expiring silence", "name", name, "id", silence.ID)
+			if err := alertmanagerClient.ExpireSilence(ctx, silence.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func toAlertmanagerSilence(silence gardencorev1beta1.AlertSilence) Silence {
+	result := Silence{
+		EndsAt:    silence.EndsAt.UTC(),
+		CreatedBy: ptr.Deref(silence.CreatedBy, "gardener"),
+		Comment:   strings.TrimSpace(managedCommentPrefix + silence.Name + "] " + ptr.Deref(silence.Comment, "")),
+	}
+
+	if silence.StartsAt != nil {
+		result.StartsAt = silence.StartsAt.UTC()
+	}
+
+	for _, matcher := range silence.Matchers {
+		result.Matchers = append(result.Matchers, Matcher{Name: matcher.Name, Value: matcher.Value, IsRegex: matcher.Regex, IsEqual: true})
+	}
+
+	return result
+}
+
+func managedSilenceName(silence Silence) (string, bool) {
+	rest, ok := strings.CutPrefix(silence.Comment, managedCommentPrefix)
+	if !ok {
+		return "", false
+	}
+	name, _, ok := strings.Cut(rest, "]")
+	return name, ok
+}
+
+// equivalent returns whether the existing silence is equivalent to the desired one. The start time is not compared
+// since Alertmanager moves start times in the past to the creation time of the silence.
+func equivalent(existing, desired Silence) bool {
+	return existing.EndsAt.Equal(desired.EndsAt) &&
+		existing.CreatedBy == desired.CreatedBy &&
+		existing.Comment == desired.Comment &&
+		slices.Equal(sortedMatchers(existing.Matchers), sortedMatchers(desired.Matchers))
+}
+
+func sortedMatchers(matchers []Matcher) []Matcher {
+	return slices.SortedFunc(slices.Values(matchers), func(a, b Matcher) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Value, b.Value))
+	})
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alertsilence_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/alertsilence"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClock  *testclock.FakeClock
+		reconciler *Reconciler
+		syncPeriod = 10 * time.Minute
+
+		gardenClient       client.Client
+		seedClient         client.Client
+		alertmanagerClient *fakeAlertmanagerClient
+		address            string
+
+		shoot          *gardencorev1beta1.Shoot
+		internalSecret *gardencorev1beta1.InternalSecret
+		endsAt         time.Time
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		alertmanagerClient = &fakeAlertmanagerClient{}
+		address = ""
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config:       gardenletconfigv1alpha1.ShootAlertSilenceControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:        fakeClock,
+			NewAlertmanagerClient: func(a string) AlertmanagerClient {
+				address = a
+				return alertmanagerClient
+			},
+			SeedName: "seed",
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--foo--bar"},
+		}
+
+		endsAt = fakeClock.Now().Add(time.Hour)
+		internalSecret = &gardencorev1beta1.InternalSecret{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar.alert-silences", Namespace: "garden-foo"},
+			Data: map[string][]byte{"silences": []byte(`[` +
+				`{"name":"maintenance","matchers":[{"name":"alertname","value":"KubeletTooManyPods"},{"name":"service","value":"node.*","regex":true}],"startsAt":"2026-01-01T11:00:00Z","endsAt":"2026-01-01T13:00:00Z","createdBy":"alice","comment":"node maintenance"},` +
+				`{"name":"expired","matchers":[{"name":"service","value":"vpn"}],"endsAt":"2026-01-01T11:00:00Z"}` +
+				`]`)},
+		}
+
+		Expect(seedClient.Create(ctx, &monitoringv1.Alertmanager{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "shoot--foo--bar"}})).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	reconcileShoot := func() (reconcile.Result, error) {
+		return reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+	}
+
+	maintenanceSilence := func() Silence {
+		return Silence{
+			Matchers:  []Matcher{{Name: "alertname", Value: "KubeletTooManyPods", IsEqual: true}, {Name: "service", Value: "node.*", IsRegex: true, IsEqual: true}},
+			StartsAt:  time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC),
+			EndsAt:    endsAt,
+			CreatedBy: "alice",
+			Comment:   "[gardener:maintenance] node maintenance",
+		}
+	}
+
+	Context("shoot not managed by this gardenlet", func() {
+		BeforeEach(func() {
+			shoot.Spec.SeedName = ptr.To("other")
+		})
+
+		It("should do nothing", func() {
+			Expect(gardenClient.Create(ctx, internalSecret)).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+			Expect(address).To(BeEmpty())
+		})
+	})
+
+	Context("hibernated shoot", func() {
+		BeforeEach(func() {
+			shoot.Status.IsHibernated = true
+		})
+
+		It("should requeue without pushing the silences", func() {
+			Expect(gardenClient.Create(ctx, internalSecret)).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(address).To(BeEmpty())
+		})
+	})
+
+	It("should requeue if the shoot does not have an Alertmanager", func() {
+		Expect(seedClient.Delete(ctx, &monitoringv1.Alertmanager{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "shoot--foo--bar"}})).To(Succeed())
+		Expect(gardenClient.Create(ctx, internalSecret)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(address).To(BeEmpty())
+	})
+
+	It("should push the stored silences which did not expire yet", func() {
+		Expect(gardenClient.Create(ctx, internalSecret)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(address).To(Equal("http://alertmanager-shoot.shoot--foo--bar.svc.cluster.local:9093"))
+		Expect(alertmanagerClient.created).To(ConsistOf(maintenanceSilence()))
+		Expect(alertmanagerClient.expired).To(BeEmpty())
+	})
+
+	It("should not push a silence again if an equivalent silence exists", func() {
+		Expect(gardenClient.Create(ctx, internalSecret)).To(Succeed())
+		existing := maintenanceSilence()
+		existing.ID = "1"
+		existing.StartsAt = fakeClock.Now()
+		existing.Matchers[0], existing.Matchers[1] = existing.Matchers[1], existing.Matchers[0]
+		existing.Status = &SilenceStatus{State: "active"}
+		alertmanagerClient.silences = []Silence{existing}
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(alertmanagerClient.created).To(BeEmpty())
+		Expect(alertmanagerClient.expired).To(BeEmpty())
+	})
+
+	It("should replace changed silences and expire silences which are no longer stored", func() {
+		Expect(gardenClient.Create(ctx, internalSecret)).To(Succeed())
+		changed := maintenanceSilence()
+		changed.ID = "1"
+		changed.EndsAt = endsAt.Add(-30 * time.Minute)
+		removed := Silence{ID: "2", Comment: "[gardener:removed]", EndsAt: endsAt, Status: &SilenceStatus{State: "active"}}
+		alreadyExpired := Silence{ID: "3", Comment: "[gardener:old]", EndsAt: endsAt, Status: &SilenceStatus{State: "expired"}}
+		unmanaged := Silence{ID: "4", Comment: "created via the Alertmanager UI", EndsAt: endsAt, Status: &SilenceStatus{State: "active"}}
+		alertmanagerClient.silences = []Silence{changed, removed, alreadyExpired, unmanaged}
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(alertmanagerClient.created).To(ConsistOf(maintenanceSilence()))
+		Expect(alertmanagerClient.expired).To(ConsistOf("1", "2"))
+	})
+
+	It("should expire all managed silences if there are no stored silences", func() {
+		alertmanagerClient.silences = []Silence{{ID: "1", Comment: "[gardener:maintenance]", EndsAt: endsAt}}
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(alertmanagerClient.created).To(BeEmpty())
+		Expect(alertmanagerClient.expired).To(ConsistOf("1"))
+	})
+
+	It("should return an error if Alertmanager is not reachable", func() {
+		alertmanagerClient.err = errors.New("fake")
+
+		_, err := reconcileShoot()
+		Expect(err).To(MatchError(ContainSubstring("fake")))
+	})
+})
+
+type fakeAlertmanagerClient struct {
+	err      error
+	silences []Silence
+
+	created []Silence
+	expired []string
+}
+
+func (f *fakeAlertmanagerClient) ListSilences(context.Context) ([]Silence, error) {
+	return f.silences, f.err
+}
+
+func (f *fakeAlertmanagerClient) CreateSilence(_ context.Context, silence Silence) error {
+	f.created = append(f.created, silence)
+	return nil
+}
+
+func (f *fakeAlertmanagerClient) ExpireSilence(_ context.Context, id string) error {
+	f.expired = append(f.expired, id)
+	return nil
+}
@@ -5,20 +5,29 @@
 package care
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apiserverinternalv1alpha1 "k8s.io/api/apiserverinternal/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/validation/apigroups"
 )
 
 const (
@@ -44,6 +54,8 @@ const (
 	// Any webhook on lease resources in kube-system namespace with a larger timeout can break leader election of essential
 	// control plane controllers.
 	WebhookMaximumTimeoutSecondsNotProblematicForLeases = 3
+
+	metricNameRequestedDeprecatedAPIs = "apiserver_requested_deprecated_apis"
 )
 
 func shootHibernatedConstraints(clock clock.Clock, conditions ...gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
@@ -73,6 +85,7 @@ type Constraint struct {
 	seedClient             client.Client
 	initializeShootClients ShootClientInit
 	shootClient            client.Client
+	shootRESTClient        rest.Interface
 
 	log   logr.Logger
 	clock clock.Clock
@@ -147,6 +160,7 @@ func (c *Constraint) constraintsChecks(
 		)
 	}
 	c.shootClient = shootClient.Client()
+	c.shootRESTClient = shootClient.RESTClient()
 
 	status, reason, message, errorCodes, err = c.CheckForProblematicWebhooks(ctx)
 	if err != nil {
@@ -164,9 +178,16 @@ func (c *Constraint) constraintsChecks(
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, status, reason, message)
 	}
 
+	status, reason, message, err = c.checkUpgradeReadiness(ctx)
+	if err != nil {
+		constraints.upgradeReadiness = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.upgradeReadiness, err)
+	} else {
+		constraints.upgradeReadiness = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.upgradeReadiness, status, reason, message)
+	}
+
 	return filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.manualInPlaceWorkersUpdated},
+		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks, constraints.manualInPlaceWorkersUpdated, constraints.upgradeReadiness},
 	)
 }
 
@@ -297,6 +318,154 @@ func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx con
 		nil
 }
 
+// checkUpgradeReadiness checks whether APIs are still requested in the shoot cluster which are removed in the next
+// Kubernetes minor version. It is based on the apiserver_requested_deprecated_apis metric of the kube-apiserver.
+// Additionally, it checks whether objects are still stored in such APIs based on the StorageVersion API of the
+// kube-apiserver if it is enabled.
+func (c *Constraint) checkUpgradeReadiness(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	if c.shoot.KubernetesVersion == nil {
+		return "", "", "", fmt.Errorf("kubernetes version of the shoot is unknown")
+	}
+	nextMinorVersion := c.shoot.KubernetesVersion.IncMinor()
+
+	metrics, err := c.shootRESTClient.Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return "", "", "", fmt.Errorf("could not fetch metrics of the shoot's kube-apiserver: %w", err)
+	}
+
+	removedAPIs, err := RequestedAPIsRemovedInVersion(bytes.NewReader(metrics), &nextMinorVersion)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	storageVersionList := &apiserverinternalv1alpha1.StorageVersionList{}
+	if err := c.shootClient.List(ctx, storageVersionList); err != nil && !meta.IsNoMatchError(err) && !apierrors.IsNotFound(err) {
+		return "", "", "", fmt.Errorf("could not list storage versions in the shoot: %w", err)
+	}
+	storedRemovedAPIs := StoredAPIsRemovedInVersion(storageVersionList.Items, &nextMinorVersion)
+
+	var msgs []string
+	if len(removedAPIs) > 0 {
+		msgs = append(msgs, fmt.Sprintf("Some APIs requested in your cluster are removed in Kubernetes %d.%d: %s.",
+			nextMinorVersion.Major(), nextMinorVersion.Minor(), strings.Join(removedAPIs, ", ")))
+	}
+	if len(storedRemovedAPIs) > 0 {
+		msgs = append(msgs, fmt.Sprintf("Some objects in your cluster are stored in APIs which are removed in Kubernetes %d.%d: %s.",
+			nextMinorVersion.Major(), nextMinorVersion.Minor(), strings.Join(storedRemovedAPIs, ", ")))
+	}
+
+	if len(msgs) > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			"RemovedAPIsInUse",
+			strings.Join(msgs, " ") + " Please migrate your clients, manifests and stored objects before updating. See https://github.com/gardener/gardener/blob/master/docs/usage/shoot/shoot_status.md#constraints for more details.",
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"NoRemovedAPIsInUse",
+		fmt.Sprintf("No APIs are requested which are removed in Kubernetes %d.%d", nextMinorVersion.Major(), nextMinorVersion.Minor()),
+		nil
+}
+
+// StoredAPIsRemovedInVersion returns the sorted list of APIs (formatted as <resource>.<group>/<version>) which are used
+// as encoding version for storing objects according to the given StorageVersions and which are removed in the given
+// version, i.e., they are served in the previous minor version but not in the given one. APIs which are not known to
+// Gardener, e.g. those of CRDs or aggregated API servers, are ignored.
+func StoredAPIsRemovedInVersion(storageVersions []apiserverinternalv1alpha1.StorageVersion, version *semver.Version) []string {
+	if version.Minor() == 0 {
+		return nil
+	}
+
+	var (
+		previousVersion = semver.New(version.Major(), version.Minor()-1, 0, "", "")
+		removedAPIs     = sets.New[string]()
+	)
+
+	for _, storageVersion := range storageVersions {
+		// StorageVersions are named <group>.<resource>, resource names do not contain dots.
+		resource := storageVersion.Name[strings.LastIndex(storageVersion.Name, ".")+1:]
+
+		encodingVersions := sets.New[string]()
+		if storageVersion.Status.CommonEncodingVersion != nil {
+			encodingVersions.Insert(*storageVersion.Status.CommonEncodingVersion)
+		}
+		for _, serverStorageVersion := range storageVersion.Status.StorageVersions {
+			encodingVersions.Insert(serverStorageVersion.EncodingVersion)
+		}
+
+		for encodingVersion := range encodingVersions {
+			gvr := encodingVersion + "/" + resource
+			supported, _, err := apigroups.IsAPISupported(gvr, version.String())
+			if err != nil || supported {
+				continue
+			}
+			if supportedBefore, _, err := apigroups.IsAPISupported(gvr, previousVersion.String()); err != nil || !supportedBefore {
+				continue
+			}
+
+			api := resource
+			if group, apiVersion, ok := strings.Cut(encodingVersion, "/"); ok {
+				api += "." + group + "/" + apiVersion
+			} else {
+				api += "/" + encodingVersion
+			}
+			removedAPIs.Insert(api)
+		}
+	}
+
+	return sets.List(removedAPIs)
+}
+
+// RequestedAPIsRemovedInVersion parses the given kube-apiserver metrics in the Prometheus text format and returns the
+// sorted list of requested deprecated APIs (formatted as <resource>[/<subresource>].<group>/<version>) whose removal
+// release is less than or equal to the given version.
+func RequestedAPIsRemovedInVersion(metrics io.Reader, version *semver.Version) ([]string, error) {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	metricFamilies, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse metrics of the shoot's kube-apiserver: %w", err)
+	}
+
+	metricFamily, ok := metricFamilies[metricNameRequestedDeprecatedAPIs]
+	if !ok {
+		return nil, nil
+	}
+
+	removedAPIs := sets.New[string]()
+	for _, metric := range metricFamily.GetMetric() {
+		if metric.GetGauge().GetValue() <= 0 {
+			continue
+		}
+
+		labelValues := make(map[string]string, len(metric.GetLabel()))
+		for _, label := range metric.GetLabel() {
+			labelValues[label.GetName()] = label.GetValue()
+		}
+
+		if labelValues["removed_release"] == "" {
+			continue
+		}
+		removedRelease, err := semver.NewVersion(labelValues["removed_release"])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse removed release %q: %w", labelValues["removed_release"], err)
+		}
+		if removedRelease.Major() != version.Major() || removedRelease.Minor() > version.Minor() {
+			continue
+		}
+
+		api := labelValues["resource"]
+		if subresource := labelValues["subresource"]; subresource != "" {
+			api += "/" + subresource
+		}
+		if group := labelValues["group"]; group != "" {
+			api += "." + group
+		}
+		removedAPIs.Insert(api + "/" + labelValues["version"])
+	}
+
+	return sets.List(removedAPIs), nil
+}
+
 // CheckForProblematicWebhooks checks the Shoot for problematic webhooks which could prevent shoot worker nodes from
 // joining the cluster.
 func (c *Constraint) CheckForProblematicWebhooks(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, []gardencorev1beta1.ErrorCode, error) {
@@ -453,6 +622,7 @@ type ShootConstraints struct {
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
 	manualInPlaceWorkersUpdated           gardencorev1beta1.Condition
+	upgradeReadiness                      gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -463,6 +633,7 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
 		g.manualInPlaceWorkersUpdated,
+		g.upgradeReadiness,
 	}
 }
 
@@ -474,6 +645,7 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
 		g.manualInPlaceWorkersUpdated.Type,
+		g.upgradeReadiness.Type,
 	}
 }
 
@@ -486,5 +658,6 @@ func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoo
 		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
 		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
 		manualInPlaceWorkersUpdated:           v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootManualInPlaceWorkersUpdated),
+		upgradeReadiness:                      v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootUpgradeReadiness),
 	}
 }
@@ -7,13 +7,18 @@ package care_test
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apiserverinternalv1alpha1 "k8s.io/api/apiserverinternal/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	appsv1beta1 "k8s.io/api/apps/v1beta1"
 	appsv1beta2 "k8s.io/api/apps/v1beta2"
@@ -34,6 +39,8 @@ import (
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	fakerestclient "k8s.io/client-go/rest/fake"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	apiregistrationv1beta1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1beta1"
 	"k8s.io/utils/clock"
@@ -676,6 +683,88 @@ var _ = Describe("Constraints", func() {
 					))
 				})
 			})
+
+			Context("#UpgradeReadiness", func() {
+				var (
+					metrics string
+
+					newConstraint = func() *Constraint {
+						shootPkg := &shootpkg.Shoot{
+							ControlPlaneNamespace: controlPlaneNamespace,
+							KubernetesVersion:     semver.MustParse("1.31.2"),
+						}
+						shootPkg.SetInfo(&gardencorev1beta1.Shoot{})
+
+						restClient := &fakerestclient.RESTClient{
+							NegotiatedSerializer: serializer.NewCodecFactory(kubernetes.ShootScheme).WithoutConversion(),
+							Resp: &http.Response{
+								StatusCode: http.StatusOK,
+								Body:       io.NopCloser(strings.NewReader(metrics)),
+							},
+						}
+
+						return NewConstraint(
+							logr.Discard(),
+							shootPkg,
+							seedClient,
+							func() (kubernetes.Interface, bool, error) {
+								return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).WithRESTClient(restClient).Build(), true, nil
+							},
+							clock,
+						)
+					}
+				)
+
+				It("should remove the 'UpgradeReadiness' constraint because it's true", func() {
+					metrics = `# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 0
+`
+
+					Expect(newConstraint().Check(ctx, constraints)).NotTo(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+					))
+				})
+
+				It("should keep the 'UpgradeReadiness' constraint because it's false", func() {
+					metrics = `# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1
+`
+
+					Expect(newConstraint().Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("RemovedAPIsInUse"),
+						WithMessage("Some APIs requested in your cluster are removed in Kubernetes 1.32: flowschemas.flowcontrol.apiserver.k8s.io/v1beta3."),
+					))
+				})
+
+				It("should keep the 'UpgradeReadiness' constraint because objects are stored in removed APIs", func() {
+					metrics = ""
+					Expect(shootClient.Create(ctx, &apiserverinternalv1alpha1.StorageVersion{
+						ObjectMeta: metav1.ObjectMeta{Name: "coordination.k8s.io.leasecandidates"},
+						Status: apiserverinternalv1alpha1.StorageVersionStatus{
+							StorageVersions: []apiserverinternalv1alpha1.ServerStorageVersion{{APIServerID: "kube-apiserver-1", EncodingVersion: "coordination.k8s.io/v1alpha1"}},
+						},
+					})).To(Succeed())
+
+					Expect(newConstraint().Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+						WithStatus(gardencorev1beta1.ConditionProgressing),
+						WithReason("RemovedAPIsInUse"),
+						WithMessage("Some objects in your cluster are stored in APIs which are removed in Kubernetes 1.32: leasecandidates.coordination.k8s.io/v1alpha1."),
+					))
+				})
+
+				It("should set the 'UpgradeReadiness' constraint to unknown because the metrics cannot be parsed", func() {
+					metrics = "foo{"
+
+					Expect(newConstraint().Check(ctx, constraints)).To(ContainCondition(
+						OfType(gardencorev1beta1.ShootUpgradeReadiness),
+						WithStatus(gardencorev1beta1.ConditionUnknown),
+						WithMessageSubstrings("could not parse metrics of the shoot's kube-apiserver"),
+					))
+				})
+			})
 		})
 
 		Describe("#CheckIfCACertificateValiditiesAcceptable", func() {
@@ -747,6 +836,77 @@ var _ = Describe("Constraints", func() {
 		})
 	})
 
+	Describe("#RequestedAPIsRemovedInVersion", func() {
+		const metrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="flowschemas",subresource="",version="v1beta3"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.32",resource="prioritylevelconfigurations",subresource="",version="v1beta3"} 0
+apiserver_requested_deprecated_apis{group="",removed_release="1.31",resource="componentstatuses",subresource="",version="v1"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.32",resource="horizontalpodautoscalers",subresource="status",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="resource.k8s.io",removed_release="1.33",resource="resourceclaims",subresource="",version="v1alpha3"} 1
+apiserver_requested_deprecated_apis{group="example.com",removed_release="",resource="foos",subresource="",version="v1"} 1
+# HELP apiserver_request_total [STABLE] Counter of apiserver requests.
+# TYPE apiserver_request_total counter
+apiserver_request_total{code="200",resource="flowschemas",verb="LIST"} 5
+`
+
+		It("should return the requested APIs removed in the given version or earlier", func() {
+			Expect(RequestedAPIsRemovedInVersion(strings.NewReader(metrics), semver.MustParse("1.32.0"))).To(HaveExactElements(
+				"componentstatuses/v1",
+				"flowschemas.flowcontrol.apiserver.k8s.io/v1beta3",
+				"horizontalpodautoscalers/status.autoscaling/v2beta2",
+			))
+		})
+
+		It("should return nothing if no requested API is removed in the given version", func() {
+			Expect(RequestedAPIsRemovedInVersion(strings.NewReader(metrics), semver.MustParse("1.30.0"))).To(BeEmpty())
+		})
+
+		It("should return nothing if the metric is not present", func() {
+			Expect(RequestedAPIsRemovedInVersion(strings.NewReader(""), semver.MustParse("1.32.0"))).To(BeEmpty())
+		})
+
+		It("should return an error if the removed release cannot be parsed", func() {
+			_, err := RequestedAPIsRemovedInVersion(strings.NewReader(`# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="",removed_release="foo",resource="pods",subresource="",version="v1"} 1
+`), semver.MustParse("1.32.0"))
+			Expect(err).To(MatchError(ContainSubstring(`could not parse removed release "foo"`)))
+		})
+	})
+
+	Describe("#StoredAPIsRemovedInVersion", func() {
+		storageVersion := func(name string, commonEncodingVersion *string, encodingVersions ...string) apiserverinternalv1alpha1.StorageVersion {
+			sv := apiserverinternalv1alpha1.StorageVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status:     apiserverinternalv1alpha1.StorageVersionStatus{CommonEncodingVersion: commonEncodingVersion},
+			}
+			for _, encodingVersion := range encodingVersions {
+				sv.Status.StorageVersions = append(sv.Status.StorageVersions, apiserverinternalv1alpha1.ServerStorageVersion{EncodingVersion: encodingVersion})
+			}
+			return sv
+		}
+
+		storageVersions := []apiserverinternalv1alpha1.StorageVersion{
+			storageVersion("core.pods", ptr.To("v1"), "v1"),
+			storageVersion("networking.k8s.io.servicecidrs", nil, "networking.k8s.io/v1", "networking.k8s.io/v1beta1"),
+			storageVersion("coordination.k8s.io.leasecandidates", ptr.To("coordination.k8s.io/v1alpha1"), "coordination.k8s.io/v1alpha1"),
+			storageVersion("example.com.foos", ptr.To("example.com/v1alpha1"), "example.com/v1alpha1"),
+		}
+
+		It("should return the stored APIs removed in the given version", func() {
+			Expect(StoredAPIsRemovedInVersion(storageVersions, semver.MustParse("1.32.0"))).To(HaveExactElements(
+				"leasecandidates.coordination.k8s.io/v1alpha1",
+			))
+			Expect(StoredAPIsRemovedInVersion(storageVersions, semver.MustParse("1.31.0"))).To(HaveExactElements(
+				"servicecidrs.networking.k8s.io/v1beta1",
+			))
+		})
+
+		It("should return nothing if no stored API is removed in the given version", func() {
+			Expect(StoredAPIsRemovedInVersion(storageVersions, semver.MustParse("1.30.0"))).To(BeEmpty())
+		})
+	})
+
 	Describe("ShootConstraints", func() {
 		Describe("#NewShootConstraints", func() {
 			It("should initialize all constraints", func() {
@@ -758,6 +918,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -778,6 +939,7 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -792,6 +954,7 @@ var _ = Describe("Constraints", func() {
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
 					OfType("ManualInPlaceWorkersUpdated"),
+					OfType("UpgradeReadiness"),
 				))
 			})
 		})
@@ -806,6 +969,7 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
 					gardencorev1beta1.ConditionType("ManualInPlaceWorkersUpdated"),
+					gardencorev1beta1.ConditionType("UpgradeReadiness"),
 				))
 			})
 		})
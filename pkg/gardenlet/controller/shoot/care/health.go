@@ -83,6 +83,7 @@ type Health struct {
 	controllerRegistrationToLastHeartbeatTime map[string]*metav1.MicroTime
 	conditionThresholds                       map[gardencorev1beta1.ConditionType]time.Duration
 	healthChecker                             *healthchecker.HealthChecker
+	queryPrometheus                           PrometheusQuerier
 }
 
 // ShootClientInit is a function that initializes a kubernetes client for a Shoot.
@@ -117,6 +118,7 @@ func NewHealth(
 			clock,
 			healthchecker.WithConditionThresholds(conditionThresholds),
 			healthchecker.WithLastOperation(shoot.GetInfo().Status.LastOperation)),
+		queryPrometheus: QueryPrometheus,
 	}
 }
 
@@ -168,6 +170,12 @@ func (h *Health) Check(
 		})
 	}
 
+	// The VPN connectivity probes are executed by the shoot Prometheus, hence the condition is only maintained if
+	// monitoring is enabled.
+	if h.shoot.Purpose == gardencorev1beta1.ShootPurposeTesting || !gardenlethelper.IsMonitoringEnabled(h.gardenletConfiguration) {
+		conditions.vpnConnectivity = nil
+	}
+
 	// Health checks with dependencies to the Kube-Apiserver.
 	shootClient, apiServerRunning, err := h.initializeShootClients()
 	if apiServerRunning && err == nil {
@@ -191,6 +199,15 @@ func (h *Health) Check(
 					return nil
 				})
 		}
+		if conditions.vpnConnectivity != nil {
+			taskFns = append(taskFns,
+				func(ctx context.Context) error {
+					newVPNConnectivity, err := h.checkVPNConnectivity(ctx, *conditions.vpnConnectivity)
+					vpnCondition := v1beta1helper.NewConditionOrError(h.clock, *conditions.vpnConnectivity, newVPNConnectivity, err)
+					conditions.vpnConnectivity = &vpnCondition
+					return nil
+				})
+		}
 	} else {
 		// Some health checks cannot be executed when the API server is not running.
 		// Maintain the affected conditions here.
@@ -206,6 +223,10 @@ func (h *Health) Check(
 			nodeCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.everyNodeReady, message)
 			conditions.everyNodeReady = &nodeCondition
 		}
+		if conditions.vpnConnectivity != nil {
+			vpnCondition := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, *conditions.vpnConnectivity, message)
+			conditions.vpnConnectivity = &vpnCondition
+		}
 	}
 
 	// Execute all relevant health checks.
@@ -1009,6 +1030,7 @@ type ShootConditions struct {
 	observabilityComponentsHealthy gardencorev1beta1.Condition
 	systemComponentsHealthy        gardencorev1beta1.Condition
 	everyNodeReady                 *gardencorev1beta1.Condition
+	vpnConnectivity                *gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot conditions as a slice.
@@ -1023,7 +1045,13 @@ func (s ShootConditions) ConvertToSlice() []gardencorev1beta1.Condition {
 		conditions = append(conditions, *s.everyNodeReady)
 	}
 
-	return append(conditions, s.systemComponentsHealthy)
+	conditions = append(conditions, s.systemComponentsHealthy)
+
+	if s.vpnConnectivity != nil {
+		conditions = append(conditions, *s.vpnConnectivity)
+	}
+
+	return conditions
 }
 
 // ConditionTypes returns all shoot condition types.
@@ -1038,7 +1066,13 @@ func (s ShootConditions) ConditionTypes() []gardencorev1beta1.ConditionType {
 		types = append(types, gardencorev1beta1.ShootEveryNodeReady)
 	}
 
-	return append(types, s.systemComponentsHealthy.Type)
+	types = append(types, s.systemComponentsHealthy.Type)
+
+	if s.vpnConnectivity != nil {
+		types = append(types, gardencorev1beta1.ShootVPNConnectivity)
+	}
+
+	return types
 }
 
 // NewShootConditions returns a new instance of ShootConditions.
@@ -1054,6 +1088,9 @@ func NewShootConditions(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoot
 	if !v1beta1helper.IsWorkerless(shoot) {
 		nodeCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootEveryNodeReady)
 		shootConditions.everyNodeReady = &nodeCondition
+
+		vpnCondition := v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Conditions, gardencorev1beta1.ShootVPNConnectivity)
+		shootConditions.vpnConnectivity = &vpnCondition
 	}
 
 	return shootConditions
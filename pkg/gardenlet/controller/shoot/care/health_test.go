@@ -1037,6 +1037,7 @@ var _ = Describe("health check", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -1086,6 +1087,7 @@ var _ = Describe("health check", func() {
 					OfType("ObservabilityComponentsHealthy"),
 					OfType("EveryNodeReady"),
 					OfType("SystemComponentsHealthy"),
+					OfType("VPNConnectivity"),
 				))
 			})
 		})
@@ -1106,6 +1108,7 @@ var _ = Describe("health check", func() {
 					gardencorev1beta1.ConditionType("ObservabilityComponentsHealthy"),
 					gardencorev1beta1.ConditionType("EveryNodeReady"),
 					gardencorev1beta1.ConditionType("SystemComponentsHealthy"),
+					gardencorev1beta1.ConditionType("VPNConnectivity"),
 				))
 			})
 		})
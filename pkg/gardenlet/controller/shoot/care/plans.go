@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+// Plans contains information needed to collect the plans of the shoot's extension resources.
+type Plans struct {
+	log        logr.Logger
+	shoot      *shoot.Shoot
+	seedClient client.Client
+}
+
+// NewPlans creates a new Plans instance with the given parameters.
+func NewPlans(log logr.Logger, shoot *shoot.Shoot, seedClient client.Client) *Plans {
+	return &Plans{
+		log:        log,
+		shoot:      shoot,
+		seedClient: seedClient,
+	}
+}
+
+// Collect returns the plans stored in the status of the shoot's Infrastructure and Worker resources. It returns the
+// given plans unchanged if the extension resources cannot be read.
+func (p *Plans) Collect(ctx context.Context, current []gardencorev1beta1.ShootPlan) []gardencorev1beta1.ShootPlan {
+	infrastructureList := &extensionsv1alpha1.InfrastructureList{}
+	if err := p.seedClient.List(ctx, infrastructureList, client.InNamespace(p.shoot.ControlPlaneNamespace)); err != nil {
+		p.log.Error(err, "Failed listing Infrastructures for collecting plans")
+		return current
+	}
+
+	workerList := &extensionsv1alpha1.WorkerList{}
+	if err := p.seedClient.List(ctx, workerList, client.InNamespace(p.shoot.ControlPlaneNamespace)); err != nil {
+		p.log.Error(err, "Failed listing Workers for collecting plans")
+		return current
+	}
+
+	var plans []gardencorev1beta1.ShootPlan
+	for _, infrastructure := range infrastructureList.Items {
+		if plan := infrastructure.Status.Plan; plan != nil {
+			plans = append(plans, toShootPlan(extensionsv1alpha1.InfrastructureResource, infrastructure.Name, plan))
+		}
+	}
+	for _, worker := range workerList.Items {
+		if plan := worker.Status.Plan; plan != nil {
+			plans = append(plans, toShootPlan(extensionsv1alpha1.WorkerResource, worker.Name, plan))
+		}
+	}
+
+	return plans
+}
+
+func toShootPlan(kind, name string, plan *extensionsv1alpha1.Plan) gardencorev1beta1.ShootPlan {
+	shootPlan := gardencorev1beta1.ShootPlan{
+		Kind:            kind,
+		Name:            name,
+		ShootGeneration: ptr.Deref(plan.ShootGeneration, 0),
+		LastUpdateTime:  plan.LastUpdateTime,
+		Error:           plan.Error,
+	}
+
+	for _, change := range plan.Changes {
+		shootPlan.Changes = append(shootPlan.Changes, gardencorev1beta1.ShootPlannedChange{
+			Kind:        change.Kind,
+			Name:        change.Name,
+			Action:      string(change.Action),
+			Description: change.Description,
+		})
+	}
+
+	return shootPlan
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("Plans", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx        context.Context
+		seedClient client.Client
+		shoot      *shootpkg.Shoot
+		now        metav1.Time
+
+		current []gardencorev1beta1.ShootPlan
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shoot = &shootpkg.Shoot{ControlPlaneNamespace: namespace}
+		now = metav1.NewTime(time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC).Local())
+
+		current = []gardencorev1beta1.ShootPlan{{Kind: "Worker", Name: "old"}}
+	})
+
+	It("should collect the plans of the Infrastructure and Worker resources", func() {
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace},
+			Status: extensionsv1alpha1.InfrastructureStatus{Plan: &extensionsv1alpha1.Plan{
+				ShootGeneration: ptr.To[int64](3),
+				LastUpdateTime:  now,
+				Changes:         []extensionsv1alpha1.PlannedChange{{Kind: "aws_vpc", Name: "vpc", Action: extensionsv1alpha1.PlannedChangeActionReplace, Description: ptr.To("cidr changes")}},
+			}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace},
+			Status: extensionsv1alpha1.WorkerStatus{Plan: &extensionsv1alpha1.Plan{
+				ShootGeneration: ptr.To[int64](3),
+				LastUpdateTime:  now,
+				Error:           ptr.To("fake"),
+			}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "without-plan", Namespace: namespace},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "shoot--foo--other"},
+			Status:     extensionsv1alpha1.WorkerStatus{Plan: &extensionsv1alpha1.Plan{}},
+		})).To(Succeed())
+
+		Expect(NewPlans(logr.Discard(), shoot, seedClient).Collect(ctx, current)).To(ConsistOf(
+			gardencorev1beta1.ShootPlan{
+				Kind:            "Infrastructure",
+				Name:            "bar",
+				ShootGeneration: 3,
+				LastUpdateTime:  now,
+				Changes:         []gardencorev1beta1.ShootPlannedChange{{Kind: "aws_vpc", Name: "vpc", Action: "Replace", Description: ptr.To("cidr changes")}},
+			},
+			gardencorev1beta1.ShootPlan{
+				Kind:            "Worker",
+				Name:            "bar",
+				ShootGeneration: 3,
+				LastUpdateTime:  now,
+				Error:           ptr.To("fake"),
+			},
+		))
+	})
+
+	It("should return no plans if no extension resource has a plan", func() {
+		Expect(NewPlans(logr.Discard(), shoot, seedClient).Collect(ctx, current)).To(BeEmpty())
+	})
+
+	It("should return the current plans if the extension resources cannot be listed", func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithInterceptorFuncs(interceptor.Funcs{
+			List: func(_ context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+				return errors.New("fake")
+			},
+		}).Build()
+
+		Expect(NewPlans(logr.Discard(), shoot, seedClient).Collect(ctx, current)).To(Equal(current))
+	})
+})
@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
@@ -39,6 +40,10 @@ var (
 	NewHealthCheck = defaultNewHealthCheck
 	// NewConstraintCheck is used to create a new Constraint check instance.
 	NewConstraintCheck = defaultNewConstraintCheck
+	// NewControlPlaneSLOCheck is used to create a new control plane SLO check instance.
+	NewControlPlaneSLOCheck = defaultNewControlPlaneSLOCheck
+	// NewPlanCollector is used to create a new plan collection instance.
+	NewPlanCollector = defaultNewPlanCollector
 	// NewGarbageCollector is used to create a new garbage collection instance.
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
@@ -157,7 +162,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	)
 	if err != nil {
 		updatedConditions, updatedConstraints := r.setStatusToUnknown("Precondition failed: operation could not be initialized", shootConditions.ConvertToSlice(), shootConstraints.ConvertToSlice())
-		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, shoot.Status.ControlPlaneSLO, shoot.Status.Plans); err != nil {
 			log.Error(err, "Error when trying to update the shoot status after failed operation initialization")
 		}
 		return reconcile.Result{}, err
@@ -167,6 +172,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		updatedControlPlaneSLO                = shoot.Status.ControlPlaneSLO
+		updatedPlans                          = shoot.Status.Plans
 	)
 
 	if err := flow.Parallel(
@@ -203,6 +210,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			)
 			return nil
 		},
+		// Trigger control plane SLO computation
+		func(ctx context.Context) error {
+			updatedControlPlaneSLO = NewControlPlaneSLOCheck(
+				log,
+				o.Shoot,
+				r.SeedClientSet.Client(),
+				r.Clock,
+			).Check(
+				ctx,
+				shoot.Status.ControlPlaneSLO,
+			)
+			return nil
+		},
+		// Trigger collection of the plans of the extension resources
+		func(ctx context.Context) error {
+			updatedPlans = NewPlanCollector(
+				log,
+				o.Shoot,
+				r.SeedClientSet.Client(),
+			).Collect(
+				ctx,
+				shoot.Status.Plans,
+			)
+			return nil
+		},
 		// Trigger garbage collection
 		func(ctx context.Context) error {
 			NewGarbageCollector(o, initializeShootClients).Collect(ctx)
@@ -221,7 +253,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
-	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, updatedControlPlaneSLO, updatedPlans); err != nil {
 		log.Error(err, "Error when trying to update the shoot status")
 		return reconcile.Result{}, err
 	}
@@ -237,9 +269,12 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 	return out
 }
 
-func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition) error {
-	// Update Shoot status (conditions, constraints) only if necessary
-	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) && !v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) {
+func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition, updatedControlPlaneSLO *gardencorev1beta1.ControlPlaneSLO, updatedPlans []gardencorev1beta1.ShootPlan) error {
+	// Update Shoot status (conditions, constraints, control plane SLO, plans) only if necessary
+	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) &&
+		!v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) &&
+		apiequality.Semantic.DeepEqual(shoot.Status.ControlPlaneSLO, updatedControlPlaneSLO) &&
+		apiequality.Semantic.DeepEqual(shoot.Status.Plans, updatedPlans) {
 		return nil
 	}
 
@@ -248,11 +283,13 @@ func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *ga
 	mergedConditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, existingConditions.ConditionTypes())
 	mergedConstraints := v1beta1helper.BuildConditions(shoot.Status.Constraints, updatedConstraints, existingConstraints.ConstraintTypes())
 
-	log.V(1).Info("Updating status conditions and constraints")
+	log.V(1).Info("Updating status conditions, constraints, control plane SLO and plans")
 
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
 	shoot.Status.Conditions = mergedConditions
 	shoot.Status.Constraints = mergedConstraints
+	shoot.Status.ControlPlaneSLO = updatedControlPlaneSLO
+	shoot.Status.Plans = updatedPlans
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
@@ -248,6 +248,13 @@ var _ = Describe("Shoot Care Control", func() {
 				operationFunc  NewOperationFunc
 			)
 
+			BeforeEach(func() {
+				DeferCleanup(test.WithVars(
+					&NewControlPlaneSLOCheck, controlPlaneSLOCheckFunc(func(current *gardencorev1beta1.ControlPlaneSLO) *gardencorev1beta1.ControlPlaneSLO { return current }),
+					&NewPlanCollector, planCollectorFunc(func(current []gardencorev1beta1.ShootPlan) []gardencorev1beta1.ShootPlan { return current }),
+				))
+			})
+
 			JustBeforeEach(func() {
 				shootClientMap = fakeclientmap.NewClientMapBuilder().Build()
 
@@ -368,6 +375,67 @@ var _ = Describe("Shoot Care Control", func() {
 				})
 			})
 
+			Context("when the control plane SLO is changed", func() {
+				var controlPlaneSLO *gardencorev1beta1.ControlPlaneSLO
+
+				BeforeEach(func() {
+					controlPlaneSLO = &gardencorev1beta1.ControlPlaneSLO{
+						Window:         metav1.Duration{Duration: 30 * 24 * time.Hour},
+						Availability:   &gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99.9", Actual: "99.950", ErrorBudgetRemaining: "50.0"},
+						LastUpdateTime: metav1.NewTime(fakeClock.Now().UTC().Truncate(time.Second)),
+					}
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+						&NewControlPlaneSLOCheck, controlPlaneSLOCheckFunc(func(_ *gardencorev1beta1.ControlPlaneSLO) *gardencorev1beta1.ControlPlaneSLO {
+							return controlPlaneSLO
+						}),
+					))
+				})
+
+				It("should update the control plane SLO", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.ControlPlaneSLO.Window).To(Equal(controlPlaneSLO.Window))
+					Expect(updatedShoot.Status.ControlPlaneSLO.Availability).To(Equal(controlPlaneSLO.Availability))
+					Expect(updatedShoot.Status.ControlPlaneSLO.LastUpdateTime.Equal(&controlPlaneSLO.LastUpdateTime)).To(BeTrue())
+					Expect(updatedShoot.Status.Conditions).To(BeEmpty())
+				})
+			})
+
+			Context("when plans are changed", func() {
+				var plans []gardencorev1beta1.ShootPlan
+
+				BeforeEach(func() {
+					plans = []gardencorev1beta1.ShootPlan{{
+						Kind:            "Worker",
+						Name:            "foo",
+						ShootGeneration: 2,
+						Changes:         []gardencorev1beta1.ShootPlannedChange{{Kind: "MachineDeployment", Name: "foo-z1", Action: "Rollout"}},
+					}}
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+						&NewPlanCollector, planCollectorFunc(func(_ []gardencorev1beta1.ShootPlan) []gardencorev1beta1.ShootPlan {
+							return plans
+						}),
+					))
+				})
+
+				It("should update the plans", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.Plans).To(HaveLen(1))
+					Expect(updatedShoot.Status.Plans[0].Changes).To(Equal(plans[0].Changes))
+				})
+			})
+
 			Context("when conditions / constraints are changed", func() {
 				var conditions, constraints []gardencorev1beta1.Condition
 
@@ -585,6 +653,37 @@ func constraintCheckFunc(fn resultingConstraintFunc) NewConstraintCheckFunc {
 	}
 }
 
+type resultingControlPlaneSLOFunc func(*gardencorev1beta1.ControlPlaneSLO) *gardencorev1beta1.ControlPlaneSLO
+
+func (c resultingControlPlaneSLOFunc) Check(_ context.Context, current *gardencorev1beta1.ControlPlaneSLO) *gardencorev1beta1.ControlPlaneSLO {
+	return c(current)
+}
+
+func controlPlaneSLOCheckFunc(fn resultingControlPlaneSLOFunc) NewControlPlaneSLOCheckFunc {
+	return func(_ logr.Logger,
+		_ *shootpkg.Shoot,
+		_ client.Client,
+		_ clock.Clock,
+	) ControlPlaneSLOCheck {
+		return fn
+	}
+}
+
+type resultingPlansFunc func([]gardencorev1beta1.ShootPlan) []gardencorev1beta1.ShootPlan
+
+func (c resultingPlansFunc) Collect(_ context.Context, current []gardencorev1beta1.ShootPlan) []gardencorev1beta1.ShootPlan {
+	return c(current)
+}
+
+func planCollectorFunc(fn resultingPlansFunc) NewPlanCollectorFunc {
+	return func(_ logr.Logger,
+		_ *shootpkg.Shoot,
+		_ client.Client,
+	) PlanCollector {
+		return fn
+	}
+}
+
 func opFunc(op *operation.Operation, err error) NewOperationFunc {
 	return func(
 		_ context.Context,
@@ -639,13 +738,18 @@ func containConditionsInUnknownStatus(message string, isWorkerless bool) types.G
 	)
 
 	if !isWorkerless {
-		expectedLength = 6
+		expectedLength = 7
 		matcher = And(matcher,
 			ContainCondition(
 				OfType(gardencorev1beta1.ShootEveryNodeReady),
 				WithStatus(gardencorev1beta1.ConditionUnknown),
 				WithMessage(message),
 			),
+			ContainCondition(
+				OfType(gardencorev1beta1.ShootVPNConnectivity),
+				WithStatus(gardencorev1beta1.ConditionUnknown),
+				WithMessage(message),
+			),
 		)
 	}
 
@@ -653,7 +757,7 @@ func containConditionsInUnknownStatus(message string, isWorkerless bool) types.G
 }
 
 func containConstraintsInUnknownStatus(message string) types.GomegaMatcher {
-	var expectedLength = 6
+	var expectedLength = 7
 	matcher := And(
 		ContainCondition(
 			OfType(gardencorev1beta1.ShootHibernationPossible),
@@ -677,6 +781,10 @@ func containConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			OfType(gardencorev1beta1.ShootManualInPlaceWorkersUpdated),
 			WithStatus(gardencorev1beta1.ConditionUnknown),
 			WithMessage(message),
+		), ContainCondition(
+			OfType(gardencorev1beta1.ShootUpgradeReadiness),
+			WithStatus(gardencorev1beta1.ConditionUnknown),
+			WithMessage(message),
 		),
 	)
 
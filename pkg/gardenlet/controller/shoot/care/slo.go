@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	prom "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+// MaxControlPlaneSLOReports is the maximum number of monthly reports kept in the shoot status.
+const MaxControlPlaneSLOReports = 12
+
+// PrometheusQuerier is a function type that evaluates the given instant query at the given time against the
+// Prometheus reachable at the given address. It returns the value of the single resulting sample and false if the
+// query did not yield a sample.
+type PrometheusQuerier func(ctx context.Context, address, query string, ts time.Time) (float64, bool, error)
+
+// ControlPlaneSLO contains information needed to compute the service levels of the shoot's control plane.
+type ControlPlaneSLO struct {
+	log        logr.Logger
+	shoot      *shoot.Shoot
+	seedClient client.Client
+	clock      clock.Clock
+	query      PrometheusQuerier
+}
+
+// NewControlPlaneSLO creates a new ControlPlaneSLO instance with the given parameters.
+func NewControlPlaneSLO(log logr.Logger, shoot *shoot.Shoot, seedClient client.Client, clock clock.Clock, query PrometheusQuerier) *ControlPlaneSLO {
+	return &ControlPlaneSLO{
+		log:        log,
+		shoot:      shoot,
+		seedClient: seedClient,
+		clock:      clock,
+		query:      query,
+	}
+}
+
+// Check computes the service levels of the shoot's control plane based on the SLO recording rules of the shoot
+// Prometheus. It returns the given service levels unchanged if they cannot be computed, e.g. because the shoot is
+// hibernated or its monitoring is disabled.
+func (c *ControlPlaneSLO) Check(ctx context.Context, current *gardencorev1beta1.ControlPlaneSLO) *gardencorev1beta1.ControlPlaneSLO {
+	if c.shoot.HibernationEnabled || c.shoot.GetInfo().Status.IsHibernated {
+		return current
+	}
+
+	prometheusObj := &monitoringv1.Prometheus{}
+	if err := c.seedClient.Get(ctx, client.ObjectKey{Name: shootprometheus.Label, Namespace: c.shoot.ControlPlaneNamespace}, prometheusObj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			c.log.Error(err, "Failed reading shoot Prometheus for computing the control plane service levels")
+		}
+		return current
+	}
+
+	var (
+		now     = c.clock.Now().UTC()
+		address = fmt.Sprintf("http://prometheus-%s.%s.svc.cluster.local:%d", shootprometheus.Label, c.shoot.ControlPlaneNamespace, prometheus.ServicePorts().Web.Port)
+		updated = &gardencorev1beta1.ControlPlaneSLO{Window: metav1.Duration{Duration: shootprometheus.SLOWindow}}
+		err     error
+	)
+
+	if current != nil {
+		updated = current.DeepCopy()
+		updated.Window = metav1.Duration{Duration: shootprometheus.SLOWindow}
+	}
+
+	if updated.Availability, err = c.serviceLevel(ctx, address, shootprometheus.RecordAvailabilitySLIWindow, now, shootprometheus.SLOAvailabilityObjective); err != nil {
+		c.log.Error(err, "Failed computing the control plane availability service level")
+		return current
+	}
+	if updated.Latency, err = c.serviceLevel(ctx, address, shootprometheus.RecordLatencySLIWindow, now, shootprometheus.SLOLatencyObjective); err != nil {
+		c.log.Error(err, "Failed computing the control plane latency service level")
+		return current
+	}
+
+	if report, err := c.monthlyReport(ctx, address, updated.Reports, evaluationInterval(prometheusObj), now); err != nil {
+		c.log.Error(err, "Failed computing the monthly control plane service level report")
+	} else if report != nil {
+		updated.Reports = append([]gardencorev1beta1.ControlPlaneSLOReport{*report}, updated.Reports...)
+		if len(updated.Reports) > MaxControlPlaneSLOReports {
+			updated.Reports = updated.Reports[:MaxControlPlaneSLOReports]
+		}
+	}
+
+	if current != nil {
+		updated.LastUpdateTime = current.LastUpdateTime
+		if apiequality.Semantic.DeepEqual(current, updated) {
+			return current
+		}
+	}
+
+	updated.LastUpdateTime = metav1.NewTime(now)
+	return updated
+}
+
+// monthlyReport returns the report for the previous calendar month if it was not yet computed. It returns nil if the
+// shoot did not exist in the previous month or if the shoot Prometheus does not have any data for it.
+func (c *ControlPlaneSLO) monthlyReport(ctx context.Context, address string, reports []gardencorev1beta1.ControlPlaneSLOReport, interval time.Duration, now time.Time) (*gardencorev1beta1.ControlPlaneSLOReport, error) {
+	var (
+		end   = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		start = end.AddDate(0, -1, 0)
+		month = start.Format("2006-01")
+	)
+
+	if len(reports) > 0 && reports[0].Month == month {
+		return nil, nil
+	}
+
+	if !c.shoot.GetInfo().CreationTimestamp.Time.Before(end) {
+		return nil, nil
+	}
+
+	// The report is based on the data retained by the shoot Prometheus, i.e., the beginning of months longer than the
+	// retention period might not be covered.
+	rangeSelector := fmt.Sprintf("[%ds]", int64(end.Sub(start).Seconds()))
+
+	availability, err := c.serviceLevel(ctx, address, "avg_over_time("+shootprometheus.RecordAvailabilitySLI+rangeSelector+")", end, shootprometheus.SLOAvailabilityObjective)
+	if err != nil {
+		return nil, err
+	}
+	latency, err := c.serviceLevel(ctx, address, "avg_over_time("+shootprometheus.RecordLatencySLI+rangeSelector+")", end, shootprometheus.SLOLatencyObjective)
+	if err != nil {
+		return nil, err
+	}
+
+	if availability == nil && latency == nil {
+		return nil, nil
+	}
+
+	// The coverage reveals whether the report is based on incomplete data, e.g. because the shoot was hibernated, its
+	// Prometheus was unavailable, or the beginning of the month is no longer retained. Both indicators are recorded by
+	// the same rule group, hence it is sufficient to count the samples of the availability indicator.
+	samples, _, err := c.query(ctx, address, "count_over_time("+shootprometheus.RecordAvailabilitySLI+rangeSelector+")", end)
+	if err != nil {
+		return nil, err
+	}
+
+	coveredStart := start
+	if creationTimestamp := c.shoot.GetInfo().CreationTimestamp.Time; creationTimestamp.After(start) {
+		coveredStart = creationTimestamp
+	}
+
+	return &gardencorev1beta1.ControlPlaneSLOReport{
+		Month:        month,
+		Availability: availability,
+		Latency:      latency,
+		Coverage:     ptr.To(NewControlPlaneSLOCoverage(samples, end.Sub(coveredStart), interval)),
+	}, nil
+}
+
+// NewControlPlaneSLOCoverage returns the ratio of the given number of samples to the number of samples expected in the
+// given duration with the given evaluation interval. The value is rendered in percent and capped at 100.
+func NewControlPlaneSLOCoverage(samples float64, duration, interval time.Duration) string {
+	var coverage float64
+	if expected := float64(duration / interval); expected > 0 {
+		coverage = min(samples/expected, 1)
+	}
+
+	return strconv.FormatFloat(coverage*100, 'f', 1, 64)
+}
+
+// evaluationInterval returns the interval in which the given Prometheus evaluates the recording rules of the service
+// level indicators.
+func evaluationInterval(prometheusObj *monitoringv1.Prometheus) time.Duration {
+	if interval, err := model.ParseDuration(string(prometheusObj.Spec.EvaluationInterval)); err == nil && interval > 0 {
+		return time.Duration(interval)
+	}
+
+	// This is the default evaluation interval of Prometheus.
+	return 30 * time.Second
+}
+
+func (c *ControlPlaneSLO) serviceLevel(ctx context.Context, address, query string, ts time.Time, objective float64) (*gardencorev1beta1.ControlPlaneServiceLevel, error) {
+	actual, found, err := c.query(ctx, address, query, ts)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return NewControlPlaneServiceLevel(objective, actual), nil
+}
+
+// NewControlPlaneServiceLevel returns the service level for the given objective and actual ratio. The values are
+// rendered in percent.
+func NewControlPlaneServiceLevel(objective, actual float64) *gardencorev1beta1.ControlPlaneServiceLevel {
+	return &gardencorev1beta1.ControlPlaneServiceLevel{
+		Objective:            strconv.FormatFloat(objective*100, 'f', -1, 64),
+		Actual:               strconv.FormatFloat(actual*100, 'f', 3, 64),
+		ErrorBudgetRemaining: strconv.FormatFloat((1-(1-actual)/(1-objective))*100, 'f', 1, 64),
+	}
+}
+
+// QueryPrometheus evaluates the given instant query at the given time against the Prometheus reachable at the given
+// address.
+func QueryPrometheus(ctx context.Context, address, query string, ts time.Time) (float64, bool, error) {
+	promClient, err := prom.NewClient(prom.Config{Address: address})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to create Prometheus client: %w", err)
+	}
+
+	// set a maximum timeout for the query, but callers can set a shorter timeout via the context
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	result, warnings, err := promv1.NewAPI(promClient).Query(ctx, query, ts)
+	if err != nil {
+		return 0, false, fmt.Errorf("query %q failed: %w", query, err)
+	}
+
+	if len(warnings) > 0 {
+		return 0, false, fmt.Errorf("query %q returned warnings: %s", query, strings.Join(warnings, ", "))
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return 0, false, fmt.Errorf("query %q returned an unexpected result type: %s", query, result.Type())
+	}
+
+	switch len(vector) {
+	case 0:
+		return 0, false, nil
+	case 1:
+		return float64(vector[0].Value), true, nil
+	default:
+		return 0, false, fmt.Errorf("query %q returned %d samples, expected at most one", query, len(vector))
+	}
+}
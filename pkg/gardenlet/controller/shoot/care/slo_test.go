@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("ControlPlaneSLO", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		address   = "http://prometheus-shoot.shoot--foo--bar.svc.cluster.local:80"
+
+		availabilityWindowQuery = "shoot:apiserver_availability_sli:ratio_rate30d"
+		latencyWindowQuery      = "shoot:apiserver_latency_sli:ratio_rate30d"
+		availabilityMonthQuery  = "avg_over_time(shoot:apiserver_availability_sli:ratio_rate5m[2592000s])"
+		latencyMonthQuery       = "avg_over_time(shoot:apiserver_latency_sli:ratio_rate5m[2592000s])"
+		samplesMonthQuery       = "count_over_time(shoot:apiserver_availability_sli:ratio_rate5m[2592000s])"
+	)
+
+	var (
+		ctx        context.Context
+		seedClient client.Client
+		fakeClock  *testclock.FakeClock
+
+		shoot      *shootpkg.Shoot
+		prometheus *monitoringv1.Prometheus
+
+		now        time.Time
+		monthStart time.Time
+		results    map[string]float64
+		queryErr   error
+		queries    []string
+
+		check *ControlPlaneSLO
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		now = time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+		monthStart = time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+		fakeClock = testclock.NewFakeClock(now)
+
+		shoot = &shootpkg.Shoot{ControlPlaneNamespace: namespace}
+		shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bar",
+				Namespace:         "garden-foo",
+				CreationTimestamp: metav1.NewTime(time.Date(2026, time.August, 3, 0, 0, 0, 0, time.UTC)),
+			},
+		})
+
+		prometheus = &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+			Spec:       monitoringv1.PrometheusSpec{EvaluationInterval: "1m"},
+		}
+		Expect(seedClient.Create(ctx, prometheus)).To(Succeed())
+
+		results = map[string]float64{
+			availabilityWindowQuery: 0.9995,
+			latencyWindowQuery:      0.995,
+			availabilityMonthQuery:  0.9985,
+			latencyMonthQuery:       0.999,
+			samplesMonthQuery:       21600,
+		}
+		queryErr = nil
+		queries = nil
+	})
+
+	JustBeforeEach(func() {
+		check = NewControlPlaneSLO(logr.Discard(), shoot, seedClient, fakeClock, func(_ context.Context, addr, query string, ts time.Time) (float64, bool, error) {
+			Expect(addr).To(Equal(address))
+			queries = append(queries, query)
+
+			if queryErr != nil {
+				return 0, false, queryErr
+			}
+
+			switch query {
+			case availabilityWindowQuery, latencyWindowQuery:
+				Expect(ts).To(Equal(now))
+			case availabilityMonthQuery, latencyMonthQuery, samplesMonthQuery:
+				Expect(ts).To(Equal(monthStart))
+			default:
+				return 0, false, fmt.Errorf("unexpected query %q", query)
+			}
+
+			value, ok := results[query]
+			return value, ok, nil
+		})
+	})
+
+	Describe("#Check", func() {
+		It("should compute the service levels and the report for the previous month", func() {
+			Expect(check.Check(ctx, nil)).To(Equal(&gardencorev1beta1.ControlPlaneSLO{
+				Window:       metav1.Duration{Duration: 30 * 24 * time.Hour},
+				Availability: &gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99.9", Actual: "99.950", ErrorBudgetRemaining: "50.0"},
+				Latency:      &gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99", Actual: "99.500", ErrorBudgetRemaining: "50.0"},
+				Reports: []gardencorev1beta1.ControlPlaneSLOReport{{
+					Month:        "2026-09",
+					Availability: &gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99.9", Actual: "99.850", ErrorBudgetRemaining: "-50.0"},
+					Latency:      &gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99", Actual: "99.900", ErrorBudgetRemaining: "90.0"},
+					Coverage:     ptr.To("50.0"),
+				}},
+				LastUpdateTime: metav1.NewTime(now),
+			}))
+		})
+
+		It("should not compute the report again if it is already present", func() {
+			current := check.Check(ctx, nil)
+			queries = nil
+			fakeClock.Step(time.Hour)
+			now = fakeClock.Now()
+
+			Expect(check.Check(ctx, current)).To(Equal(current))
+			Expect(queries).To(ConsistOf(availabilityWindowQuery, latencyWindowQuery))
+		})
+
+		It("should keep the last update time if the service levels did not change", func() {
+			current := check.Check(ctx, nil)
+			lastUpdateTime := current.LastUpdateTime
+			fakeClock.Step(time.Hour)
+			now = fakeClock.Now()
+
+			Expect(check.Check(ctx, current).LastUpdateTime).To(Equal(lastUpdateTime))
+		})
+
+		It("should update the last update time if the service levels changed", func() {
+			current := check.Check(ctx, nil)
+			fakeClock.Step(time.Hour)
+			now = fakeClock.Now()
+			results[availabilityWindowQuery] = 0.999
+
+			updated := check.Check(ctx, current)
+			Expect(updated.Availability.Actual).To(Equal("99.900"))
+			Expect(updated.LastUpdateTime).To(Equal(metav1.NewTime(now)))
+		})
+
+		It("should compute the coverage of the report since the creation of the shoot", func() {
+			shoot.GetInfo().CreationTimestamp = metav1.NewTime(time.Date(2026, time.September, 16, 0, 0, 0, 0, time.UTC))
+
+			Expect(check.Check(ctx, nil).Reports).To(ConsistOf(HaveField("Coverage", PointTo(Equal("100.0")))))
+		})
+
+		It("should compute the coverage of the report with the default evaluation interval", func() {
+			prometheus.Spec.EvaluationInterval = ""
+			Expect(seedClient.Update(ctx, prometheus)).To(Succeed())
+
+			Expect(check.Check(ctx, nil).Reports).To(ConsistOf(HaveField("Coverage", PointTo(Equal("25.0")))))
+		})
+
+		It("should not compute a report if the shoot was created in the current month", func() {
+			shoot.GetInfo().CreationTimestamp = metav1.NewTime(monthStart.Add(time.Hour))
+
+			Expect(check.Check(ctx, nil).Reports).To(BeEmpty())
+			Expect(queries).To(ConsistOf(availabilityWindowQuery, latencyWindowQuery))
+		})
+
+		It("should not compute a report if there is no data for the previous month", func() {
+			delete(results, availabilityMonthQuery)
+			delete(results, latencyMonthQuery)
+
+			Expect(check.Check(ctx, nil).Reports).To(BeEmpty())
+		})
+
+		It("should keep at most the maximum number of reports", func() {
+			current := &gardencorev1beta1.ControlPlaneSLO{}
+			for i := 1; i <= MaxControlPlaneSLOReports; i++ {
+				current.Reports = append(current.Reports, gardencorev1beta1.ControlPlaneSLOReport{Month: fmt.Sprintf("2025-%02d", 13-i)})
+			}
+
+			reports := check.Check(ctx, current).Reports
+			Expect(reports).To(HaveLen(MaxControlPlaneSLOReports))
+			Expect(reports[0].Month).To(Equal("2026-09"))
+			Expect(reports[MaxControlPlaneSLOReports-1].Month).To(Equal("2025-02"))
+		})
+
+		It("should not change the service levels if the shoot is hibernated", func() {
+			shoot.GetInfo().Status.IsHibernated = true
+			current := &gardencorev1beta1.ControlPlaneSLO{Window: metav1.Duration{Duration: time.Hour}}
+
+			Expect(check.Check(ctx, current)).To(BeIdenticalTo(current))
+			Expect(queries).To(BeEmpty())
+		})
+
+		It("should not change the service levels if the shoot Prometheus does not exist", func() {
+			Expect(seedClient.Delete(ctx, prometheus)).To(Succeed())
+
+			Expect(check.Check(ctx, nil)).To(BeNil())
+			Expect(queries).To(BeEmpty())
+		})
+
+		It("should not change the service levels if querying Prometheus fails", func() {
+			queryErr = errors.New("fake")
+			current := &gardencorev1beta1.ControlPlaneSLO{Window: metav1.Duration{Duration: time.Hour}}
+
+			Expect(check.Check(ctx, current)).To(BeIdenticalTo(current))
+		})
+	})
+
+	DescribeTable("#NewControlPlaneServiceLevel",
+		func(objective, actual float64, expected gardencorev1beta1.ControlPlaneServiceLevel) {
+			Expect(NewControlPlaneServiceLevel(objective, actual)).To(PointTo(Equal(expected)))
+		},
+
+		Entry("objective met", 0.999, 1.0, gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99.9", Actual: "100.000", ErrorBudgetRemaining: "100.0"}),
+		Entry("error budget exhausted", 0.999, 0.999, gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99.9", Actual: "99.900", ErrorBudgetRemaining: "0.0"}),
+		Entry("objective missed", 0.99, 0.97, gardencorev1beta1.ControlPlaneServiceLevel{Objective: "99", Actual: "97.000", ErrorBudgetRemaining: "-200.0"}),
+	)
+
+	DescribeTable("#NewControlPlaneSLOCoverage",
+		func(samples float64, duration, interval time.Duration, expected string) {
+			Expect(NewControlPlaneSLOCoverage(samples, duration, interval)).To(Equal(expected))
+		},
+
+		Entry("complete", 60.0, time.Hour, time.Minute, "100.0"),
+		Entry("incomplete", 45.0, time.Hour, time.Minute, "75.0"),
+		Entry("more samples than expected", 61.0, time.Hour, time.Minute, "100.0"),
+		Entry("no samples", 0.0, time.Hour, time.Minute, "0.0"),
+		Entry("no expected samples", 0.0, time.Duration(0), time.Minute, "0.0"),
+	)
+})
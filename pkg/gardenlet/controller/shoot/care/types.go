@@ -98,6 +98,61 @@ var defaultNewConstraintCheck = func(
 	)
 }
 
+// ControlPlaneSLOCheck is an interface used to compute the service levels of the shoot's control plane.
+type ControlPlaneSLOCheck interface {
+	Check(ctx context.Context, current *gardencorev1beta1.ControlPlaneSLO) *gardencorev1beta1.ControlPlaneSLO
+}
+
+// NewControlPlaneSLOCheckFunc is a function used to create a new instance for computing control plane service levels.
+type NewControlPlaneSLOCheckFunc func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	clock clock.Clock,
+) ControlPlaneSLOCheck
+
+// defaultNewControlPlaneSLOCheck is the default function to create a new instance for computing control plane service
+// levels.
+var defaultNewControlPlaneSLOCheck NewControlPlaneSLOCheckFunc = func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+	clock clock.Clock,
+) ControlPlaneSLOCheck {
+	return NewControlPlaneSLO(
+		log,
+		shoot,
+		seedClient,
+		clock,
+		QueryPrometheus,
+	)
+}
+
+// PlanCollector is an interface used to collect the plans of the shoot's extension resources.
+type PlanCollector interface {
+	Collect(ctx context.Context, current []gardencorev1beta1.ShootPlan) []gardencorev1beta1.ShootPlan
+}
+
+// NewPlanCollectorFunc is a function used to create a new instance for collecting plans.
+type NewPlanCollectorFunc func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+) PlanCollector
+
+// defaultNewPlanCollector is the default function to create a new instance for collecting plans.
+var defaultNewPlanCollector NewPlanCollectorFunc = func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	seedClient client.Client,
+) PlanCollector {
+	return NewPlans(
+		log,
+		shoot,
+		seedClient,
+	)
+}
+
 // GarbageCollector is an interface used to perform garbage collection.
 type GarbageCollector interface {
 	Collect(ctx context.Context)
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	vpnshoot "github.com/gardener/gardener/pkg/component/networking/vpn/shoot"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+)
+
+// VPNConnectivityLossThreshold is the ratio of failed connectivity probes of a segment and tunnel during the last five
+// minutes above which the VPN connectivity is considered broken.
+const VPNConnectivityLossThreshold = 0.2
+
+// VPNConnectivityProbeResult is the result of the connectivity probes of a segment and tunnel.
+type VPNConnectivityProbeResult struct {
+	// Segment is the segment of the probes.
+	Segment string
+	// Tunnel is the tunnel of the probes. It is empty if the probes of the segment are not attributed to a tunnel.
+	Tunnel string
+	// Loss is the ratio of failed probes during the last five minutes.
+	Loss float64
+}
+
+func (r VPNConnectivityProbeResult) String() string {
+	s := "segment " + r.Segment
+	if r.Tunnel != "" {
+		s += " via tunnel " + r.Tunnel
+	}
+	return s + " (" + strconv.FormatFloat(r.Loss*100, 'f', 0, 64) + "% loss)"
+}
+
+// QueryVPNConnectivity queries the recorded loss of the VPN connectivity probes for all segments at the given time. The
+// probes of the tunnel segment are queried for each of the given tunnels. Segments and tunnels without recorded probes
+// are omitted from the result.
+func QueryVPNConnectivity(ctx context.Context, query PrometheusQuerier, address string, ts time.Time, tunnels []string) ([]VPNConnectivityProbeResult, error) {
+	var results []VPNConnectivityProbeResult
+
+	for _, segment := range vpnshoot.ProbeSegments {
+		segmentTunnels := []string{""}
+		if segment == vpnshoot.ProbeSegmentTunnel {
+			segmentTunnels = tunnels
+		}
+
+		for _, tunnel := range segmentTunnels {
+			selector := fmt.Sprintf(`segment=%q`, segment)
+			if tunnel != "" {
+				selector += fmt.Sprintf(`,tunnel=%q`, tunnel)
+			}
+
+			loss, found, err := query(ctx, address, "max("+vpnshoot.RecordProbeLoss+"{"+selector+"})", ts)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+
+			results = append(results, VPNConnectivityProbeResult{Segment: segment, Tunnel: tunnel, Loss: loss})
+		}
+	}
+
+	return results, nil
+}
+
+// checkVPNConnectivity checks whether the connectivity probes through the VPN between the control plane and the shoot
+// succeed.
+func (h *Health) checkVPNConnectivity(ctx context.Context, condition gardencorev1beta1.Condition) (*gardencorev1beta1.Condition, error) {
+	if err := h.seedClient.Client().Get(ctx, client.ObjectKey{Name: shootprometheus.Label, Namespace: h.shoot.ControlPlaneNamespace}, &monitoringv1.Prometheus{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			c := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, condition, "The shoot Prometheus executing the VPN connectivity probes does not exist.")
+			return &c, nil
+		}
+		return nil, fmt.Errorf("failed reading shoot Prometheus: %w", err)
+	}
+
+	var (
+		address = fmt.Sprintf("http://prometheus-%s.%s.svc.cluster.local:%d", shootprometheus.Label, h.shoot.ControlPlaneNamespace, prometheus.ServicePorts().Web.Port)
+		tunnels = vpnshoot.TunnelNames(h.shoot.VPNHighAvailabilityEnabled, h.shoot.VPNHighAvailabilityNumberOfShootClients)
+	)
+
+	results, err := QueryVPNConnectivity(ctx, h.queryPrometheus, address, h.clock.Now(), tunnels)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying VPN connectivity probes: %w", err)
+	}
+
+	if len(results) == 0 {
+		c := v1beta1helper.UpdatedConditionUnknownErrorMessageWithClock(h.clock, condition, "No VPN connectivity probe results have been recorded yet.")
+		return &c, nil
+	}
+
+	var failed []string
+	for _, result := range results {
+		if result.Loss > VPNConnectivityLossThreshold {
+			failed = append(failed, result.String())
+		}
+	}
+
+	if len(failed) > 0 {
+		c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "VPNConnectivityProbesFailing", fmt.Sprintf("VPN connectivity probes are failing for %s.", strings.Join(failed, ", ")))
+		return &c, nil
+	}
+
+	c := v1beta1helper.UpdatedConditionWithClock(h.clock, condition, gardencorev1beta1.ConditionTrue, "VPNConnectivityProbesSucceeding", "VPN connectivity probes are succeeding for all segments and tunnels.")
+	return &c, nil
+}
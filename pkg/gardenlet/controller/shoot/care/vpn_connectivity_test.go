@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("VPNConnectivity", func() {
+	const address = "http://prometheus-shoot.shoot--foo--bar.svc.cluster.local:80"
+
+	var (
+		ctx      context.Context
+		now      time.Time
+		results  map[string]float64
+		queryErr error
+		queries  []string
+
+		query PrometheusQuerier
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+		results = map[string]float64{}
+		queryErr = nil
+		queries = nil
+
+		query = func(_ context.Context, addr, query string, ts time.Time) (float64, bool, error) {
+			Expect(addr).To(Equal(address))
+			Expect(ts).To(Equal(now))
+			queries = append(queries, query)
+
+			if queryErr != nil {
+				return 0, false, queryErr
+			}
+
+			value, ok := results[query]
+			return value, ok, nil
+		}
+	})
+
+	Describe("#QueryVPNConnectivity", func() {
+		It("should query all segments and the tunnel segment per tunnel", func() {
+			results = map[string]float64{
+				`max(shoot:vpn_probe_loss:ratio{segment="service"})`:                     0.1,
+				`max(shoot:vpn_probe_loss:ratio{segment="tunnel",tunnel="vpn-shoot-0"})`: 0,
+				`max(shoot:vpn_probe_loss:ratio{segment="tunnel",tunnel="vpn-shoot-1"})`: 1,
+			}
+
+			Expect(QueryVPNConnectivity(ctx, query, address, now, []string{"vpn-shoot-0", "vpn-shoot-1"})).To(Equal([]VPNConnectivityProbeResult{
+				{Segment: "service", Loss: 0.1},
+				{Segment: "tunnel", Tunnel: "vpn-shoot-0", Loss: 0},
+				{Segment: "tunnel", Tunnel: "vpn-shoot-1", Loss: 1},
+			}))
+			Expect(queries).To(HaveExactElements(
+				`max(shoot:vpn_probe_loss:ratio{segment="node"})`,
+				`max(shoot:vpn_probe_loss:ratio{segment="pod"})`,
+				`max(shoot:vpn_probe_loss:ratio{segment="service"})`,
+				`max(shoot:vpn_probe_loss:ratio{segment="tunnel",tunnel="vpn-shoot-0"})`,
+				`max(shoot:vpn_probe_loss:ratio{segment="tunnel",tunnel="vpn-shoot-1"})`,
+			))
+		})
+
+		It("should not query the tunnel segment without tunnels", func() {
+			Expect(QueryVPNConnectivity(ctx, query, address, now, nil)).To(BeEmpty())
+			Expect(queries).To(HaveExactElements(
+				`max(shoot:vpn_probe_loss:ratio{segment="node"})`,
+				`max(shoot:vpn_probe_loss:ratio{segment="pod"})`,
+				`max(shoot:vpn_probe_loss:ratio{segment="service"})`,
+			))
+		})
+
+		It("should return nothing if no probes were recorded", func() {
+			Expect(QueryVPNConnectivity(ctx, query, address, now, []string{"vpn-shoot"})).To(BeEmpty())
+		})
+
+		It("should return the error of a failing query", func() {
+			queryErr = errors.New("fake")
+
+			results, err := QueryVPNConnectivity(ctx, query, address, now, []string{"vpn-shoot"})
+			Expect(err).To(MatchError("fake"))
+			Expect(results).To(BeNil())
+			Expect(queries).To(HaveLen(1))
+		})
+	})
+
+	Describe("VPNConnectivityProbeResult", func() {
+		DescribeTable("#String",
+			func(result VPNConnectivityProbeResult, expected string) {
+				Expect(result.String()).To(Equal(expected))
+			},
+
+			Entry("with tunnel", VPNConnectivityProbeResult{Segment: "tunnel", Tunnel: "vpn-shoot-1", Loss: 1}, "segment tunnel via tunnel vpn-shoot-1 (100% loss)"),
+			Entry("without tunnel", VPNConnectivityProbeResult{Segment: "pod", Loss: 0.25}, "segment pod (25% loss)"),
+		)
+	})
+})
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnostics_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/diagnostics"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#ShootPredicate", func() {
+		var (
+			p        predicate.Predicate
+			shoot    *gardencorev1beta1.Shoot
+			oldShoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = ShootPredicate()
+			oldShoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot"}}
+			shoot = oldShoot.DeepCopy()
+		})
+
+		It("should return true for created shoots without request annotation to delete expired bundles", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for shoots without request annotation", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return true for shoots with request annotation", func() {
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/diagnostics-requested": "3600"}
+
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return false for updates if the request annotation did not change", func() {
+			oldShoot.Annotations = map[string]string{"shoot.gardener.cloud/diagnostics-requested": "3600"}
+			shoot.Annotations = map[string]string{"shoot.gardener.cloud/diagnostics-requested": "3600"}
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,400 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// maxBundleSize is the maximum size of a compressed diagnostics bundle. It must fit into an InternalSecret.
+	maxBundleSize = 900 * 1024
+	// maxContainerLogBytes is the maximum number of bytes read from the logs of a single container.
+	maxContainerLogBytes = 128 * 1024
+
+	redacted = "<redacted>"
+)
+
+var (
+	pemBlockRegexp   = regexp.MustCompile(`(?s)-----BEGIN [A-Z0-9 ]+-----.*?-----END [A-Z0-9 ]+-----`)
+	bearerRegexp     = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9\-._~+/]+=*`)
+	credentialRegexp = regexp.MustCompile(`(?i)((?:password|passwd|secret|token|apikey|api_key|access_key)["']?\s*[:=]\s*["']?)[^\s"',}]+`)
+
+	// logContainers are the containers whose logs are collected, keyed by the name of the Deployment or StatefulSet of
+	// the control plane component. Logs of other pods in the control plane namespace, e.g., of extensions, are not
+	// collected since the redaction cannot cover all formats of sensitive information they might log.
+	logContainers = map[string]sets.Set[string]{
+		v1beta1constants.DeploymentNameKubeAPIServer:            sets.New("kube-apiserver"),
+		v1beta1constants.DeploymentNameKubeControllerManager:    sets.New("kube-controller-manager"),
+		v1beta1constants.DeploymentNameKubeScheduler:            sets.New("kube-scheduler"),
+		v1beta1constants.DeploymentNameGardenerResourceManager:  sets.New("gardener-resource-manager"),
+		v1beta1constants.DeploymentNameClusterAutoscaler:        sets.New("cluster-autoscaler"),
+		v1beta1constants.DeploymentNameMachineControllerManager: sets.New("machine-controller-manager"),
+		v1beta1constants.DeploymentNameVPNSeedServer:            sets.New("vpn-seed-server"),
+		v1beta1constants.ETCDMain:                               sets.New("etcd", "backup-restore"),
+		v1beta1constants.ETCDEvents:                             sets.New("etcd", "backup-restore"),
+	}
+
+	// extensionFields are the fields of extension resources which are collected. Other fields, e.g., the provider
+	// configuration, the state or the files of OperatingSystemConfigs, are not collected since they might contain
+	// sensitive information.
+	extensionFields = [][]string{
+		{"apiVersion"},
+		{"kind"},
+		{"metadata", "name"},
+		{"metadata", "namespace"},
+		{"metadata", "generation"},
+		{"metadata", "creationTimestamp"},
+		{"metadata", "deletionTimestamp"},
+		{"spec", "type"},
+		{"spec", "class"},
+		{"status", "observedGeneration"},
+		{"status", "lastOperation"},
+		{"status", "lastError"},
+		{"status", "conditions"},
+	}
+
+	extensionKinds = []struct {
+		objKind           string
+		newObjectListFunc func() client.ObjectList
+	}{
+		{extensionsv1alpha1.BackupEntryResource, func() client.ObjectList { return &extensionsv1alpha1.BackupEntryList{} }},
+		{extensionsv1alpha1.ContainerRuntimeResource, func() client.ObjectList { return &extensionsv1alpha1.ContainerRuntimeList{} }},
+		{extensionsv1alpha1.ControlPlaneResource, func() client.ObjectList { return &extensionsv1alpha1.ControlPlaneList{} }},
+		{extensionsv1alpha1.DNSRecordResource, func() client.ObjectList { return &extensionsv1alpha1.DNSRecordList{} }},
+		{extensionsv1alpha1.ExtensionResource, func() client.ObjectList { return &extensionsv1alpha1.ExtensionList{} }},
+		{extensionsv1alpha1.InfrastructureResource, func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} }},
+		{extensionsv1alpha1.NetworkResource, func() client.ObjectList { return &extensionsv1alpha1.NetworkList{} }},
+		{extensionsv1alpha1.OperatingSystemConfigResource, func() client.ObjectList { return &extensionsv1alpha1.OperatingSystemConfigList{} }},
+		{extensionsv1alpha1.WorkerResource, func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} }},
+	}
+)
+
+type file struct {
+	name string
+	data []byte
+}
+
+// Redact removes PEM blocks, bearer tokens and values of credential-like keys (passwords, secrets, tokens, API keys)
+// from the given data.
+func Redact(data []byte) []byte {
+	data = pemBlockRegexp.ReplaceAll(data, []byte(redacted))
+	data = bearerRegexp.ReplaceAll(data, []byte("${1}"+redacted))
+	return credentialRegexp.ReplaceAll(data, []byte("${1}"+redacted))
+}
+
+func (r *Reconciler) collect(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, since time.Duration) ([]byte, error) {
+	var (
+		controlPlaneNamespace = v1beta1helper.ControlPlaneNamespaceForShoot(shoot)
+		sinceTime             = r.Clock.Now().Add(-since)
+		files                 []file
+	)
+
+	for _, collect := range []func() ([]file, error){
+		func() ([]file, error) { return r.collectShoot(shoot.DeepCopy()) },
+		func() ([]file, error) { return r.collectShootState(ctx, shoot) },
+		func() ([]file, error) { return r.collectExtensions(ctx, controlPlaneNamespace) },
+		func() ([]file, error) { return r.collectManagedResources(ctx, controlPlaneNamespace) },
+		func() ([]file, error) { return r.collectEvents(ctx, controlPlaneNamespace, sinceTime) },
+	} {
+		f, err := collect()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f...)
+	}
+
+	logFiles, err := r.collectLogs(ctx, log, controlPlaneNamespace, since)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := archive(append(files, logFiles...), r.Clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bundle) > maxBundleSize {
+		log.Info("Diagnostics bundle exceeds maximum size, omitting logs", "size", len(bundle), "maxSize", maxBundleSize)
+		files = append(files, file{name: "logs/OMITTED", data: []byte("Logs were omitted because the bundle exceeded the maximum size.\n")})
+
+		if bundle, err = archive(files, r.Clock.Now()); err != nil {
+			return nil, err
+		}
+		if len(bundle) > maxBundleSize {
+			return nil, fmt.Errorf("diagnostics bundle exceeds maximum size of %d bytes even without logs", maxBundleSize)
+		}
+	}
+
+	return bundle, nil
+}
+
+func (r *Reconciler) collectShoot(shoot *gardencorev1beta1.Shoot) ([]file, error) {
+	data, err := toYAML(shoot)
+	if err != nil {
+		return nil, err
+	}
+	return []file{{name: "shoot.yaml", data: data}}, nil
+}
+
+func (r *Reconciler) collectShootState(ctx context.Context, shoot *gardencorev1beta1.Shoot) ([]file, error) {
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading ShootState: %w", err)
+	}
+
+	// The ShootState contains secrets and the internal state of extensions, hence only the names are kept.
+	for i := range shootState.Spec.Gardener {
+		shootState.Spec.Gardener[i].Data = runtime.RawExtension{}
+	}
+	for i := range shootState.Spec.Extensions {
+		shootState.Spec.Extensions[i].State = nil
+	}
+	for i := range shootState.Spec.Resources {
+		shootState.Spec.Resources[i].Data = runtime.RawExtension{}
+	}
+
+	data, err := toYAML(shootState)
+	if err != nil {
+		return nil, err
+	}
+	return []file{{name: "shootstate.yaml", data: data}}, nil
+}
+
+func (r *Reconciler) collectExtensions(ctx context.Context, controlPlaneNamespace string) ([]file, error) {
+	var files []file
+
+	for _, extension := range extensionKinds {
+		objList := extension.newObjectListFunc()
+		if err := r.SeedClient.List(ctx, objList, client.InNamespace(controlPlaneNamespace)); err != nil {
+			return nil, fmt.Errorf("failed to list extension resources of kind %s: %w", extension.objKind, err)
+		}
+
+		if err := meta.EachListItem(objList, func(obj runtime.Object) error {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			if err != nil {
+				return err
+			}
+
+			u := &unstructured.Unstructured{Object: map[string]any{}}
+			for _, fields := range extensionFields {
+				value, found, err := unstructured.NestedFieldNoCopy(content, fields...)
+				if err != nil || !found {
+					continue
+				}
+				if err := unstructured.SetNestedField(u.Object, value, fields...); err != nil {
+					return err
+				}
+			}
+
+			data, err := toYAML(u)
+			if err != nil {
+				return err
+			}
+
+			files = append(files, file{name: path.Join("extensions", strings.ToLower(extension.objKind), u.GetName()+".yaml"), data: data})
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("failed collecting extension resources of kind %s: %w", extension.objKind, err)
+		}
+	}
+
+	return files, nil
+}
+
+func (r *Reconciler) collectManagedResources(ctx context.Context, controlPlaneNamespace string) ([]file, error) {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SeedClient.List(ctx, managedResourceList, client.InNamespace(controlPlaneNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing ManagedResources: %w", err)
+	}
+
+	type managedResource struct {
+		Name       string                        `json:"name"`
+		Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
+	}
+
+	managedResources := make([]managedResource, 0, len(managedResourceList.Items))
+	for _, mr := range managedResourceList.Items {
+		managedResources = append(managedResources, managedResource{Name: mr.Name, Conditions: mr.Status.Conditions})
+	}
+
+	data, err := yaml.Marshal(managedResources)
+	if err != nil {
+		return nil, err
+	}
+	return []file{{name: "managedresources.yaml", data: data}}, nil
+}
+
+func (r *Reconciler) collectEvents(ctx context.Context, controlPlaneNamespace string, since time.Time) ([]file, error) {
+	eventList := &corev1.EventList{}
+	if err := r.SeedClient.List(ctx, eventList, client.InNamespace(controlPlaneNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing events: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for _, event := range eventList.Items {
+		timestamp := event.LastTimestamp.Time
+		if timestamp.IsZero() {
+			timestamp = event.EventTime.Time
+		}
+		if timestamp.Before(since) || !isCollectedObject(event.InvolvedObject) {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "%s %s %s %s/%s: %s\n", timestamp.UTC().Format(time.RFC3339), event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message)
+	}
+
+	return []file{{name: "events.log", data: Redact(buf.Bytes())}}, nil
+}
+
+func (r *Reconciler) collectLogs(ctx context.Context, log logr.Logger, controlPlaneNamespace string, since time.Duration) ([]file, error) {
+	podList := &corev1.PodList{}
+	if err := r.SeedClient.List(ctx, podList, client.InNamespace(controlPlaneNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing pods: %w", err)
+	}
+
+	var (
+		files        []file
+		podInterface = r.SeedClientSet.Kubernetes().CoreV1().Pods(controlPlaneNamespace)
+	)
+
+	for _, pod := range podList.Items {
+		containers, ok := logContainers[workloadName(&pod)]
+		if !ok {
+			continue
+		}
+
+		for _, container := range pod.Spec.Containers {
+			if !containers.Has(container.Name) {
+				continue
+			}
+
+			logs, err := kubernetesutils.GetPodLogs(ctx, podInterface, pod.Name, &corev1.PodLogOptions{
+				Container:    container.Name,
+				SinceSeconds: ptr.To(int64(since.Seconds())),
+				LimitBytes:   ptr.To[int64](maxContainerLogBytes),
+			})
+			if err != nil {
+				log.V(1).Info("Failed reading container logs, skipping", "pod", pod.Name, "container", container.Name, "error", err.Error())
+				continue
+			}
+
+			files = append(files, file{name: path.Join("logs", pod.Name, container.Name+".log"), data: Redact(logs)})
+		}
+	}
+
+	return files, nil
+}
+
+// workloadName returns the name of the Deployment or StatefulSet controlling the given pod.
+func workloadName(pod *corev1.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return ""
+	}
+
+	switch owner.Kind {
+	case "ReplicaSet":
+		return strings.TrimSuffix(owner.Name, "-"+pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey])
+	case "StatefulSet":
+		return owner.Name
+	}
+	return ""
+}
+
+// isCollectedObject returns whether the events of the given object are collected, i.e., whether it is an extension
+// resource or belongs to a control plane component whose logs are collected.
+func isCollectedObject(ref corev1.ObjectReference) bool {
+	for _, extension := range extensionKinds {
+		if ref.Kind == extension.objKind {
+			return true
+		}
+	}
+
+	switch ref.Kind {
+	case "Deployment", "StatefulSet":
+		_, ok := logContainers[ref.Name]
+		return ok
+	case "ReplicaSet", "Pod":
+		for name := range logContainers {
+			if strings.HasPrefix(ref.Name, name+"-") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func toYAML(obj client.Object) ([]byte, error) {
+	obj.SetManagedFields(nil)
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling %s: %w", obj.GetName(), err)
+	}
+	return Redact(data), nil
+}
+
+func archive(files []file, modTime time.Time) ([]byte, error) {
+	var (
+		buf bytes.Buffer
+		gw  = gzip.NewWriter(&buf)
+		tw  = tar.NewWriter(gw)
+	)
+
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    f.name,
+			Mode:    0600,
+			Size:    int64(len(f.data)),
+			ModTime: modTime,
+		}); err != nil {
+			return nil, fmt.Errorf("failed writing header for %s: %w", f.name, err)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return nil, fmt.Errorf("failed writing %s: %w", f.name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
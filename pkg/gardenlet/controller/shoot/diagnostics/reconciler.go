@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler collects diagnostics bundles for Shoots which are annotated with a request for collecting diagnostics and
+// stores them in an InternalSecret in the project namespace. Expired bundles are deleted.
+type Reconciler struct {
+	GardenClient  client.Client
+	SeedClient    client.Client
+	SeedClientSet kubernetes.Interface
+	Config        gardenletconfigv1alpha1.ShootDiagnosticsControllerConfiguration
+	Clock         clock.Clock
+	SeedName      string
+}
+
+// Reconcile collects the diagnostics bundle for the Shoot if requested, or deletes an expired bundle.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		log.Info("Shoot is being deleted or is no longer managed by this gardenlet, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	bundleSecret := &gardencorev1beta1.InternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixDiagnostics),
+			Namespace: shoot.Namespace,
+		},
+	}
+
+	value, requested := shoot.Annotations[v1beta1constants.AnnotationShootDiagnosticsRequested]
+	if !requested {
+		return r.deleteExpiredBundle(ctx, log, bundleSecret)
+	}
+
+	sinceSeconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || sinceSeconds <= 0 {
+		log.Info("Ignoring request for collecting diagnostics with invalid time window", "sinceSeconds", value)
+		return reconcile.Result{}, r.removeRequestAnnotation(ctx, shoot, value)
+	}
+
+	log.Info("Collecting diagnostics bundle", "sinceSeconds", sinceSeconds)
+	collectionTimestamp := r.Clock.Now().UTC()
+
+	bundle, err := r.collect(ctx, log, shoot, time.Duration(sinceSeconds)*time.Second)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed collecting diagnostics bundle: %w", err)
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.GardenClient, bundleSecret, func() error {
+		bundleSecret.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(shoot, gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot")),
+		}
+		bundleSecret.Labels = map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleDiagnostics}
+		bundleSecret.Annotations = map[string]string{
+			v1beta1constants.AnnotationDiagnosticsSinceSeconds:        value,
+			v1beta1constants.AnnotationDiagnosticsCollectionTimestamp: collectionTimestamp.Format(time.RFC3339),
+			v1beta1constants.AnnotationDiagnosticsExpirationTimestamp: collectionTimestamp.Add(r.Config.BundleTTL.Duration).Format(time.RFC3339),
+		}
+		bundleSecret.Type = corev1.SecretTypeOpaque
+		bundleSecret.Data = map[string][]byte{v1beta1constants.DataKeyDiagnosticsBundle: bundle}
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed storing diagnostics bundle: %w", err)
+	}
+
+	log.Info("Successfully collected diagnostics bundle", "internalSecret", client.ObjectKeyFromObject(bundleSecret), "size", len(bundle))
+
+	if err := r.removeRequestAnnotation(ctx, shoot, value); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.BundleTTL.Duration}, nil
+}
+
+// removeRequestAnnotation removes the annotation requesting the collection of diagnostics from the Shoot. If the
+// annotation was changed in the meantime (e.g., because another time window was requested), it is kept and the
+// resulting conflict leads to a new collection.
+func (r *Reconciler) removeRequestAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot, value string) error {
+	if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot); err != nil {
+		return fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.Annotations[v1beta1constants.AnnotationShootDiagnosticsRequested] != value {
+		return fmt.Errorf("request for collecting diagnostics has changed in the meantime, collecting again")
+	}
+
+	patch := client.MergeFromWithOptions(shoot.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	delete(shoot.Annotations, v1beta1constants.AnnotationShootDiagnosticsRequested)
+	if err := r.GardenClient.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed removing annotation %s: %w", v1beta1constants.AnnotationShootDiagnosticsRequested, err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) deleteExpiredBundle(ctx context.Context, log logr.Logger, bundleSecret *gardencorev1beta1.InternalSecret) (reconcile.Result, error) {
+	if err := r.GardenClient.Get(ctx, client.ObjectKeyFromObject(bundleSecret), bundleSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed reading diagnostics bundle: %w", err)
+	}
+
+	expirationTimestamp, err := time.Parse(time.RFC3339, bundleSecret.Annotations[v1beta1constants.AnnotationDiagnosticsExpirationTimestamp])
+	if err == nil {
+		if remaining := expirationTimestamp.Sub(r.Clock.Now()); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	log.Info("Deleting expired diagnostics bundle", "internalSecret", client.ObjectKeyFromObject(bundleSecret))
+	return reconcile.Result{}, client.IgnoreNotFound(r.GardenClient.Delete(ctx, bundleSecret))
+}
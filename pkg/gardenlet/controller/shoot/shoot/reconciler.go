@@ -875,7 +875,8 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 				gardencorev1beta1.ShootControlPlaneHealthy,
 				gardencorev1beta1.ShootObservabilityComponentsHealthy,
 				gardencorev1beta1.ShootEveryNodeReady,
-				gardencorev1beta1.ShootSystemComponentsHealthy:
+				gardencorev1beta1.ShootSystemComponentsHealthy,
+				gardencorev1beta1.ShootVPNConnectivity:
 				if cond.Status != gardencorev1beta1.ConditionFalse {
 					shoot.Status.Conditions[i].Status = gardencorev1beta1.ConditionProgressing
 					shoot.Status.Conditions[i].LastUpdateTime = metav1.Now()
@@ -660,6 +660,11 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Fn:           flow.TaskFn(botanist.DestroySeedLogging).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureDeleted),
 		})
+		destroyLogForwarding = g.Add(flow.Task{
+			Name:         "Deleting shoot control plane log forwarding in Seed",
+			Fn:           flow.TaskFn(botanist.DestroyLogForwarding).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureDeleted),
+		})
 
 		syncPoint = flow.NewTaskIDs(
 			deleteAlertmanager,
@@ -667,6 +672,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			deleteBlackboxExporter,
 			deletePlutono,
 			destroySeedLogging,
+			destroyLogForwarding,
 			waitUntilKubeAPIServerDeleted,
 			waitUntilControlPlaneDeleted,
 			waitUntilExtensionResourcesAfterKubeAPIServerDeleted,
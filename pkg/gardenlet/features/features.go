@@ -31,5 +31,6 @@ func GetFeatures() []featuregate.Feature {
 		features.VPNBondingModeRoundRobin,
 		features.PrometheusHealthChecks,
 		features.RemoveVali,
+		features.SSHCertificateOnlyAccess,
 	}
 }
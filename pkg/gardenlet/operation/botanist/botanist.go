@@ -51,6 +51,14 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		namespaces = append(namespaces, v1beta1constants.GardenNamespace)
 	}
 
+	// The names of the secrets which are taken over from the clone source depend on their rotation status, hence it
+	// must be inherited before the secrets manager is initialized.
+	if b.IsRestoringClone() {
+		if err := b.InheritCredentialsRotationFromCloneSource(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	o.SecretsManager, err = secretsmanager.New(
 		ctx,
 		b.Logger.WithName("secretsmanager"),
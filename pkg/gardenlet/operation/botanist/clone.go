@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// cloneSourceShoot returns the shoot this shoot is cloned from. The source shoot must be located in the same namespace
+// and must be scheduled to the same seed.
+func (b *Botanist) cloneSourceShoot(ctx context.Context) (*gardencorev1beta1.Shoot, error) {
+	sourceShoot := &gardencorev1beta1.Shoot{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Namespace: b.Shoot.GetInfo().Namespace, Name: b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootCloneSource]}, sourceShoot); err != nil {
+		return nil, fmt.Errorf("failed reading clone source shoot: %w", err)
+	}
+
+	if ptr.Deref(sourceShoot.Spec.SeedName, "") != b.Seed.GetInfo().Name || ptr.Deref(sourceShoot.Status.SeedName, "") != b.Seed.GetInfo().Name {
+		return nil, fmt.Errorf("clone source shoot %s is not running on seed %s", client.ObjectKeyFromObject(sourceShoot), b.Seed.GetInfo().Name)
+	}
+
+	return sourceShoot, nil
+}
+
+// cloneSourceSecretNames returns the names of the secrets which are taken over from the clone source. The restored
+// etcd data is encrypted with the encryption key of the source, and the restored service account tokens and
+// certificates are signed by the service account key and the certificate authorities of the source.
+func (b *Botanist) cloneSourceSecretNames() []string {
+	names := []string{v1beta1constants.SecretNameETCDEncryptionKey, v1beta1constants.SecretNameServiceAccountKey}
+	for _, config := range caCertConfigurations(b.Shoot.IsWorkerless, b.Shoot.IsSelfHosted()) {
+		names = append(names, config.GetName())
+	}
+	return names
+}
+
+// InheritCredentialsRotationFromCloneSource copies the rotation status of the credentials which are taken over from the
+// clone source to the shoot. The names of the secrets depend on their last rotation, hence the status must be known
+// before the secrets manager is initialized.
+func (b *Botanist) InheritCredentialsRotationFromCloneSource(ctx context.Context) error {
+	sourceShoot, err := b.cloneSourceShoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	sourceCredentials := sourceShoot.Status.Credentials
+	if sourceCredentials == nil || sourceCredentials.Rotation == nil {
+		return nil
+	}
+
+	if credentials := b.Shoot.GetInfo().Status.Credentials; credentials != nil && credentials.Rotation != nil &&
+		(credentials.Rotation.CertificateAuthorities != nil || credentials.Rotation.ServiceAccountKey != nil || credentials.Rotation.ETCDEncryptionKey != nil) {
+		return nil
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, false, func(shoot *gardencorev1beta1.Shoot) error {
+		if shoot.Status.Credentials == nil {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{}
+		}
+		if shoot.Status.Credentials.Rotation == nil {
+			shoot.Status.Credentials.Rotation = &gardencorev1beta1.ShootCredentialsRotation{}
+		}
+
+		shoot.Status.Credentials.Rotation.CertificateAuthorities = sourceCredentials.Rotation.CertificateAuthorities.DeepCopy()
+		shoot.Status.Credentials.Rotation.ServiceAccountKey = sourceCredentials.Rotation.ServiceAccountKey.DeepCopy()
+		shoot.Status.Credentials.Rotation.ETCDEncryptionKey = sourceCredentials.Rotation.ETCDEncryptionKey.DeepCopy()
+		return nil
+	})
+}
+
+// restoreSecretsFromCloneSource creates the secrets which are taken over from the clone source in the shoot namespace
+// in the seed. Like for the restoration of a migrated control plane, the data of the secrets is computed the same way
+// as for the ShootState, however it is read from the control plane namespace of the source since it runs on the same
+// seed.
+func (b *Botanist) restoreSecretsFromCloneSource(ctx context.Context) error {
+	sourceShoot, err := b.cloneSourceShoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	secretsToPersist, err := shootstate.ComputeSecretsToPersist(ctx, b.SeedClientSet.Client(), sourceShoot.Status.TechnicalID)
+	if err != nil {
+		return fmt.Errorf("failed computing secrets of clone source shoot %s: %w", client.ObjectKeyFromObject(sourceShoot), err)
+	}
+
+	names := b.cloneSourceSecretNames()
+	secretsToPersist = slices.DeleteFunc(secretsToPersist, func(data gardencorev1beta1.GardenerResourceData) bool {
+		return data.Labels[secretsmanager.LabelKeyManagerIdentity] != v1beta1constants.SecretManagerIdentityGardenlet ||
+			!slices.Contains(names, data.Labels[secretsmanager.LabelKeyName])
+	})
+
+	return b.restoreSecrets(ctx, secretsToPersist)
+}
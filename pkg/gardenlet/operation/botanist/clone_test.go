@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		ctx = context.TODO()
+
+		gardenClient client.Client
+		botanist     *Botanist
+
+		sourceShoot *gardencorev1beta1.Shoot
+		shoot       *gardencorev1beta1.Shoot
+
+		rotationTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+
+		sourceShoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: "garden-foo"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName: ptr.To("seed"),
+				Credentials: &gardencorev1beta1.ShootCredentials{
+					Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+						CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &rotationTime},
+						ServiceAccountKey:      &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &rotationTime},
+						ETCDEncryptionKey:      &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationCompleted, LastInitiationTime: &rotationTime},
+						SSHKeypair:             &gardencorev1beta1.ShootSSHKeypairRotation{LastInitiationTime: &rotationTime},
+					},
+				},
+			},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "clone",
+				Namespace:   "garden-foo",
+				Annotations: map[string]string{"shoot.gardener.cloud/clone-source": "source"},
+			},
+			Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+		}
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			GardenClient: gardenClient,
+			Seed:         &seedpkg.Seed{},
+			Shoot:        &shootpkg.Shoot{},
+		}}
+		botanist.Seed.SetInfo(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}})
+	})
+
+	JustBeforeEach(func() {
+		for _, obj := range []*gardencorev1beta1.Shoot{sourceShoot, shoot} {
+			status := obj.Status.DeepCopy()
+			Expect(gardenClient.Create(ctx, obj)).To(Succeed())
+			obj.Status = *status
+			Expect(gardenClient.Status().Update(ctx, obj)).To(Succeed())
+		}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#InheritCredentialsRotationFromCloneSource", func() {
+		It("should copy the rotation status of the credentials which are taken over from the source", func() {
+			Expect(botanist.InheritCredentialsRotationFromCloneSource(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Credentials).To(Equal(&gardencorev1beta1.ShootCredentials{
+				Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+					CertificateAuthorities: sourceShoot.Status.Credentials.Rotation.CertificateAuthorities,
+					ServiceAccountKey:      sourceShoot.Status.Credentials.Rotation.ServiceAccountKey,
+					ETCDEncryptionKey:      sourceShoot.Status.Credentials.Rotation.ETCDEncryptionKey,
+				},
+			}))
+			Expect(botanist.Shoot.GetInfo().Status.Credentials).To(Equal(shoot.Status.Credentials))
+		})
+
+		Context("credentials of source never rotated", func() {
+			BeforeEach(func() {
+				sourceShoot.Status.Credentials = nil
+			})
+
+			It("should do nothing", func() {
+				Expect(botanist.InheritCredentialsRotationFromCloneSource(ctx)).To(Succeed())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.Credentials).To(BeNil())
+			})
+		})
+
+		Context("rotation status already inherited", func() {
+			BeforeEach(func() {
+				shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{
+					Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+						ServiceAccountKey: &gardencorev1beta1.ServiceAccountKeyRotation{Phase: gardencorev1beta1.RotationPreparing},
+					},
+				}
+			})
+
+			It("should not overwrite the rotation status of the shoot", func() {
+				Expect(botanist.InheritCredentialsRotationFromCloneSource(ctx)).To(Succeed())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.Credentials.Rotation.CertificateAuthorities).To(BeNil())
+				Expect(shoot.Status.Credentials.Rotation.ServiceAccountKey.Phase).To(Equal(gardencorev1beta1.RotationPreparing))
+			})
+		})
+
+		Context("source running on another seed", func() {
+			BeforeEach(func() {
+				sourceShoot.Status.SeedName = ptr.To("other-seed")
+			})
+
+			It("should fail", func() {
+				Expect(botanist.InheritCredentialsRotationFromCloneSource(ctx)).To(MatchError(ContainSubstring("is not running on seed seed")))
+			})
+		})
+	})
+})
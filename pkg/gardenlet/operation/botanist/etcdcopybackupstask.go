@@ -16,6 +16,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	etcdcopybackupstask "github.com/gardener/gardener/pkg/component/etcd/copybackupstask"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // NewEtcdCopyBackupsTask is a function exposed for testing.
@@ -23,17 +24,24 @@ var NewEtcdCopyBackupsTask = etcdcopybackupstask.New
 
 // DefaultEtcdCopyBackupsTask creates the default deployer for the EtcdCopyBackupsTask resource.
 func (b *Botanist) DefaultEtcdCopyBackupsTask() etcdcopybackupstask.Interface {
+	values := &etcdcopybackupstask.Values{
+		Name:      b.Shoot.GetInfo().Name,
+		Namespace: b.Shoot.ControlPlaneNamespace,
+		WaitForFinalSnapshot: &druidcorev1alpha1.WaitForFinalSnapshotSpec{
+			Enabled: true,
+			Timeout: &metav1.Duration{Duration: etcdcopybackupstask.DefaultTimeout},
+		},
+	}
+
+	// The etcd of the clone source keeps running, hence there is no final snapshot to wait for.
+	if b.IsRestoringClone() {
+		values.WaitForFinalSnapshot = nil
+	}
+
 	return NewEtcdCopyBackupsTask(
 		b.Logger,
 		b.SeedClientSet.Client(),
-		&etcdcopybackupstask.Values{
-			Name:      b.Shoot.GetInfo().Name,
-			Namespace: b.Shoot.ControlPlaneNamespace,
-			WaitForFinalSnapshot: &druidcorev1alpha1.WaitForFinalSnapshotSpec{
-				Enabled: true,
-				Timeout: &metav1.Duration{Duration: etcdcopybackupstask.DefaultTimeout},
-			},
-		},
+		values,
 		etcdcopybackupstask.DefaultInterval,
 		etcdcopybackupstask.DefaultSevereThreshold,
 		etcdcopybackupstask.DefaultTimeout,
@@ -49,32 +57,57 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 		return err
 	}
 
-	sourceBackupEntryName := fmt.Sprintf("%s-%s", v1beta1constants.BackupSourcePrefix, b.Shoot.BackupEntryName)
-	sourceBackupEntry := &extensionsv1alpha1.BackupEntry{}
-	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: sourceBackupEntryName}, sourceBackupEntry); err != nil {
-		return err
-	}
-	sourceSecretName := fmt.Sprintf("%s-%s", v1beta1constants.BackupSourcePrefix, v1beta1constants.BackupSecretName)
-	sourceSecret := &corev1.Secret{}
-	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: sourceSecretName}, sourceSecret); err != nil {
-		return err
-	}
 	secret := &corev1.Secret{}
-	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: v1beta1constants.BackupSecretName}, secret); err != nil {
-		return err
+	if b.IsRestoringClone() {
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: v1beta1constants.BackupSecretName}, secret); err != nil {
+			return err
+		}
+
+		// The backups of the clone source are located in the same backup bucket, hence the secret of the shoot's own
+		// BackupEntry can be used for both stores.
+		sourceBackupEntryName, err := b.cloneSourceBackupEntryName(ctx)
+		if err != nil {
+			return err
+		}
+
+		provider := druidcorev1alpha1.StorageProvider(b.Seed.GetInfo().Spec.Backup.Provider)
+		container := string(secret.Data[v1beta1constants.DataKeyBackupBucketName])
+
+		b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetSourceStore(druidcorev1alpha1.StoreSpec{
+			Provider:  &provider,
+			SecretRef: &corev1.SecretReference{Name: secret.Name},
+			Prefix:    fmt.Sprintf("%s/etcd-%s", sourceBackupEntryName, v1beta1constants.ETCDRoleMain),
+			Container: &container,
+		})
+	} else {
+		sourceBackupEntryName := fmt.Sprintf("%s-%s", v1beta1constants.BackupSourcePrefix, b.Shoot.BackupEntryName)
+		sourceBackupEntry := &extensionsv1alpha1.BackupEntry{}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: sourceBackupEntryName}, sourceBackupEntry); err != nil {
+			return err
+		}
+		sourceSecretName := fmt.Sprintf("%s-%s", v1beta1constants.BackupSourcePrefix, v1beta1constants.BackupSecretName)
+		sourceSecret := &corev1.Secret{}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: sourceSecretName}, sourceSecret); err != nil {
+			return err
+		}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: v1beta1constants.BackupSecretName}, secret); err != nil {
+			return err
+		}
+
+		sourceProvider := druidcorev1alpha1.StorageProvider(sourceBackupEntry.Spec.Type)
+		sourceContainer := string(sourceSecret.Data[v1beta1constants.DataKeyBackupBucketName])
+
+		b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetSourceStore(druidcorev1alpha1.StoreSpec{
+			Provider:  &sourceProvider,
+			SecretRef: &corev1.SecretReference{Name: sourceSecret.Name},
+			Prefix:    fmt.Sprintf("%s/etcd-%s", b.Shoot.BackupEntryName, v1beta1constants.ETCDRoleMain),
+			Container: &sourceContainer,
+		})
 	}
 
-	sourceProvider := druidcorev1alpha1.StorageProvider(sourceBackupEntry.Spec.Type)
 	provider := druidcorev1alpha1.StorageProvider(b.Seed.GetInfo().Spec.Backup.Provider)
-	sourceContainer := string(sourceSecret.Data[v1beta1constants.DataKeyBackupBucketName])
 	container := string(secret.Data[v1beta1constants.DataKeyBackupBucketName])
 
-	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetSourceStore(druidcorev1alpha1.StoreSpec{
-		Provider:  &sourceProvider,
-		SecretRef: &corev1.SecretReference{Name: sourceSecret.Name},
-		Prefix:    fmt.Sprintf("%s/etcd-%s", b.Shoot.BackupEntryName, v1beta1constants.ETCDRoleMain),
-		Container: &sourceContainer,
-	})
 	b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.SetTargetStore(druidcorev1alpha1.StoreSpec{
 		Provider:  &provider,
 		SecretRef: &corev1.SecretReference{Name: secret.Name},
@@ -84,3 +117,13 @@ func (b *Botanist) DeployEtcdCopyBackupsTask(ctx context.Context) error {
 
 	return b.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Deploy(ctx)
 }
+
+// cloneSourceBackupEntryName returns the name of the BackupEntry of the shoot this shoot is cloned from.
+func (b *Botanist) cloneSourceBackupEntryName(ctx context.Context) (string, error) {
+	sourceShoot, err := b.cloneSourceShoot(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return gardenerutils.GenerateBackupEntryName(sourceShoot.Status.TechnicalID, sourceShoot.Status.UID, sourceShoot.UID)
+}
@@ -19,7 +19,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -115,6 +117,26 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			etcdCopyBackupsTask := botanist.DefaultEtcdCopyBackupsTask()
 			Expect(etcdCopyBackupsTask).NotTo(BeNil())
 		})
+
+		It("should not wait for a final snapshot when restoring a clone", func() {
+			botanist.Shoot.GetInfo().Annotations = map[string]string{"shoot.gardener.cloud/clone-source": "source"}
+
+			validator := &newEtcdCopyBackupsTaskValidator{
+				expectedClient: Equal(c),
+				expectedLogger: BeAssignableToTypeOf(logr.Logger{}),
+				expectedValues: Equal(&etcdcopybackupstask.Values{
+					Name:      botanist.Shoot.GetInfo().Name,
+					Namespace: botanist.Shoot.ControlPlaneNamespace,
+				}),
+				expectedWaitInterval:        Equal(etcdcopybackupstask.DefaultInterval),
+				expectedWaitSevereThreshold: Equal(etcdcopybackupstask.DefaultSevereThreshold),
+				expectedWaitTimeout:         Equal(etcdcopybackupstask.DefaultTimeout),
+			}
+
+			defer test.WithVars(&NewEtcdCopyBackupsTask, validator.NewEtcdCopyBackupsTask)()
+
+			Expect(botanist.DefaultEtcdCopyBackupsTask()).NotTo(BeNil())
+		})
 	})
 
 	Describe("#DeployEtcdCopyBackupsTask", func() {
@@ -223,6 +245,69 @@ var _ = Describe("EtcdCopyBackupsTask", func() {
 			etcdCopyBackupsTask.EXPECT().Deploy(ctx).Return(fakeErr)
 			Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(MatchError(fakeErr))
 		})
+
+		Context("restoring a clone", func() {
+			var sourceShoot *gardencorev1beta1.Shoot
+
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Annotations = map[string]string{"shoot.gardener.cloud/clone-source": "source"}
+
+				sourceShoot = &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: projectName, UID: "source-uid"},
+					Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+					Status: gardencorev1beta1.ShootStatus{
+						SeedName:    ptr.To(seedName),
+						TechnicalID: "shoot--foo--source",
+						UID:         "source-uid",
+					},
+				}
+			})
+
+			It("should copy the backups of the clone source within the same bucket", func() {
+				botanist.GardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(sourceShoot).Build()
+
+				etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+				etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+				c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret)).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+					obj.Name = "etcd-backup"
+					obj.Data = map[string][]byte{"bucketName": []byte("bucket")}
+					return nil
+				})
+				etcdCopyBackupsTask.EXPECT().SetSourceStore(druidcorev1alpha1.StoreSpec{
+					Provider:  ptr.To(druidcorev1alpha1.StorageProvider("gcp")),
+					SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+					Prefix:    "shoot--foo--source--source-uid/etcd-main",
+					Container: ptr.To("bucket"),
+				})
+				etcdCopyBackupsTask.EXPECT().SetTargetStore(druidcorev1alpha1.StoreSpec{
+					Provider:  ptr.To(druidcorev1alpha1.StorageProvider("gcp")),
+					SecretRef: &corev1.SecretReference{Name: "etcd-backup"},
+					Prefix:    backupEntryName + "/etcd-main",
+					Container: ptr.To("bucket"),
+				})
+				etcdCopyBackupsTask.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(Succeed())
+			})
+
+			It("should return an error if the clone source runs on another seed", func() {
+				sourceShoot.Status.SeedName = ptr.To("other-seed")
+				botanist.GardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(sourceShoot).Build()
+
+				etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+				etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+				c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret))
+				Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(MatchError(ContainSubstring("clone source shoot foo/source is not running on seed seed")))
+			})
+
+			It("should return an error if the clone source does not exist", func() {
+				botanist.GardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+				etcdCopyBackupsTask.EXPECT().Destroy(ctx)
+				etcdCopyBackupsTask.EXPECT().WaitCleanup(ctx)
+				c.EXPECT().Get(ctx, client.ObjectKeyFromObject(etcdBackupSecret), gomock.AssignableToTypeOf(etcdBackupSecret))
+				Expect(botanist.DeployEtcdCopyBackupsTask(ctx)).To(MatchError(ContainSubstring("failed reading clone source shoot")))
+			})
+		})
 	})
 })
 
@@ -9,13 +9,17 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	apiserverv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	"k8s.io/client-go/tools/clientcmd"
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"k8s.io/utils/ptr"
@@ -24,11 +28,14 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
+	"github.com/gardener/gardener/pkg/component/apiserver"
 	resourcemanagerconstants "github.com/gardener/gardener/pkg/component/gardener/resourcemanager/constants"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/component/shared"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	netutils "github.com/gardener/gardener/pkg/utils/net"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -49,6 +56,11 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		vpnConfig.IPFamilies = b.Seed.GetInfo().Spec.Networks.IPFamilies
 	}
 
+	auditWebhookConfig, err := b.computeKubeAPIServerAuditWebhookConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return shared.NewKubeAPIServer(
 		ctx,
 		b.SeedClientSet,
@@ -66,13 +78,88 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 		b.Shoot.IsWorkerless,
 		b.Shoot.RunsControlPlane(),
 		b.ShootUsesIstioTLSTermination(),
-		nil,
+		auditWebhookConfig,
+		b.computeKubeAPIServerAuditLogConfig(),
 		nil,
 		nil,
 		nil,
 	)
 }
 
+func (b *Botanist) computeKubeAPIServerAuditLogConfig() *apiserver.AuditLog {
+	// The log backend is required for shipping the audit events into the logging stack and for forwarding them to the
+	// logging outputs of the shoot.
+	if v1beta1helper.ShootAuditLogBackendEnabled(b.Shoot.GetInfo()) ||
+		(b.isShootLogForwardingEnabled() && v1beta1helper.ShootForwardsLogs(b.Shoot.GetInfo(), gardencorev1beta1.LoggingSourceAudit)) {
+		return &apiserver.AuditLog{}
+	}
+	return nil
+}
+
+var availableAuditWebhookSchemes = sets.New("http", "https")
+
+func (b *Botanist) computeKubeAPIServerAuditWebhookConfig(ctx context.Context) (*apiserver.AuditWebhook, error) {
+	shoot := b.Shoot.GetInfo()
+
+	if shoot.Spec.Logging == nil || shoot.Spec.Logging.AuditBackends == nil || shoot.Spec.Logging.AuditBackends.Webhook == nil {
+		return nil, nil
+	}
+	webhook := shoot.Spec.Logging.AuditBackends.Webhook
+
+	resource := v1beta1helper.GetResourceByName(shoot.Spec.Resources, webhook.ResourceName)
+	if resource == nil {
+		return nil, fmt.Errorf("resource %q referenced by audit webhook backend not found", webhook.ResourceName)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: resource.ResourceRef.Name, Namespace: shoot.Namespace}}
+	if err := b.GardenClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		// Ignore the missing secret on cluster deletion to prevent failing redeployments of the kube-apiserver in case
+		// the end-user deleted the secret before/simultaneously to the deletion.
+		if apierrors.IsNotFound(err) && shoot.DeletionTimestamp != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving kubeconfig secret %s for audit webhook backend failed: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	kubeconfig := secret.Data[kubernetes.KubeConfig]
+	if len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("missing kubeconfig in secret %s for audit webhook backend", client.ObjectKeyFromObject(secret))
+	}
+
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading kubeconfig for audit webhook backend: %w", err)
+	}
+	if err := kubernetes.ValidateConfig(*config); err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig for audit webhook backend: %w", err)
+	}
+	// The kube-apiserver sends the audit events from the seed's network, hence the webhook must not target endpoints
+	// which are only reachable from within the seed, e.g., other control plane components or the cloud metadata service.
+	for name, cluster := range config.Clusters {
+		u, err := url.Parse(cluster.Server)
+		if err != nil {
+			return nil, fmt.Errorf("invalid server %q of cluster %q in kubeconfig for audit webhook backend: %w", cluster.Server, name, err)
+		}
+		if !availableAuditWebhookSchemes.Has(u.Scheme) || !netutils.IsPublicHost(u.Hostname()) {
+			return nil, fmt.Errorf("invalid server %q of cluster %q in kubeconfig for audit webhook backend: must be an http(s) URL which does not refer to a cluster-internal, loopback, link-local or private host", cluster.Server, name)
+		}
+	}
+
+	out := &apiserver.AuditWebhook{
+		Kubeconfig:      kubeconfig,
+		BatchMaxSize:    webhook.BatchMaxSize,
+		BatchBufferSize: webhook.BatchBufferSize,
+	}
+	if webhook.BatchMaxWait != nil {
+		out.BatchMaxWait = &webhook.BatchMaxWait.Duration
+	}
+	if webhook.InitialBackoff != nil {
+		out.InitialBackoff = &webhook.InitialBackoff.Duration
+	}
+
+	return out, nil
+}
+
 func (b *Botanist) computeKubeAPIServerAutoscalingConfig() kubeapiserver.AutoscalingConfig {
 	var (
 		scaleDownDisabled = false
@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +33,7 @@ import (
 	fakeclientmap "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	"github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/component/apiserver"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	mockkubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
@@ -528,6 +530,91 @@ users:
 		})
 	})
 
+	Describe("#computeKubeAPIServerAuditWebhookConfig", func() {
+		var secret *corev1.Secret
+
+		kubeconfigWithServer := func(server string) []byte {
+			return []byte(`apiVersion: v1
+kind: Config
+current-context: audit
+clusters:
+- name: audit
+  cluster:
+    server: ` + server + `
+contexts:
+- name: audit
+  context:
+    cluster: audit
+    user: audit
+users:
+- name: audit
+  user:
+    token: foo
+`)
+		}
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "audit-webhook-kubeconfig", Namespace: projectNamespace},
+				Data:       map[string][]byte{kubernetes.KubeConfig: kubeconfigWithServer("https://audit.example.com/events")},
+			}
+
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
+				Name:        "audit-webhook",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: secret.Name},
+			}}
+			shoot.Spec.Logging = &gardencorev1beta1.Logging{AuditBackends: &gardencorev1beta1.AuditBackends{
+				Webhook: &gardencorev1beta1.AuditWebhookBackend{
+					ResourceName: "audit-webhook",
+					BatchMaxSize: ptr.To[int32](100),
+					BatchMaxWait: &metav1.Duration{Duration: 5 * time.Second},
+				},
+			}}
+			botanist.Shoot.SetInfo(shoot)
+		})
+
+		It("should return nil if no webhook backend is configured", func() {
+			shoot := botanist.Shoot.GetInfo()
+			shoot.Spec.Logging = nil
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.computeKubeAPIServerAuditWebhookConfig(ctx)).To(BeNil())
+		})
+
+		It("should return the webhook configuration", func() {
+			Expect(gardenClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(botanist.computeKubeAPIServerAuditWebhookConfig(ctx)).To(Equal(&apiserver.AuditWebhook{
+				Kubeconfig:   secret.Data[kubernetes.KubeConfig],
+				BatchMaxSize: ptr.To[int32](100),
+				BatchMaxWait: ptr.To(5 * time.Second),
+			}))
+		})
+
+		It("should fail if the secret does not exist", func() {
+			_, err := botanist.computeKubeAPIServerAuditWebhookConfig(ctx)
+			Expect(err).To(MatchError(ContainSubstring("retrieving kubeconfig secret garden-foo/audit-webhook-kubeconfig for audit webhook backend failed")))
+		})
+
+		DescribeTable("should fail if the kubeconfig refers to a non-public server",
+			func(server string) {
+				secret.Data[kubernetes.KubeConfig] = kubeconfigWithServer(server)
+				Expect(gardenClient.Create(ctx, secret)).To(Succeed())
+
+				_, err := botanist.computeKubeAPIServerAuditWebhookConfig(ctx)
+				Expect(err).To(MatchError(ContainSubstring("must be an http(s) URL which does not refer to a cluster-internal, loopback, link-local or private host")))
+			},
+
+			Entry("service host name", "https://vali.garden.svc:3100"),
+			Entry("single-label host name", "https://kube-apiserver"),
+			Entry("loopback address", "https://127.0.0.1"),
+			Entry("private address", "https://10.250.0.1"),
+			Entry("link-local address", "http://169.254.169.254/latest/meta-data"),
+			Entry("unsupported scheme", "unix:///var/run/audit.sock"),
+		)
+	})
+
 	Describe("#DeleteKubeAPIServer", func() {
 		It("should properly invalidate the client and destroy the component", func() {
 			clientMap := fakeclientmap.NewClientMap().AddClient(keys.ForShoot(botanist.Shoot.GetInfo()), seedClientSet)
@@ -10,11 +10,16 @@ import (
 	"slices"
 	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/gardener/gardener/imagevector"
 	gardenlethelper "github.com/gardener/gardener/pkg/api/config/gardenlet/v1alpha1/helper"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/logging/eventlogger"
+	"github.com/gardener/gardener/pkg/component/observability/logging/fluentcustomresources"
 	"github.com/gardener/gardener/pkg/component/observability/logging/vali"
 	valiconstants "github.com/gardener/gardener/pkg/component/observability/logging/vali/constants"
 	"github.com/gardener/gardener/pkg/component/observability/opentelemetry/collector"
@@ -127,6 +132,72 @@ func (b *Botanist) DestroySeedLogging(ctx context.Context) error {
 	return b.Shoot.Components.ControlPlane.Vali.Destroy(ctx)
 }
 
+// DeployLogForwarding deploys the fluent-bit resources for forwarding the logs of the shoot control plane to the
+// outputs configured in the Shoot specification.
+func (b *Botanist) DeployLogForwarding(ctx context.Context) error {
+	shoot := b.Shoot.GetInfo()
+
+	if !b.isShootLogForwardingEnabled() || shoot.Spec.Logging == nil || len(shoot.Spec.Logging.Outputs) == 0 {
+		return b.DestroyLogForwarding(ctx)
+	}
+
+	var outputs []fluentcustomresources.LogForwardingOutput
+	for _, output := range shoot.Spec.Logging.Outputs {
+		logForwardingOutput := fluentcustomresources.LogForwardingOutput{
+			Name:    output.Name,
+			Type:    output.Type,
+			URL:     output.URL,
+			Sources: output.Sources,
+		}
+
+		if output.ResourceName != nil {
+			resource := v1beta1helper.GetResourceByName(shoot.Spec.Resources, *output.ResourceName)
+			if resource == nil {
+				return fmt.Errorf("resource %q referenced by logging output %q not found", *output.ResourceName, output.Name)
+			}
+
+			secret := &corev1.Secret{}
+			if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: v1beta1constants.ReferencedResourcesPrefix + resource.ResourceRef.Name}, secret); err != nil {
+				return fmt.Errorf("failed reading secret for logging output %q: %w", output.Name, err)
+			}
+			logForwardingOutput.Credentials = secret.Data
+		}
+
+		outputs = append(outputs, logForwardingOutput)
+	}
+
+	filters, clusterOutputs, secrets, err := fluentcustomresources.GetLogForwardingResources(
+		b.Shoot.ControlPlaneNamespace,
+		v1beta1constants.GardenNamespace,
+		outputs,
+		v1beta1helper.ShootAuditLogBackendEnabled(shoot),
+		map[string]string{v1beta1constants.LabelKeyCustomLoggingResource: v1beta1constants.LabelValueCustomLoggingResource},
+	)
+	if err != nil {
+		return err
+	}
+
+	return b.newLogForwarding(fluentcustomresources.Values{
+		Filters: filters,
+		Outputs: clusterOutputs,
+		Secrets: secrets,
+	}).Deploy(ctx)
+}
+
+// DestroyLogForwarding destroys the fluent-bit resources for forwarding the logs of the shoot control plane.
+func (b *Botanist) DestroyLogForwarding(ctx context.Context) error {
+	return b.newLogForwarding(fluentcustomresources.Values{}).Destroy(ctx)
+}
+
+func (b *Botanist) newLogForwarding(values fluentcustomresources.Values) component.DeployWaiter {
+	values.Suffix = fluentcustomresources.LogForwardingSuffix
+	return fluentcustomresources.New(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, values)
+}
+
+func (b *Botanist) isShootLogForwardingEnabled() bool {
+	return b.Shoot != nil && !b.Shoot.RunsControlPlane() && gardenlethelper.IsLoggingEnabled(b.Config)
+}
+
 func (b *Botanist) isShootNodeLoggingEnabled() bool {
 	isValiEnabled := gardenlethelper.IsValiEnabled(b.Config)
 	isVictoriaLogsEnabled := gardenlethelper.IsVictoriaLogsEnabled(b.Config)
@@ -13,14 +13,18 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/mock"
@@ -36,6 +40,7 @@ import (
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
@@ -561,3 +566,103 @@ var _ = Describe("Logging", func() {
 		})
 	})
 })
+
+var _ = Describe("LogForwarding", func() {
+	var (
+		ctx                   = context.TODO()
+		controlPlaneNamespace = "shoot--foo--bar"
+
+		seedClient runtimeclient.Client
+		botanist   *Botanist
+
+		managedResource *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger:        logr.Discard(),
+				SeedClientSet: fake.NewClientSetBuilder().WithClient(seedClient).Build(),
+				Config: &gardenletconfigv1alpha1.GardenletConfiguration{
+					Logging: &gardenletconfigv1alpha1.Logging{
+						Enabled: ptr.To(true),
+					},
+				},
+				Shoot: &shootpkg.Shoot{
+					ControlPlaneNamespace: controlPlaneNamespace,
+				},
+			},
+		}
+
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: "garden-foo",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Resources: []gardencorev1beta1.NamedResourceReference{{
+					Name:        "siem-credentials",
+					ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "siem"},
+				}},
+				Logging: &gardencorev1beta1.Logging{
+					Outputs: []gardencorev1beta1.LoggingOutput{{
+						Name:         "siem",
+						Type:         gardencorev1beta1.LoggingOutputTypeHTTP,
+						URL:          "https://siem.example.com",
+						Sources:      []gardencorev1beta1.LoggingSource{gardencorev1beta1.LoggingSourceControlPlane},
+						ResourceName: ptr.To("siem-credentials"),
+					}},
+				},
+			},
+		})
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fluent-operator-custom-resources-log-forwarding",
+				Namespace: controlPlaneNamespace,
+			},
+		}
+	})
+
+	Describe("#DeployLogForwarding", func() {
+		It("should deploy the log forwarding resources", func() {
+			Expect(seedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ref-siem", Namespace: controlPlaneNamespace},
+				Data:       map[string][]byte{"username": []byte("user")},
+			})).To(Succeed())
+
+			Expect(botanist.DeployLogForwarding(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, runtimeclient.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+			managedResourceSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: controlPlaneNamespace}}
+			Expect(seedClient.Get(ctx, runtimeclient.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			manifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceSecret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			test.ExpectKindWithNameAndNamespace(manifests, "ClusterOutput", "shoot--foo--bar--siem", "")
+			test.ExpectKindWithNameAndNamespace(manifests, "Secret", "shoot--foo--bar--siem", "garden")
+		})
+
+		It("should fail if the referenced secret does not exist", func() {
+			Expect(botanist.DeployLogForwarding(ctx)).To(MatchError(ContainSubstring(`failed reading secret for logging output "siem"`)))
+		})
+
+		It("should destroy the log forwarding resources if there are no outputs", func() {
+			Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+			botanist.Shoot.GetInfo().Spec.Logging = nil
+
+			Expect(botanist.DeployLogForwarding(ctx)).To(Succeed())
+			Expect(seedClient.Get(ctx, runtimeclient.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+
+		It("should destroy the log forwarding resources if logging is disabled", func() {
+			Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+			botanist.Config.Logging.Enabled = ptr.To(false)
+
+			Expect(botanist.DeployLogForwarding(ctx)).To(Succeed())
+			Expect(seedClient.Get(ctx, runtimeclient.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+})
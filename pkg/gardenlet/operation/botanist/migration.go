@@ -71,7 +71,7 @@ func (b *Botanist) runParallelTaskForEachComponent(ctx context.Context, componen
 
 // IsCopyOfBackupsRequired check if etcd backups need to be copied between seeds.
 func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
-	if b.Seed.GetInfo().Spec.Backup == nil || !b.IsRestorePhase() {
+	if b.Seed.GetInfo().Spec.Backup == nil || (!b.IsRestorePhase() && !b.IsRestoringClone()) {
 		return false, nil
 	}
 
@@ -82,6 +82,12 @@ func (b *Botanist) IsCopyOfBackupsRequired(ctx context.Context) (bool, error) {
 		return false, nil
 	}
 
+	// The backups of the clone source are copied within the backup bucket of this seed, hence there is no source
+	// BackupEntry whose state needs to be checked.
+	if b.IsRestoringClone() {
+		return true, nil
+	}
+
 	backupEntry, err := b.Shoot.Components.BackupEntry.Get(ctx)
 	if err != nil {
 		return false, fmt.Errorf("error while retrieving BackupEntry: %w", err)
@@ -113,6 +119,16 @@ func (b *Botanist) IsRestorePhase() bool {
 	return v1beta1helper.ShootHasOperationType(b.Shoot.GetInfo().Status.LastOperation, gardencorev1beta1.LastOperationTypeRestore)
 }
 
+// IsRestoringClone returns true when the shoot is being created as a clone of another shoot whose latest etcd backup
+// shall be restored.
+func (b *Botanist) IsRestoringClone() bool {
+	shoot := b.Shoot.GetInfo()
+	if _, ok := shoot.Annotations[v1beta1constants.AnnotationShootCloneSource]; !ok {
+		return false
+	}
+	return shoot.Status.LastOperation == nil || v1beta1helper.ShootHasOperationType(shoot.Status.LastOperation, gardencorev1beta1.LastOperationTypeCreate)
+}
+
 // ShallowDeleteMachineResources deletes all machine-related resources by forcefully removing their finalizers.
 func (b *Botanist) ShallowDeleteMachineResources(ctx context.Context) error {
 	var taskFns []flow.TaskFn
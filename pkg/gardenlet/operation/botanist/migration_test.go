@@ -333,6 +333,34 @@ var _ = Describe("migration", func() {
 				Expect(copyRequired).To(BeTrue())
 			})
 		})
+
+		Context("Shoot is created as clone which is restored from a backup", func() {
+			BeforeEach(func() {
+				botanist.Shoot.GetInfo().Annotations = map[string]string{"shoot.gardener.cloud/clone-source": "source"}
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeCreate
+			})
+
+			It("should return false if etcd main resource has been deployed", func() {
+				etcdMain.EXPECT().Get(ctx)
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+
+			It("should return true without checking the source backupentry if etcd main resource does not exist", func() {
+				etcdMain.EXPECT().Get(ctx).Return(nil, apierrors.NewNotFound(schema.GroupResource{}, "etcd-main"))
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeTrue())
+			})
+
+			It("should return false if the shoot has already been created", func() {
+				botanist.Shoot.GetInfo().Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeReconcile
+				copyRequired, err := botanist.IsCopyOfBackupsRequired(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(copyRequired).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("#IsRestorePhase", func() {
@@ -347,6 +375,36 @@ var _ = Describe("migration", func() {
 		})
 	})
 
+	Describe("#IsRestoringClone", func() {
+		It("should return true if the clone source annotation is set and the shoot is being created", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"shoot.gardener.cloud/clone-source": "source"}},
+				Status:     gardencorev1beta1.ShootStatus{LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}},
+			})
+			Expect(botanist.IsRestoringClone()).To(BeTrue())
+		})
+
+		It("should return true if the clone source annotation is set and the shoot has no last operation", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"shoot.gardener.cloud/clone-source": "source"}},
+			})
+			Expect(botanist.IsRestoringClone()).To(BeTrue())
+		})
+
+		It("should return false if the clone source annotation is set and the shoot has already been created", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"shoot.gardener.cloud/clone-source": "source"}},
+				Status:     gardencorev1beta1.ShootStatus{LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile}},
+			})
+			Expect(botanist.IsRestoringClone()).To(BeFalse())
+		})
+
+		It("should return false if the clone source annotation is not set", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{LastOperation: &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}}})
+			Expect(botanist.IsRestoringClone()).To(BeFalse())
+		})
+	})
+
 	Describe("#ShallowDeleteMachineResources", func() {
 		It("should delete most of the resources and remove MCM finalizers", func() {
 			var (
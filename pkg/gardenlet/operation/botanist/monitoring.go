@@ -11,14 +11,19 @@ import (
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
 	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot/userrules"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -27,9 +32,29 @@ import (
 
 // DefaultAlertmanager creates a new alertmanager deployer.
 func (b *Botanist) DefaultAlertmanager() (alertmanager.Interface, error) {
-	var emailReceivers []string
-	if monitoring := b.Shoot.GetInfo().Spec.Monitoring; monitoring != nil && monitoring.Alerting != nil {
+	var (
+		shoot          = b.Shoot.GetInfo()
+		emailReceivers []string
+		receivers      []alertmanager.Receiver
+	)
+
+	if monitoring := shoot.Spec.Monitoring; monitoring != nil && monitoring.Alerting != nil {
 		emailReceivers = monitoring.Alerting.EmailReceivers
+
+		for _, receiver := range monitoring.Alerting.Receivers {
+			resource := v1beta1helper.GetResourceByName(shoot.Spec.Resources, receiver.ResourceName)
+			if resource == nil {
+				return nil, fmt.Errorf("resource %q referenced by alert receiver %q not found", receiver.ResourceName, receiver.Name)
+			}
+
+			receivers = append(receivers, alertmanager.Receiver{
+				Name:         receiver.Name,
+				Type:         receiver.Type,
+				SecretName:   v1beta1constants.ReferencedResourcesPrefix + resource.ResourceRef.Name,
+				Severities:   receiver.Severities,
+				Visibilities: receiver.Visibilities,
+			})
+		}
 	}
 
 	return sharedcomponent.NewAlertmanager(b.Logger, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, alertmanager.Values{
@@ -40,6 +65,7 @@ func (b *Botanist) DefaultAlertmanager() (alertmanager.Interface, error) {
 		Replicas:           b.Shoot.GetReplicas(1),
 		AlertingSMTPSecret: b.LoadSecret(v1beta1constants.GardenRoleAlerting),
 		EmailReceivers:     emailReceivers,
+		Receivers:          receivers,
 		Ingress: &alertmanager.IngressValues{
 			Host:           b.ComputeAlertManagerHost(),
 			SecretsManager: b.SecretsManager,
@@ -173,9 +199,54 @@ func (b *Botanist) DeployPrometheus(ctx context.Context) error {
 	}
 	b.Shoot.Components.ControlPlane.Prometheus.SetCentralScrapeConfigs(shootprometheus.CentralScrapeConfigs(b.Shoot.ControlPlaneNamespace, caSecret.Name, b.Shoot.IsWorkerless))
 
+	prometheusRules := shootprometheus.CentralPrometheusRules(b.Shoot.IsWorkerless, b.Shoot.WantsAlertmanager)
+	userPrometheusRule, err := b.userPrometheusRule(ctx)
+	if err != nil {
+		return err
+	}
+	if userPrometheusRule != nil {
+		prometheusRules = append(prometheusRules, userPrometheusRule)
+	}
+	b.Shoot.Components.ControlPlane.Prometheus.SetCentralPrometheusRules(prometheusRules)
+
 	return b.Shoot.Components.ControlPlane.Prometheus.Deploy(ctx)
 }
 
+// userPrometheusRule returns the PrometheusRule containing the user-defined rules from the ConfigMap referenced in the
+// Shoot specification, or nil if no such ConfigMap is referenced.
+func (b *Botanist) userPrometheusRule(ctx context.Context) (*monitoringv1.PrometheusRule, error) {
+	configMapName := v1beta1helper.GetShootMonitoringRulesConfigMapName(b.Shoot.GetInfo().Spec.Monitoring)
+	if configMapName == "" {
+		return nil, nil
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.GetInfo().Namespace, Name: configMapName}}
+	if err := b.GardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		// Ignore a missing ConfigMap on cluster deletion, the end-user might have deleted it before/simultaneously to
+		// the deletion.
+		if apierrors.IsNotFound(err) && b.Shoot.GetInfo().DeletionTimestamp != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading monitoring rules ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	rulesRaw, ok := configMap.Data[userrules.DataKey]
+	if !ok {
+		return nil, fmt.Errorf("missing '.data[%s]' in monitoring rules ConfigMap %s", userrules.DataKey, client.ObjectKeyFromObject(configMap))
+	}
+
+	spec, err := userrules.Parse(rulesRaw)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing monitoring rules ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	if errList := userrules.Validate(spec); len(errList) > 0 {
+		return nil, fmt.Errorf("monitoring rules ConfigMap %s contains invalid rules: %w", client.ObjectKeyFromObject(configMap), errList.ToAggregate())
+	}
+
+	return userrules.PrometheusRule(spec), nil
+}
+
 // DestroyPrometheus destroys the shoot Prometheus.
 func (b *Botanist) DestroyPrometheus(ctx context.Context) error {
 	if err := b.Shoot.Components.ControlPlane.Prometheus.Destroy(ctx); err != nil {
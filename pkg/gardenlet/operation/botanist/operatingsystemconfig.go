@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
 	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/networking/nodelocaldns/constants"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -130,17 +131,33 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 	}
 
 	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
-		sshKeypairSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
+		// With the SSHCertificateOnlyAccess feature gate, the nodes only trust user certificates signed by the SSH
+		// certificate authority, i.e., no static SSH keys are authorized for the gardener user.
+		if !features.DefaultFeatureGate.Enabled(features.SSHCertificateOnlyAccess) {
+			sshKeypairSecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
+			if !found {
+				return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHKeyPair)
+			}
+			publicKeys := []string{string(sshKeypairSecret.Data[secretsutils.DataKeySSHAuthorizedKeys])}
+
+			if sshKeypairSecretOld, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair, secretsmanager.Old); found {
+				publicKeys = append(publicKeys, string(sshKeypairSecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+			}
+
+			b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHPublicKeys(publicKeys)
+		}
+
+		sshCASecret, found := b.SecretsManager.Get(v1beta1constants.SecretNameCASSH)
 		if !found {
-			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameSSHKeyPair)
+			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCASSH)
 		}
-		publicKeys := []string{string(sshKeypairSecret.Data[secretsutils.DataKeySSHAuthorizedKeys])}
+		caPublicKeys := []string{string(sshCASecret.Data[secretsutils.DataKeySSHAuthorizedKeys])}
 
-		if sshKeypairSecretOld, found := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair, secretsmanager.Old); found {
-			publicKeys = append(publicKeys, string(sshKeypairSecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
+		if sshCASecretOld, found := b.SecretsManager.Get(v1beta1constants.SecretNameCASSH, secretsmanager.Old); found {
+			caPublicKeys = append(caPublicKeys, string(sshCASecretOld.Data[secretsutils.DataKeySSHAuthorizedKeys]))
 		}
 
-		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHPublicKeys(publicKeys)
+		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHCAPublicKeys(caPublicKeys)
 	}
 
 	var clusterDNSAddresses []string
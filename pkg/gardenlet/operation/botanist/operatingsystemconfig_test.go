@@ -31,6 +31,7 @@ import (
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	mockoperatingsystemconfig "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/mock"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
@@ -77,6 +78,7 @@ var _ = Describe("operatingsystemconfig", func() {
 		By("Create secrets managed outside of this function for which secretsmanager.Get() will be called")
 		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: namespace}, Data: map[string][]byte{"bundle.crt": []byte(caBundle)}})).To(Succeed())
 		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ssh-keypair", Namespace: namespace}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-ssh", Namespace: namespace}})).To(Succeed())
 
 		botanist = &Botanist{
 			Operation: &operation.Operation{
@@ -132,6 +134,7 @@ var _ = Describe("operatingsystemconfig", func() {
 			BeforeEach(func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
 				operatingSystemConfig.EXPECT().SetSSHPublicKeys(gomock.AssignableToTypeOf([]string{}))
+				operatingSystemConfig.EXPECT().SetSSHCAPublicKeys(gomock.AssignableToTypeOf([]string{}))
 				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
 			})
 
@@ -191,10 +194,27 @@ var _ = Describe("operatingsystemconfig", func() {
 			})
 		})
 
+		Context("deploy with SSHCertificateOnlyAccess feature gate", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.SSHCertificateOnlyAccess, true))
+			})
+
+			It("should only set the public keys of the SSH certificate authority", func() {
+				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
+				operatingSystemConfig.EXPECT().SetSSHCAPublicKeys(gomock.AssignableToTypeOf([]string{}))
+				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
+				operatingSystemConfig.EXPECT().SetCABundle(caBundle)
+
+				operatingSystemConfig.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployOperatingSystemConfig(ctx)).To(Succeed())
+			})
+		})
+
 		Context("restore", func() {
 			BeforeEach(func() {
 				operatingSystemConfig.EXPECT().SetAPIServerURL(fmt.Sprintf("https://api.%s", shootDomain))
 				operatingSystemConfig.EXPECT().SetSSHPublicKeys(gomock.AssignableToTypeOf([]string{}))
+				operatingSystemConfig.EXPECT().SetSSHCAPublicKeys(gomock.AssignableToTypeOf([]string{}))
 				operatingSystemConfig.EXPECT().SetClusterDNSAddresses(coreDNS)
 
 				shoot := botanist.Shoot.GetInfo()
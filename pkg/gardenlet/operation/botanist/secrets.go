@@ -22,6 +22,7 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
@@ -40,7 +41,15 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 	// explicitly only done in case of restoration to prevent split-brain situations as described in
 	// https://github.com/gardener/gardener/issues/5377.
 	if b.IsRestorePhase() {
-		if err := b.restoreSecretsFromShootState(ctx); err != nil {
+		if err := b.restoreSecrets(ctx, b.Shoot.GetShootState().Spec.Gardener); err != nil {
+			return err
+		}
+	}
+
+	// If the etcd of a clone is restored from the backup of the source, the secrets which are required to read the
+	// restored data are taken over from the source, see restoreSecretsFromCloneSource.
+	if b.IsRestoringClone() {
+		if err := b.restoreSecretsFromCloneSource(ctx); err != nil {
 			return err
 		}
 	}
@@ -52,7 +61,7 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 	}
 
 	if v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()) {
-		taskFns = append(taskFns, b.generateSSHKeypair)
+		taskFns = append(taskFns, b.generateSSHKeypair, b.generateSSHCertificateAuthority)
 	} else {
 		taskFns = append(taskFns, b.deleteSSHKeypair)
 	}
@@ -81,6 +90,8 @@ func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 
 		if shootStatus.Credentials.Rotation.SSHKeypair != nil && shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime != nil {
 			rotation[v1beta1constants.SecretNameSSHKeyPair] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
+			// The SSH certificate authority is rotated together with the SSH key pair since both grant access to the nodes.
+			rotation[v1beta1constants.SecretNameCASSH] = shootStatus.Credentials.Rotation.SSHKeypair.LastInitiationTime.Time
 		}
 
 		if shootStatus.Credentials.Rotation.Observability != nil && shootStatus.Credentials.Rotation.Observability.LastInitiationTime != nil {
@@ -99,10 +110,10 @@ func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 	return rotation
 }
 
-func (b *Botanist) restoreSecretsFromShootState(ctx context.Context) error {
+func (b *Botanist) restoreSecrets(ctx context.Context, gardenerData []gardencorev1beta1.GardenerResourceData) error {
 	var fns []flow.TaskFn
 
-	for _, v := range b.Shoot.GetShootState().Spec.Gardener {
+	for _, v := range gardenerData {
 		entry := v
 
 		if entry.Type != v1beta1constants.DataTypeSecret {
@@ -310,6 +321,10 @@ func (b *Botanist) generateGenericTokenKubeconfig(ctx context.Context) error {
 	return err
 }
 
+// generateSSHKeypair generates the SSH key pair of the shoot and syncs it to the project namespace in the garden. With
+// the SSHCertificateOnlyAccess feature gate, the key pair is only passed to the Infrastructure and Worker extensions
+// (users access the nodes with short-lived certificates signed by the SSH certificate authority), hence previously
+// synced copies are deleted from the garden instead.
 func (b *Botanist) generateSSHKeypair(ctx context.Context) error {
 	sshKeypairSecret, err := b.SecretsManager.Generate(ctx, &secretsutils.RSASecretConfig{
 		Name:       v1beta1constants.SecretNameSSHKeyPair,
@@ -320,6 +335,10 @@ func (b *Botanist) generateSSHKeypair(ctx context.Context) error {
 		return err
 	}
 
+	if features.DefaultFeatureGate.Enabled(features.SSHCertificateOnlyAccess) {
+		return b.deleteSSHKeypair(ctx)
+	}
+
 	if err := b.syncShootCredentialToGarden(
 		ctx,
 		gardenerutils.ShootProjectSecretSuffixSSHKeypair,
@@ -345,6 +364,18 @@ func (b *Botanist) generateSSHKeypair(ctx context.Context) error {
 	return nil
 }
 
+// generateSSHCertificateAuthority generates the SSH certificate authority of the shoot. The nodes trust user
+// certificates signed by it, see OperatingSystemConfig. It is not synced to the garden since its private key must only be
+// used by gardenlet for signing short-lived user certificates, e.g., for Bastions.
+func (b *Botanist) generateSSHCertificateAuthority(ctx context.Context) error {
+	_, err := b.SecretsManager.Generate(ctx, &secretsutils.RSASecretConfig{
+		Name:       v1beta1constants.SecretNameCASSH,
+		Bits:       4096,
+		UsedForSSH: true,
+	}, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.KeepOld))
+	return err
+}
+
 func (b *Botanist) generateObservabilityIngressPassword(ctx context.Context) error {
 	secret, err := b.SecretsManager.Generate(ctx, &secretsutils.BasicAuthSecretConfig{
 		Name:           v1beta1constants.SecretNameObservabilityIngressUsers,
@@ -16,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -24,6 +25,7 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
@@ -31,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -335,6 +338,57 @@ var _ = Describe("Secrets", func() {
 				Expect(gardenSecret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "ssh-keypair"))
 			})
 
+			It("should also sync the old ssh-keypair secret to the garden", func() {
+				Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ssh-keypair-old", Namespace: controlPlaneNamespace}})).To(Succeed())
+
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				gardenSecret := &corev1.Secret{}
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ssh-keypair.old"}, gardenSecret)).To(Succeed())
+				Expect(gardenSecret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "ssh-keypair"))
+			})
+
+			Context("with SSHCertificateOnlyAccess feature gate", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.SSHCertificateOnlyAccess, true))
+				})
+
+				It("should generate the ssh keypair but delete it from the garden", func() {
+					Expect(gardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: shootName + ".ssh-keypair", Namespace: gardenNamespace}})).To(Succeed())
+					Expect(gardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: shootName + ".ssh-keypair.old", Namespace: gardenNamespace}})).To(Succeed())
+
+					Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+					secretList := &corev1.SecretList{}
+					Expect(seedClient.List(ctx, secretList, client.InNamespace(controlPlaneNamespace), client.MatchingLabels{
+						"name":       "ssh-keypair",
+						"managed-by": "secrets-manager",
+					})).To(Succeed())
+					Expect(secretList.Items).To(HaveLen(1))
+
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ssh-keypair"}, &corev1.Secret{})).To(BeNotFoundError())
+					Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ssh-keypair.old"}, &corev1.Secret{})).To(BeNotFoundError())
+				})
+			})
+
+			It("should generate the ssh certificate authority but not sync it to the garden", func() {
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				secretList := &corev1.SecretList{}
+				Expect(seedClient.List(ctx, secretList, client.InNamespace(controlPlaneNamespace), client.MatchingLabels{
+					"name":       "ca-ssh",
+					"managed-by": "secrets-manager",
+				})).To(Succeed())
+				Expect(secretList.Items).To(HaveLen(1))
+				Expect(secretList.Items[0].Labels).To(And(
+					HaveKeyWithValue("persist", "true"),
+					HaveKeyWithValue("rotation-strategy", "keepold"),
+				))
+				Expect(secretList.Items[0].Data).To(And(HaveKey("id_rsa"), HaveKey("id_rsa.pub")))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ca-ssh"}, &corev1.Secret{})).To(BeNotFoundError())
+			})
+
 			It("should not generate the ssh keypair in case of workerless shoot", func() {
 				shoot := botanist.Shoot.GetInfo()
 				shoot.Spec.Provider.Workers = nil
@@ -350,16 +404,6 @@ var _ = Describe("Secrets", func() {
 				Expect(secretList.Items).To(BeEmpty())
 			})
 
-			It("should also sync the old ssh-keypair secret to the garden", func() {
-				Expect(seedClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ssh-keypair-old", Namespace: controlPlaneNamespace}})).To(Succeed())
-
-				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
-
-				gardenSecret := &corev1.Secret{}
-				Expect(gardenClient.Get(ctx, client.ObjectKey{Namespace: gardenNamespace, Name: shootName + ".ssh-keypair.old"}, gardenSecret)).To(Succeed())
-				Expect(gardenSecret.Labels).To(HaveKeyWithValue("gardener.cloud/role", "ssh-keypair"))
-			})
-
 			It("should delete ssh-keypair secrets when ssh access is set to false in workers settings", func() {
 				Expect(gardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: shootName + ".ssh-keypair", Namespace: gardenNamespace}})).To(Succeed())
 				Expect(gardenClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: shootName + ".ssh-keypair.old", Namespace: gardenNamespace}})).To(Succeed())
@@ -532,6 +576,67 @@ var _ = Describe("Secrets", func() {
 				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: "some-other-data"}, &corev1.Secret{})).To(BeNotFoundError())
 			})
 		})
+
+		Context("when shoot is restored from the backup of its clone source", func() {
+			var sourceNamespace = "shoot--foo--source"
+
+			BeforeEach(func() {
+				botanist.Seed.SetInfo(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}})
+
+				shoot := botanist.Shoot.GetInfo().DeepCopy()
+				shoot.Annotations = map[string]string{"shoot.gardener.cloud/clone-source": "source"}
+				botanist.Shoot.SetInfo(shoot)
+
+				Expect(gardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: gardenNamespace},
+					Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")},
+					Status:     gardencorev1beta1.ShootStatus{SeedName: ptr.To("seed"), TechnicalID: sourceNamespace},
+				})).To(Succeed())
+
+				for name, labels := range map[string]map[string]string{
+					"ca": {"name": "ca", "persist": "true", "managed-by": "secrets-manager", "manager-identity": "gardenlet"},
+					"kube-apiserver-etcd-encryption-key-12345": {"name": "kube-apiserver-etcd-encryption-key", "persist": "true", "managed-by": "secrets-manager", "manager-identity": "gardenlet"},
+					"service-account-key-12345":                {"name": "service-account-key", "persist": "true", "managed-by": "secrets-manager", "manager-identity": "gardenlet"},
+					"ssh-keypair-12345":                        {"name": "ssh-keypair", "persist": "true", "managed-by": "secrets-manager", "manager-identity": "gardenlet"},
+					"extension-foo-secret-12345":               {"name": "ca", "persist": "true", "managed-by": "secrets-manager", "manager-identity": "extension-foo"},
+					"ca-client-not-persisted":                  {"name": "ca-client", "managed-by": "secrets-manager", "manager-identity": "gardenlet"},
+				} {
+					Expect(seedClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: sourceNamespace, Labels: labels},
+						Data:       map[string][]byte{"data-for": []byte(name)},
+					})).To(Succeed())
+				}
+			})
+
+			It("should take over the certificate authorities, the service account key and the etcd encryption key of the source", func() {
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				for _, name := range []string{"kube-apiserver-etcd-encryption-key-12345", "service-account-key-12345"} {
+					secret := &corev1.Secret{}
+					Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: name}, secret)).To(Succeed())
+					Expect(secret.Immutable).To(PointTo(BeTrue()))
+					Expect(secret.Data).To(Equal(map[string][]byte{"data-for": []byte(name)}))
+				}
+
+				secret := &corev1.Secret{}
+				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: "ca"}, secret)).To(Succeed())
+				verifyCASecret("ca", secret, Equal(map[string][]byte{"data-for": []byte("ca")}))
+
+				for _, name := range []string{"ssh-keypair-12345", "extension-foo-secret-12345", "ca-client-not-persisted"} {
+					Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: name}, &corev1.Secret{})).To(BeNotFoundError())
+				}
+			})
+
+			It("should not take over any secrets if the shoot was already created", func() {
+				shoot := botanist.Shoot.GetInfo().DeepCopy()
+				shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeReconcile}
+				botanist.Shoot.SetInfo(shoot)
+
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: "service-account-key-12345"}, &corev1.Secret{})).To(BeNotFoundError())
+			})
+		})
 	})
 })
 
@@ -775,6 +775,7 @@ func (r *Reconciler) newKubeAPIServer(
 		false,
 		features.DefaultFeatureGate.Enabled(features.IstioTLSTermination),
 		auditWebhookConfig,
+		nil,
 		authenticationWebhookConfig,
 		authorizationWebhookConfigs,
 		resourcesToStoreInETCDEvents,
@@ -12,6 +12,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	api "github.com/gardener/gardener/pkg/provider-local/apis/local"
 	"github.com/gardener/gardener/pkg/provider-local/apis/local/install"
 )
@@ -41,3 +42,14 @@ func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfi
 	}
 	return cloudProfileConfig, nil
 }
+
+// BackupBucketConfigFromBackupBucket decodes the provider specific configuration of the given backup bucket.
+func BackupBucketConfigFromBackupBucket(backupBucket *extensionsv1alpha1.BackupBucket) (*api.BackupBucketConfig, error) {
+	backupBucketConfig := &api.BackupBucketConfig{}
+	if backupBucket != nil && backupBucket.Spec.ProviderConfig != nil && backupBucket.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(backupBucket.Spec.ProviderConfig.Raw, nil, backupBucketConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of backupBucket '%s': %w", backupBucket.Name, err)
+		}
+	}
+	return backupBucketConfig, nil
+}
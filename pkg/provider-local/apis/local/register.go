@@ -35,6 +35,7 @@ var (
 // Adds the list of known types to api.Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&BackupBucketConfig{},
 		&CloudProfileConfig{},
 		&WorkerStatus{},
 	)
@@ -38,6 +38,7 @@ func init() {
 // Adds the list of known types to api.Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&BackupBucketConfig{},
 		&CloudProfileConfig{},
 		&WorkerStatus{},
 	)
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig represents the configuration for a backup bucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Immutability defines the immutability configuration for the backup bucket.
+	// +optional
+	Immutability *ImmutableConfig `json:"immutability,omitempty"`
+	// Versioning defines the versioning configuration for the backup bucket.
+	// +optional
+	Versioning *VersioningConfig `json:"versioning,omitempty"`
+	// Lifecycle defines the lifecycle configuration for the backup bucket.
+	// +optional
+	Lifecycle *LifecycleConfig `json:"lifecycle,omitempty"`
+}
+
+// ImmutableConfig represents the immutability configuration for a backup bucket.
+type ImmutableConfig struct {
+	// RetentionType specifies the type of retention for the backup bucket.
+	// Currently allowed value is:
+	// - "bucket": retention policy applies on the entire bucket.
+	RetentionType RetentionType `json:"retentionType"`
+	// RetentionPeriod specifies the immutability retention period for the backup bucket.
+	// Objects in the bucket cannot be deleted or overwritten before their age exceeds this period.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod"`
+	// Locked indicates whether the immutable retention policy is locked for the backup bucket.
+	// A locked policy cannot be removed and its retention period cannot be reduced.
+	// +optional
+	Locked bool `json:"locked"`
+}
+
+// RetentionType defines the level at which immutability properties are applied on objects.
+type RetentionType string
+
+// BucketLevelImmutability sets the immutability feature on the bucket level.
+const BucketLevelImmutability RetentionType = "bucket"
+
+// VersioningConfig represents the versioning configuration for a backup bucket.
+type VersioningConfig struct {
+	// Enabled specifies whether deleted objects are kept as noncurrent versions.
+	Enabled bool `json:"enabled"`
+}
+
+// LifecycleConfig represents the lifecycle configuration for a backup bucket.
+type LifecycleConfig struct {
+	// NoncurrentVersionExpiration is the duration after which noncurrent versions of objects are deleted. If it is not
+	// set, noncurrent versions are kept until they are no longer retained by the immutability configuration or, if
+	// versioning is enabled, forever.
+	// +optional
+	NoncurrentVersionExpiration *metav1.Duration `json:"noncurrentVersionExpiration,omitempty"`
+}
@@ -14,6 +14,7 @@ import (
 
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	local "github.com/gardener/gardener/pkg/provider-local/apis/local"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -25,6 +26,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*local.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_local_BackupBucketConfig(a.(*BackupBucketConfig), b.(*local.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*local.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_local_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*local.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileConfig)(nil), (*local.CloudProfileConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudProfileConfig_To_local_CloudProfileConfig(a.(*CloudProfileConfig), b.(*local.CloudProfileConfig), scope)
 	}); err != nil {
@@ -35,6 +46,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImmutableConfig)(nil), (*local.ImmutableConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImmutableConfig_To_local_ImmutableConfig(a.(*ImmutableConfig), b.(*local.ImmutableConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*local.ImmutableConfig)(nil), (*ImmutableConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_local_ImmutableConfig_To_v1alpha1_ImmutableConfig(a.(*local.ImmutableConfig), b.(*ImmutableConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LifecycleConfig)(nil), (*local.LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleConfig_To_local_LifecycleConfig(a.(*LifecycleConfig), b.(*local.LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*local.LifecycleConfig)(nil), (*LifecycleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_local_LifecycleConfig_To_v1alpha1_LifecycleConfig(a.(*local.LifecycleConfig), b.(*LifecycleConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*local.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_local_MachineImage(a.(*MachineImage), b.(*local.MachineImage), scope)
 	}); err != nil {
@@ -75,6 +106,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersioningConfig)(nil), (*local.VersioningConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersioningConfig_To_local_VersioningConfig(a.(*VersioningConfig), b.(*local.VersioningConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*local.VersioningConfig)(nil), (*VersioningConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_local_VersioningConfig_To_v1alpha1_VersioningConfig(a.(*local.VersioningConfig), b.(*VersioningConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*local.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_local_WorkerStatus(a.(*WorkerStatus), b.(*local.WorkerStatus), scope)
 	}); err != nil {
@@ -88,6 +129,30 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_BackupBucketConfig_To_local_BackupBucketConfig(in *BackupBucketConfig, out *local.BackupBucketConfig, s conversion.Scope) error {
+	out.Immutability = (*local.ImmutableConfig)(unsafe.Pointer(in.Immutability))
+	out.Versioning = (*local.VersioningConfig)(unsafe.Pointer(in.Versioning))
+	out.Lifecycle = (*local.LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_local_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_local_BackupBucketConfig(in *BackupBucketConfig, out *local.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_local_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_local_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *local.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Immutability = (*ImmutableConfig)(unsafe.Pointer(in.Immutability))
+	out.Versioning = (*VersioningConfig)(unsafe.Pointer(in.Versioning))
+	out.Lifecycle = (*LifecycleConfig)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_local_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_local_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *local.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_local_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudProfileConfig_To_local_CloudProfileConfig(in *CloudProfileConfig, out *local.CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]local.MachineImages)(unsafe.Pointer(&in.MachineImages))
 	return nil
@@ -108,6 +173,50 @@ func Convert_local_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *local.C
 	return autoConvert_local_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImmutableConfig_To_local_ImmutableConfig(in *ImmutableConfig, out *local.ImmutableConfig, s conversion.Scope) error {
+	out.RetentionType = local.RetentionType(in.RetentionType)
+	out.RetentionPeriod = in.RetentionPeriod
+	out.Locked = in.Locked
+	return nil
+}
+
+// Convert_v1alpha1_ImmutableConfig_To_local_ImmutableConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ImmutableConfig_To_local_ImmutableConfig(in *ImmutableConfig, out *local.ImmutableConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImmutableConfig_To_local_ImmutableConfig(in, out, s)
+}
+
+func autoConvert_local_ImmutableConfig_To_v1alpha1_ImmutableConfig(in *local.ImmutableConfig, out *ImmutableConfig, s conversion.Scope) error {
+	out.RetentionType = RetentionType(in.RetentionType)
+	out.RetentionPeriod = in.RetentionPeriod
+	out.Locked = in.Locked
+	return nil
+}
+
+// Convert_local_ImmutableConfig_To_v1alpha1_ImmutableConfig is an autogenerated conversion function.
+func Convert_local_ImmutableConfig_To_v1alpha1_ImmutableConfig(in *local.ImmutableConfig, out *ImmutableConfig, s conversion.Scope) error {
+	return autoConvert_local_ImmutableConfig_To_v1alpha1_ImmutableConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LifecycleConfig_To_local_LifecycleConfig(in *LifecycleConfig, out *local.LifecycleConfig, s conversion.Scope) error {
+	out.NoncurrentVersionExpiration = (*v1.Duration)(unsafe.Pointer(in.NoncurrentVersionExpiration))
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleConfig_To_local_LifecycleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleConfig_To_local_LifecycleConfig(in *LifecycleConfig, out *local.LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleConfig_To_local_LifecycleConfig(in, out, s)
+}
+
+func autoConvert_local_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *local.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	out.NoncurrentVersionExpiration = (*v1.Duration)(unsafe.Pointer(in.NoncurrentVersionExpiration))
+	return nil
+}
+
+// Convert_local_LifecycleConfig_To_v1alpha1_LifecycleConfig is an autogenerated conversion function.
+func Convert_local_LifecycleConfig_To_v1alpha1_LifecycleConfig(in *local.LifecycleConfig, out *LifecycleConfig, s conversion.Scope) error {
+	return autoConvert_local_LifecycleConfig_To_v1alpha1_LifecycleConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_local_MachineImage(in *MachineImage, out *local.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -202,6 +311,26 @@ func Convert_local_MachineImages_To_v1alpha1_MachineImages(in *local.MachineImag
 	return autoConvert_local_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_VersioningConfig_To_local_VersioningConfig(in *VersioningConfig, out *local.VersioningConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_VersioningConfig_To_local_VersioningConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VersioningConfig_To_local_VersioningConfig(in *VersioningConfig, out *local.VersioningConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VersioningConfig_To_local_VersioningConfig(in, out, s)
+}
+
+func autoConvert_local_VersioningConfig_To_v1alpha1_VersioningConfig(in *local.VersioningConfig, out *VersioningConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_local_VersioningConfig_To_v1alpha1_VersioningConfig is an autogenerated conversion function.
+func Convert_local_VersioningConfig_To_v1alpha1_VersioningConfig(in *local.VersioningConfig, out *VersioningConfig, s conversion.Scope) error {
+	return autoConvert_local_VersioningConfig_To_v1alpha1_VersioningConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_local_WorkerStatus(in *WorkerStatus, out *local.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]local.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
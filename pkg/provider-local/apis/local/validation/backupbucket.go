@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	api "github.com/gardener/gardener/pkg/provider-local/apis/local"
+)
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *api.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if immutability := config.Immutability; immutability != nil {
+		immutabilityPath := fldPath.Child("immutability")
+
+		if immutability.RetentionType != api.BucketLevelImmutability {
+			allErrs = append(allErrs, field.NotSupported(immutabilityPath.Child("retentionType"), immutability.RetentionType, []string{string(api.BucketLevelImmutability)}))
+		}
+		if immutability.RetentionPeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(immutabilityPath.Child("retentionPeriod"), immutability.RetentionPeriod.Duration.String(), "must be a positive duration"))
+		}
+	}
+
+	if lifecycle := config.Lifecycle; lifecycle != nil && lifecycle.NoncurrentVersionExpiration != nil && lifecycle.NoncurrentVersionExpiration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("lifecycle", "noncurrentVersionExpiration"), lifecycle.NoncurrentVersionExpiration.Duration.String(), "must be a positive duration"))
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketConfigUpdate validates updates to a BackupBucketConfig object. A locked immutability
+// configuration can neither be removed, unlocked nor can its retention period be reduced.
+func ValidateBackupBucketConfigUpdate(oldConfig, newConfig *api.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldConfig.Immutability == nil || !oldConfig.Immutability.Locked {
+		return allErrs
+	}
+
+	immutabilityPath := fldPath.Child("immutability")
+	if newConfig.Immutability == nil {
+		return append(allErrs, field.Forbidden(immutabilityPath, "immutability configuration cannot be removed once it is locked"))
+	}
+
+	if !newConfig.Immutability.Locked {
+		allErrs = append(allErrs, field.Forbidden(immutabilityPath.Child("locked"), "immutability configuration cannot be unlocked once it is locked"))
+	}
+	if newConfig.Immutability.RetentionPeriod.Duration < oldConfig.Immutability.RetentionPeriod.Duration {
+		allErrs = append(allErrs, field.Forbidden(immutabilityPath.Child("retentionPeriod"), "retention period cannot be reduced once the immutability configuration is locked"))
+	}
+
+	return allErrs
+}
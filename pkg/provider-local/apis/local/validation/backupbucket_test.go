@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	api "github.com/gardener/gardener/pkg/provider-local/apis/local"
+	. "github.com/gardener/gardener/pkg/provider-local/apis/local/validation"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var (
+		fldPath = field.NewPath("spec", "providerConfig")
+		config  *api.BackupBucketConfig
+	)
+
+	BeforeEach(func() {
+		config = &api.BackupBucketConfig{
+			Immutability: &api.ImmutableConfig{
+				RetentionType:   api.BucketLevelImmutability,
+				RetentionPeriod: metav1.Duration{Duration: time.Hour},
+				Locked:          true,
+			},
+			Versioning: &api.VersioningConfig{Enabled: true},
+			Lifecycle:  &api.LifecycleConfig{NoncurrentVersionExpiration: &metav1.Duration{Duration: 24 * time.Hour}},
+		}
+	})
+
+	Describe("#ValidateBackupBucketConfig", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow an empty configuration", func() {
+			Expect(ValidateBackupBucketConfig(&api.BackupBucketConfig{}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported retention types and non-positive durations", func() {
+			config.Immutability.RetentionType = "object"
+			config.Immutability.RetentionPeriod.Duration = 0
+			config.Lifecycle.NoncurrentVersionExpiration.Duration = -time.Minute
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.providerConfig.immutability.retentionType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.providerConfig.immutability.retentionPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.providerConfig.lifecycle.noncurrentVersionExpiration"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateBackupBucketConfigUpdate", func() {
+		var newConfig *api.BackupBucketConfig
+
+		BeforeEach(func() {
+			newConfig = config.DeepCopy()
+		})
+
+		It("should allow increasing the retention period of a locked configuration", func() {
+			newConfig.Immutability.RetentionPeriod.Duration = 2 * time.Hour
+
+			Expect(ValidateBackupBucketConfigUpdate(config, newConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow any change of an unlocked configuration", func() {
+			config.Immutability.Locked = false
+			newConfig.Immutability = nil
+
+			Expect(ValidateBackupBucketConfigUpdate(config, newConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid removing a locked configuration", func() {
+			newConfig.Immutability = nil
+
+			Expect(ValidateBackupBucketConfigUpdate(config, newConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.providerConfig.immutability"),
+				})),
+			))
+		})
+
+		It("should forbid unlocking and reducing the retention period of a locked configuration", func() {
+			newConfig.Immutability.Locked = false
+			newConfig.Immutability.RetentionPeriod.Duration = time.Minute
+
+			Expect(ValidateBackupBucketConfigUpdate(config, newConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.providerConfig.immutability.locked"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.providerConfig.immutability.retentionPeriod"),
+				})),
+			))
+		})
+	})
+})
@@ -6,18 +6,25 @@ package backupbucket
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/provider-local/apis/local/helper"
+	"github.com/gardener/gardener/pkg/provider-local/apis/local/validation"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -26,12 +33,14 @@ type actuator struct {
 
 	client      client.Client
 	bbDirectory string
+	store       *backupstore.Store
 }
 
-func newActuator(mgr manager.Manager, bbDirectory string) backupbucket.Actuator {
+func newActuator(mgr manager.Manager, store *backupstore.Store) backupbucket.Actuator {
 	return &actuator{
 		client:      mgr.GetClient(),
-		bbDirectory: bbDirectory,
+		bbDirectory: store.Path,
+		store:       store,
 	}
 }
 
@@ -41,6 +50,16 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket
 		fileMode os.FileMode = 0775
 	)
 
+	config, err := helper.BackupBucketConfigFromBackupBucket(backupBucket)
+	if err != nil {
+		return err
+	}
+
+	fldPath := field.NewPath("spec", "providerConfig")
+	if allErrs := validation.ValidateBackupBucketConfig(config, fldPath); len(allErrs) > 0 {
+		return v1beta1helper.NewErrorWithCodes(allErrs.ToAggregate(), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
 	log.Info("Reconciling directory", "path", filePath)
 	if err := os.Mkdir(filePath, fileMode); err != nil && !os.IsExist(err) {
 		return err
@@ -51,6 +70,21 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket
 		return err
 	}
 
+	oldConfig, err := a.store.ReadPolicy(backupBucket.Name)
+	if err != nil {
+		return err
+	}
+	if oldConfig != nil {
+		if allErrs := validation.ValidateBackupBucketConfigUpdate(oldConfig, config, fldPath); len(allErrs) > 0 {
+			return v1beta1helper.NewErrorWithCodes(allErrs.ToAggregate(), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+	}
+
+	log.Info("Writing bucket policy", "immutable", config.Immutability != nil, "versioned", config.Versioning != nil && config.Versioning.Enabled)
+	if err := a.store.WritePolicy(backupBucket.Name, config); err != nil {
+		return err
+	}
+
 	if backupBucket.Status.GeneratedSecretRef == nil {
 		if err := a.createBackupBucketGeneratedSecret(ctx, backupBucket); err != nil {
 			return err
@@ -61,15 +95,22 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket
 }
 
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
+	retainedObjects, err := a.store.RetainedObjects(bb.Name)
+	if err != nil {
+		return err
+	}
+	if retainedObjects > 0 {
+		return fmt.Errorf("bucket %q cannot be deleted because it still contains %d objects under retention", bb.Name, retainedObjects)
+	}
+
 	if ref := bb.Status.GeneratedSecretRef; ref != nil {
 		if err := kubernetesutils.DeleteObject(ctx, a.client, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: ref.Namespace}}); err != nil {
 			return err
 		}
 	}
 
-	path := filepath.Join(a.bbDirectory, bb.Name)
-	log.Info("Deleting directory", "path", path)
-	return os.RemoveAll(path)
+	log.Info("Deleting directory", "path", filepath.Join(a.bbDirectory, bb.Name))
+	return a.store.DeleteBucket(bb.Name)
 }
 
 func (a *actuator) createBackupBucketGeneratedSecret(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket) error {
@@ -9,11 +9,13 @@ import (
 	"slices"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
 
@@ -35,8 +37,17 @@ func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts backup
 		return !supportedExtensionClasses.Has(class)
 	})
 
+	store := backupstore.New(opts.BackupBucketPath, clock.RealClock{})
+	if err := mgr.Add(&lifecycleRunnable{
+		log:      mgr.GetLogger().WithName(ControllerName).WithName("lifecycle"),
+		store:    store,
+		interval: lifecycleInterval,
+	}); err != nil {
+		return err
+	}
+
 	return backupbucket.Add(mgr, backupbucket.AddArgs{
-		Actuator:          newActuator(mgr, opts.BackupBucketPath),
+		Actuator:          newActuator(mgr, store),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              local.Type,
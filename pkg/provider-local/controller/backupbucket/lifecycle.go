@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
+)
+
+// lifecycleInterval is the interval in which the retention of objects is enforced and expired noncurrent versions are
+// deleted from the backup buckets. It is short since retained objects deleted by etcd-backup-restore are only restored
+// in this interval.
+const lifecycleInterval = 10 * time.Second
+
+// lifecycleRunnable periodically restores retained objects which were deleted or overwritten via the bucket directories
+// and deletes the noncurrent versions of all backup buckets which are expired according to the immutability,
+// versioning and lifecycle configuration of their bucket.
+type lifecycleRunnable struct {
+	log      logr.Logger
+	store    *backupstore.Store
+	interval time.Duration
+}
+
+// Start implements manager.Runnable.
+func (l *lifecycleRunnable) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(_ context.Context) {
+		if err := l.store.EnforceRetention(l.log); err != nil {
+			l.log.Error(err, "Failed enforcing retention")
+		}
+		if err := l.store.ExpireNoncurrentVersions(l.log); err != nil {
+			l.log.Error(err, "Failed expiring noncurrent versions")
+		}
+	}, l.interval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (l *lifecycleRunnable) NeedLeaderElection() bool {
+	return true
+}
@@ -6,7 +6,6 @@ package backupentry
 
 import (
 	"context"
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -18,19 +17,20 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
 )
 
 type actuator struct {
 	client             client.Client
 	containerMountPath string
-	backBucketPath     string
+	store              *backupstore.Store
 }
 
-func newActuator(mgr manager.Manager, containerMountPath, backupBucketPath string) genericactuator.BackupEntryDelegate {
+func newActuator(mgr manager.Manager, containerMountPath string, store *backupstore.Store) genericactuator.BackupEntryDelegate {
 	return &actuator{
 		client:             mgr.GetClient(),
 		containerMountPath: containerMountPath,
-		backBucketPath:     backupBucketPath,
+		store:              store,
 	}
 }
 
@@ -41,7 +41,6 @@ func (a *actuator) GetETCDSecretData(_ context.Context, _ logr.Logger, _ *extens
 
 func (a *actuator) Delete(_ context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
 	entryName := strings.TrimPrefix(be.Name, v1beta1constants.BackupSourcePrefix+"-")
-	path := filepath.Join(a.backBucketPath, be.Spec.BucketName, entryName)
-	log.Info("Deleting directory", "path", path)
-	return os.RemoveAll(path)
+	log.Info("Deleting directory", "path", filepath.Join(a.store.Path, be.Spec.BucketName, entryName))
+	return a.store.DeleteEntry(log, be.Spec.BucketName, entryName)
 }
@@ -9,12 +9,14 @@ import (
 	"slices"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
 
@@ -37,7 +39,7 @@ func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts backup
 	})
 
 	return backupentry.Add(mgr, backupentry.AddArgs{
-		Actuator:          genericactuator.NewActuator(mgr, newActuator(mgr, opts.ContainerMountPath, opts.BackupBucketPath)),
+		Actuator:          genericactuator.NewActuator(mgr, newActuator(mgr, opts.ContainerMountPath, backupstore.New(opts.BackupBucketPath, clock.RealClock{}))),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupentry.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              local.Type,
@@ -0,0 +1,551 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/clock"
+
+	api "github.com/gardener/gardener/pkg/provider-local/apis/local"
+	"github.com/gardener/gardener/pkg/provider-local/apis/local/helper"
+	"github.com/gardener/gardener/pkg/provider-local/apis/local/v1alpha1"
+)
+
+const (
+	// PolicyFileName is the name of the file in the bucket directory which contains the effective BackupBucketConfig.
+	PolicyFileName = ".bucket-policy.json"
+	// NoncurrentDirectoryName is the name of the directory in the bucket directory which contains the noncurrent
+	// versions of deleted objects. They are stored in sub directories named after the unix time of their deletion.
+	NoncurrentDirectoryName = ".noncurrent"
+	// RetentionDirectoryName is the name of the directory in the store directory which contains hard links to the
+	// retained objects of all buckets. Only the bucket directories are mounted into the etcd-backup-restore containers,
+	// hence retained objects which are deleted or overwritten by etcd-backup-restore can be restored from it.
+	RetentionDirectoryName = ".retention"
+)
+
+var codec = serializer.NewCodecFactory(helper.Scheme, serializer.EnableStrict).LegacyCodec(v1alpha1.SchemeGroupVersion)
+
+// Store is a backup store on the local file system which mimics the immutability, versioning and lifecycle semantics
+// of the object stores of cloud providers. Each bucket is a directory below Path.
+type Store struct {
+	// Path is the path to the directory containing the bucket directories.
+	Path string
+	// Clock is used to determine the age of objects.
+	Clock clock.Clock
+}
+
+// New returns a new Store for the given path.
+func New(path string, clock clock.Clock) *Store {
+	return &Store{Path: path, Clock: clock}
+}
+
+// ReadPolicy returns the policy of the given bucket. It returns nil if no policy has been written yet.
+func (s *Store) ReadPolicy(bucket string) (*api.BackupBucketConfig, error) {
+	data, err := os.ReadFile(filepath.Join(s.Path, bucket, PolicyFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	obj, err := runtime.Decode(codec, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding policy of bucket %q: %w", bucket, err)
+	}
+
+	config, ok := obj.(*api.BackupBucketConfig)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T in policy of bucket %q", obj, bucket)
+	}
+	return config, nil
+}
+
+// WritePolicy writes the policy of the given bucket.
+func (s *Store) WritePolicy(bucket string, config *api.BackupBucketConfig) error {
+	data, err := runtime.Encode(codec, config)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(s.Path, bucket, PolicyFileName), data, 0640)
+}
+
+// WriteObject writes the given data to the object with the given key in the bucket. It does nothing if the object
+// already contains the given data. Existing objects which are still retained by the immutability configuration of the
+// bucket and, if versioning is enabled, all existing objects are kept as noncurrent versions of the bucket.
+func (s *Store) WriteObject(bucket, key string, data []byte) error {
+	if !filepath.IsLocal(key) {
+		return fmt.Errorf("invalid object key %q", key)
+	}
+
+	config, err := s.ReadPolicy(bucket)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bucketPath = filepath.Join(s.Path, bucket)
+		objectPath = filepath.Join(bucketPath, key)
+		now        = s.Clock.Now()
+	)
+
+	if info, err := os.Stat(objectPath); err == nil {
+		existingData, err := os.ReadFile(objectPath)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(existingData, data) {
+			return nil
+		}
+
+		if config != nil && (versioningEnabled(config) || retained(config, info, now)) {
+			if err := s.moveObject(bucket, key, filepath.Join(NoncurrentDirectoryName, strconv.FormatInt(now.Unix(), 10), key)); err != nil {
+				return err
+			}
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(objectPath), 0775); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that readers never observe partially written objects.
+	file, err := os.CreateTemp(filepath.Dir(objectPath), "."+filepath.Base(objectPath)+"-")
+	if err != nil {
+		return err
+	}
+	// The temporary file is gone after it has been renamed, i.e., the error is only relevant if writing it failed.
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), objectPath)
+}
+
+// DeleteEntry deletes all objects of the given entry in the bucket. Objects which are still retained by the
+// immutability configuration of the bucket and, if versioning is enabled, all objects are moved to the noncurrent
+// versions of the bucket instead.
+func (s *Store) DeleteEntry(log logr.Logger, bucket, entry string) error {
+	config, err := s.ReadPolicy(bucket)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bucketPath        = filepath.Join(s.Path, bucket)
+		entryPath         = filepath.Join(bucketPath, entry)
+		now               = s.Clock.Now()
+		noncurrentRelPath = filepath.Join(NoncurrentDirectoryName, strconv.FormatInt(now.Unix(), 10))
+		kept              int
+	)
+
+	if config != nil && (config.Immutability != nil || versioningEnabled(config)) {
+		if err := filepath.WalkDir(entryPath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			if !versioningEnabled(config) && !retained(config, info, now) {
+				return os.Remove(path)
+			}
+
+			relPath, err := filepath.Rel(bucketPath, path)
+			if err != nil {
+				return err
+			}
+
+			kept++
+			return s.moveObject(bucket, relPath, filepath.Join(noncurrentRelPath, relPath))
+		}); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	if kept > 0 {
+		log.Info("Kept objects as noncurrent versions", "count", kept, "path", filepath.Join(bucketPath, noncurrentRelPath))
+	}
+
+	return os.RemoveAll(entryPath)
+}
+
+// RetainedObjects returns the number of objects in the bucket (including noncurrent versions) which are still
+// retained by the immutability configuration of the bucket.
+func (s *Store) RetainedObjects(bucket string) (int, error) {
+	config, err := s.ReadPolicy(bucket)
+	if err != nil || config == nil || config.Immutability == nil {
+		return 0, err
+	}
+
+	var (
+		bucketPath = filepath.Join(s.Path, bucket)
+		now        = s.Clock.Now()
+		count      int
+	)
+
+	if err := filepath.WalkDir(bucketPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path == filepath.Join(bucketPath, PolicyFileName) {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if retained(config, info, now) {
+			count++
+		}
+		return nil
+	}); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ExpireNoncurrentVersions deletes the noncurrent versions of all buckets which are neither retained by the
+// immutability configuration nor kept by the versioning and lifecycle configuration of their bucket anymore.
+func (s *Store) ExpireNoncurrentVersions(log logr.Logger) error {
+	buckets, err := s.buckets()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, bucket := range buckets {
+		if err := s.expireNoncurrentVersions(log.WithValues("bucket", bucket), bucket); err != nil {
+			errs = append(errs, fmt.Errorf("failed expiring noncurrent versions of bucket %q: %w", bucket, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Store) expireNoncurrentVersions(log logr.Logger, bucket string) error {
+	config, err := s.ReadPolicy(bucket)
+	if err != nil || config == nil {
+		return err
+	}
+
+	var (
+		noncurrentPath = filepath.Join(s.Path, bucket, NoncurrentDirectoryName)
+		now            = s.Clock.Now()
+	)
+
+	versions, err := os.ReadDir(noncurrentPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	for _, version := range versions {
+		deletionTimestamp, err := strconv.ParseInt(version.Name(), 10, 64)
+		if err != nil {
+			log.Info("Ignoring unexpected entry in noncurrent versions", "name", version.Name())
+			continue
+		}
+
+		if !noncurrentVersionExpired(config, time.Unix(deletionTimestamp, 0), now) {
+			continue
+		}
+
+		versionPath := filepath.Join(noncurrentPath, version.Name())
+		var deleted int
+		if err := filepath.WalkDir(versionPath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			if retained(config, info, now) {
+				return nil
+			}
+
+			deleted++
+			return os.Remove(path)
+		}); err != nil {
+			return err
+		}
+
+		if deleted > 0 {
+			log.Info("Deleted expired noncurrent versions", "count", deleted, "path", versionPath)
+		}
+
+		if err := removeEmptyDirectories(versionPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EnforceRetention enforces the immutability configuration of all buckets for objects which are deleted or overwritten
+// via the bucket directories directly, e.g., by the garbage collection of etcd-backup-restore. Retained objects are
+// protected by hard links in the retention directory, which is not accessible via the bucket directories. Deleted
+// retained objects are restored and overwritten retained objects are kept as noncurrent versions.
+func (s *Store) EnforceRetention(log logr.Logger) error {
+	buckets, err := s.buckets()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, bucket := range buckets {
+		if err := s.enforceRetention(log.WithValues("bucket", bucket), bucket); err != nil {
+			errs = append(errs, fmt.Errorf("failed enforcing retention of bucket %q: %w", bucket, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Store) enforceRetention(log logr.Logger, bucket string) error {
+	config, err := s.ReadPolicy(bucket)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bucketPath    = filepath.Join(s.Path, bucket)
+		retentionPath = filepath.Join(s.Path, RetentionDirectoryName, bucket)
+		now           = s.Clock.Now()
+		restored      int
+		kept          int
+	)
+
+	if config == nil || config.Immutability == nil {
+		return os.RemoveAll(retentionPath)
+	}
+
+	// Restore the retained objects which were deleted or overwritten since the last run and release the objects whose
+	// retention period has passed.
+	if err := filepath.WalkDir(retentionPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if !retained(config, info, now) {
+			return os.Remove(path)
+		}
+
+		relPath, err := filepath.Rel(retentionPath, path)
+		if err != nil {
+			return err
+		}
+
+		objectInfo, err := os.Lstat(filepath.Join(bucketPath, relPath))
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+
+			restored++
+			return link(path, filepath.Join(bucketPath, relPath))
+		}
+
+		if os.SameFile(info, objectInfo) {
+			return nil
+		}
+
+		targetRelPath := filepath.Join(NoncurrentDirectoryName, strconv.FormatInt(now.Unix(), 10), relPath)
+		if err := link(path, filepath.Join(bucketPath, targetRelPath)); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(retentionPath, targetRelPath)), 0775); err != nil {
+			return err
+		}
+
+		kept++
+		return os.Rename(path, filepath.Join(retentionPath, targetRelPath))
+	}); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if restored > 0 {
+		log.Info("Restored deleted objects under retention", "count", restored)
+	}
+	if kept > 0 {
+		log.Info("Kept overwritten objects under retention as noncurrent versions", "count", kept)
+	}
+
+	// Protect the objects which are retained but not protected yet.
+	if err := filepath.WalkDir(bucketPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path == filepath.Join(bucketPath, PolicyFileName) {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() || !retained(config, info, now) {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(bucketPath, path)
+		if err != nil {
+			return err
+		}
+
+		if _, err := os.Lstat(filepath.Join(retentionPath, relPath)); !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return link(path, filepath.Join(retentionPath, relPath))
+	}); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := removeEmptyDirectories(retentionPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// DeleteBucket deletes the given bucket including the hard links protecting its retained objects.
+func (s *Store) DeleteBucket(bucket string) error {
+	if err := os.RemoveAll(filepath.Join(s.Path, RetentionDirectoryName, bucket)); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(s.Path, bucket))
+}
+
+// buckets returns the names of all buckets in the store.
+func (s *Store) buckets() ([]string, error) {
+	entries, err := os.ReadDir(s.Path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var buckets []string
+	for _, entry := range entries {
+		// Bucket names are names of Kubernetes objects, i.e., they cannot start with a dot.
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			buckets = append(buckets, entry.Name())
+		}
+	}
+	return buckets, nil
+}
+
+// moveObject moves the object at the given path relative to the bucket directory to the given target path. If the
+// object is protected by a hard link in the retention directory, the hard link is moved first so that the object is not
+// restored at its old path in the meantime.
+func (s *Store) moveObject(bucket, relPath, targetRelPath string) error {
+	var (
+		retentionPath = filepath.Join(s.Path, RetentionDirectoryName, bucket)
+		bucketPath    = filepath.Join(s.Path, bucket)
+	)
+
+	if _, err := os.Lstat(filepath.Join(retentionPath, relPath)); err == nil {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(retentionPath, targetRelPath)), 0775); err != nil {
+			return err
+		}
+		if err := os.Rename(filepath.Join(retentionPath, relPath), filepath.Join(retentionPath, targetRelPath)); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filepath.Join(bucketPath, targetRelPath)), 0775); err != nil {
+		return err
+	}
+	return os.Rename(filepath.Join(bucketPath, relPath), filepath.Join(bucketPath, targetRelPath))
+}
+
+// link creates a hard link at the given new path to the given old path including all missing parent directories.
+func link(oldPath, newPath string) error {
+	if err := os.MkdirAll(filepath.Dir(newPath), 0775); err != nil {
+		return err
+	}
+	return os.Link(oldPath, newPath)
+}
+
+func versioningEnabled(config *api.BackupBucketConfig) bool {
+	return config.Versioning != nil && config.Versioning.Enabled
+}
+
+func retained(config *api.BackupBucketConfig, info fs.FileInfo, now time.Time) bool {
+	return config.Immutability != nil && now.Before(info.ModTime().Add(config.Immutability.RetentionPeriod.Duration))
+}
+
+// noncurrentVersionExpired returns whether noncurrent versions deleted at the given time are expired with respect to
+// the versioning and lifecycle configuration. Without versioning, noncurrent versions only exist as long as they are
+// retained by the immutability configuration.
+func noncurrentVersionExpired(config *api.BackupBucketConfig, deletedAt, now time.Time) bool {
+	if config.Lifecycle != nil && config.Lifecycle.NoncurrentVersionExpiration != nil {
+		return !now.Before(deletedAt.Add(config.Lifecycle.NoncurrentVersionExpiration.Duration))
+	}
+	return !versioningEnabled(config)
+}
+
+// removeEmptyDirectories removes all empty directories below and including the given path.
+func removeEmptyDirectories(path string) error {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	empty := true
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			empty = false
+			continue
+		}
+
+		if err := removeEmptyDirectories(filepath.Join(path, entry.Name())); err != nil {
+			return err
+		}
+		if _, err := os.Stat(filepath.Join(path, entry.Name())); err == nil {
+			empty = false
+		}
+	}
+
+	if !empty {
+		return nil
+	}
+	return os.Remove(path)
+}
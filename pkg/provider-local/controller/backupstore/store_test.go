@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupstore_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+
+	api "github.com/gardener/gardener/pkg/provider-local/apis/local"
+	. "github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
+)
+
+var _ = Describe("Store", func() {
+	const (
+		bucket = "bucket"
+		entry  = "shoot--foo--bar--uid"
+	)
+
+	var (
+		log       = logr.Discard()
+		fakeClock *testclock.FakeClock
+		store     *Store
+
+		bucketPath string
+		objectPath string
+		config     *api.BackupBucketConfig
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Unix(1700000000, 0))
+		store = New(GinkgoT().TempDir(), fakeClock)
+
+		bucketPath = filepath.Join(store.Path, bucket)
+		objectPath = filepath.Join(bucketPath, entry, "v2", "Full-00000000-00000001-1700000000")
+		Expect(os.MkdirAll(filepath.Dir(objectPath), 0775)).To(Succeed())
+		Expect(os.WriteFile(objectPath, []byte("snapshot"), 0640)).To(Succeed())
+		Expect(os.Chtimes(objectPath, fakeClock.Now(), fakeClock.Now())).To(Succeed())
+
+		config = &api.BackupBucketConfig{}
+	})
+
+	noncurrentObjectPath := func() string {
+		return filepath.Join(bucketPath, NoncurrentDirectoryName, strconv.FormatInt(fakeClock.Now().Unix(), 10), entry, "v2", "Full-00000000-00000001-1700000000")
+	}
+
+	Describe("#ReadPolicy, #WritePolicy", func() {
+		It("should return nil if no policy was written", func() {
+			Expect(store.ReadPolicy(bucket)).To(BeNil())
+		})
+
+		It("should read the written policy", func() {
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}, Locked: true}
+			config.Lifecycle = &api.LifecycleConfig{NoncurrentVersionExpiration: &metav1.Duration{Duration: time.Minute}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+
+			Expect(store.ReadPolicy(bucket)).To(Equal(config))
+		})
+	})
+
+	Describe("#WriteObject", func() {
+		It("should write a new object", func() {
+			Expect(store.WriteObject(bucket, entry+"/bastions/foo/sessions.log", []byte("log"))).To(Succeed())
+
+			Expect(os.ReadFile(filepath.Join(bucketPath, entry, "bastions", "foo", "sessions.log"))).To(Equal([]byte("log")))
+		})
+
+		It("should overwrite the object if the bucket has no policy", func() {
+			Expect(store.WriteObject(bucket, entry+"/v2/Full-00000000-00000001-1700000000", []byte("new"))).To(Succeed())
+
+			Expect(os.ReadFile(objectPath)).To(Equal([]byte("new")))
+			Expect(filepath.Join(bucketPath, NoncurrentDirectoryName)).NotTo(BeAnExistingFile())
+		})
+
+		It("should not touch the object if its data is unchanged", func() {
+			config.Versioning = &api.VersioningConfig{Enabled: true}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+
+			Expect(store.WriteObject(bucket, entry+"/v2/Full-00000000-00000001-1700000000", []byte("snapshot"))).To(Succeed())
+
+			Expect(filepath.Join(bucketPath, NoncurrentDirectoryName)).NotTo(BeAnExistingFile())
+		})
+
+		It("should keep a retained object as noncurrent version", func() {
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+			fakeClock.Step(time.Minute)
+
+			Expect(store.WriteObject(bucket, entry+"/v2/Full-00000000-00000001-1700000000", []byte("new"))).To(Succeed())
+
+			Expect(os.ReadFile(objectPath)).To(Equal([]byte("new")))
+			Expect(os.ReadFile(noncurrentObjectPath())).To(Equal([]byte("snapshot")))
+		})
+
+		It("should reject keys outside of the bucket", func() {
+			Expect(store.WriteObject(bucket, "../other/object", []byte("new"))).To(MatchError(ContainSubstring("invalid object key")))
+		})
+	})
+
+	Describe("#DeleteEntry", func() {
+		It("should delete the entry if the bucket has no policy", func() {
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+
+			Expect(filepath.Join(bucketPath, entry)).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(bucketPath, NoncurrentDirectoryName)).NotTo(BeAnExistingFile())
+		})
+
+		It("should succeed if the entry does not exist", func() {
+			config.Versioning = &api.VersioningConfig{Enabled: true}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+
+			Expect(store.DeleteEntry(log, bucket, "other")).To(Succeed())
+		})
+
+		It("should keep retained objects as noncurrent versions", func() {
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+
+			Expect(filepath.Join(bucketPath, entry)).NotTo(BeAnExistingFile())
+			Expect(noncurrentObjectPath()).To(BeAnExistingFile())
+		})
+
+		It("should delete objects whose retention period has passed", func() {
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+			fakeClock.Step(time.Hour)
+
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+
+			Expect(filepath.Join(bucketPath, entry)).NotTo(BeAnExistingFile())
+			Expect(noncurrentObjectPath()).NotTo(BeAnExistingFile())
+		})
+
+		It("should keep all objects as noncurrent versions if versioning is enabled", func() {
+			config.Versioning = &api.VersioningConfig{Enabled: true}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+
+			Expect(filepath.Join(bucketPath, entry)).NotTo(BeAnExistingFile())
+			Expect(noncurrentObjectPath()).To(BeAnExistingFile())
+		})
+	})
+
+	Describe("#RetainedObjects", func() {
+		It("should not count objects if immutability is not configured", func() {
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+
+			Expect(store.RetainedObjects(bucket)).To(BeZero())
+		})
+
+		It("should count retained objects including noncurrent versions", func() {
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(bucketPath, entry, "v2", "Incr-00000001-00000002-1700000001"), []byte("delta"), 0640)).To(Succeed())
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+
+			Expect(store.RetainedObjects(bucket)).To(Equal(2))
+
+			fakeClock.Step(time.Hour)
+			Expect(store.RetainedObjects(bucket)).To(Equal(1))
+		})
+	})
+
+	Describe("#ExpireNoncurrentVersions", func() {
+		It("should delete noncurrent versions once their retention period has passed", func() {
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+			path := noncurrentObjectPath()
+
+			Expect(store.ExpireNoncurrentVersions(log)).To(Succeed())
+			Expect(path).To(BeAnExistingFile())
+
+			fakeClock.Step(time.Hour)
+			Expect(store.ExpireNoncurrentVersions(log)).To(Succeed())
+			Expect(path).NotTo(BeAnExistingFile())
+			Expect(os.ReadDir(filepath.Join(bucketPath, NoncurrentDirectoryName))).To(BeEmpty())
+		})
+
+		It("should keep noncurrent versions forever if versioning is enabled without lifecycle configuration", func() {
+			config.Versioning = &api.VersioningConfig{Enabled: true}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+			path := noncurrentObjectPath()
+
+			fakeClock.Step(365 * 24 * time.Hour)
+			Expect(store.ExpireNoncurrentVersions(log)).To(Succeed())
+			Expect(path).To(BeAnExistingFile())
+		})
+
+		It("should delete noncurrent versions after the noncurrent version expiration", func() {
+			config.Versioning = &api.VersioningConfig{Enabled: true}
+			config.Lifecycle = &api.LifecycleConfig{NoncurrentVersionExpiration: &metav1.Duration{Duration: 24 * time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+			path := noncurrentObjectPath()
+
+			fakeClock.Step(23 * time.Hour)
+			Expect(store.ExpireNoncurrentVersions(log)).To(Succeed())
+			Expect(path).To(BeAnExistingFile())
+
+			fakeClock.Step(time.Hour)
+			Expect(store.ExpireNoncurrentVersions(log)).To(Succeed())
+			Expect(path).NotTo(BeAnExistingFile())
+		})
+
+		It("should succeed if the store directory does not exist", func() {
+			Expect(New(filepath.Join(store.Path, "missing"), fakeClock).ExpireNoncurrentVersions(log)).To(Succeed())
+		})
+	})
+
+	Describe("#EnforceRetention", func() {
+		var retentionObjectPath string
+
+		BeforeEach(func() {
+			retentionObjectPath = filepath.Join(store.Path, RetentionDirectoryName, bucket, entry, "v2", "Full-00000000-00000001-1700000000")
+
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+		})
+
+		It("should restore retained objects which were deleted via the bucket directory", func() {
+			Expect(store.EnforceRetention(log)).To(Succeed())
+			Expect(retentionObjectPath).To(BeAnExistingFile())
+
+			Expect(os.Remove(objectPath)).To(Succeed())
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(os.ReadFile(objectPath)).To(Equal([]byte("snapshot")))
+		})
+
+		It("should keep retained objects which were overwritten via the bucket directory as noncurrent versions", func() {
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(os.Remove(objectPath)).To(Succeed())
+			Expect(os.WriteFile(objectPath, []byte("new"), 0640)).To(Succeed())
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(os.ReadFile(objectPath)).To(Equal([]byte("new")))
+			Expect(os.ReadFile(noncurrentObjectPath())).To(Equal([]byte("snapshot")))
+
+			By("Protect both versions")
+			Expect(os.Remove(objectPath)).To(Succeed())
+			Expect(os.Remove(noncurrentObjectPath())).To(Succeed())
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(os.ReadFile(objectPath)).To(Equal([]byte("new")))
+			Expect(os.ReadFile(noncurrentObjectPath())).To(Equal([]byte("snapshot")))
+		})
+
+		It("should not restore objects whose retention period has passed", func() {
+			Expect(store.EnforceRetention(log)).To(Succeed())
+			fakeClock.Step(time.Hour)
+
+			Expect(os.Remove(objectPath)).To(Succeed())
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(objectPath).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(store.Path, RetentionDirectoryName, bucket)).NotTo(BeAnExistingFile())
+		})
+
+		It("should not restore retained objects at their old path after they were moved by the store", func() {
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(store.DeleteEntry(log, bucket, entry)).To(Succeed())
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(filepath.Join(bucketPath, entry)).NotTo(BeAnExistingFile())
+			Expect(noncurrentObjectPath()).To(BeAnExistingFile())
+		})
+
+		It("should release all objects if immutability is not configured anymore", func() {
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(store.WritePolicy(bucket, &api.BackupBucketConfig{})).To(Succeed())
+			Expect(os.Remove(objectPath)).To(Succeed())
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(objectPath).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(store.Path, RetentionDirectoryName, bucket)).NotTo(BeAnExistingFile())
+		})
+	})
+
+	Describe("#DeleteBucket", func() {
+		It("should delete the bucket and its retention directory", func() {
+			config.Immutability = &api.ImmutableConfig{RetentionType: api.BucketLevelImmutability, RetentionPeriod: metav1.Duration{Duration: time.Hour}}
+			Expect(store.WritePolicy(bucket, config)).To(Succeed())
+			Expect(store.EnforceRetention(log)).To(Succeed())
+
+			Expect(store.DeleteBucket(bucket)).To(Succeed())
+
+			Expect(bucketPath).NotTo(BeAnExistingFile())
+			Expect(filepath.Join(store.Path, RetentionDirectoryName, bucket)).NotTo(BeAnExistingFile())
+		})
+	})
+})
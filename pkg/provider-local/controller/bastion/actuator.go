@@ -37,16 +37,18 @@ import (
 const SSHPort = 22
 
 type actuator struct {
-	client client.Client
+	client         client.Client
+	sessionShipper *sessionShipper
 }
 
-func newActuator(mgr manager.Manager) bastion.Actuator {
+func newActuator(mgr manager.Manager, sessionShipper *sessionShipper) bastion.Actuator {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:         mgr.GetClient(),
+		sessionShipper: sessionShipper,
 	}
 }
 
-func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
 	image, err := bastionImage(cluster)
 	if err != nil {
 		return err
@@ -85,10 +87,19 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, bastion *extens
 
 	patch := client.MergeFrom(bastion.DeepCopy())
 	bastion.Status.Ingress = service.Status.LoadBalancer.Ingress[0].DeepCopy()
-	return a.client.Status().Patch(ctx, bastion, patch)
+	if err := a.client.Status().Patch(ctx, bastion, patch); err != nil {
+		return err
+	}
+
+	return a.sessionShipper.ship(ctx, log, bastion)
 }
 
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion, _ *extensionscontroller.Cluster) error {
+	// The session records are lost with the Bastion Pod, hence they must be shipped a last time before deleting it.
+	if err := a.sessionShipper.ship(ctx, log, bastion); err != nil {
+		return fmt.Errorf("failed shipping session records before deleting the Bastion Pod: %w", err)
+	}
+
 	// Explicitly delete the Bastion Pod so that we can wait for it to terminate. The other objects will get cleaned up by
 	// the garbage collector (due to ownerReferences), but only after removing the Bastion's finalizer.
 	if err := a.client.Delete(ctx, podForBastion(bastion, "", "")); err != nil {
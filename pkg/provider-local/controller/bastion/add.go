@@ -7,16 +7,31 @@ package bastion
 import (
 	"context"
 	"slices"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
 
+const (
+	// SessionsControllerName is the name of the controller which regularly ships the session records of Bastions.
+	SessionsControllerName = "bastion_sessions"
+	// SessionsSyncPeriod is the period in which the session records of Bastions are shipped.
+	SessionsSyncPeriod = time.Minute
+)
+
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
 	DefaultAddOptions = AddOptions{}
@@ -33,6 +48,9 @@ type AddOptions struct {
 	IgnoreOperationAnnotation bool
 	// ExtensionClasses are the configured extension classes for this extension deployment.
 	ExtensionClasses []extensionsv1alpha1.ExtensionClass
+	// BackupBucketPath is the path to the directory containing the backup buckets, to which the session records of
+	// Bastions are shipped.
+	BackupBucketPath string
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -42,13 +60,38 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
 		return !supportedExtensionClasses.Has(class)
 	})
 
-	return bastion.Add(mgr, bastion.AddArgs{
-		Actuator:          newActuator(mgr),
+	shipper := &sessionShipper{
+		client:      mgr.GetClient(),
+		podExecutor: kubernetes.NewPodExecutor(mgr.GetConfig()),
+		store:       backupstore.New(opts.BackupBucketPath, clock.RealClock{}),
+	}
+
+	if err := bastion.Add(mgr, bastion.AddArgs{
+		Actuator:          newActuator(mgr, shipper),
 		ControllerOptions: opts.Controller,
 		Predicates:        bastion.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              local.Type,
 		ExtensionClasses:  classes,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(SessionsControllerName).
+		For(&extensionsv1alpha1.Bastion{}, builder.WithPredicates(predicateutils.AddTypeAndClassPredicates([]predicate.Predicate{
+			predicate.GenerationChangedPredicate{},
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				bastion, ok := obj.(*extensionsv1alpha1.Bastion)
+				return ok && bastion.Spec.SessionRecording != nil
+			}),
+		}, classes, local.Type)...)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: opts.Controller.MaxConcurrentReconciles}).
+		Complete(&sessionReconciler{
+			client:     mgr.GetClient(),
+			shipper:    shipper,
+			syncPeriod: SessionsSyncPeriod,
+		})
 }
 
 // AddToManager adds a controller with the default Options.
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
+)
+
+// ShipSessionRecords is exported for testing.
+func ShipSessionRecords(ctx context.Context, log logr.Logger, c client.Client, podExecutor kubernetes.PodExecutor, store *backupstore.Store, bastion *extensionsv1alpha1.Bastion) error {
+	return (&sessionShipper{client: c, podExecutor: podExecutor, store: store}).ship(ctx, log, bastion)
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	extensionsbastion "github.com/gardener/gardener/extensions/pkg/bastion"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
+)
+
+// sessionShipper ships the session log and transcripts recorded by a bastion pod to the bucket of the BackupEntry
+// referenced in the Bastion and reports the recorded sessions in the status of the Bastion.
+type sessionShipper struct {
+	client      client.Client
+	podExecutor kubernetes.PodExecutor
+	store       *backupstore.Store
+}
+
+func (s *sessionShipper) ship(ctx context.Context, log logr.Logger, bastion *extensionsv1alpha1.Bastion) error {
+	if bastion.Spec.SessionRecording == nil {
+		return nil
+	}
+
+	pod := &corev1.Pod{}
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: bastion.Namespace, Name: objectMetaForBastion(bastion).Name}, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading bastion pod: %w", err)
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil
+	}
+
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := s.client.Get(ctx, client.ObjectKey{Name: bastion.Spec.SessionRecording.BackupEntryName}, backupEntry); err != nil {
+		return fmt.Errorf("failed reading BackupEntry %q: %w", bastion.Spec.SessionRecording.BackupEntryName, err)
+	}
+
+	sessionLog, err := s.readFile(ctx, pod, extensionsv1alpha1.BastionSessionLogPath)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := extensionsbastion.ParseSessionLog(bastion, sessionLog)
+	if err != nil {
+		return err
+	}
+
+	// Ship the transcripts before the session log so that the shipped session log never references missing transcripts.
+	for _, session := range sessions {
+		if session.Transcript == nil {
+			continue
+		}
+
+		transcript, err := s.readFile(ctx, pod, path.Join(extensionsv1alpha1.BastionTranscriptsDirectory, path.Base(*session.Transcript)))
+		if err != nil {
+			return err
+		}
+		if err := s.store.WriteObject(backupEntry.Spec.BucketName, *session.Transcript, transcript); err != nil {
+			return fmt.Errorf("failed shipping transcript of session %s: %w", session.ID, err)
+		}
+	}
+
+	if err := s.store.WriteObject(backupEntry.Spec.BucketName, extensionsbastion.SessionLogObjectKey(bastion), sessionLog); err != nil {
+		return fmt.Errorf("failed shipping session log: %w", err)
+	}
+
+	if apiequality.Semantic.DeepEqual(bastion.Status.Sessions, sessions) {
+		return nil
+	}
+
+	log.Info("Shipped session records", "sessions", len(sessions), "bucket", backupEntry.Spec.BucketName)
+	patch := client.MergeFrom(bastion.DeepCopy())
+	bastion.Status.Sessions = sessions
+	return s.client.Status().Patch(ctx, bastion, patch)
+}
+
+// readFile returns the content of the given file in the bastion pod. It returns no data if the file does not exist
+// (yet), e.g., because the user data has not been executed so far.
+func (s *sessionShipper) readFile(ctx context.Context, pod *corev1.Pod, filePath string) ([]byte, error) {
+	stdout, _, err := s.podExecutor.Execute(ctx, pod.Namespace, pod.Name, "machine", "sh", "-c", `if [ -e "$0" ]; then cat "$0"; fi`, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading %s in bastion pod: %w", filePath, err)
+	}
+
+	data, err := io.ReadAll(stdout)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading %s in bastion pod: %w", filePath, err)
+	}
+	return data, nil
+}
+
+// sessionReconciler regularly ships the session records of Bastions with session recording, since the Bastion
+// controller only reconciles Bastions when they are changed.
+type sessionReconciler struct {
+	client     client.Client
+	shipper    *sessionShipper
+	syncPeriod time.Duration
+}
+
+func (r *sessionReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	bastion := &extensionsv1alpha1.Bastion{}
+	if err := r.client.Get(ctx, request.NamespacedName, bastion); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// The session records are shipped a last time by the actuator before the bastion pod is deleted.
+	if bastion.DeletionTimestamp != nil || bastion.Spec.SessionRecording == nil {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.shipper.ship(ctx, log, bastion); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed shipping session records: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.syncPeriod}, nil
+}
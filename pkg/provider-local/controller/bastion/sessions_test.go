@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
+	. "github.com/gardener/gardener/pkg/provider-local/controller/bastion"
+)
+
+var _ = Describe("Sessions", func() {
+	const (
+		namespace  = "shoot--foo--bar"
+		entryName  = "shoot--foo--bar--uid"
+		bucketName = "bucket"
+		sessionLog = `2026-01-01T10:00:00+00:00 start 11111111-1111-1111-1111-111111111111 10.0.0.1 10.250.0.2 -
+2026-01-01T10:05:00+00:00 start 22222222-2222-2222-2222-222222222222 10.0.0.1 - 22222222-2222-2222-2222-222222222222.log
+2026-01-01T10:10:00+00:00 end 11111111-1111-1111-1111-111111111111 10.0.0.1 10.250.0.2 -
+`
+	)
+
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		ctrl        *gomock.Controller
+		podExecutor *mockkubernetes.MockPodExecutor
+		fakeClient  client.Client
+		store       *backupstore.Store
+
+		bastion *extensionsv1alpha1.Bastion
+		pod     *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		podExecutor = mockkubernetes.NewMockPodExecutor(ctrl)
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&extensionsv1alpha1.Bastion{}).Build()
+		store = backupstore.New(GinkgoT().TempDir(), clock.RealClock{})
+
+		bastion = &extensionsv1alpha1.Bastion{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: namespace, UID: "bastion-uid"},
+			Spec: extensionsv1alpha1.BastionSpec{
+				SessionRecording: &extensionsv1alpha1.BastionSessionRecording{BackupEntryName: entryName, Transcripts: true},
+			},
+		}
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "bastion-foo", Namespace: namespace},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+
+		Expect(fakeClient.Create(ctx, bastion)).To(Succeed())
+		Expect(fakeClient.Create(ctx, &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: entryName},
+			Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: bucketName},
+		})).To(Succeed())
+	})
+
+	expectReadFile := func(filePath, data string) {
+		podExecutor.EXPECT().Execute(gomock.Any(), namespace, "bastion-foo", "machine", "sh", "-c", gomock.Any(), filePath).Return(bytes.NewBufferString(data), &bytes.Buffer{}, nil)
+	}
+
+	Describe("#ShipSessionRecords", func() {
+		It("should ship the session log and transcripts and report the sessions", func() {
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+			expectReadFile("/var/log/gardener-bastion/sessions.log", sessionLog)
+			expectReadFile("/var/log/gardener-bastion/transcripts/22222222-2222-2222-2222-222222222222.log", "transcript")
+
+			Expect(ShipSessionRecords(ctx, log, fakeClient, podExecutor, store, bastion)).To(Succeed())
+
+			prefix := filepath.Join(store.Path, bucketName, entryName, "bastions", "foo", "bastion-uid")
+			Expect(os.ReadFile(filepath.Join(prefix, "sessions.log"))).To(Equal([]byte(sessionLog)))
+			Expect(os.ReadFile(filepath.Join(prefix, "transcripts", "22222222-2222-2222-2222-222222222222.log"))).To(Equal([]byte("transcript")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(bastion), bastion)).To(Succeed())
+			Expect(bastion.Status.Sessions).To(ConsistOf(
+				extensionsv1alpha1.BastionSession{
+					ID:        "11111111-1111-1111-1111-111111111111",
+					Source:    "10.0.0.1",
+					Node:      "10.250.0.2",
+					StartTime: metav1.NewTime(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC).Local()),
+					EndTime:   ptr.To(metav1.NewTime(time.Date(2026, 1, 1, 10, 10, 0, 0, time.UTC).Local())),
+				},
+				extensionsv1alpha1.BastionSession{
+					ID:         "22222222-2222-2222-2222-222222222222",
+					Source:     "10.0.0.1",
+					StartTime:  metav1.NewTime(time.Date(2026, 1, 1, 10, 5, 0, 0, time.UTC).Local()),
+					Transcript: ptr.To(entryName + "/bastions/foo/bastion-uid/transcripts/22222222-2222-2222-2222-222222222222.log"),
+				},
+			))
+		})
+
+		It("should do nothing if the bastion pod does not exist", func() {
+			Expect(ShipSessionRecords(ctx, log, fakeClient, podExecutor, store, bastion)).To(Succeed())
+
+			Expect(filepath.Join(store.Path, bucketName)).NotTo(BeADirectory())
+		})
+
+		It("should do nothing if session recording is not enabled", func() {
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+			bastion.Spec.SessionRecording = nil
+
+			Expect(ShipSessionRecords(ctx, log, fakeClient, podExecutor, store, bastion)).To(Succeed())
+		})
+
+		It("should fail if the session log cannot be parsed", func() {
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+			expectReadFile("/var/log/gardener-bastion/sessions.log", "invalid\n")
+
+			Expect(ShipSessionRecords(ctx, log, fakeClient, podExecutor, store, bastion)).To(MatchError(ContainSubstring("invalid session log line 1")))
+			Expect(filepath.Join(store.Path, bucketName)).NotTo(BeADirectory())
+		})
+	})
+})
@@ -17,6 +17,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/provider-local/local"
 )
 
 const (
@@ -39,7 +40,13 @@ type Actuator struct {
 }
 
 // Reconcile ensures that the DNS record is correctly represented via RFC 2136 dynamic DNS update.
-func (a *Actuator) Reconcile(_ context.Context, _ logr.Logger, dnsRecord *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+func (a *Actuator) Reconcile(ctx context.Context, log logr.Logger, dnsRecord *extensionsv1alpha1.DNSRecord, cluster *extensionscontroller.Cluster) error {
+	if dnsRecord.Annotations[local.AnnotationFaultDNSResolution] == "true" {
+		// Simulate a DNS resolution failure by removing the record from the DNS server while still reporting success.
+		log.Info("Removing DNS record to simulate resolution failure", "annotation", local.AnnotationFaultDNSResolution)
+		return a.Delete(ctx, log, dnsRecord, cluster)
+	}
+
 	header, err := headerForDNSRecord(dnsRecord)
 	if err != nil {
 		return fmt.Errorf("failed to create header for %s: %w", dnsRecord.Spec.Name, err)
@@ -188,6 +188,23 @@ var _ = Describe("Actuator", func() {
 			// Insert RR should use default TTL of 120
 			Expect(dnsClient.messages[0].Ns[1].Header().Ttl).To(Equal(uint32(120)))
 		})
+
+		It("should only remove the record if a DNS resolution failure is injected", func(ctx SpecContext) {
+			metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, "local.provider.extensions.gardener.cloud/fault-dns-resolution", "true")
+
+			Expect(actuator.Reconcile(ctx, log, dnsRecord, cluster)).To(Succeed())
+
+			Expect(dnsClient.messages).To(HaveLen(1))
+			Expect(dnsClient.messages[0].Ns).To(HaveExactElements(
+				PointTo(MatchAllFields(Fields{
+					"Hdr": MatchFields(IgnoreExtras, Fields{
+						"Name":   Equal("api.something.local.gardener.cloud."),
+						"Class":  BeEquivalentTo(dns.ClassANY),
+						"Rrtype": Equal(dns.TypeA),
+					}),
+				})),
+			))
+		})
 	})
 
 	Describe("Delete", func() {
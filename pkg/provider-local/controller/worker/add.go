@@ -14,8 +14,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 )
@@ -58,9 +60,13 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	})
 
 	return worker.Add(ctx, mgr, worker.AddArgs{
-		Actuator:               NewActuator(mgr, opts.GardenCluster),
-		ControllerOptions:      opts.Controller,
-		Predicates:             worker.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
+		Actuator:          NewActuator(mgr, opts.GardenCluster),
+		ControllerOptions: opts.Controller,
+		// Changes of the fault annotations are reconciled without the reconcile operation annotation.
+		Predicates: []predicate.Predicate{predicate.Or(
+			predicate.And(worker.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation)...),
+			predicate.And(extensionspredicate.ShootNotFailedPredicate(ctx, mgr), FaultAnnotationsChanged()),
+		)},
 		Type:                   local.Type,
 		ExtensionClasses:       classes,
 		SelfHostedShootCluster: opts.SelfHostedShootCluster,
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener/pkg/provider-local/local"
+	machineproviderlocal "github.com/gardener/gardener/pkg/provider-local/machine-provider/local"
+)
+
+// annotationFaultState is an annotation on machine pods containing the faults which were injected into the machine. It
+// is used to only exec into machine pods whose desired fault state has changed.
+const annotationFaultState = "local.provider.extensions.gardener.cloud/fault-state"
+
+var faultAnnotations = []string{
+	local.AnnotationFaultZoneOutage,
+	local.AnnotationFaultNodeNotReady,
+	local.AnnotationFaultAPILatency,
+}
+
+// FaultAnnotationsChanged is a predicate which returns true if any of the fault annotations of the Worker changed.
+// This allows injecting and reverting faults without annotating the Worker with the reconcile operation annotation.
+func FaultAnnotationsChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			for _, annotation := range faultAnnotations {
+				if e.ObjectOld.GetAnnotations()[annotation] != e.ObjectNew.GetAnnotations()[annotation] {
+					return true
+				}
+			}
+			return false
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// injectFaults simulates the faults configured via annotations on the Worker for the given machine pods. Faults which
+// are no longer configured are reverted, i.e., the annotations reflect the desired state of all machines. Machine pods
+// whose fault state did not change are not touched.
+func (w *workerDelegate) injectFaults(ctx context.Context, pods []corev1.Pod) error {
+	var (
+		outageZones   = sets.New(splitList(w.worker.Annotations[local.AnnotationFaultZoneOutage])...)
+		notReadyNodes = sets.New(splitList(w.worker.Annotations[local.AnnotationFaultNodeNotReady])...)
+		latency       time.Duration
+	)
+
+	if value, ok := w.worker.Annotations[local.AnnotationFaultAPILatency]; ok {
+		var err error
+		if latency, err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("failed parsing annotation %s: %w", local.AnnotationFaultAPILatency, err)
+		}
+	}
+
+	for _, pod := range pods {
+		// The node name equals the machine pod name.
+		stopped := notReadyNodes.Has(pod.Name) || outageZones.Has(pod.Labels[corev1.LabelTopologyZone])
+
+		// Machines without the annotation never had faults injected, hence the empty state equals the state without faults.
+		desiredState := faultState(stopped, latency)
+		if pod.Annotations[annotationFaultState] == desiredState {
+			continue
+		}
+
+		if _, _, err := w.podExecutor.Execute(ctx,
+			pod.Namespace,
+			pod.Name,
+			machineproviderlocal.MachinePodContainerName,
+			"sh",
+			"-c",
+			kubeletCommand(stopped)+"; "+latencyCommand(latency),
+		); err != nil {
+			return fmt.Errorf("failed to inject faults into machine pod %s: %w", pod.Name, err)
+		}
+
+		patch := client.MergeFrom(pod.DeepCopy())
+		if desiredState == "" {
+			delete(pod.Annotations, annotationFaultState)
+		} else {
+			metav1.SetMetaDataAnnotation(&pod.ObjectMeta, annotationFaultState, desiredState)
+		}
+		if err := w.providerClient.Patch(ctx, &pod, patch); err != nil {
+			return fmt.Errorf("failed to record fault state of machine pod %s: %w", pod.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// faultState returns the value of the fault state annotation for the given faults. It is empty if no faults are
+// injected.
+func faultState(stopped bool, latency time.Duration) string {
+	var faults []string
+	if stopped {
+		faults = append(faults, "kubelet-stopped")
+	}
+	if latency > 0 {
+		faults = append(faults, "latency="+latency.String())
+	}
+	return strings.Join(faults, ",")
+}
+
+// kubeletCommand returns the command for stopping the kubelet or starting it again if it was stopped before.
+// gardener-node-agent is stopped as well since it would restart the kubelet otherwise. A marker file ensures that the
+// units are only started if they were stopped by this function, i.e., the bootstrapping of new machines is not affected.
+func kubeletCommand(stopped bool) string {
+	const marker = "/var/lib/provider-local-fault-kubelet-stopped"
+
+	if stopped {
+		return "touch " + marker + " && systemctl stop gardener-node-agent kubelet"
+	}
+	return "if [ -f " + marker + " ]; then systemctl start gardener-node-agent kubelet && rm -f " + marker + "; fi"
+}
+
+// latencyCommand returns the command for adding the given latency to all egress traffic of the machine. The latency is
+// removed if it is zero.
+func latencyCommand(latency time.Duration) string {
+	if latency <= 0 {
+		return "tc qdisc del dev eth0 root 2>/dev/null || true"
+	}
+	return fmt.Sprintf("tc qdisc replace dev eth0 root netem delay %dms", latency.Milliseconds())
+}
+
+func splitList(value string) []string {
+	var out []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
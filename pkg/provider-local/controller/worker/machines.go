@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/provider-local/controller/infrastructure"
 	"github.com/gardener/gardener/pkg/provider-local/local"
 	machineproviderlocal "github.com/gardener/gardener/pkg/provider-local/machine-provider/local"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // DeployMachineClasses generates and creates the local provider specific machine classes.
@@ -205,7 +206,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				Strategy:                     machineDeploymentStrategy,
 				PoolName:                     pool.Name,
 				Priority:                     pool.Priority,
-				Labels:                       pool.Labels,
+				Labels:                       machineDeploymentLabels(pool.Labels, pool.Zones, zone),
 				Annotations:                  pool.Annotations,
 				Taints:                       pool.Taints,
 				MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
@@ -313,8 +314,17 @@ func (w *workerDelegate) PostReconcileHook(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return w.injectFaults(ctx, podList.Items)
 }
 
 func (w *workerDelegate) PreDeleteHook(_ context.Context) error  { return nil }
 func (w *workerDelegate) PostDeleteHook(_ context.Context) error { return nil }
+
+// machineDeploymentLabels returns the labels for the nodes of a machine deployment. If the pool is distributed over
+// zones, the nodes are labeled with their zone like cloud-controller-managers of other providers do.
+func machineDeploymentLabels(poolLabels map[string]string, poolZones []string, zone string) map[string]string {
+	if len(poolZones) == 0 {
+		return poolLabels
+	}
+	return utils.MergeStringMaps(poolLabels, map[string]string{corev1.LabelTopologyZone: zone})
+}
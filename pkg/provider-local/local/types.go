@@ -31,6 +31,19 @@ const (
 	// 'networking.gardener.cloud/to-istio-ingressgateway=allowed' to istio-ingressgateway pods running in
 	// 'istio-ingress' namespace.
 	LabelNetworkPolicyToIstioIngressGateway = "networking.gardener.cloud/to-istio-ingressgateway"
+
+	// AnnotationFaultZoneOutage is an annotation for Worker resources containing a comma-separated list of zones. All
+	// machines in these zones are simulated to be unavailable, i.e., their kubelets are stopped.
+	AnnotationFaultZoneOutage = "local.provider.extensions.gardener.cloud/fault-zone-outage"
+	// AnnotationFaultNodeNotReady is an annotation for Worker resources containing a comma-separated list of node names.
+	// The kubelets of these nodes are stopped so that the nodes become NotReady.
+	AnnotationFaultNodeNotReady = "local.provider.extensions.gardener.cloud/fault-node-not-ready"
+	// AnnotationFaultAPILatency is an annotation for Worker resources containing a duration (e.g., `500ms`) which is
+	// added to the network traffic of all machines, i.e., requests to the kube-apiserver are slowed down.
+	AnnotationFaultAPILatency = "local.provider.extensions.gardener.cloud/fault-api-latency"
+	// AnnotationFaultDNSResolution is an annotation for DNSRecord resources. If set to `true`, the record is removed from
+	// the local DNS server so that its name cannot be resolved anymore.
+	AnnotationFaultDNSResolution = "local.provider.extensions.gardener.cloud/fault-dns-resolution"
 )
 
 var (
@@ -161,7 +161,32 @@ func (d *localDriver) applyPod(
 
 		local.LabelNetworkPolicyToIstioIngressGateway: v1beta1constants.LabelNetworkPolicyAllowed,
 	}
+
+	var affinity *corev1.Affinity
+	if zone := machineZone(req.MachineClass); zone != "" {
+		// The zone label is used for simulating zone outages, see https://github.com/gardener/gardener/blob/master/docs/extensions/provider-local.md#fault-injection.
+		pod.Labels[corev1.LabelTopologyZone] = zone
+
+		// Prefer scheduling the machine pod to a node in the same zone if the cluster hosting the machine pods spans
+		// multiple zones (e.g., the multi-zone kind cluster).
+		affinity = &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{
+					Weight: 100,
+					Preference: corev1.NodeSelectorTerm{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      corev1.LabelTopologyZone,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{zone},
+						}},
+					},
+				}},
+			},
+		}
+	}
+
 	pod.Spec = corev1.PodSpec{
+		Affinity: affinity,
 		Containers: []corev1.Container{
 			{
 				Name:            MachinePodContainerName,
@@ -289,3 +314,11 @@ func validateProviderSpecAndSecret(machineClass *machinev1alpha1.MachineClass, s
 
 	return providerSpec, nil
 }
+
+// machineZone returns the zone of the machine class. It is empty if the machine class is not bound to a zone.
+func machineZone(machineClass *machinev1alpha1.MachineClass) string {
+	if machineClass.NodeTemplate == nil {
+		return ""
+	}
+	return machineClass.NodeTemplate.Zone
+}
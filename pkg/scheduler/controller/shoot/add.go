@@ -40,8 +40,10 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&gardencorev1beta1.Shoot{}, builder.WithPredicates(
-			r.ShootUnassignedPredicate(),
-			r.ShootSpecChangedPredicate(),
+			predicate.Or(
+				predicate.And(r.ShootUnassignedPredicate(), r.ShootSpecChangedPredicate()),
+				r.ShootRescheduleRequestedPredicate(),
+			),
 			r.ShootIsNotSelfHosted(),
 			predicate.Not(predicateutils.IsDeleting()),
 		)).
@@ -64,6 +66,19 @@ func (r *Reconciler) ShootUnassignedPredicate() predicate.Predicate {
 	})
 }
 
+// ShootRescheduleRequestedPredicate is a predicate that returns true if a shoot is assigned to a seed and annotated
+// for being rescheduled to another seed.
+func (r *Reconciler) ShootRescheduleRequestedPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if shoot, ok := obj.(*gardencorev1beta1.Shoot); ok {
+			return shoot.Spec.SeedName != nil &&
+				ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) == v1beta1constants.DefaultSchedulerName &&
+				shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true"
+		}
+		return false
+	})
+}
+
 // ShootSpecChangedPredicate is a predicate that returns true if the shoot spec was updated.
 func (r *Reconciler) ShootSpecChangedPredicate() predicate.Predicate {
 	return predicate.Funcs{
@@ -7,6 +7,7 @@ package shoot_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -158,6 +159,53 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("#ShootRescheduleRequestedPredicate", func() {
+		var (
+			predicate predicate.Predicate
+			shoot     *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			predicate = reconciler.ShootRescheduleRequestedPredicate()
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"scheduling.gardener.cloud/reschedule": "true"},
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					SeedName: ptr.To("seed"),
+				},
+			}
+		})
+
+		It("should be true when shoot is assigned and annotated for rescheduling", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot})).To(BeTrue())
+			Expect(predicate.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+			Expect(predicate.Generic(event.GenericEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should be false when shoot is not annotated for rescheduling", func() {
+			shoot.Annotations = nil
+
+			Expect(predicate.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should be false when shoot is unassigned", func() {
+			shoot.Spec.SeedName = nil
+
+			Expect(predicate.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should be false when shoot uses a custom scheduler", func() {
+			shoot.Spec.SchedulerName = ptr.To("custom-scheduler")
+
+			Expect(predicate.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot})).To(BeFalse())
+		})
+	})
+
 	Describe("#ShootIsNotSelfHosted", func() {
 		var (
 			predicate predicate.Predicate
@@ -56,6 +56,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if shoot.Spec.SeedName != nil {
+		if shoot.DeletionTimestamp == nil && shoot.Annotations[v1beta1constants.AnnotationSchedulingReschedule] == "true" {
+			return reconcile.Result{}, r.reschedule(ctx, log, shoot)
+		}
 		log.Info("Shoot already scheduled onto seed, nothing left to do", "seed", *shoot.Spec.SeedName)
 		return reconcile.Result{}, nil
 	}
@@ -90,6 +93,51 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{}, nil
 }
 
+// reschedule moves the given shoot to another seed if its current seed is being drained. This triggers the control
+// plane migration of the shoot.
+func (r *Reconciler) reschedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
+	currentSeed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: *shoot.Spec.SeedName}, currentSeed); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed reading seed %s: %w", *shoot.Spec.SeedName, err)
+	}
+
+	if currentSeed.Status.Drain == nil || currentSeed.Status.Drain.Phase != gardencorev1beta1.SeedDrainPhaseDraining {
+		log.Info("Ignoring reschedule request because seed is not being drained", "seed", *shoot.Spec.SeedName)
+		return r.removeRescheduleAnnotation(ctx, shoot)
+	}
+
+	if shoot.Status.SeedName != nil && *shoot.Status.SeedName != *shoot.Spec.SeedName {
+		log.Info("Ignoring reschedule request because shoot is already being migrated", "seed", *shoot.Spec.SeedName)
+		return r.removeRescheduleAnnotation(ctx, shoot)
+	}
+
+	seed, err := r.DetermineSeed(ctx, log, shoot)
+	if err != nil {
+		r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, gardencorev1beta1.EventActionReconcile, "Failed to reschedule Shoot from draining seed %q: %s", currentSeed.Name, err.Error())
+		return fmt.Errorf("failed to determine seed for rescheduling shoot: %w", err)
+	}
+
+	shoot.Spec.SeedName = &seed.Name
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		if !apierrors.IsConflict(err) {
+			r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, gardencorev1beta1.EventActionReconcile, "Failed to reschedule Shoot from draining seed %q: %s", currentSeed.Name, err.Error())
+		}
+		return fmt.Errorf("failed to bind shoot to seed: %w", err)
+	}
+
+	log.Info("Shoot successfully rescheduled to seed", "sourceSeed", currentSeed.Name, "seed", seed.Name)
+	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, gardencorev1beta1.EventActionReconcile, "Rescheduled from draining seed %q to seed %q", currentSeed.Name, seed.Name)
+
+	return r.removeRescheduleAnnotation(ctx, shoot)
+}
+
+func (r *Reconciler) removeRescheduleAnnotation(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingReschedule)
+	delete(shoot.Annotations, v1beta1constants.AnnotationSchedulingRescheduleRequestTime)
+	return r.Client.Patch(ctx, shoot, patch)
+}
+
 func (r *Reconciler) reportFailedScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, err error) {
 	// Conflict errors are likely to occur during scheduling but will be retried by the controller.
 	// Skip reporting the event and updating the status, the error will still be logged.
@@ -186,6 +234,10 @@ func (r *Reconciler) DetermineSeed(
 	if err != nil {
 		return nil, err
 	}
+	filteredSeeds, err = filterSeedsBeingDrained(filteredSeeds)
+	if err != nil {
+		return nil, err
+	}
 	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
 	if err != nil {
 		return nil, err
@@ -393,6 +445,21 @@ func filterSeedsWithDisabledShootReconciliations(seedList []gardencorev1beta1.Se
 	return seedsWithEnabledReconciliations, nil
 }
 
+// filterSeedsBeingDrained filters seeds which are being drained or have been drained.
+func filterSeedsBeingDrained(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	var seedsNotBeingDrained []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if seed.Status.Drain == nil {
+			seedsNotBeingDrained = append(seedsNotBeingDrained, seed)
+		}
+	}
+
+	if len(seedsNotBeingDrained) == 0 {
+		return nil, fmt.Errorf("none of the %d seeds is available for scheduling since all of them are being drained", len(seedList))
+	}
+	return seedsNotBeingDrained, nil
+}
+
 // filterSeedsMatchingDomain filters seeds that can support the shoot's domain configuration.
 // If the shoot uses a default domain, only seeds that have that domain configured in their DNS defaults are selected.
 // If the shoot uses a custom domain, all seeds are accepted.
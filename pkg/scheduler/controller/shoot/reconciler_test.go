@@ -912,6 +912,20 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
+
+		It("should fail because it cannot find a seed cluster due to drain", func() {
+			seed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{
+				Phase: gardencorev1beta1.SeedDrainPhaseDraining,
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("all of them are being drained")))
+			Expect(bestSeed).To(BeNil())
+		})
 	})
 
 	Context("#DetermineBestSeedCandidate", func() {
@@ -13,6 +13,10 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/utils/clock"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -23,6 +27,10 @@ import (
 const (
 	logKeyFlow = "flow"
 	logKeyTask = "task"
+
+	// TracerName is the name of the tracer used for the spans of flow executions. Each flow execution is a span with
+	// a child span for each executed task.
+	TracerName = "github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // ErrorCleaner is called when a task which errored during the previous reconciliation phase completes with success
@@ -229,6 +237,12 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Running.Insert(id)
 
 	go func() {
+		ctx, span := otel.Tracer(TracerName).Start(ctx, string(id), trace.WithAttributes(
+			attribute.String(logKeyFlow, e.flow.name),
+			attribute.String(logKeyTask, string(id)),
+		))
+		defer span.End()
+
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
 		err := node.fn(ctx)
@@ -237,6 +251,8 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 
 		if err != nil {
 			log.Error(err, "Error")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 			err = fmt.Errorf("task %q failed: %w", id, err)
 		} else {
 			log.Info("Succeeded")
@@ -278,10 +294,19 @@ func (e *execution) reportProgress(ctx context.Context) {
 	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+func (e *execution) run(ctx context.Context) (err error) {
 	e.flow.start = e.flow.clock.Now()
 	defer close(e.done)
 
+	ctx, span := otel.Tracer(TracerName).Start(ctx, e.flow.name, trace.WithAttributes(attribute.String(logKeyFlow, e.flow.name)))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -11,6 +11,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
@@ -114,6 +118,41 @@ var _ = Describe("Flow", func() {
 			Expect(causes.Errors).To(ConsistOf(err1, err2))
 		})
 
+		It("should record a span for the flow and child spans for the executed tasks", func() {
+			var (
+				recorder = tracetest.NewSpanRecorder()
+				err1     = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return err1 }, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				f = g.Compile()
+			)
+
+			oldTracerProvider := otel.GetTracerProvider()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+			DeferCleanup(func() { otel.SetTracerProvider(oldTracerProvider) })
+
+			Expect(f.Run(ctx, flow.Opts{})).To(HaveOccurred())
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(3))
+
+			flowSpan := spans[2]
+			Expect(flowSpan.Name()).To(Equal("foo"))
+			Expect(flowSpan.Status().Code).To(Equal(codes.Error))
+
+			Expect(spans[0].Name()).To(Equal("x"))
+			Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+			Expect(spans[1].Name()).To(Equal("y"))
+			Expect(spans[1].Status().Code).To(Equal(codes.Error))
+			for _, span := range spans[:2] {
+				Expect(span.Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+				Expect(span.SpanContext().TraceID()).To(Equal(flowSpan.SpanContext().TraceID()))
+			}
+		})
+
 		It("should not process any function due to a canceled context", func() {
 			var (
 				g = flow.NewGraph("foo")
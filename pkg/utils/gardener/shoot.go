@@ -215,6 +215,11 @@ const (
 	ShootProjectSecretSuffixOldSSHKeypair = v1beta1constants.SecretNameSSHKeyPair + ".old"
 	// ShootProjectSecretSuffixMonitoring is a constant for a shoot project secret with suffix 'monitoring'.
 	ShootProjectSecretSuffixMonitoring = "monitoring"
+	// ShootProjectSecretSuffixDiagnostics is a constant for a shoot project internal secret with suffix 'diagnostics'.
+	ShootProjectSecretSuffixDiagnostics = "diagnostics"
+	// ShootProjectSecretSuffixAlertSilences is a constant for a shoot project internal secret with suffix
+	// 'alert-silences'.
+	ShootProjectSecretSuffixAlertSilences = "alert-silences"
 	// ShootProjectConfigMapSuffixCACluster is a constant for a shoot project secret with suffix 'ca-cluster'.
 	ShootProjectConfigMapSuffixCACluster = "ca-cluster"
 	// ShootProjectConfigMapSuffixCAKubelet is a constant for a shoot project secret with suffix 'ca-kubelet'.
@@ -235,6 +240,8 @@ func GetShootProjectSecretSuffixes() []string {
 func GetShootProjectInternalSecretSuffixes() []string {
 	return []string{
 		ShootProjectSecretSuffixCAClient,
+		ShootProjectSecretSuffixDiagnostics,
+		ShootProjectSecretSuffixAlertSilences,
 	}
 }
 
@@ -806,7 +813,13 @@ func GetShootConditionTypes(workerless bool) []gardencorev1beta1.ConditionType {
 		shootConditionTypes = append(shootConditionTypes, gardencorev1beta1.ShootEveryNodeReady)
 	}
 
-	return append(shootConditionTypes, gardencorev1beta1.ShootSystemComponentsHealthy)
+	shootConditionTypes = append(shootConditionTypes, gardencorev1beta1.ShootSystemComponentsHealthy)
+
+	if !workerless {
+		shootConditionTypes = append(shootConditionTypes, gardencorev1beta1.ShootVPNConnectivity)
+	}
+
+	return shootConditionTypes
 }
 
 // DefaultGVKsForEncryption returns the list of GroupVersionKinds which are encrypted by default.
@@ -365,7 +365,7 @@ var _ = Describe("Shoot", func() {
 
 	Describe("#GetShootProjectInternalSecretSuffixes", func() {
 		It("should return the expected list", func() {
-			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client"))
+			Expect(GetShootProjectInternalSecretSuffixes()).To(ConsistOf("ca-client", "diagnostics", "alert-silences"))
 		})
 	})
 
@@ -1965,6 +1965,7 @@ var _ = Describe("Shoot", func() {
 				gardencorev1beta1.ConditionType("ObservabilityComponentsHealthy"),
 				gardencorev1beta1.ConditionType("EveryNodeReady"),
 				gardencorev1beta1.ConditionType("SystemComponentsHealthy"),
+				gardencorev1beta1.ConditionType("VPNConnectivity"),
 			))
 		})
 
@@ -121,7 +121,7 @@ func computeGardenerData(
 	[]gardencorev1beta1.GardenerResourceData,
 	error,
 ) {
-	secretsToPersist, err := computeSecretsToPersist(ctx, seedClient, controlPlaneNamespace)
+	secretsToPersist, err := ComputeSecretsToPersist(ctx, seedClient, controlPlaneNamespace)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +149,9 @@ func computeGardenerData(
 	return secretsToPersist, nil
 }
 
-func computeSecretsToPersist(
+// ComputeSecretsToPersist returns the data of all secrets in the given control plane namespace which must be persisted
+// in the ShootState.
+func ComputeSecretsToPersist(
 	ctx context.Context,
 	seedClient client.Client,
 	controlPlaneNamespace string,
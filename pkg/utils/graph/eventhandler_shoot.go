@@ -53,6 +53,7 @@ func (g *graph) setupShootWatch(ctx context.Context, informer cache.Informer) er
 				v1beta1helper.GetShootAuditPolicyConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuditPolicyConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
 				v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
 				!apiequality.Semantic.DeepEqual(v1beta1helper.GetShootAuthorizationConfiguration(oldShoot.Spec.Kubernetes.KubeAPIServer), v1beta1helper.GetShootAuthorizationConfiguration(newShoot.Spec.Kubernetes.KubeAPIServer)) ||
+				v1beta1helper.GetShootMonitoringRulesConfigMapName(oldShoot.Spec.Monitoring) != v1beta1helper.GetShootMonitoringRulesConfigMapName(newShoot.Spec.Monitoring) ||
 				!v1beta1helper.ShootDNSProviderCredentialsRefsEqual(oldShoot.Spec.DNS, newShoot.Spec.DNS) ||
 				!v1beta1helper.ResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources) ||
 				v1beta1helper.HasManagedIssuer(oldShoot) != v1beta1helper.HasManagedIssuer(newShoot) ||
@@ -183,6 +184,11 @@ func (g *graph) HandleShootCreateOrUpdate(ctx context.Context, shoot *gardencore
 		}
 	}
 
+	if configMapName := v1beta1helper.GetShootMonitoringRulesConfigMapName(shoot.Spec.Monitoring); len(configMapName) > 0 {
+		configMapVertex := g.getOrCreateVertex(VertexTypeConfigMap, shoot.Namespace, configMapName)
+		g.addEdge(configMapVertex, shootVertex)
+	}
+
 	if shoot.Spec.DNS != nil {
 		for _, provider := range shoot.Spec.DNS.Providers {
 			if provider.CredentialsRef != nil {
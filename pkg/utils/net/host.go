@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package net
+
+import (
+	"net"
+	"slices"
+	"strings"
+)
+
+var (
+	// nonPublicHostSuffixes are suffixes of host names which are resolved to cluster-internal or otherwise non-public
+	// addresses.
+	nonPublicHostSuffixes = []string{".local", ".localhost", ".internal", ".svc", ".cluster.local"}
+	// sharedAddressSpace is the IPv4 shared address space (RFC 6598) which is not covered by net.IP.IsPrivate.
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+)
+
+// IsPublicHost returns whether the given host (an IP address or a host name) refers to a public endpoint, i.e., it is
+// not a loopback, link-local, private or cluster-internal address or host name. Host names can only be checked
+// syntactically, egress traffic to non-public networks must still be prevented by network policies.
+func IsPublicHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return !ip.IsLoopback() &&
+			!ip.IsPrivate() &&
+			!ip.IsLinkLocalUnicast() &&
+			!ip.IsLinkLocalMulticast() &&
+			!ip.IsInterfaceLocalMulticast() &&
+			!ip.IsMulticast() &&
+			!ip.IsUnspecified() &&
+			!sharedAddressSpace.Contains(ip)
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || !strings.Contains(host, ".") {
+		return false
+	}
+
+	return !slices.ContainsFunc(nonPublicHostSuffixes, func(suffix string) bool {
+		return strings.HasSuffix(host, suffix)
+	})
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package net_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/net"
+)
+
+var _ = DescribeTable("#IsPublicHost",
+	func(host string, expected bool) {
+		Expect(IsPublicHost(host)).To(Equal(expected))
+	},
+
+	Entry("public IPv4 address", "1.2.3.4", true),
+	Entry("public IPv6 address", "2001:4860:4860::8888", true),
+	Entry("public host name", "logs.example.com", true),
+	Entry("public host name with trailing dot", "logs.example.com.", true),
+	Entry("loopback IPv4 address", "127.0.0.1", false),
+	Entry("loopback IPv6 address", "::1", false),
+	Entry("private IPv4 address", "10.1.2.3", false),
+	Entry("private IPv6 address", "fd00::1", false),
+	Entry("link-local IPv4 address", "169.254.169.254", false),
+	Entry("link-local IPv6 address", "fe80::1", false),
+	Entry("shared address space", "100.64.0.1", false),
+	Entry("unspecified address", "0.0.0.0", false),
+	Entry("multicast address", "224.0.0.1", false),
+	Entry("localhost", "localhost", false),
+	Entry("localhost in upper case", "LOCALHOST", false),
+	Entry("single label host name", "kube-apiserver", false),
+	Entry("service host name", "kube-apiserver.shoot--foo--bar.svc", false),
+	Entry("cluster-local host name", "kube-apiserver.shoot--foo--bar.svc.cluster.local", false),
+	Entry("internal host name", "metadata.google.internal", false),
+	Entry("localhost subdomain", "foo.localhost", false),
+)
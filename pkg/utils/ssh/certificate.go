@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// PrincipalPrefix is the prefix of the principals of user certificates which are issued for individual users. Nodes
+// allow such principals to log in as the gardener user.
+const PrincipalPrefix = "gardener-user:"
+
+// UserPrincipal returns the principal of user certificates which are issued for the given user. Characters which are
+// not allowed in principals or which would break the sshd configuration are replaced.
+func UserPrincipal(user string) string {
+	if user == "" {
+		user = "unknown"
+	}
+
+	return PrincipalPrefix + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', strings.ContainsRune(".-_@:", r):
+			return r
+		default:
+			return '_'
+		}
+	}, user)
+}
+
+// SignUserCertificate signs the given public key (in authorized_keys format) with the given private key (in PEM format)
+// of an SSH certificate authority. The returned user certificate (in authorized_keys format) is only valid for the
+// given principals in the given time range. If source addresses (CIDRs) are given, the certificate is only accepted
+// for connections from these addresses. It only permits allocating a PTY, i.e., port and agent forwarding are not
+// permitted.
+func SignUserCertificate(caPrivateKey, publicKey []byte, keyID string, principals, sourceAddresses []string, validAfter, validBefore time.Time) ([]byte, error) {
+	signer, err := ssh.ParsePrivateKey(caPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing private key of certificate authority: %w", err)
+	}
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public key: %w", err)
+	}
+
+	if !validBefore.After(validAfter) {
+		return nil, fmt.Errorf("certificate must be valid before %s but is only valid after %s", validBefore, validAfter)
+	}
+
+	var serial [8]byte
+	if _, err := rand.Read(serial[:]); err != nil {
+		return nil, fmt.Errorf("failed generating certificate serial: %w", err)
+	}
+
+	certificate := &ssh.Certificate{
+		Key:             key,
+		Serial:          binary.BigEndian.Uint64(serial[:]),
+		CertType:        ssh.UserCert,
+		KeyId:           keyID,
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(validAfter.Unix()),  // #nosec G115 -- Unix timestamps of valid certificates are positive.
+		ValidBefore:     uint64(validBefore.Unix()), // #nosec G115 -- Unix timestamps of valid certificates are positive.
+		Permissions: ssh.Permissions{
+			Extensions: map[string]string{
+				"permit-pty": "",
+			},
+		},
+	}
+
+	if len(sourceAddresses) > 0 {
+		certificate.CriticalOptions = map[string]string{
+			"source-address": strings.Join(sourceAddresses, ","),
+		}
+	}
+
+	if err := certificate.SignCert(rand.Reader, signer); err != nil {
+		return nil, fmt.Errorf("failed signing certificate: %w", err)
+	}
+
+	return bytes.TrimSpace(ssh.MarshalAuthorizedKey(certificate)), nil
+}
+
+// ParseUserCertificate parses the given user certificate (in authorized_keys format).
+func ParseUserCertificate(data []byte) (*ssh.Certificate, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing certificate: %w", err)
+	}
+
+	certificate, ok := key.(*ssh.Certificate)
+	if !ok || certificate.CertType != ssh.UserCert {
+		return nil, fmt.Errorf("key is not an SSH user certificate")
+	}
+
+	return certificate, nil
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+
+	. "github.com/gardener/gardener/pkg/utils/ssh"
+)
+
+var _ = Describe("Certificate", func() {
+	var (
+		caPrivateKey []byte
+		caPublicKey  ssh.PublicKey
+		publicKey    []byte
+
+		validAfter  time.Time
+		validBefore time.Time
+	)
+
+	BeforeEach(func() {
+		caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		caPrivateKey = pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(caKey)})
+		caPublicKey, err = ssh.NewPublicKey(&caKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		userKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		userPublicKey, err := ssh.NewPublicKey(&userKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		publicKey = ssh.MarshalAuthorizedKey(userPublicKey)
+
+		validAfter = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+		validBefore = validAfter.Add(time.Hour)
+	})
+
+	Describe("#SignUserCertificate", func() {
+		It("should sign a user certificate which is accepted for the given principal and time", func() {
+			data, err := SignUserCertificate(caPrivateKey, publicKey, "foo", []string{"gardener"}, nil, validAfter, validBefore)
+			Expect(err).NotTo(HaveOccurred())
+
+			certificate, err := ParseUserCertificate(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.KeyId).To(Equal("foo"))
+			Expect(certificate.ValidPrincipals).To(ConsistOf("gardener"))
+			Expect(certificate.Permissions.Extensions).To(Equal(map[string]string{"permit-pty": ""}))
+			Expect(certificate.Permissions.CriticalOptions).To(BeEmpty())
+			Expect(certificate.SignatureKey.Marshal()).To(Equal(caPublicKey.Marshal()))
+
+			checker := &ssh.CertChecker{
+				IsUserAuthority: func(auth ssh.PublicKey) bool {
+					return string(auth.Marshal()) == string(caPublicKey.Marshal())
+				},
+				Clock: func() time.Time { return validAfter.Add(time.Minute) },
+			}
+			Expect(checker.CheckCert("gardener", certificate)).To(Succeed())
+			Expect(checker.CheckCert("root", certificate)).To(MatchError(ContainSubstring("not in the set of valid principals")))
+
+			checker.Clock = func() time.Time { return validBefore.Add(time.Minute) }
+			Expect(checker.CheckCert("gardener", certificate)).To(MatchError(ContainSubstring("expired")))
+		})
+
+		It("should restrict the source addresses", func() {
+			data, err := SignUserCertificate(caPrivateKey, publicKey, "foo", []string{"gardener"}, []string{"10.0.0.0/8", "1.2.3.4/32"}, validAfter, validBefore)
+			Expect(err).NotTo(HaveOccurred())
+
+			certificate, err := ParseUserCertificate(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.Permissions.CriticalOptions).To(Equal(map[string]string{"source-address": "10.0.0.0/8,1.2.3.4/32"}))
+		})
+
+		It("should fail for an invalid private key", func() {
+			_, err := SignUserCertificate([]byte("foo"), publicKey, "foo", []string{"gardener"}, nil, validAfter, validBefore)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing private key")))
+		})
+
+		It("should fail for an invalid public key", func() {
+			_, err := SignUserCertificate(caPrivateKey, []byte("foo"), "foo", []string{"gardener"}, nil, validAfter, validBefore)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing public key")))
+		})
+
+		It("should fail for an empty validity period", func() {
+			_, err := SignUserCertificate(caPrivateKey, publicKey, "foo", []string{"gardener"}, nil, validBefore, validAfter)
+			Expect(err).To(MatchError(ContainSubstring("certificate must be valid before")))
+		})
+	})
+
+	Describe("#UserPrincipal", func() {
+		It("should return the principal for the given user", func() {
+			Expect(UserPrincipal("alice@example.com")).To(Equal("gardener-user:alice@example.com"))
+			Expect(UserPrincipal("system:serviceaccount:garden-foo:robot")).To(Equal("gardener-user:system:serviceaccount:garden-foo:robot"))
+		})
+
+		It("should replace characters which are not allowed", func() {
+			Expect(UserPrincipal("Alice Doe, Jr.")).To(Equal("gardener-user:Alice_Doe__Jr."))
+		})
+
+		It("should return a principal for an unknown user", func() {
+			Expect(UserPrincipal("")).To(Equal("gardener-user:unknown"))
+		})
+	})
+
+	Describe("#ParseUserCertificate", func() {
+		It("should fail for a plain public key", func() {
+			_, err := ParseUserCertificate(publicKey)
+			Expect(err).To(MatchError("key is not an SSH user certificate"))
+		})
+	})
+})
@@ -68,6 +68,29 @@ func WithPrivateKeyBytes(key []byte) Option {
 	}
 }
 
+// WithCertificate configures the client to authenticate using the given RSA private key and the SSH user certificate
+// (in authorized_keys format) issued for its public key.
+func WithCertificate(key *rsa.PrivateKey, certificate []byte) Option {
+	return func(opts *Config) error {
+		signer, err := ssh.NewSignerFromKey(key)
+		if err != nil {
+			return err
+		}
+
+		cert, err := ParseUserCertificate(certificate)
+		if err != nil {
+			return err
+		}
+
+		certSigner, err := ssh.NewCertSigner(cert, signer)
+		if err != nil {
+			return err
+		}
+		opts.Auth = append(opts.Auth, ssh.PublicKeys(certSigner))
+		return nil
+	}
+}
+
 // WithProxyConnection configures the client to open the new TCP connection to the remote host via another open SSH
 // connection.
 func WithProxyConnection(conn *Connection) Option {
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationPrefix is the prefix of the annotations which carry the W3C trace context (i.e., the `traceparent` and
+// `tracestate` headers) on objects, e.g. on extension resources.
+const AnnotationPrefix = "tracing.gardener.cloud/"
+
+// Options are the options for setting up tracing.
+type Options struct {
+	// ServiceName is the name of the service emitting the traces.
+	ServiceName string
+	// Endpoint is the address (host:port) of the OTLP gRPC receiver the traces are exported to.
+	Endpoint string
+	// Insecure disables TLS for the connection to the endpoint.
+	Insecure bool
+	// SamplingPercentage is the percentage of traces which are sampled.
+	SamplingPercentage int32
+}
+
+// Setup configures a global tracer provider which exports traces to the OTLP endpoint in the given options, and a
+// global W3C trace context propagator. The returned function flushes the remaining spans and shuts the tracer
+// provider down.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OTLP trace exporter: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", opts.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(float64(opts.SamplingPercentage)/100))),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return tracerProvider.Shutdown, nil
+}
+
+// InjectIntoAnnotations writes the trace context of the given context to the annotations of the given object. It is a
+// no-op if the context does not carry a recording span, i.e., if tracing is disabled.
+func InjectIntoAnnotations(ctx context.Context, obj metav1.Object) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	if len(carrier) == 0 {
+		return
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, len(carrier))
+	}
+	// Remove stale headers of a previous trace context which are not part of the new one (e.g., the `tracestate` header
+	// is omitted if it is empty), otherwise they would be propagated together with the new trace context.
+	for key := range annotations {
+		if header, ok := strings.CutPrefix(key, AnnotationPrefix); ok {
+			if _, found := carrier[header]; !found {
+				delete(annotations, key)
+			}
+		}
+	}
+	for key, value := range carrier {
+		annotations[AnnotationPrefix+key] = value
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// ExtractFromAnnotations returns a context which carries the trace context found in the annotations of the given
+// object as remote parent. The given context is returned unchanged if the object does not carry a trace context.
+func ExtractFromAnnotations(ctx context.Context, obj metav1.Object) context.Context {
+	carrier := propagation.MapCarrier{}
+	for key, value := range obj.GetAnnotations() {
+		if header, ok := strings.CutPrefix(key, AnnotationPrefix); ok {
+			carrier[header] = value
+		}
+	}
+
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx = context.Background()
+		obj *metav1.ObjectMeta
+	)
+
+	BeforeEach(func() {
+		obj = &metav1.ObjectMeta{Name: "foo", Annotations: map[string]string{"foo": "bar"}}
+
+		oldPropagator := otel.GetTextMapPropagator()
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+		DeferCleanup(func() { otel.SetTextMapPropagator(oldPropagator) })
+	})
+
+	Describe("#InjectIntoAnnotations", func() {
+		It("should not add annotations if the context does not carry a span", func() {
+			InjectIntoAnnotations(ctx, obj)
+
+			Expect(obj.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should add the trace context to the annotations", func() {
+			spanCtx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "test")
+			defer span.End()
+
+			InjectIntoAnnotations(spanCtx, obj)
+
+			Expect(obj.Annotations).To(HaveKeyWithValue("foo", "bar"))
+			Expect(obj.Annotations).To(HaveKeyWithValue("tracing.gardener.cloud/traceparent", ContainSubstring(span.SpanContext().TraceID().String())))
+		})
+
+		It("should remove a stale trace state if the new trace context does not have one", func() {
+			traceState, err := trace.ParseTraceState("vendor=value")
+			Expect(err).NotTo(HaveOccurred())
+
+			oldSpanCtx, oldSpan := sdktrace.NewTracerProvider().Tracer("test").Start(trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{1},
+				SpanID:     trace.SpanID{1},
+				TraceFlags: trace.FlagsSampled,
+				TraceState: traceState,
+			})), "test")
+			defer oldSpan.End()
+
+			InjectIntoAnnotations(oldSpanCtx, obj)
+			Expect(obj.Annotations).To(HaveKeyWithValue("tracing.gardener.cloud/tracestate", "vendor=value"))
+
+			spanCtx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "test")
+			defer span.End()
+
+			InjectIntoAnnotations(spanCtx, obj)
+
+			Expect(obj.Annotations).To(HaveKeyWithValue("foo", "bar"))
+			Expect(obj.Annotations).To(HaveKeyWithValue("tracing.gardener.cloud/traceparent", ContainSubstring(span.SpanContext().TraceID().String())))
+			Expect(obj.Annotations).NotTo(HaveKey("tracing.gardener.cloud/tracestate"))
+		})
+	})
+
+	Describe("#ExtractFromAnnotations", func() {
+		It("should return the given context if the object does not carry a trace context", func() {
+			Expect(ExtractFromAnnotations(ctx, obj)).To(Equal(ctx))
+		})
+
+		It("should return a context with the remote span context of the object", func() {
+			spanCtx, span := sdktrace.NewTracerProvider().Tracer("test").Start(ctx, "test")
+			defer span.End()
+			InjectIntoAnnotations(spanCtx, obj)
+
+			remoteSpanContext := trace.SpanContextFromContext(ExtractFromAnnotations(ctx, obj))
+			Expect(remoteSpanContext.IsRemote()).To(BeTrue())
+			Expect(remoteSpanContext.TraceID()).To(Equal(span.SpanContext().TraceID()))
+			Expect(remoteSpanContext.SpanID()).To(Equal(span.SpanContext().SpanID()))
+		})
+	})
+})
@@ -1086,6 +1086,13 @@ func (r *ReferenceManager) ensureShootReferences(ctx context.Context, attributes
 		}
 	}
 
+	oldMonitoringRulesConfigMapName := helper.GetShootMonitoringRulesConfigMapName(oldShoot.Spec.Monitoring)
+	if monitoringRulesConfigMapName := helper.GetShootMonitoringRulesConfigMapName(shoot.Spec.Monitoring); monitoringRulesConfigMapName != "" && monitoringRulesConfigMapName != oldMonitoringRulesConfigMapName && shoot.DeletionTimestamp == nil {
+		if err := r.lookupConfigMap(ctx, shoot.Namespace, monitoringRulesConfigMapName); err != nil {
+			return fmt.Errorf("failed to resolve monitoring rules config map reference: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1884,6 +1884,16 @@ var _ = Describe("resourcereferencemanager", func() {
 					}
 				}, "failed to resolve structured authorization kubeconfig secret reference")
 			})
+
+			Context("monitoring rules config maps", func() {
+				tests("monitoring rules config map", "configmaps", func(shoot *core.Shoot) {
+					shoot.Spec.Monitoring = &core.Monitoring{
+						Rules: &core.MonitoringRules{
+							ConfigMapName: "foo",
+						},
+					}
+				}, "failed to resolve monitoring rules config map reference")
+			})
 		})
 
 		Context("tests for Seed objects", func() {
@@ -57,6 +57,8 @@ const (
 	PluginNameClusterOpenIDConnectPreset = "ClusterOpenIDConnectPreset"
 	// PluginNameOpenIDConnectPreset is the name of the OpenIDConnectPreset admission plugin.
 	PluginNameOpenIDConnectPreset = "OpenIDConnectPreset"
+	// PluginNameShootPolicy is the name of the ShootPolicy admission plugin.
+	PluginNameShootPolicy = "ShootPolicy"
 	// PluginNameShootQuotaValidator is the name of the ShootQuotaValidator admission plugin.
 	PluginNameShootQuotaValidator = "ShootQuotaValidator"
 	// PluginNameShootTolerationRestriction is the name of the ShootTolerationRestriction admission plugin.
@@ -88,6 +90,7 @@ func AllOrderedPluginNames() []string {
 		PluginNameShootDNSRewriting,                 // ShootDNSRewriting
 		PluginNameShootQuotaValidator,               // ShootQuotaValidator
 		PluginNameShootMutator,                      // ShootMutator
+		PluginNameShootPolicy,                       // ShootPolicy
 		PluginNameShootValidator,                    // ShootValidator
 		PluginNameSeedValidator,                     // SeedValidator
 		PluginNameSeedMutator,                       // SeedMutator
@@ -134,6 +137,7 @@ func DefaultOnPlugins() sets.Set[string] {
 		PluginNameShootResourceReservation,        // ShootResourceReservation
 		PluginNameShootQuotaValidator,             // ShootQuotaValidator
 		PluginNameShootMutator,                    // ShootMutator
+		PluginNameShootPolicy,                     // ShootPolicy
 		PluginNameShootValidator,                  // ShootValidator
 		PluginNameShootVPAEnabledByDefault,        // ShootVPAEnabledByDefault
 		PluginNameSeedValidator,                   // SeedValidator
@@ -0,0 +1,334 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/warning"
+
+	gardencoreapi "github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/api/settings/shootpolicy"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingsv1alpha1listers "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// AnnotationKeyPrefixAudit is the prefix of the audit annotations which are added for violations of policies with
+// enforcement action `Audit`. The full key is the prefix followed by `<kind>.<name>` of the policy.
+const AnnotationKeyPrefixAudit = "shootpolicy.admission.gardener.cloud/"
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootPolicy, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootPolicy contains listers and admission handler.
+type ShootPolicy struct {
+	*admission.Handler
+
+	projectLister            gardencorev1beta1listers.ProjectLister
+	shootPolicyLister        settingsv1alpha1listers.ShootPolicyLister
+	clusterShootPolicyLister settingsv1alpha1listers.ClusterShootPolicyLister
+	readyFunc                admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&ShootPolicy{})
+	_ = admissioninitializer.WantsSettingsInformerFactory(&ShootPolicy{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ShootPolicy admission plugin.
+func New() (*ShootPolicy, error) {
+	return &ShootPolicy{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootPolicy) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	projectInformer := f.Core().V1beta1().Projects()
+	s.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetSettingsInformerFactory(f settingsinformers.SharedInformerFactory) {
+	shootPolicyInformer := f.Settings().V1alpha1().ShootPolicies()
+	s.shootPolicyLister = shootPolicyInformer.Lister()
+
+	clusterShootPolicyInformer := f.Settings().V1alpha1().ClusterShootPolicies()
+	s.clusterShootPolicyLister = clusterShootPolicyInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootPolicyInformer.Informer().HasSynced, clusterShootPolicyInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootPolicy) ValidateInitialization() error {
+	if s.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	if s.shootPolicyLister == nil {
+		return errors.New("missing shootpolicy lister")
+	}
+	if s.clusterShootPolicyLister == nil {
+		return errors.New("missing clustershootpolicy lister")
+	}
+	return nil
+}
+
+var (
+	_ admission.MutationInterface   = (*ShootPolicy)(nil)
+	_ admission.ValidationInterface = (*ShootPolicy)(nil)
+)
+
+// Admit applies the mutations of all ShootPolicies and ClusterShootPolicies matching the Shoot. Mutations of policies
+// with enforcement action `Audit` are not applied.
+func (s *ShootPolicy) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := s.waitUntilReady(a); err != nil {
+		return err
+	}
+
+	shoot, oldShoot, err := s.shootsFromAttributes(a)
+	if err != nil || shoot == nil {
+		return err
+	}
+
+	policies, err := s.applicablePolicies(shoot)
+	if err != nil {
+		return err
+	}
+
+	var mutated bool
+	for _, policy := range policies {
+		if policy.EnforcementAction == settingsv1alpha1.ShootPolicyEnforcementActionAudit || !policy.Program.HasMutations() {
+			continue
+		}
+
+		if err := policy.Program.Mutate(shoot, oldShoot); err != nil {
+			return admission.NewForbidden(a, fmt.Errorf("failed applying mutations of %s: %w", policy.Name, err))
+		}
+		mutated = true
+	}
+
+	if !mutated {
+		return nil
+	}
+
+	coreShoot := &core.Shoot{}
+	if err := gardencoreapi.Scheme.Convert(shoot, coreShoot, nil); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not convert v1beta1.Shoot to Shoot: %w", err))
+	}
+	*a.GetObject().(*core.Shoot) = *coreShoot
+
+	return nil
+}
+
+// Validate evaluates the validations of all ShootPolicies and ClusterShootPolicies matching the Shoot. On updates,
+// validations which were already violated by the previous version of the Shoot are not enforced to not block
+// unrelated changes to existing Shoots.
+func (s *ShootPolicy) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := s.waitUntilReady(a); err != nil {
+		return err
+	}
+
+	shoot, oldShoot, err := s.shootsFromAttributes(a)
+	if err != nil || shoot == nil {
+		return err
+	}
+
+	policies, err := s.applicablePolicies(shoot)
+	if err != nil {
+		return err
+	}
+
+	var denied []string
+	for _, policy := range policies {
+		messages, err := policy.Program.Validate(shoot, oldShoot)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("failed evaluating %s: %w", policy.Name, err))
+		}
+
+		if oldShoot != nil && len(messages) > 0 {
+			oldMessages, err := policy.Program.Validate(oldShoot, nil)
+			if err != nil {
+				return apierrors.NewInternalError(fmt.Errorf("failed evaluating %s for previous Shoot: %w", policy.Name, err))
+			}
+			oldViolations := sets.New(oldMessages...)
+			messages = slices.DeleteFunc(messages, oldViolations.Has)
+		}
+
+		if len(messages) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("%s: %s", policy.Name, strings.Join(messages, ", "))
+		switch policy.EnforcementAction {
+		case settingsv1alpha1.ShootPolicyEnforcementActionWarn:
+			warning.AddWarning(ctx, "", message)
+		case settingsv1alpha1.ShootPolicyEnforcementActionAudit:
+			if err := a.AddAnnotation(AnnotationKeyPrefixAudit+strings.ReplaceAll(strings.ToLower(policy.Name), "/", "."), strings.Join(messages, ", ")); err != nil {
+				return apierrors.NewInternalError(fmt.Errorf("failed adding audit annotation for %s: %w", policy.Name, err))
+			}
+		default:
+			denied = append(denied, message)
+		}
+	}
+
+	if len(denied) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("shoot violates policies: %s", strings.Join(denied, "; ")))
+	}
+
+	return nil
+}
+
+func (s *ShootPolicy) waitUntilReady(a admission.Attributes) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+	return nil
+}
+
+// shootsFromAttributes returns the Shoot and the old Shoot (only for updates) in their v1beta1 representation. It
+// returns nil if the request is not relevant for this plugin. The policies are only enforced for the creation of Shoots
+// and for changes of their specification initiated by users, i.e., status updates, other subresources and changes by
+// Gardener components (e.g., during the maintenance of the Shoot) are not affected.
+func (s *ShootPolicy) shootsFromAttributes(a admission.Attributes) (*gardencorev1beta1.Shoot, *gardencorev1beta1.Shoot, error) {
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	if len(a.GetSubresource()) != 0 || a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil, nil, nil
+	}
+
+	if a.GetUserInfo() != nil && isGardenerSystemUser(a.GetUserInfo()) {
+		return nil, nil, nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return nil, nil, apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// Ignore Shoots which are being deleted, the policies must not block the removal of finalizers.
+	if shoot.DeletionTimestamp != nil {
+		return nil, nil, nil
+	}
+
+	v1beta1Shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencoreapi.Scheme.Convert(shoot, v1beta1Shoot, nil); err != nil {
+		return nil, nil, apierrors.NewInternalError(fmt.Errorf("could not convert Shoot to v1beta1.Shoot: %w", err))
+	}
+
+	if a.GetOperation() != admission.Update {
+		return v1beta1Shoot, nil, nil
+	}
+
+	oldShoot, ok := a.GetOldObject().(*core.Shoot)
+	if !ok {
+		return nil, nil, apierrors.NewBadRequest("could not convert old resource into Shoot object")
+	}
+
+	// Ignore updates which do not change the specification, e.g., of labels, annotations or finalizers.
+	if apiequality.Semantic.DeepEqual(shoot.Spec, oldShoot.Spec) {
+		return nil, nil, nil
+	}
+
+	v1beta1OldShoot := &gardencorev1beta1.Shoot{}
+	if err := gardencoreapi.Scheme.Convert(oldShoot, v1beta1OldShoot, nil); err != nil {
+		return nil, nil, apierrors.NewInternalError(fmt.Errorf("could not convert old Shoot to v1beta1.Shoot: %w", err))
+	}
+
+	return v1beta1Shoot, v1beta1OldShoot, nil
+}
+
+// isGardenerSystemUser returns true if the user is a Gardener component, i.e., a gardenlet or a component accessing the
+// garden cluster with a service account in the kube-system namespace (e.g., gardener-controller-manager).
+func isGardenerSystemUser(userInfo user.Info) bool {
+	if userInfo.GetName() == user.APIServerUser {
+		return true
+	}
+
+	for _, group := range userInfo.GetGroups() {
+		if group == v1beta1constants.SeedsGroup || group == v1beta1constants.ShootsGroup ||
+			group == serviceaccount.MakeNamespaceGroupName(metav1.NamespaceSystem) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *ShootPolicy) applicablePolicies(shoot *gardencorev1beta1.Shoot) ([]shootpolicy.Applicable, error) {
+	policies, err := s.shootPolicyLister.ShootPolicies(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not list shootpolicies: %w", err))
+	}
+
+	clusterPolicies, err := s.clusterShootPolicyLister.List(labels.Everything())
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not list clustershootpolicies: %w", err))
+	}
+
+	if len(policies) == 0 && len(clusterPolicies) == 0 {
+		return nil, nil
+	}
+
+	var project *gardencorev1beta1.Project
+	if len(clusterPolicies) > 0 {
+		project, err = admissionutils.ProjectForNamespaceFromLister(s.projectLister, shoot.Namespace)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, apierrors.NewInternalError(fmt.Errorf("could not get project for namespace %q: %w", shoot.Namespace, err))
+		}
+	}
+
+	result, err := shootpolicy.ApplicablePolicies(shoot, project, policies, clusterPolicies)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,349 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package policy_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	settingsinformers "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+)
+
+type annotationRecordingAttributes struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *annotationRecordingAttributes) AddAnnotation(key, value string) error {
+	a.annotations[key] = value
+	return nil
+}
+
+type fakeWarningRecorder struct {
+	warnings []string
+}
+
+func (f *fakeWarningRecorder) AddWarning(_, text string) {
+	f.warnings = append(f.warnings, text)
+}
+
+var _ = Describe("ShootPolicy", func() {
+	var (
+		ctx              context.Context
+		warningRecorder  *fakeWarningRecorder
+		admissionHandler *ShootPolicy
+
+		settingsInformerFactory settingsinformers.SharedInformerFactory
+		coreInformerFactory     gardencoreinformers.SharedInformerFactory
+
+		namespace     = "garden-foo"
+		project       *gardencorev1beta1.Project
+		policy        *settingsv1alpha1.ShootPolicy
+		clusterPolicy *settingsv1alpha1.ClusterShootPolicy
+		shoot         *core.Shoot
+	)
+
+	BeforeEach(func() {
+		warningRecorder = &fakeWarningRecorder{}
+		ctx = warning.WithWarningRecorder(context.Background(), warningRecorder)
+
+		admissionHandler, _ = New()
+		admissionHandler.AssignReadyFunc(func() bool { return true })
+		settingsInformerFactory = settingsinformers.NewSharedInformerFactory(nil, 0)
+		admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+		coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+		admissionHandler.SetCoreInformerFactory(coreInformerFactory)
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"tier": "gold"}},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: &namespace},
+		}
+		policy = &settingsv1alpha1.ShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "purpose", Namespace: namespace},
+			Spec: settingsv1alpha1.ShootPolicySpec{
+				ShootSelector:     &metav1.LabelSelector{},
+				EnforcementAction: ptr.To(settingsv1alpha1.ShootPolicyEnforcementActionDeny),
+				Validations: []settingsv1alpha1.ShootPolicyValidation{{
+					Expression: "object.spec.?purpose.orValue('') != 'infrastructure'",
+					Message:    ptr.To("purpose infrastructure is not allowed"),
+				}},
+			},
+		}
+		clusterPolicy = &settingsv1alpha1.ClusterShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "gold"},
+			Spec: settingsv1alpha1.ClusterShootPolicySpec{
+				ShootPolicySpec: settingsv1alpha1.ShootPolicySpec{
+					ShootSelector:     &metav1.LabelSelector{},
+					EnforcementAction: ptr.To(settingsv1alpha1.ShootPolicyEnforcementActionDeny),
+					Mutations: []settingsv1alpha1.ShootPolicyMutation{{
+						Expression: "has(object.spec.purpose) ? {} : {'spec': {'purpose': 'production'}}",
+					}},
+				},
+				ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+			},
+		}
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+			Spec: core.ShootSpec{
+				Kubernetes: core.Kubernetes{Version: "1.33.0"},
+			},
+		}
+
+		Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+	})
+
+	attributes := func(operation admission.Operation, subresource string, oldShoot *core.Shoot) admission.Attributes {
+		if oldShoot == nil {
+			return admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), subresource, operation, &metav1.CreateOptions{}, false, nil)
+		}
+		return admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), subresource, operation, &metav1.UpdateOptions{}, false, nil)
+	}
+
+	updateAttributesForUser := func(oldShoot *core.Shoot, userInfo user.Info) admission.Attributes {
+		return admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+	}
+
+	var (
+		gardenletUser = &user.DefaultInfo{
+			Name:   v1beta1constants.SeedUserNamePrefix + "seed",
+			Groups: []string{v1beta1constants.SeedsGroup, user.AllAuthenticated},
+		}
+		controllerManagerUser = &user.DefaultInfo{
+			Name:   "system:serviceaccount:kube-system:gardener-controller-manager",
+			Groups: []string{"system:serviceaccounts", "system:serviceaccounts:kube-system", user.AllAuthenticated},
+		}
+		endUser = &user.DefaultInfo{
+			Name:   "foo@example.com",
+			Groups: []string{user.AllAuthenticated},
+		}
+	)
+
+	Describe("#Admit", func() {
+		It("should do nothing if no policies exist", func() {
+			expected := shoot.DeepCopy()
+			Expect(admissionHandler.Admit(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+
+		It("should apply the mutations of matching cluster policies", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+
+			Expect(admissionHandler.Admit(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(PointTo(Equal(core.ShootPurposeProduction)))
+		})
+
+		It("should not apply the mutations of cluster policies not matching the project", func() {
+			clusterPolicy.Spec.ProjectSelector.MatchLabels["tier"] = "silver"
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+
+			Expect(admissionHandler.Admit(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should not apply the mutations of policies with enforcement action Audit", func() {
+			clusterPolicy.Spec.EnforcementAction = ptr.To(settingsv1alpha1.ShootPolicyEnforcementActionAudit)
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+
+			Expect(admissionHandler.Admit(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should ignore subresources", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+
+			Expect(admissionHandler.Admit(ctx, attributes(admission.Create, "status", nil), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should apply the mutations on updates of the specification by users", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+
+			Expect(admissionHandler.Admit(ctx, updateAttributesForUser(oldShoot, endUser), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(PointTo(Equal(core.ShootPurposeProduction)))
+		})
+
+		It("should not apply the mutations on updates which do not change the specification", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			shoot.Annotations = map[string]string{"gardener.cloud/operation": "reconcile"}
+
+			Expect(admissionHandler.Admit(ctx, updateAttributesForUser(oldShoot, endUser), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should not apply the mutations on updates by gardenlets", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+
+			Expect(admissionHandler.Admit(ctx, updateAttributesForUser(oldShoot, gardenletUser), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should not apply the mutations on maintenance updates by gardener-controller-manager", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+
+			Expect(admissionHandler.Admit(ctx, updateAttributesForUser(oldShoot, controllerManagerUser), nil)).To(Succeed())
+			Expect(shoot.Spec.Purpose).To(BeNil())
+		})
+
+		It("should forbid the request if a mutation cannot be evaluated", func() {
+			clusterPolicy.Spec.Mutations[0].Expression = "{'spec': {'purpose': object.spec.foo}}"
+			Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPolicies().Informer().GetStore().Add(clusterPolicy)).To(Succeed())
+
+			err := admissionHandler.Admit(ctx, attributes(admission.Create, "", nil), nil)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("failed applying mutations of ClusterShootPolicy/gold")))
+		})
+	})
+
+	Describe("#Validate", func() {
+		BeforeEach(func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeInfrastructure)
+		})
+
+		It("should allow Shoots satisfying all policies", func() {
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+
+			Expect(admissionHandler.Validate(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+		})
+
+		It("should deny Shoots violating a policy with enforcement action Deny", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+
+			err := admissionHandler.Validate(ctx, attributes(admission.Create, "", nil), nil)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("ShootPolicy/purpose: purpose infrastructure is not allowed")))
+		})
+
+		It("should ignore policies in other namespaces", func() {
+			policy.Namespace = "garden-bar"
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+
+			Expect(admissionHandler.Validate(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+		})
+
+		It("should ignore policies whose shoot selector does not match", func() {
+			policy.Spec.ShootSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+
+			Expect(admissionHandler.Validate(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+		})
+
+		It("should return a warning for policies with enforcement action Warn", func() {
+			policy.Spec.EnforcementAction = ptr.To(settingsv1alpha1.ShootPolicyEnforcementActionWarn)
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+
+			Expect(admissionHandler.Validate(ctx, attributes(admission.Create, "", nil), nil)).To(Succeed())
+			Expect(warningRecorder.warnings).To(ConsistOf("ShootPolicy/purpose: purpose infrastructure is not allowed"))
+		})
+
+		It("should add an audit annotation for policies with enforcement action Audit", func() {
+			policy.Spec.EnforcementAction = ptr.To(settingsv1alpha1.ShootPolicyEnforcementActionAudit)
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+
+			attrs := &annotationRecordingAttributes{Attributes: attributes(admission.Create, "", nil), annotations: map[string]string{}}
+			Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+			Expect(warningRecorder.warnings).To(BeEmpty())
+			Expect(attrs.annotations).To(HaveKeyWithValue(
+				"shootpolicy.admission.gardener.cloud/shootpolicy.purpose", "purpose infrastructure is not allowed",
+			))
+		})
+
+		It("should not enforce validations which were already violated by the old Shoot", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+
+			Expect(admissionHandler.Validate(ctx, attributes(admission.Update, "", oldShoot), nil)).To(Succeed())
+		})
+
+		It("should enforce validations which were not violated by the old Shoot", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+
+			Expect(apierrors.IsForbidden(admissionHandler.Validate(ctx, attributes(admission.Update, "", oldShoot), nil))).To(BeTrue())
+		})
+
+		It("should ignore updates which do not change the specification", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+			shoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+			// Policies added after the creation of the Shoot may match on labels which are changed later on.
+			policy.Spec.Validations[0].Expression = "object.metadata.?labels.foo.orValue('') != 'bar'"
+			shoot.Labels = map[string]string{"foo": "bar"}
+
+			Expect(admissionHandler.Validate(ctx, updateAttributesForUser(oldShoot, endUser), nil)).To(Succeed())
+		})
+
+		It("should ignore status updates", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+
+			Expect(admissionHandler.Validate(ctx, attributes(admission.Update, "status", oldShoot), nil)).To(Succeed())
+		})
+
+		It("should ignore updates by gardenlets", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+
+			Expect(admissionHandler.Validate(ctx, updateAttributesForUser(oldShoot, gardenletUser), nil)).To(Succeed())
+		})
+
+		It("should ignore maintenance updates by gardener-controller-manager", func() {
+			policy.Spec.Validations[0].Expression = "object.spec.kubernetes.version != '1.33.1'"
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+
+			Expect(admissionHandler.Validate(ctx, updateAttributesForUser(oldShoot, controllerManagerUser), nil)).To(Succeed())
+			Expect(apierrors.IsForbidden(admissionHandler.Validate(ctx, updateAttributesForUser(oldShoot, endUser), nil))).To(BeTrue())
+		})
+
+		It("should ignore Shoots in deletion", func() {
+			Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(policy)).To(Succeed())
+			oldShoot := shoot.DeepCopy()
+			oldShoot.Spec.Purpose = ptr.To(core.ShootPurposeEvaluation)
+			shoot.DeletionTimestamp = &metav1.Time{}
+
+			Expect(admissionHandler.Validate(ctx, attributes(admission.Update, "", oldShoot), nil)).To(Succeed())
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the listers are missing", func() {
+			plugin, _ := New()
+			Expect(plugin.ValidateInitialization()).To(MatchError("missing project lister"))
+		})
+
+		It("should not return an error if all listers are set", func() {
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
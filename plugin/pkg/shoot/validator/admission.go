@@ -250,6 +250,10 @@ func (v *ValidateShoot) Validate(ctx context.Context, a admission.Attributes, _
 		}
 	}
 
+	if err := validateCloneSource(a, shoot, oldShoot); err != nil {
+		return err
+	}
+
 	cloudProfileSpec, err := gardenerutils.GetCloudProfileSpec(v.cloudProfileLister, v.namespacedCloudProfileLister, shoot)
 	if err != nil {
 		return apierrors.NewInternalError(fmt.Errorf("could not find referenced cloud profile: %+v", err.Error()))
@@ -460,6 +464,10 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", c.shoot.Name, c.seed.Name))
 		}
 
+		if c.seed.Status.Drain != nil {
+			return admission.NewForbidden(a, fmt.Errorf("cannot schedule shoot '%s' on seed '%s' that is being drained", c.shoot.Name, c.seed.Name))
+		}
+
 		var seedTaints []core.SeedTaint
 		if c.seed.Spec.Taints != nil {
 			for _, taint := range c.seed.Spec.Taints {
@@ -598,6 +606,30 @@ func getNumberOfShootsOnSeed(shootLister gardencorev1beta1listers.ShootLister, s
 	return int64(seedUsage[seedName]), nil
 }
 
+// validateCloneSource ensures that the clone source annotation, which instructs gardenlet to restore the etcd backup of
+// another Shoot, can only be set by the gardener-apiserver when the 'shoots/clone' subresource is requested.
+func validateCloneSource(a admission.Attributes, shoot, oldShoot *core.Shoot) error {
+	source, ok := shoot.Annotations[v1beta1constants.AnnotationShootCloneSource]
+	if !ok {
+		return nil
+	}
+
+	switch a.GetOperation() {
+	case admission.Create:
+		if slices.Equal(a.GetUserInfo().GetExtra()[v1beta1constants.UserExtraShootCloneSource], []string{source}) {
+			return nil
+		}
+	case admission.Update:
+		if oldSource, ok := oldShoot.Annotations[v1beta1constants.AnnotationShootCloneSource]; ok && oldSource == source {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	return admission.NewForbidden(a, fmt.Errorf("annotation %q can only be set via the 'shoots/clone' subresource", v1beta1constants.AnnotationShootCloneSource))
+}
+
 func authorize(ctx context.Context, a admission.Attributes, auth authorizer.Authorizer, operation string) error {
 	var (
 		userInfo  = a.GetUserInfo()
@@ -491,6 +491,63 @@ var _ = Describe("validator", func() {
 					Expect(err.Error()).To(ContainSubstring("name must not exceed"))
 				})
 			})
+
+			Context("with clone source annotation", func() {
+				BeforeEach(func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/clone-source", "source")
+				})
+
+				It("should admit Shoot resources created via the clone subresource", func() {
+					authorizeAttributes.Name = shoot.Name
+
+					cloneUserInfo := &user.DefaultInfo{Name: "foo", Extra: map[string][]string{"shoot.gardener.cloud/clone-source": {"source"}}}
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, cloneUserInfo)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should reject Shoot resources not created via the clone subresource", func() {
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+					err := admissionHandler.Validate(ctx, attrs, nil)
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring(`annotation "shoot.gardener.cloud/clone-source" can only be set via the 'shoots/clone' subresource`)))
+				})
+
+				It("should reject Shoot resources created via the clone subresource for another source", func() {
+					cloneUserInfo := &user.DefaultInfo{Name: "foo", Extra: map[string][]string{"shoot.gardener.cloud/clone-source": {"other"}}}
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, cloneUserInfo)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should reject adding the annotation to existing Shoot resources", func() {
+					oldShoot := shoot.DeepCopy()
+					delete(oldShoot.Annotations, "shoot.gardener.cloud/clone-source")
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should reject changing the annotation of existing Shoot resources", func() {
+					oldShoot := shoot.DeepCopy()
+					metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, "shoot.gardener.cloud/clone-source", "other")
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(BeForbiddenError())
+				})
+
+				It("should admit updates of Shoot resources keeping the annotation", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Labels = map[string]string{"foo": "bar"}
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+			})
 		})
 
 		Context("hibernation checks", func() {
@@ -5852,6 +5909,16 @@ var _ = Describe("validator", func() {
 					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is already marked for deletion", shoot.Name, newSeedName))
 				})
 
+				It("should reject update of binding if target seed is being drained", func() {
+					newSeed.Status.Drain = &gardencorev1beta1.SeedDrainStatus{Phase: gardencorev1beta1.SeedDrainPhaseDraining}
+
+					attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Validate(context.TODO(), attrs, nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("cannot schedule shoot '%s' on seed '%s' that is being drained", shoot.Name, newSeedName))
+				})
+
 				It("should reject update of binding, because target Seed doesn't have configuration for backup", func() {
 					newSeed.Spec.Backup = nil
 
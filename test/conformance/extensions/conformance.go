@@ -0,0 +1,620 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// CheckReconcile verifies that a new object is reconciled successfully, i.e. the last operation succeeds, a
+	// finalizer is added, the operation annotation is removed and the observed generation is updated.
+	CheckReconcile = "reconcile"
+	// CheckIdempotentReconcile verifies that reconciling an object again succeeds without changing its status.
+	CheckIdempotentReconcile = "idempotent-reconcile"
+	// CheckOperationAnnotation verifies that spec changes are only reconciled if the `gardener.cloud/operation`
+	// annotation is set.
+	CheckOperationAnnotation = "operation-annotation"
+	// CheckMigrateRestore verifies that an object can be migrated and restored from its state.
+	CheckMigrateRestore = "migrate-restore"
+	// CheckDelete verifies that the finalizer of a deleted object is removed.
+	CheckDelete = "delete"
+	// CheckForceDelete verifies that an object of a shoot which is deleted forcefully is deleted.
+	CheckForceDelete = "force-delete"
+	// CheckErrorPrefix is the prefix of the checks verifying the last error of the error cases of an extension kind.
+	CheckErrorPrefix = "error/"
+
+	objectName = "conformance"
+)
+
+// errSkipped is returned by checks which are not applicable.
+type errSkipped struct {
+	reason string
+}
+
+func (e *errSkipped) Error() string {
+	return e.reason
+}
+
+// Runner runs the conformance checks against an extension which reconciles the objects of the extension kinds in the
+// cluster the client points to.
+type Runner struct {
+	// Client is the client for the cluster the extension runs against.
+	Client client.Client
+	// Log is the logger.
+	Log logr.Logger
+	// Timeout is the timeout for a single operation of the extension. Defaults to 2 minutes.
+	Timeout time.Duration
+	// PollInterval is the interval for checking the status of objects. Defaults to 1 second.
+	PollInterval time.Duration
+	// QuietPeriod is the period for which objects without operation annotation must not be reconciled. Defaults to 10
+	// seconds.
+	QuietPeriod time.Duration
+	// IgnoreOperationAnnotation specifies whether the extension was started with `--ignore-operation-annotation`.
+	// In this case, objects are created without the operation annotation and the operation-annotation check is skipped.
+	IgnoreOperationAnnotation bool
+	// NamespacePrefix is the prefix for the shoot namespaces created for the checks. Defaults to `shoot--conformance--`.
+	NamespacePrefix string
+}
+
+// Run runs all conformance checks for the given extension kinds and returns the report.
+func (r *Runner) Run(ctx context.Context, kinds ...Kind) *Report {
+	r.setDefaults()
+
+	report := &Report{}
+	for _, kind := range kinds {
+		checks := []struct {
+			name string
+			fn   func(context.Context, Kind, string) error
+		}{
+			{CheckReconcile, r.checkReconcile},
+			{CheckIdempotentReconcile, r.checkIdempotentReconcile},
+			{CheckOperationAnnotation, r.checkOperationAnnotation},
+			{CheckMigrateRestore, r.checkMigrateRestore},
+			{CheckDelete, r.checkDelete},
+			{CheckForceDelete, r.checkForceDelete},
+		}
+
+		for _, errorCase := range kind.ErrorCases {
+			checks = append(checks, struct {
+				name string
+				fn   func(context.Context, Kind, string) error
+			}{CheckErrorPrefix + errorCase.Name, func(ctx context.Context, kind Kind, namespace string) error {
+				return r.checkErrorCase(ctx, kind, namespace, errorCase)
+			}})
+		}
+
+		for i, check := range checks {
+			log := r.Log.WithValues("kind", kind.Name, "check", check.name)
+			log.Info("Running check")
+
+			var (
+				start     = time.Now()
+				namespace = fmt.Sprintf("%s%s-%d", r.NamespacePrefix, strings.ToLower(kind.Name), i)
+				err       = check.fn(ctx, kind, namespace)
+				result    = Result{Kind: kind.Name, Check: check.name, Status: ResultStatusPassed, Duration: time.Since(start)}
+				skipped   *errSkipped
+			)
+
+			switch {
+			case errors.As(err, &skipped):
+				result.Status, result.Message = ResultStatusSkipped, skipped.reason
+			case err != nil:
+				result.Status, result.Message = ResultStatusFailed, err.Error()
+			}
+
+			log.Info("Finished check", "status", result.Status, "message", result.Message)
+			report.Add(result)
+		}
+	}
+
+	return report
+}
+
+func (r *Runner) setDefaults() {
+	if r.Timeout == 0 {
+		r.Timeout = 2 * time.Minute
+	}
+	if r.PollInterval == 0 {
+		r.PollInterval = time.Second
+	}
+	if r.QuietPeriod == 0 {
+		r.QuietPeriod = 10 * time.Second
+	}
+	if r.NamespacePrefix == "" {
+		r.NamespacePrefix = v1beta1constants.TechnicalIDPrefix + "conformance--"
+	}
+}
+
+func (r *Runner) checkReconcile(ctx context.Context, kind Kind, namespace string) error {
+	obj, err := r.setup(ctx, kind, namespace, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.cleanup(ctx, obj)
+
+	_, err = r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeCreate, nil)
+	return err
+}
+
+func (r *Runner) checkIdempotentReconcile(ctx context.Context, kind Kind, namespace string) error {
+	obj, err := r.setup(ctx, kind, namespace, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.cleanup(ctx, obj)
+
+	lastOperation, err := r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeCreate, nil)
+	if err != nil {
+		return err
+	}
+	status := comparableStatus(obj)
+
+	for range 2 {
+		if err := r.annotate(ctx, obj, v1beta1constants.GardenerOperationReconcile, lastOperation); err != nil {
+			return err
+		}
+		if lastOperation, err = r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeReconcile, lastOperation); err != nil {
+			return err
+		}
+		if newStatus := comparableStatus(obj); newStatus != status {
+			return fmt.Errorf("status changed when reconciling again without changes:\n%s\nto:\n%s", status, newStatus)
+		}
+	}
+
+	return nil
+}
+
+func (r *Runner) checkOperationAnnotation(ctx context.Context, kind Kind, namespace string) error {
+	if r.IgnoreOperationAnnotation {
+		return &errSkipped{reason: "extension ignores the operation annotation"}
+	}
+	if kind.UpdateSpec == nil {
+		return &errSkipped{reason: "kind does not define a spec update"}
+	}
+
+	obj, err := r.setup(ctx, kind, namespace, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.cleanup(ctx, obj)
+
+	lastOperation, err := r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeCreate, nil)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	kind.UpdateSpec(obj)
+	if err := r.Client.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed updating spec: %w", err)
+	}
+
+	quietCtx, cancel := context.WithTimeout(ctx, r.QuietPeriod)
+	defer cancel()
+	if err := wait.PollUntilContextCancel(quietCtx, r.PollInterval, true, func(ctx context.Context) (bool, error) {
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return false, err
+		}
+		if status := obj.GetExtensionStatus(); status.GetObservedGeneration() == obj.GetGeneration() || !status.GetLastOperation().LastUpdateTime.Equal(&lastOperation.LastUpdateTime) {
+			return false, errors.New("object was reconciled after a spec change without operation annotation")
+		}
+		return false, nil
+	}); err != nil && !wait.Interrupted(err) {
+		return err
+	}
+
+	if err := r.annotate(ctx, obj, v1beta1constants.GardenerOperationReconcile, lastOperation); err != nil {
+		return err
+	}
+	_, err = r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeReconcile, lastOperation)
+	return err
+}
+
+func (r *Runner) checkMigrateRestore(ctx context.Context, kind Kind, namespace string) error {
+	if kind.SkipMigration {
+		return &errSkipped{reason: "kind does not support migration"}
+	}
+
+	obj, err := r.setup(ctx, kind, namespace, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.cleanup(ctx, obj)
+
+	lastOperation, err := r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeCreate, nil)
+	if err != nil {
+		return err
+	}
+	finalizers := obj.GetFinalizers()
+
+	if err := r.annotate(ctx, obj, v1beta1constants.GardenerOperationMigrate, lastOperation); err != nil {
+		return err
+	}
+	if _, err := r.waitForLastOperation(ctx, obj, gardencorev1beta1.LastOperationTypeMigrate, lastOperation, func(obj extensionsv1alpha1.Object) error {
+		if len(obj.GetFinalizers()) > 0 {
+			return fmt.Errorf("finalizers %v were not removed during migration", obj.GetFinalizers())
+		}
+		return checkOperationAnnotationRemoved(obj)
+	}); err != nil {
+		return err
+	}
+
+	// The object is deleted in the source seed and re-created with its state in the destination seed, see
+	// https://gardener.cloud/docs/gardener/extensions/migration/.
+	state := obj.GetExtensionStatus().GetState()
+	if err := r.Client.Delete(ctx, obj); err != nil {
+		return fmt.Errorf("failed deleting migrated object: %w", err)
+	}
+	if err := r.waitForDeletion(ctx, obj); err != nil {
+		return fmt.Errorf("migrated object was not deleted: %w", err)
+	}
+
+	restored := kind.NewObject(obj.GetNamespace(), obj.GetName())
+	restored.SetAnnotations(map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationWaitForState})
+	if err := r.Client.Create(ctx, restored); err != nil {
+		return fmt.Errorf("failed re-creating object: %w", err)
+	}
+
+	if state != nil {
+		patch := client.MergeFrom(restored.DeepCopyObject().(client.Object))
+		restored.GetExtensionStatus().SetState(state)
+		if err := r.Client.Status().Patch(ctx, restored, patch); err != nil {
+			return fmt.Errorf("failed restoring state: %w", err)
+		}
+	}
+
+	if err := r.annotate(ctx, restored, v1beta1constants.GardenerOperationRestore, nil); err != nil {
+		return err
+	}
+	if _, err := r.waitForLastOperation(ctx, restored, gardencorev1beta1.LastOperationTypeRestore, nil, func(obj extensionsv1alpha1.Object) error {
+		for _, finalizer := range finalizers {
+			if !slices.Contains(obj.GetFinalizers(), finalizer) {
+				return fmt.Errorf("finalizer %q was not added during restoration", finalizer)
+			}
+		}
+		return checkOperationAnnotationRemoved(obj)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Runner) checkDelete(ctx context.Context, kind Kind, namespace string) error {
+	obj, err := r.setup(ctx, kind, namespace, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.cleanup(ctx, obj)
+
+	if _, err := r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeCreate, nil); err != nil {
+		return err
+	}
+
+	return r.deleteAndWait(ctx, obj)
+}
+
+func (r *Runner) checkForceDelete(ctx context.Context, kind Kind, namespace string) error {
+	if kind.ClusterScoped {
+		return &errSkipped{reason: "kind is not related to a shoot"}
+	}
+
+	obj, err := r.setup(ctx, kind, namespace, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer r.cleanup(ctx, obj)
+
+	if _, err := r.waitForSucceeded(ctx, obj, gardencorev1beta1.LastOperationTypeCreate, nil); err != nil {
+		return err
+	}
+
+	if err := r.updateCluster(ctx, kind, namespace, func(shoot *gardencorev1beta1.Shoot) {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationConfirmationForceDeletion, "true")
+		shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	}); err != nil {
+		return err
+	}
+
+	return r.deleteAndWait(ctx, obj)
+}
+
+func (r *Runner) checkErrorCase(ctx context.Context, kind Kind, namespace string, errorCase ErrorCase) error {
+	obj, err := r.setup(ctx, kind, namespace, errorCase.MutateShoot, errorCase.MutateObject)
+	if err != nil {
+		return err
+	}
+	defer r.cleanup(ctx, obj)
+
+	return r.poll(ctx, obj, func(obj extensionsv1alpha1.Object) (bool, error) {
+		lastOperation, lastError := obj.GetExtensionStatus().GetLastOperation(), obj.GetExtensionStatus().GetLastError()
+		if lastOperation == nil || (lastOperation.State != gardencorev1beta1.LastOperationStateError && lastOperation.State != gardencorev1beta1.LastOperationStateFailed) {
+			if lastOperation != nil && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
+				return false, errors.New("last operation succeeded although an error was expected")
+			}
+			return false, nil
+		}
+
+		if lastError == nil {
+			return false, errors.New("last operation is erroneous but last error is not set")
+		}
+		for _, code := range errorCase.ExpectedCodes {
+			if !v1beta1helper.HasErrorCode([]gardencorev1beta1.LastError{*lastError}, code) {
+				return false, fmt.Errorf("last error %q does not contain expected error code %s but %v", lastError.Description, code, lastError.Codes)
+			}
+		}
+		return true, nil
+	})
+}
+
+// setup creates the shoot namespace, the `Cluster` resource, the prerequisites and the object of the given kind.
+func (r *Runner) setup(
+	ctx context.Context,
+	kind Kind,
+	namespace string,
+	mutateShoot func(*gardencorev1beta1.Shoot),
+	mutateObject func(extensionsv1alpha1.Object),
+) (
+	extensionsv1alpha1.Object,
+	error,
+) {
+	if err := r.Client.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   namespace,
+		Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot},
+	}}); err != nil {
+		return nil, fmt.Errorf("failed creating namespace: %w", err)
+	}
+
+	objectNamespace := namespace
+	if kind.ClusterScoped {
+		objectNamespace = ""
+	} else {
+		shoot := newShoot(kind, namespace)
+		if mutateShoot != nil {
+			mutateShoot(shoot)
+		}
+
+		cluster, err := LocalCluster(namespace, shoot)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.Client.Create(ctx, cluster); err != nil {
+			return nil, fmt.Errorf("failed creating cluster: %w", err)
+		}
+	}
+
+	if kind.Prepare != nil {
+		if err := kind.Prepare(ctx, r.Client, namespace); err != nil {
+			return nil, fmt.Errorf("failed preparing prerequisites: %w", err)
+		}
+	}
+
+	name := objectName
+	if kind.ClusterScoped {
+		// Cluster-scoped objects are named like BackupEntries, i.e. `<shoot namespace>--<shoot uid>`, to keep them
+		// unique across checks and to relate them to the shoot namespace.
+		name = namespace + "--" + objectName
+	}
+
+	obj := kind.NewObject(objectNamespace, name)
+	if mutateObject != nil {
+		mutateObject(obj)
+	}
+	if !r.IgnoreOperationAnnotation {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+		obj.SetAnnotations(annotations)
+	}
+
+	if err := r.Client.Create(ctx, obj); err != nil {
+		return nil, fmt.Errorf("failed creating %s: %w", kind.Name, err)
+	}
+
+	return obj, nil
+}
+
+func newShoot(kind Kind, namespace string) *gardencorev1beta1.Shoot {
+	if kind.NewShoot != nil {
+		return kind.NewShoot(namespace)
+	}
+	return LocalShoot(namespace)
+}
+
+func (r *Runner) updateCluster(ctx context.Context, kind Kind, namespace string, mutateShoot func(*gardencorev1beta1.Shoot)) error {
+	shoot := newShoot(kind, namespace)
+	mutateShoot(shoot)
+
+	desired, err := LocalCluster(namespace, shoot)
+	if err != nil {
+		return err
+	}
+
+	cluster := &extensionsv1alpha1.Cluster{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: namespace}, cluster); err != nil {
+		return fmt.Errorf("failed reading cluster: %w", err)
+	}
+
+	patch := client.MergeFrom(cluster.DeepCopy())
+	cluster.Spec = desired.Spec
+	if err := r.Client.Patch(ctx, cluster, patch); err != nil {
+		return fmt.Errorf("failed updating cluster: %w", err)
+	}
+	return nil
+}
+
+// annotate sets the operation annotation on the object. If the last operation of a previous operation is given, the
+// annotation is only set once the current time is at least one second after its update time. This ensures that the
+// next last operation can be distinguished, as timestamps are serialized with a precision of seconds.
+func (r *Runner) annotate(ctx context.Context, obj extensionsv1alpha1.Object, operation string, previous *gardencorev1beta1.LastOperation) error {
+	if previous != nil {
+		time.Sleep(time.Until(previous.LastUpdateTime.Add(time.Second)))
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1beta1constants.GardenerOperation] = operation
+	obj.SetAnnotations(annotations)
+
+	if err := r.Client.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed annotating object with operation %q: %w", operation, err)
+	}
+	return nil
+}
+
+// waitForSucceeded waits until the object was reconciled successfully after the given previous last operation.
+func (r *Runner) waitForSucceeded(ctx context.Context, obj extensionsv1alpha1.Object, operationType gardencorev1beta1.LastOperationType, previous *gardencorev1beta1.LastOperation) (*gardencorev1beta1.LastOperation, error) {
+	return r.waitForLastOperation(ctx, obj, operationType, previous, func(obj extensionsv1alpha1.Object) error {
+		if len(obj.GetFinalizers()) == 0 {
+			return errors.New("no finalizer was added")
+		}
+		if observedGeneration := obj.GetExtensionStatus().GetObservedGeneration(); observedGeneration != obj.GetGeneration() {
+			return fmt.Errorf("observed generation %d does not match generation %d", observedGeneration, obj.GetGeneration())
+		}
+		return checkOperationAnnotationRemoved(obj)
+	})
+}
+
+// waitForLastOperation waits until the last operation of the object succeeded for the given type and was updated
+// after the given previous last operation. Afterwards, the given verification function is called.
+func (r *Runner) waitForLastOperation(
+	ctx context.Context,
+	obj extensionsv1alpha1.Object,
+	operationType gardencorev1beta1.LastOperationType,
+	previous *gardencorev1beta1.LastOperation,
+	verify func(extensionsv1alpha1.Object) error,
+) (
+	*gardencorev1beta1.LastOperation,
+	error,
+) {
+	if err := r.poll(ctx, obj, func(obj extensionsv1alpha1.Object) (bool, error) {
+		lastOperation := obj.GetExtensionStatus().GetLastOperation()
+		if lastOperation == nil || (previous != nil && !lastOperation.LastUpdateTime.After(previous.LastUpdateTime.Time)) {
+			return false, nil
+		}
+		if lastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+			return false, fmt.Errorf("last operation %s failed: %s", lastOperation.Type, lastOperation.Description)
+		}
+		return lastOperation.Type == operationType && lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// The operation annotation is removed after the last operation is reported in some implementations, hence the
+	// verification is retried until the timeout.
+	var verifyErr error
+	if err := r.poll(ctx, obj, func(obj extensionsv1alpha1.Object) (bool, error) {
+		verifyErr = verify(obj)
+		return verifyErr == nil, nil
+	}); err != nil {
+		if verifyErr != nil {
+			return nil, verifyErr
+		}
+		return nil, err
+	}
+
+	return obj.GetExtensionStatus().GetLastOperation(), nil
+}
+
+func (r *Runner) poll(ctx context.Context, obj extensionsv1alpha1.Object, condition func(extensionsv1alpha1.Object) (bool, error)) error {
+	var lastOperation *gardencorev1beta1.LastOperation
+
+	err := wait.PollUntilContextTimeout(ctx, r.PollInterval, r.Timeout, true, func(ctx context.Context) (bool, error) {
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return false, err
+		}
+		lastOperation = obj.GetExtensionStatus().GetLastOperation()
+		return condition(obj)
+	})
+	if wait.Interrupted(err) {
+		if lastOperation != nil {
+			return fmt.Errorf("timed out waiting for %s, last operation is %s in state %s: %s", client.ObjectKeyFromObject(obj), lastOperation.Type, lastOperation.State, lastOperation.Description)
+		}
+		return fmt.Errorf("timed out waiting for %s, last operation is not set", client.ObjectKeyFromObject(obj))
+	}
+	return err
+}
+
+func (r *Runner) deleteAndWait(ctx context.Context, obj extensionsv1alpha1.Object) error {
+	if err := r.Client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting object: %w", err)
+	}
+	return r.waitForDeletion(ctx, obj)
+}
+
+func (r *Runner) waitForDeletion(ctx context.Context, obj extensionsv1alpha1.Object) error {
+	return wait.PollUntilContextTimeout(ctx, r.PollInterval, r.Timeout, true, func(ctx context.Context) (bool, error) {
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
+
+// cleanup deletes the object and removes its finalizers if the extension does not delete it in time, so that failed
+// checks do not leave objects behind.
+func (r *Runner) cleanup(ctx context.Context, obj extensionsv1alpha1.Object) {
+	if err := r.deleteAndWait(ctx, obj); err == nil {
+		return
+	}
+
+	r.Log.Info("Object was not deleted by the extension, removing finalizers", "object", client.ObjectKeyFromObject(obj))
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	obj.SetFinalizers(nil)
+	if err := r.Client.Patch(ctx, obj, patch); client.IgnoreNotFound(err) != nil {
+		r.Log.Error(err, "Failed removing finalizers", "object", client.ObjectKeyFromObject(obj))
+	}
+}
+
+func checkOperationAnnotationRemoved(obj extensionsv1alpha1.Object) error {
+	if operation, ok := obj.GetAnnotations()[v1beta1constants.GardenerOperation]; ok {
+		return fmt.Errorf("operation annotation %q was not removed", operation)
+	}
+	return nil
+}
+
+// comparableStatus returns a representation of the status of the object without the fields which change with every
+// reconciliation.
+func comparableStatus(obj extensionsv1alpha1.Object) string {
+	status := obj.GetExtensionStatus()
+	return fmt.Sprintf("providerStatus=%s state=%s resources=%v", rawString(status.GetProviderStatus()), rawString(status.GetState()), status.GetResources())
+}
+
+func rawString(raw *runtime.RawExtension) string {
+	if raw == nil {
+		return "<nil>"
+	}
+	return string(raw.Raw)
+}
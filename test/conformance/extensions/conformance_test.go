@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	conformance "github.com/gardener/gardener/test/conformance/extensions"
+)
+
+const (
+	// envExtensionBinary is the path to the extension binary to check. The conformance checks are skipped if it is unset.
+	envExtensionBinary = "CONFORMANCE_EXTENSION_BINARY"
+	// envExtensionArgs are the space-separated arguments for the extension binary.
+	envExtensionArgs = "CONFORMANCE_EXTENSION_ARGS"
+	// envKinds are the comma-separated extension kinds to check. Defaults to all kinds supported by provider-local.
+	envKinds = "CONFORMANCE_KINDS"
+	// envIgnoreOperationAnnotation specifies whether the extension ignores the operation annotation.
+	envIgnoreOperationAnnotation = "CONFORMANCE_IGNORE_OPERATION_ANNOTATION"
+	// envCRDPaths are comma-separated paths to additional CRDs the extension requires.
+	envCRDPaths = "CONFORMANCE_CRD_PATHS"
+	// envReportPath is the path of the file the report is written to.
+	envReportPath = "CONFORMANCE_REPORT"
+	// envUseExistingCluster specifies whether the checks run against the cluster of the `KUBECONFIG` instead of a test
+	// environment. Kinds which require a real cluster are only checked in this case.
+	envUseExistingCluster = "USE_EXISTING_CLUSTER"
+)
+
+var _ = Describe("Extension conformance", Ordered, func() {
+	var (
+		ctx    = context.Background()
+		runner *conformance.Runner
+
+		useExistingCluster bool
+	)
+
+	BeforeAll(func() {
+		binaryPath := os.Getenv(envExtensionBinary)
+		if binaryPath == "" {
+			Skip(envExtensionBinary + " is not set")
+		}
+
+		logf.SetLogger(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, zap.WriteTo(GinkgoWriter)))
+		log := logf.Log.WithName("extensions-conformance")
+
+		By("Start test environment")
+		useExistingCluster, _ = strconv.ParseBool(os.Getenv(envUseExistingCluster))
+		testEnv := &envtest.Environment{UseExistingCluster: &useExistingCluster}
+		if !useExistingCluster {
+			crdPaths := []string{
+				filepath.Join("..", "..", "..", "pkg", "component", "extensions", "crds", "assets"),
+				// The Extension kind of provider-local creates ManagedResources.
+				filepath.Join("..", "..", "..", "pkg", "component", "gardener", "resourcemanager", "assets", "crd-resources.gardener.cloud_managedresources.yaml"),
+			}
+			if paths := os.Getenv(envCRDPaths); paths != "" {
+				crdPaths = append(crdPaths, strings.Split(paths, ",")...)
+			}
+
+			testEnv.CRDInstallOptions = envtest.CRDInstallOptions{Paths: crdPaths}
+			testEnv.ErrorIfCRDPathMissing = true
+		}
+
+		restConfig, err := testEnv.Start()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			By("Stop test environment")
+			Expect(testEnv.Stop()).To(Succeed())
+		})
+
+		kubeconfigPath := os.Getenv(clientcmd.RecommendedConfigPathEnvVar)
+		if !useExistingCluster {
+			By("Write kubeconfig for extension")
+			user, err := testEnv.AddUser(envtest.User{Name: "extension", Groups: []string{"system:masters"}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			kubeconfig, err := user.KubeConfig()
+			Expect(err).NotTo(HaveOccurred())
+
+			kubeconfigPath = filepath.Join(GinkgoT().TempDir(), "kubeconfig")
+			Expect(os.WriteFile(kubeconfigPath, kubeconfig, 0600)).To(Succeed())
+		}
+
+		By("Start extension")
+		extension := &conformance.Extension{
+			BinaryPath: binaryPath,
+			Args:       strings.Fields(os.Getenv(envExtensionArgs)),
+			Out:        GinkgoWriter,
+			Err:        GinkgoWriter,
+		}
+		Expect(extension.Start(kubeconfigPath)).To(Succeed())
+		DeferCleanup(func() {
+			By("Stop extension")
+			Expect(extension.Stop()).To(Succeed())
+		})
+
+		testClient, err := client.New(restConfig, client.Options{Scheme: kubernetes.SeedScheme})
+		Expect(err).NotTo(HaveOccurred())
+
+		ignoreOperationAnnotation, _ := strconv.ParseBool(os.Getenv(envIgnoreOperationAnnotation))
+		runner = &conformance.Runner{
+			Client:                    testClient,
+			Log:                       log,
+			IgnoreOperationAnnotation: ignoreOperationAnnotation,
+		}
+
+		DeferCleanup(func() {
+			Expect(extension.Exited()).To(Succeed())
+		})
+	})
+
+	It("should pass all conformance checks", func() {
+		var kinds []conformance.Kind
+		for _, kind := range conformance.LocalKinds() {
+			if names := os.Getenv(envKinds); names != "" && !slices.Contains(strings.Split(names, ","), kind.Name) {
+				continue
+			}
+			if kind.RequiresExistingCluster && !useExistingCluster {
+				GinkgoWriter.Printf("Skipping kind %s because it requires an existing cluster (%s=true)\n", kind.Name, envUseExistingCluster)
+				continue
+			}
+			kinds = append(kinds, kind)
+		}
+		Expect(kinds).NotTo(BeEmpty(), "no kinds selected for conformance checks")
+
+		report := runner.Run(ctx, kinds...)
+
+		Expect(report.Write(GinkgoWriter)).To(Succeed())
+		if reportPath := os.Getenv(envReportPath); reportPath != "" {
+			file, err := os.Create(filepath.Clean(reportPath))
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(file.Close)
+			Expect(report.Write(file)).To(Succeed())
+		}
+
+		Expect(report.Passed()).To(BeTrue(), "conformance checks failed, see report for details")
+	})
+})
+
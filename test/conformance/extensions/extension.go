@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Extension runs an extension binary against the cluster of the given kubeconfig.
+type Extension struct {
+	// BinaryPath is the path to the extension binary.
+	BinaryPath string
+	// Args are the arguments for the extension binary.
+	Args []string
+	// Out and Err are the writers for the output of the extension binary.
+	Out, Err io.Writer
+	// StopTimeout is the timeout for the extension binary to terminate after it was interrupted. Defaults to 30
+	// seconds.
+	StopTimeout time.Duration
+
+	cmd     *exec.Cmd
+	stopped chan error
+}
+
+// Start starts the extension binary. The path to the kubeconfig is passed via the `KUBECONFIG` environment variable.
+func (e *Extension) Start(kubeconfigPath string) error {
+	if e.BinaryPath == "" {
+		return errors.New("path to extension binary must be set")
+	}
+
+	e.cmd = exec.Command(e.BinaryPath, e.Args...) // #nosec G204 -- The binary is provided by the caller on purpose.
+	e.cmd.Env = append(os.Environ(), clientcmd.RecommendedConfigPathEnvVar+"="+kubeconfigPath)
+	e.cmd.Stdout = e.Out
+	e.cmd.Stderr = e.Err
+
+	if err := e.cmd.Start(); err != nil {
+		return fmt.Errorf("failed starting extension binary %s: %w", e.BinaryPath, err)
+	}
+
+	e.stopped = make(chan error, 1)
+	go func() {
+		e.stopped <- e.cmd.Wait()
+	}()
+
+	return nil
+}
+
+// Exited returns an error if the extension binary terminated.
+func (e *Extension) Exited() error {
+	select {
+	case err := <-e.stopped:
+		e.stopped <- err
+		return fmt.Errorf("extension binary terminated: %v", err)
+	default:
+		return nil
+	}
+}
+
+// Stop interrupts the extension binary and waits for it to terminate. The binary is killed if it does not terminate
+// within the stop timeout.
+func (e *Extension) Stop() error {
+	if e.cmd == nil || e.cmd.Process == nil {
+		return nil
+	}
+	if e.StopTimeout == 0 {
+		e.StopTimeout = 30 * time.Second
+	}
+
+	if err := e.cmd.Process.Signal(os.Interrupt); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return fmt.Errorf("failed interrupting extension binary: %w", err)
+	}
+
+	select {
+	case <-e.stopped:
+		return nil
+	case <-time.After(e.StopTimeout):
+		return e.cmd.Process.Kill()
+	}
+}
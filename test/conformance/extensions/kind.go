@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Kind describes how the conformance checks create and modify objects of an extension kind.
+type Kind struct {
+	// Name is the name of the extension kind, e.g. `Infrastructure`.
+	Name string
+	// ClusterScoped specifies whether objects of the extension kind are cluster-scoped (e.g. `BackupBucket`). No
+	// `Cluster` resource is created for cluster-scoped kinds and the force-delete check is skipped.
+	ClusterScoped bool
+	// NewObject returns a new object of the extension kind with the given name in the given namespace. The namespace
+	// is empty for cluster-scoped kinds.
+	NewObject func(namespace, name string) extensionsv1alpha1.Object
+	// NewShoot returns the shoot which is stored in the `Cluster` resource of the given namespace. If unset, the shoot
+	// returned by LocalShoot is used.
+	NewShoot func(namespace string) *gardencorev1beta1.Shoot
+	// Prepare creates the objects the extension kind depends on (e.g. secrets) in the given namespace.
+	Prepare func(ctx context.Context, c client.Client, namespace string) error
+	// UpdateSpec changes the spec of the given object such that its generation is increased. If unset, the check
+	// verifying that changes are only reconciled with the operation annotation is skipped.
+	UpdateSpec func(obj extensionsv1alpha1.Object)
+	// SkipMigration specifies whether the migrate and restore operations are not supported by the extension kind.
+	SkipMigration bool
+	// ErrorCases are objects the extension is expected to fail reconciling with the given error codes.
+	ErrorCases []ErrorCase
+	// RequiresExistingCluster specifies whether the extension kind can only be reconciled in a real cluster (e.g. with
+	// the gardener-resource-manager, machine-controller-manager or load balancers) instead of a test environment which
+	// only runs `kube-apiserver` and `etcd`.
+	RequiresExistingCluster bool
+}
+
+// ErrorCase describes an object the extension is expected to fail reconciling.
+type ErrorCase struct {
+	// Name is the name of the error case.
+	Name string
+	// MutateShoot mutates the shoot stored in the `Cluster` resource.
+	MutateShoot func(shoot *gardencorev1beta1.Shoot)
+	// MutateObject mutates the object before it is created.
+	MutateObject func(obj extensionsv1alpha1.Object)
+	// ExpectedCodes are the error codes the last error of the object must contain.
+	ExpectedCodes []gardencorev1beta1.ErrorCode
+}
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/provider-local/local"
+)
+
+// LocalShoot returns a stand-in for a shoot of provider-local whose control plane resides in the given namespace.
+func LocalShoot(namespace string) *gardencorev1beta1.Shoot {
+	return &gardencorev1beta1.Shoot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+			Kind:       "Shoot",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "conformance",
+			Namespace: "garden-local",
+		},
+		Spec: gardencorev1beta1.ShootSpec{
+			CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: local.Type},
+			Kubernetes:   gardencorev1beta1.Kubernetes{Version: "1.33.0"},
+			Networking: &gardencorev1beta1.Networking{
+				Type:     ptr.To("calico"),
+				Nodes:    ptr.To("10.10.0.0/16"),
+				Pods:     ptr.To("10.3.0.0/16"),
+				Services: ptr.To("10.4.0.0/16"),
+			},
+			Provider: gardencorev1beta1.Provider{Type: local.Type},
+			Region:   "local",
+			SeedName: ptr.To("local"),
+		},
+		Status: gardencorev1beta1.ShootStatus{
+			TechnicalID: namespace,
+		},
+	}
+}
+
+// LocalCluster returns a stand-in for the `Cluster` resource of a provider-local shoot with the given name.
+func LocalCluster(name string, shoot *gardencorev1beta1.Shoot) (*extensionsv1alpha1.Cluster, error) {
+	cloudProfile := &gardencorev1beta1.CloudProfile{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "CloudProfile"},
+		ObjectMeta: metav1.ObjectMeta{Name: local.Type},
+		Spec: gardencorev1beta1.CloudProfileSpec{
+			Type:    local.Type,
+			Regions: []gardencorev1beta1.Region{{Name: "local"}},
+		},
+	}
+
+	seed := &gardencorev1beta1.Seed{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Seed"},
+		ObjectMeta: metav1.ObjectMeta{Name: "local"},
+		Spec: gardencorev1beta1.SeedSpec{
+			Provider: gardencorev1beta1.SeedProvider{Type: local.Type, Region: "local"},
+		},
+	}
+
+	cluster := &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for raw, obj := range map[*runtime.RawExtension]runtime.Object{
+		&cluster.Spec.CloudProfile: cloudProfile,
+		&cluster.Spec.Seed:         seed,
+		&cluster.Spec.Shoot:        shoot,
+	} {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		raw.Raw = data
+	}
+
+	return cluster, nil
+}
+
+// LocalKinds returns the extension kinds of provider-local. Kinds which require a real cluster are marked with
+// RequiresExistingCluster, all others can be checked without any infrastructure besides the test environment.
+func LocalKinds() []Kind {
+	return []Kind{
+		{
+			Name: extensionsv1alpha1.InfrastructureResource,
+			NewObject: func(namespace, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: extensionsv1alpha1.InfrastructureSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						Region:      "local",
+						SecretRef:   corev1.SecretReference{Name: v1beta1constants.SecretNameCloudProvider, Namespace: namespace},
+					},
+				}
+			},
+			Prepare: func(ctx context.Context, c client.Client, namespace string) error {
+				// Without a kubeconfig in the provider secret, provider-local manages the infrastructure resources in
+				// the cluster it runs in.
+				return createCloudProviderSecret(ctx, c, namespace)
+			},
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.Infrastructure).Spec.Region = "local-updated"
+			},
+			ErrorCases: []ErrorCase{{
+				Name: "missing-node-network",
+				MutateShoot: func(shoot *gardencorev1beta1.Shoot) {
+					shoot.Spec.Networking.Nodes = nil
+				},
+			}},
+		},
+		{
+			Name: extensionsv1alpha1.OperatingSystemConfigResource,
+			NewObject: func(namespace, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.OperatingSystemConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						Purpose:     extensionsv1alpha1.OperatingSystemConfigPurposeProvision,
+						Units:       []extensionsv1alpha1.Unit{{Name: "conformance.service", Content: ptr.To("[Unit]\nDescription=conformance")}},
+					},
+				}
+			},
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				osc := obj.(*extensionsv1alpha1.OperatingSystemConfig)
+				osc.Spec.Units[0].Content = ptr.To("[Unit]\nDescription=conformance-updated")
+			},
+			ErrorCases: []ErrorCase{{
+				Name: "missing-file-secret",
+				MutateObject: func(obj extensionsv1alpha1.Object) {
+					obj.(*extensionsv1alpha1.OperatingSystemConfig).Spec.Files = []extensionsv1alpha1.File{{
+						Path:    "/etc/conformance",
+						Content: extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{Name: "missing", DataKey: "data"}},
+					}}
+				},
+			}},
+		},
+		{
+			Name:          extensionsv1alpha1.BackupBucketResource,
+			ClusterScoped: true,
+			NewObject: func(_, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: extensionsv1alpha1.BackupBucketSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						Region:      "local",
+						SecretRef:   backupProviderSecretRef,
+					},
+				}
+			},
+			Prepare: createBackupProviderSecret,
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.BackupBucket).Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","versioning":{"enabled":true}}`)}
+			},
+			// BackupBuckets are not migrated, they are reconciled by the gardenlet of the new seed.
+			SkipMigration: true,
+			ErrorCases: []ErrorCase{{
+				Name: "invalid-provider-config",
+				MutateObject: func(obj extensionsv1alpha1.Object) {
+					obj.(*extensionsv1alpha1.BackupBucket).Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"local.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","immutability":{"retentionType":"object","retentionPeriod":"-1h"}}`)}
+				},
+				ExpectedCodes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem},
+			}},
+		},
+		{
+			Name:          extensionsv1alpha1.BackupEntryResource,
+			ClusterScoped: true,
+			NewObject: func(_, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: extensionsv1alpha1.BackupEntrySpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						BucketName:  "conformance",
+						Region:      "local",
+						SecretRef:   backupProviderSecretRef,
+					},
+				}
+			},
+			Prepare: createBackupProviderSecret,
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.BackupEntry).Spec.Region = "local-updated"
+			},
+		},
+		{
+			Name: extensionsv1alpha1.ExtensionResource,
+			NewObject: func(namespace, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.Extension{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: extensionsv1alpha1.ExtensionSpec{
+						// The local-ext-shoot extension only creates a ManagedResource, hence it does not depend on the
+						// gardener-resource-manager as long as the ManagedResource CRD is installed.
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "local-ext-shoot"},
+					},
+				}
+			},
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.Extension).Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"conformance":"updated"}`)}
+			},
+		},
+		{
+			Name: extensionsv1alpha1.DNSRecordResource,
+			NewObject: func(namespace, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.DNSRecord{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: extensionsv1alpha1.DNSRecordSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						SecretRef:   corev1.SecretReference{Name: v1beta1constants.SecretNameCloudProvider, Namespace: namespace},
+						Name:        "api." + namespace + ".local.gardener.cloud",
+						RecordType:  extensionsv1alpha1.DNSRecordTypeA,
+						Values:      []string{"172.18.255.1"},
+					},
+				}
+			},
+			Prepare: createCloudProviderSecret,
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.DNSRecord).Spec.Values = []string{"172.18.255.2"}
+			},
+			// provider-local manages the records in the BIND server of the local setup.
+			RequiresExistingCluster: true,
+		},
+		{
+			Name: extensionsv1alpha1.ControlPlaneResource,
+			NewObject: func(namespace, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.ControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: extensionsv1alpha1.ControlPlaneSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						Region:      "local",
+						SecretRef:   corev1.SecretReference{Name: v1beta1constants.SecretNameCloudProvider, Namespace: namespace},
+					},
+				}
+			},
+			Prepare: createCloudProviderSecret,
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.ControlPlane).Spec.Region = "local-updated"
+			},
+			// The control plane components are deployed via ManagedResources which must become healthy.
+			RequiresExistingCluster: true,
+		},
+		{
+			Name: extensionsv1alpha1.WorkerResource,
+			NewObject: func(namespace, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: extensionsv1alpha1.WorkerSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						Region:      "local",
+						SecretRef:   corev1.SecretReference{Name: v1beta1constants.SecretNameCloudProvider, Namespace: namespace},
+						// Without worker pools, no machines are created, but the contract is verified nevertheless.
+						Pools: []extensionsv1alpha1.WorkerPool{},
+					},
+				}
+			},
+			Prepare: createCloudProviderSecret,
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.Worker).Spec.Region = "local-updated"
+			},
+			// The worker controller deploys and waits for the machine-controller-manager.
+			RequiresExistingCluster: true,
+		},
+		{
+			Name: extensionsv1alpha1.BastionResource,
+			NewObject: func(namespace, name string) extensionsv1alpha1.Object {
+				return &extensionsv1alpha1.Bastion{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: extensionsv1alpha1.BastionSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: local.Type},
+						UserData:    []byte("#!/bin/bash"),
+						Ingress:     []extensionsv1alpha1.BastionIngressPolicy{{IPBlock: networkingv1.IPBlock{CIDR: "0.0.0.0/0"}}},
+					},
+				}
+			},
+			UpdateSpec: func(obj extensionsv1alpha1.Object) {
+				obj.(*extensionsv1alpha1.Bastion).Spec.Ingress[0].IPBlock.CIDR = "10.0.0.0/8"
+			},
+			// Bastions are not migrated, they are deleted before the shoot is migrated.
+			SkipMigration: true,
+			// The bastion is a pod exposed via a LoadBalancer service which must get an ingress address.
+			RequiresExistingCluster: true,
+		},
+	}
+}
+
+var backupProviderSecretRef = corev1.SecretReference{Name: "backupprovider", Namespace: v1beta1constants.GardenNamespace}
+
+func createCloudProviderSecret(ctx context.Context, c client.Client, namespace string) error {
+	return c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.SecretNameCloudProvider, Namespace: namespace}})
+}
+
+// createBackupProviderSecret creates the backup provider secret which is shared by all BackupBuckets and BackupEntries
+// in the garden namespace. This namespace also contains the secrets generated for BackupBuckets.
+func createBackupProviderSecret(ctx context.Context, c client.Client, _ string) error {
+	if err := c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: backupProviderSecretRef.Namespace}}); client.IgnoreAlreadyExists(err) != nil {
+		return err
+	}
+	return client.IgnoreAlreadyExists(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: backupProviderSecretRef.Name, Namespace: backupProviderSecretRef.Namespace}}))
+}
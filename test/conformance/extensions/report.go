@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// ResultStatus is the status of a conformance check.
+type ResultStatus string
+
+const (
+	// ResultStatusPassed is the status of a check which passed.
+	ResultStatusPassed ResultStatus = "PASSED"
+	// ResultStatusFailed is the status of a check which failed.
+	ResultStatusFailed ResultStatus = "FAILED"
+	// ResultStatusSkipped is the status of a check which was not executed, e.g. because the extension kind does not
+	// support the checked operation.
+	ResultStatusSkipped ResultStatus = "SKIPPED"
+)
+
+// Result is the result of a single conformance check for an extension kind.
+type Result struct {
+	// Kind is the checked extension kind.
+	Kind string
+	// Check is the name of the check.
+	Check string
+	// Status is the status of the check.
+	Status ResultStatus
+	// Message contains the reason of a failed or skipped check.
+	Message string
+	// Duration is the duration of the check.
+	Duration time.Duration
+}
+
+// Report contains the results of a conformance run.
+type Report struct {
+	lock    sync.Mutex
+	results []Result
+}
+
+// Add adds the given result to the report.
+func (r *Report) Add(result Result) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.results = append(r.results, result)
+}
+
+// Results returns the results of the report.
+func (r *Report) Results() []Result {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]Result(nil), r.results...)
+}
+
+// Passed returns true if no check of the report failed.
+func (r *Report) Passed() bool {
+	for _, result := range r.Results() {
+		if result.Status == ResultStatusFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// Write writes the report as a table followed by a summary to the given writer.
+func (r *Report) Write(w io.Writer) error {
+	var (
+		results = r.Results()
+		counts  = map[ResultStatus]int{}
+		tw      = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	)
+
+	fmt.Fprintln(tw, "KIND\tCHECK\tRESULT\tDURATION\tMESSAGE")
+	for _, result := range results {
+		counts[result.Status]++
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", result.Kind, result.Check, result.Status, result.Duration.Round(time.Millisecond), strings.ReplaceAll(result.Message, "\n", " "))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d passed, %d failed, %d skipped\n", counts[ResultStatusPassed], counts[ResultStatusFailed], counts[ResultStatusSkipped])
+	return err
+}
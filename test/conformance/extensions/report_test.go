@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extensions_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	conformance "github.com/gardener/gardener/test/conformance/extensions"
+)
+
+var _ = Describe("Report", func() {
+	var report *conformance.Report
+
+	BeforeEach(func() {
+		report = &conformance.Report{}
+		report.Add(conformance.Result{Kind: "Infrastructure", Check: conformance.CheckReconcile, Status: conformance.ResultStatusPassed, Duration: 1500 * time.Millisecond})
+		report.Add(conformance.Result{Kind: "Infrastructure", Check: conformance.CheckForceDelete, Status: conformance.ResultStatusSkipped, Message: "kind is not related to a shoot"})
+	})
+
+	Describe("#Passed", func() {
+		It("should pass if no check failed", func() {
+			Expect(report.Passed()).To(BeTrue())
+		})
+
+		It("should not pass if a check failed", func() {
+			report.Add(conformance.Result{Kind: "Infrastructure", Check: conformance.CheckDelete, Status: conformance.ResultStatusFailed, Message: "timed out"})
+
+			Expect(report.Passed()).To(BeFalse())
+		})
+	})
+
+	Describe("#Write", func() {
+		It("should write the results and the summary", func() {
+			report.Add(conformance.Result{Kind: "Infrastructure", Check: conformance.CheckDelete, Status: conformance.ResultStatusFailed, Message: "timed out\nwaiting"})
+
+			buf := &bytes.Buffer{}
+			Expect(report.Write(buf)).To(Succeed())
+			Expect(buf.String()).To(Equal(`KIND            CHECK         RESULT   DURATION  MESSAGE
+Infrastructure  reconcile     PASSED   1.5s      
+Infrastructure  force-delete  SKIPPED  0s        kind is not related to a shoot
+Infrastructure  delete        FAILED   0s        timed out waiting
+
+1 passed, 1 failed, 1 skipped
+`))
+		})
+	})
+})
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupoptions"
+	"github.com/gardener/gardener/pkg/provider-local/controller/backupstore"
+	"github.com/gardener/gardener/pkg/provider-local/local"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	. "github.com/gardener/gardener/test/e2e"
+	. "github.com/gardener/gardener/test/e2e/gardener"
+)
+
+var _ = Describe("Seed Tests", Label("Seed", "default"), func() {
+	Describe("Retention of etcd Backups", Ordered, func() {
+		const (
+			mountPath = "/backupbuckets"
+			// snapshotKey is the key of a snapshot as written by etcd-backup-restore.
+			snapshotKey = "e2e/v2/Full-00000000-00000001-1700000000"
+		)
+
+		var (
+			s            *SeedContext
+			name         string
+			secret       *corev1.Secret
+			backupBucket *extensionsv1alpha1.BackupBucket
+			pod          *corev1.Pod
+		)
+
+		BeforeTestSetup(func() {
+			testContext := NewTestContext()
+
+			seedList := &gardencorev1beta1.SeedList{}
+			if err := testContext.GardenClient.List(context.Background(), seedList); err != nil {
+				testContext.Log.Error(err, "Failed to list seeds")
+				Fail(err.Error())
+			}
+
+			seedIndex := slices.IndexFunc(seedList.Items, func(item gardencorev1beta1.Seed) bool {
+				return item.Name != DefaultManagedSeedName()
+			})
+
+			if seedIndex == -1 {
+				Fail("failed to find applicable seed")
+			}
+
+			s = testContext.ForSeed(&seedList.Items[seedIndex])
+			ItShouldInitializeSeedClient(s)
+
+			name = "e2e-retention-" + utils.ComputeSHA256Hex([]byte(uuid.NewUUID()))[:8]
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: v1beta1constants.GardenNamespace,
+				},
+			}
+			backupBucket = &extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: extensionsv1alpha1.BackupBucketSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						Type: local.Type,
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+  "apiVersion": "local.provider.extensions.gardener.cloud/v1alpha1",
+  "kind": "BackupBucketConfig",
+  "immutability": {"retentionType": "bucket", "retentionPeriod": "3m"}
+}`)},
+					},
+					Region:    "local",
+					SecretRef: corev1.SecretReference{Name: secret.Name, Namespace: secret.Namespace},
+				},
+			}
+		})
+
+		It("Create immutable BackupBucket", func(ctx SpecContext) {
+			Eventually(ctx, func() error {
+				return s.SeedClient.Create(ctx, secret)
+			}).Should(Succeed())
+
+			Eventually(ctx, func() error {
+				return s.SeedClient.Create(ctx, backupBucket)
+			}).Should(Succeed())
+
+			Eventually(ctx, func(g Gomega) {
+				g.Expect(s.SeedKomega.Get(backupBucket)()).To(Succeed())
+				g.Expect(health.CheckExtensionObject(backupBucket)).To(Succeed())
+			}).Should(Succeed())
+		}, SpecTimeout(2*time.Minute))
+
+		It("Write snapshot to BackupBucket", func(ctx SpecContext) {
+			image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameAlpineConntrack)
+			Expect(err).NotTo(HaveOccurred())
+
+			// The pod mounts the directory containing all buckets, i.e., it can also observe the retention directory which
+			// is not accessible for etcd-backup-restore.
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: v1beta1constants.GardenNamespace,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:    "backup",
+						Image:   image.String(),
+						Command: []string{"sh", "-c", "mkdir -p " + filepath.Dir(filepath.Join(mountPath, name, snapshotKey)) + " && echo snapshot > " + filepath.Join(mountPath, name, snapshotKey) + " && sleep infinity"},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      "backupbuckets",
+							MountPath: mountPath,
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: "backupbuckets",
+						VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+							Path: backupoptions.DefaultContainerMountPath,
+							Type: ptr.To(corev1.HostPathDirectory),
+						}},
+					}},
+					TerminationGracePeriodSeconds: ptr.To[int64](0),
+				},
+			}
+
+			Eventually(ctx, func() error {
+				return s.SeedClient.Create(ctx, pod)
+			}).Should(Succeed())
+
+			Eventually(ctx, s.SeedKomega.Object(pod)).Should(HaveField("Status.Phase", Equal(corev1.PodRunning)))
+		}, SpecTimeout(2*time.Minute))
+
+		It("Wait until the snapshot is protected", func(ctx SpecContext) {
+			Eventually(ctx, func() error {
+				_, err := readFile(ctx, s, pod, filepath.Join(mountPath, backupstore.RetentionDirectoryName, name, snapshotKey))
+				return err
+			}).Should(Succeed())
+		}, SpecTimeout(time.Minute))
+
+		It("Restore the snapshot deleted by the garbage collection of etcd-backup-restore", func(ctx SpecContext) {
+			// etcd-backup-restore deletes snapshots from the mounted bucket directory directly.
+			Eventually(ctx, func() error {
+				_, _, err := s.SeedClientSet.PodExecutor().Execute(ctx, pod.Namespace, pod.Name, "backup", "rm", filepath.Join(mountPath, name, snapshotKey))
+				return err
+			}).Should(Succeed())
+
+			Eventually(ctx, func(g Gomega) {
+				g.Expect(readFile(ctx, s, pod, filepath.Join(mountPath, name, snapshotKey))).To(Equal("snapshot\n"))
+			}).Should(Succeed())
+		}, SpecTimeout(time.Minute))
+
+		It("Delete Pod", func(ctx SpecContext) {
+			Eventually(ctx, func() error {
+				return s.SeedClient.Delete(ctx, pod)
+			}).Should(Succeed())
+
+			Eventually(ctx, s.SeedKomega.Get(pod)).Should(BeNotFoundError())
+		}, SpecTimeout(time.Minute))
+
+		It("Do not delete BackupBucket while it contains retained snapshots", func(ctx SpecContext) {
+			Eventually(ctx, func() error {
+				return s.SeedClient.Delete(ctx, backupBucket)
+			}).Should(Succeed())
+
+			Eventually(ctx, s.SeedKomega.Object(backupBucket)).Should(
+				HaveField("Status.LastError.Description", ContainSubstring("objects under retention")),
+			)
+		}, SpecTimeout(time.Minute))
+
+		It("Delete BackupBucket after the retention period has passed", func(ctx SpecContext) {
+			Eventually(ctx, s.SeedKomega.Get(backupBucket)).WithPolling(10 * time.Second).Should(BeNotFoundError())
+
+			Eventually(ctx, func() error {
+				return s.SeedClient.Delete(ctx, secret)
+			}).Should(Or(Succeed(), BeNotFoundError()))
+		}, SpecTimeout(5*time.Minute))
+	})
+})
+
+func readFile(ctx context.Context, s *SeedContext, pod *corev1.Pod, path string) (string, error) {
+	stdout, _, err := s.SeedClientSet.PodExecutor().Execute(ctx, pod.Namespace, pod.Name, "backup", "cat", path)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := io.ReadAll(stdout)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"time"
+
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	. "github.com/gardener/gardener/test/e2e/gardener"
+	"github.com/gardener/gardener/test/e2e/gardener/seed"
+)
+
+var _ = Describe("Shoot Tests", Label("Shoot", "default"), func() {
+	test := func(s *ShootContext) {
+		ItShouldCreateShoot(s)
+		ItShouldWaitForShootToBeReconciledAndHealthy(s)
+		ItShouldInitializeShootClient(s)
+		ItShouldGetResponsibleSeed(s)
+		seed.ItShouldInitializeSeedClient(&s.SeedContext)
+
+		var (
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "e2e-clone", Namespace: metav1.NamespaceDefault},
+				Data:       map[string][]byte{"foo": []byte("bar")},
+			}
+			clone = NewTestContext().ForShoot(&gardencorev1beta1.Shoot{})
+		)
+
+		It("Create Secret in source Shoot", func(ctx SpecContext) {
+			// Secrets are encrypted in the etcd, i.e., the cloned Shoot can only read it if it took over the etcd
+			// encryption key of the source.
+			Eventually(ctx, func() error {
+				return s.ShootClient.Create(ctx, secret)
+			}).Should(Succeed())
+		}, SpecTimeout(time.Minute))
+
+		It("Wait for the Secret to be contained in a delta snapshot of the source", func(ctx SpecContext) {
+			lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{
+				Name:      druidcorev1alpha1.GetDeltaSnapshotLeaseName(metav1.ObjectMeta{Name: v1beta1constants.ETCDMain}),
+				Namespace: s.Shoot.Status.TechnicalID,
+			}}
+
+			Eventually(ctx, s.SeedKomega.Object(lease)).Should(
+				HaveField("Spec.RenewTime.Time", BeTemporally(">", secret.CreationTimestamp.Time)),
+			)
+		}, SpecTimeout(10*time.Minute))
+
+		It("Clone Shoot with restore from backup", func(ctx SpecContext) {
+			cloneRequest := &operationsv1alpha1.CloneRequest{
+				Spec: operationsv1alpha1.CloneRequestSpec{
+					Name:              s.Shoot.Name + "-cl",
+					RestoreFromBackup: true,
+				},
+			}
+
+			Eventually(ctx, func() error {
+				return s.GardenClient.SubResource("clone").Create(ctx, s.Shoot, cloneRequest)
+			}).Should(Succeed())
+
+			clone.Shoot.Name, clone.Shoot.Namespace = cloneRequest.Spec.Name, s.Shoot.Namespace
+			clone.Log = clone.Log.WithValues("shoot", client.ObjectKeyFromObject(clone.Shoot))
+			Eventually(ctx, clone.GardenKomega.Get(clone.Shoot)).Should(Succeed())
+		}, SpecTimeout(time.Minute))
+
+		ItShouldWaitForShootToBeReconciledAndHealthy(clone)
+		ItShouldInitializeShootClient(clone)
+
+		It("Read Secret in cloned Shoot", func(ctx SpecContext) {
+			restored := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secret.Name, Namespace: secret.Namespace}}
+			Eventually(ctx, clone.ShootKomega.Object(restored)).Should(HaveField("Data", Equal(secret.Data)))
+		}, SpecTimeout(time.Minute))
+
+		ItShouldDeleteShoot(clone)
+		ItShouldWaitForShootToBeDeleted(clone)
+		ItShouldDeleteShoot(s)
+		ItShouldWaitForShootToBeDeleted(s)
+	}
+
+	Context("Workerless Shoot", Label("workerless"), Ordered, func() {
+		test(NewTestContext().ForShoot(DefaultWorkerlessShoot("e2e-clone")))
+	})
+})
@@ -23,7 +23,6 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
-	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	sshutils "github.com/gardener/gardener/pkg/utils/ssh"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -76,17 +75,6 @@ func VerifyBastion(s *ShootContext) {
 			})
 		})
 
-		var nodeSSHKey []byte
-		It("should fetch the shoot SSH key", func(ctx SpecContext) {
-			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
-				Name:      gardenerutils.ComputeShootProjectResourceName(s.Shoot.Name, gardenerutils.ShootProjectSecretSuffixSSHKeypair),
-				Namespace: s.Shoot.Namespace,
-			}}
-			Eventually(ctx, s.GardenKomega.Get(secret)).Should(Succeed())
-
-			nodeSSHKey = secret.Data[secretsutils.DataKeyRSAPrivateKey]
-		}, SpecTimeout(time.Minute))
-
 		var nodeName, nodeAddrHostname, nodeAddrInternalIP string
 		It("should pick a shoot Node with Hostname and InternalIP", func(ctx SpecContext) {
 			var nodes []corev1.Node
@@ -157,6 +145,7 @@ func VerifyBastion(s *ShootContext) {
 					HaveField("Status", gardencorev1beta1.ConditionTrue),
 				))),
 				HaveField("Status.Ingress", Not(BeNil())),
+				HaveField("Status.SSHCertificate", Not(BeNil())),
 			))
 
 			bastionHost := bastion.Status.Ingress.IP
@@ -185,7 +174,7 @@ func VerifyBastion(s *ShootContext) {
 				var err error
 				nodeConnection, err = sshutils.Dial(ctx, addr,
 					sshutils.WithProxyConnection(bastionConnection),
-					sshutils.WithUser("gardener"), sshutils.WithPrivateKeyBytes(nodeSSHKey),
+					sshutils.WithUser("gardener"), sshutils.WithCertificate(bastionSSHKey, []byte(*bastion.Status.SSHCertificate)),
 				)
 				return err
 			}).Should(Succeed())
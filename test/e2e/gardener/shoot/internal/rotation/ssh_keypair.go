@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -20,12 +21,16 @@ import (
 	. "github.com/gardener/gardener/test/e2e/gardener"
 )
 
-// SSHKeypairVerifier verifies the ssh keypair rotation.
+// SSHKeypairVerifier verifies the ssh keypair rotation, including the rotation of the SSH certificate authority which is
+// rotated together with the ssh keypair.
 type SSHKeypairVerifier struct {
 	*ShootContext
 
 	oldKeypairData  map[string][]byte
 	old2KeypairData map[string][]byte
+
+	oldCAPublicKey  string
+	old2CAPublicKey string
 }
 
 // Before is called before the rotation is started.
@@ -69,6 +74,19 @@ func (v *SSHKeypairVerifier) Before(_ context.Context) {
 			}
 		}).Should(Succeed())
 	}, SpecTimeout(time.Minute))
+
+	It("Verify that current SSH CA public key(s) are trusted", func(ctx SpecContext) {
+		Eventually(ctx, func(g Gomega) {
+			trustedCAPublicKeys, err := v.readTrustedUserCAKeysFile(ctx)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(trustedCAPublicKeys).NotTo(BeEmpty())
+
+			v.oldCAPublicKey = trustedCAPublicKeys[0]
+			if len(trustedCAPublicKeys) > 1 {
+				v.old2CAPublicKey = trustedCAPublicKeys[1]
+			}
+		}).Should(Succeed(), "current SSH CA public key(s) should be trusted")
+	}, SpecTimeout(time.Minute))
 }
 
 // ExpectPreparingStatus is called while waiting for the Preparing status.
@@ -115,6 +133,16 @@ func (v *SSHKeypairVerifier) AfterPrepared(_ context.Context) {
 			}
 		}).Should(Succeed())
 	}, SpecTimeout(time.Minute))
+
+	It("Verify that new and old SSH CA public keys are trusted", func(ctx SpecContext) {
+		Eventually(ctx, func(g Gomega) {
+			trustedCAPublicKeys, err := v.readTrustedUserCAKeysFile(ctx)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(trustedCAPublicKeys).To(HaveLen(2))
+			g.Expect(trustedCAPublicKeys[0]).NotTo(Or(Equal(v.oldCAPublicKey), Equal(v.old2CAPublicKey)))
+			g.Expect(trustedCAPublicKeys[1]).To(Equal(v.oldCAPublicKey))
+		}).Should(Succeed(), "SSH CA should have been rotated")
+	}, SpecTimeout(time.Minute))
 }
 
 // ssh-keypair rotation is completed after one reconciliation (there is no second phase)
@@ -127,8 +155,29 @@ func (v *SSHKeypairVerifier) ExpectCompletingStatus(_ Gomega) {}
 func (v *SSHKeypairVerifier) AfterCompleted(_ context.Context) {}
 
 // Since we can't (and do not want ;-)) trying to really SSH into the machine pods from our test environment, we can
-// only check whether the `.ssh/authorized_keys` file on the worker nodes has the expected content.
+// only check whether the `.ssh/authorized_keys` file and the file with the trusted SSH CA public keys on the worker nodes
+// have the expected content.
 func (v *SSHKeypairVerifier) readAuthorizedKeysFile(ctx context.Context) (string, error) {
+	return v.readNodeFile(ctx, "/home/gardener/.ssh/authorized_keys")
+}
+
+func (v *SSHKeypairVerifier) readTrustedUserCAKeysFile(ctx context.Context) ([]string, error) {
+	result, err := v.readNodeFile(ctx, "/var/lib/gardener-user-trusted-user-ca-keys")
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, line := range strings.Split(result, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			keys = append(keys, line)
+		}
+	}
+
+	return keys, nil
+}
+
+func (v *SSHKeypairVerifier) readNodeFile(ctx context.Context, path string) (string, error) {
 	podList := &corev1.PodList{}
 	if err := v.SeedClient.List(ctx, podList, client.InNamespace(v.Shoot.Status.TechnicalID), client.MatchingLabels{
 		"app":              "machine",
@@ -146,7 +195,7 @@ func (v *SSHKeypairVerifier) readAuthorizedKeysFile(ctx context.Context) (string
 		v.Shoot.Status.TechnicalID,
 		podList.Items[0].Name,
 		"node",
-		"cat", "/home/gardener/.ssh/authorized_keys",
+		"cat", path,
 	)
 	if err != nil {
 		return "", err
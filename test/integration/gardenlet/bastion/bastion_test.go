@@ -6,11 +6,13 @@ package bastion_test
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"golang.org/x/crypto/ssh"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,6 +25,8 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	sshutils "github.com/gardener/gardener/pkg/utils/ssh"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -311,6 +315,50 @@ var _ = Describe("Bastion controller tests", func() {
 				g.Expect(operationsBastion.Status.ObservedGeneration).To(Equal(ptr.To(operationsBastion.Generation)))
 			}).Should(Succeed())
 		})
+
+		It("should issue an SSH certificate if the shoot has an SSH certificate authority", func() {
+			By("Create SSH certificate authority secret")
+			caConfig := &secretsutils.RSASecretConfig{Name: "ca-ssh", Bits: 2048, UsedForSSH: true}
+			caData, err := caConfig.Generate()
+			Expect(err).NotTo(HaveOccurred())
+
+			caSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ca-ssh-12345678",
+					Namespace: seedNamespace.Name,
+					Labels: map[string]string{
+						"name":             "ca-ssh",
+						"managed-by":       "secrets-manager",
+						"manager-identity": "gardenlet",
+						"issued-at-time":   strconv.FormatInt(fakeClock.Now().Unix(), 10),
+					},
+				},
+				Data: caData.SecretData(),
+			}
+			Expect(testClient.Create(ctx, caSecret)).To(Succeed())
+
+			Eventually(func() error {
+				return mgrClient.Get(ctx, client.ObjectKeyFromObject(caSecret), &corev1.Secret{})
+			}).Should(Succeed())
+
+			reconcileExtensionBastion()
+
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(operationsBastion), operationsBastion)).To(Succeed())
+				g.Expect(operationsBastion.Status.SSHCertificate).NotTo(BeNil())
+
+				certificate, err := sshutils.ParseUserCertificate([]byte(*operationsBastion.Status.SSHCertificate))
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(certificate.ValidPrincipals).To(ConsistOf("gardener-user:unknown"))
+				g.Expect(certificate.CriticalOptions).To(HaveKeyWithValue("source-address", "1.2.3.4/32"))
+				g.Expect(certificate.Extensions).To(Equal(map[string]string{"permit-pty": ""}))
+				g.Expect(certificate.ValidBefore).To(BeNumerically("==", operationsBastion.Status.ExpirationTimestamp.Unix()))
+
+				caPublicKey, _, _, _, err := ssh.ParseAuthorizedKey(caSecret.Data["id_rsa.pub"])
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(certificate.SignatureKey.Marshal()).To(Equal(caPublicKey.Marshal()))
+			}).Should(Succeed())
+		})
 	})
 
 	Context("deletion", func() {
@@ -30,8 +30,9 @@
 		Rotate ssh keypair for a shoot cluster.
 		Annotate Shoot with "gardener.cloud/operation" = "rotate-ssh-keypair".
 	Expected Output
-		- Current ssh-keypair should be rotated.
-		- Current ssh-keypair should be kept in the system post rotation.
+		- The ssh keypair and the SSH certificate authority trusted by the worker nodes should be rotated.
+		- Unless the SSHCertificateOnlyAccess feature gate is enabled, the current ssh-keypair should be rotated and kept
+		  in the system post rotation.
 
  **/
 
@@ -44,6 +45,7 @@ import (
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -116,11 +118,26 @@ var _ = ginkgo.Describe("Shoot operation testing", func() {
 	}, reconcileTimeout)
 
 	f.Beta().Serial().CIt("should rotate the ssh keypair for a shoot cluster", func(ctx context.Context) {
+		var preRotationInitiationTime *metav1.Time
+		if rotation := sshKeypairRotation(f.Shoot); rotation != nil {
+			preRotationInitiationTime = rotation.LastInitiationTime
+		}
+
+		// The ssh-keypair secret is not published if the SSHCertificateOnlyAccess feature gate is enabled in gardenlet.
 		secret := &corev1.Secret{}
-		gomega.Expect(f.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: f.Shoot.Namespace, Name: gardenerutils.ComputeShootProjectResourceName(f.Shoot.Name, gardenerutils.ShootProjectSecretSuffixSSHKeypair)}, secret)).To(gomega.Succeed())
-		preRotationPrivateKey := getKeyAndValidate(secret, secrets.DataKeyRSAPrivateKey)
-		preRotationPublicKey := getKeyAndValidate(secret, secrets.DataKeySSHAuthorizedKeys)
-		err := f.UpdateShoot(ctx, func(s *gardencorev1beta1.Shoot) error {
+		err := f.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: f.Shoot.Namespace, Name: gardenerutils.ComputeShootProjectResourceName(f.Shoot.Name, gardenerutils.ShootProjectSecretSuffixSSHKeypair)}, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			framework.ExpectNoError(err)
+		}
+		sshKeypairPublished := err == nil
+
+		var preRotationPrivateKey, preRotationPublicKey []byte
+		if sshKeypairPublished {
+			preRotationPrivateKey = getKeyAndValidate(secret, secrets.DataKeyRSAPrivateKey)
+			preRotationPublicKey = getKeyAndValidate(secret, secrets.DataKeySSHAuthorizedKeys)
+		}
+
+		err = f.UpdateShoot(ctx, func(s *gardencorev1beta1.Shoot) error {
 			metav1.SetMetaDataAnnotation(&s.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationRotateSSHKeypair)
 			return nil
 		})
@@ -132,6 +149,19 @@ var _ = ginkgo.Describe("Shoot operation testing", func() {
 			gomega.Expect(v).NotTo(gomega.Equal(v1beta1constants.ShootOperationRotateSSHKeypair))
 		}
 
+		postRotation := sshKeypairRotation(f.Shoot)
+		gomega.Expect(postRotation).NotTo(gomega.BeNil())
+		gomega.Expect(postRotation.LastInitiationTime).NotTo(gomega.BeNil())
+		gomega.Expect(postRotation.LastCompletionTime).NotTo(gomega.BeNil())
+		if preRotationInitiationTime != nil {
+			gomega.Expect(postRotation.LastInitiationTime.After(preRotationInitiationTime.Time)).To(gomega.BeTrue())
+		}
+		gomega.Expect(postRotation.LastCompletionTime.After(postRotation.LastInitiationTime.Time)).To(gomega.BeTrue())
+
+		if !sshKeypairPublished {
+			return
+		}
+
 		gomega.Expect(f.GardenClient.Client().Get(ctx, client.ObjectKey{Namespace: f.Shoot.Namespace, Name: gardenerutils.ComputeShootProjectResourceName(f.Shoot.Name, gardenerutils.ShootProjectSecretSuffixSSHKeypair)}, secret)).To(gomega.Succeed())
 		postRotationPrivateKey := getKeyAndValidate(secret, secrets.DataKeyRSAPrivateKey)
 		postRotationPublicKey := getKeyAndValidate(secret, secrets.DataKeySSHAuthorizedKeys)
@@ -144,7 +174,6 @@ var _ = ginkgo.Describe("Shoot operation testing", func() {
 		gomega.Expect(preRotationPublicKey).NotTo(gomega.Equal(postRotationPublicKey))
 		gomega.Expect(preRotationPrivateKey).To(gomega.Equal(postRotationOldPrivateKey))
 		gomega.Expect(preRotationPublicKey).To(gomega.Equal(postRotationOldPublicKey))
-
 	}, reconcileTimeout)
 })
 
@@ -154,3 +183,10 @@ func getKeyAndValidate(s *corev1.Secret, field string) []byte {
 	gomega.Expect(v).ToNot(gomega.BeEmpty())
 	return v
 }
+
+func sshKeypairRotation(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.ShootSSHKeypairRotation {
+	if shoot.Status.Credentials == nil || shoot.Status.Credentials.Rotation == nil {
+		return nil
+	}
+	return shoot.Status.Credentials.Rotation.SSHKeypair
+}